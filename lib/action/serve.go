@@ -0,0 +1,113 @@
+package action
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/server"
+)
+
+// Serve runs a server that accepts connections from PostgreSQL clients on the address.
+// Each connection is processed in its own session and transaction initialized by the initialize function.
+//
+// Clients connecting from the trusted hosts, a comma-separated list of IP addresses or CIDR notations,
+// are accepted without passwords, and the other clients are required the password if it is not empty.
+func Serve(ctx context.Context, proc *query.Processor, address string, password string, trustedHosts string, initialize func(context.Context, *query.Processor) error) error {
+	hosts, err := parseTrustedHosts(trustedHosts)
+	if err != nil {
+		return err
+	}
+
+	listener, err := listen(proc, address)
+	if err != nil {
+		return err
+	}
+	auth := server.PostgresAuth{
+		Password:     password,
+		TrustedHosts: hosts,
+	}
+	return server.NewPostgresServer(processorFactory(initialize), auth, proc.LogError).Serve(ctx, listener)
+}
+
+// ServeHTTP runs a server that accepts HTTP requests on the address.
+// Each request is processed in its own session and transaction initialized by the initialize function.
+// If the token is not empty, requests are required to have the token as a bearer token.
+func ServeHTTP(ctx context.Context, proc *query.Processor, address string, timeout time.Duration, token string, initialize func(context.Context, *query.Processor) error) error {
+	listener, err := listen(proc, address)
+	if err != nil {
+		return err
+	}
+	return server.NewHTTPServer(processorFactory(initialize), timeout, token, proc.LogError).Serve(ctx, listener)
+}
+
+func parseTrustedHosts(s string) ([]*net.IPNet, error) {
+	var hosts []*net.IPNet
+	for _, host := range strings.Split(s, ",") {
+		host = strings.TrimSpace(host)
+		if len(host) < 1 {
+			continue
+		}
+
+		if strings.Contains(host, "/") {
+			_, n, err := net.ParseCIDR(host)
+			if err != nil {
+				return nil, query.NewIncorrectCommandUsageError("invalid trusted host " + host)
+			}
+			hosts = append(hosts, n)
+			continue
+		}
+
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return nil, query.NewIncorrectCommandUsageError("invalid trusted host " + host)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+			bits = 8 * net.IPv4len
+		}
+		hosts = append(hosts, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return hosts, nil
+}
+
+func listen(proc *query.Processor, address string) (net.Listener, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, query.NewIOError(nil, err.Error())
+	}
+
+	proc.Log("Listening on "+listener.Addr().String(), false)
+	return listener, nil
+}
+
+func processorFactory(initialize func(context.Context, *query.Processor) error) server.ProcessorFactory {
+	return func(ctx context.Context) (*query.Processor, error) {
+		session := query.NewSession()
+		session.SetStdout(query.NewDiscard())
+		session.SetStderr(query.NewDiscard())
+		if err := session.SetStdin(nil); err != nil {
+			return nil, err
+		}
+
+		tx, err := query.NewTransaction(ctx, file.DefaultWaitTimeout, file.DefaultRetryDelay, session)
+		if err != nil {
+			return nil, err
+		}
+		_ = tx.SetFlag(cmd.QuietFlag, true)
+
+		p := query.NewProcessor(tx)
+		if initialize != nil {
+			if err = initialize(ctx, p); err != nil {
+				_ = p.ReleaseResourcesWithErrors()
+				return nil, err
+			}
+		}
+		return p, nil
+	}
+}
@@ -0,0 +1,65 @@
+package action
+
+import (
+	"reflect"
+	"testing"
+)
+
+var parseTrustedHostsTests = []struct {
+	Name   string
+	Input  string
+	Result []string
+	Error  string
+}{
+	{
+		Name:   "IP Addresses",
+		Input:  "127.0.0.1, ::1",
+		Result: []string{"127.0.0.1/32", "::1/128"},
+	},
+	{
+		Name:   "CIDR Notations",
+		Input:  "192.168.0.0/16,fd00::/8",
+		Result: []string{"192.168.0.0/16", "fd00::/8"},
+	},
+	{
+		Name:   "Empty",
+		Input:  "",
+		Result: nil,
+	},
+	{
+		Name:  "Invalid Host",
+		Input: "127.0.0.1,localhost",
+		Error: "incorrect usage: invalid trusted host localhost",
+	},
+	{
+		Name:  "Invalid CIDR Notation",
+		Input: "192.168.0.0/33",
+		Error: "incorrect usage: invalid trusted host 192.168.0.0/33",
+	},
+}
+
+func TestParseTrustedHosts(t *testing.T) {
+	for _, v := range parseTrustedHostsTests {
+		hosts, err := parseTrustedHosts(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		var result []string
+		for _, h := range hosts {
+			result = append(result, h.String())
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
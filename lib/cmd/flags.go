@@ -8,7 +8,6 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
-	"time"
 
 	"github.com/mithrandie/go-text"
 	txjson "github.com/mithrandie/go-text/json"
@@ -27,6 +26,7 @@ const (
 	TimezoneFlag                 = "TIMEZONE"
 	DatetimeFormatFlag           = "DATETIME_FORMAT"
 	AnsiQuotesFlag               = "ANSI_QUOTES"
+	ExactDecimalFlag             = "EXACT_DECIMAL"
 	WaitTimeoutFlag              = "WAIT_TIMEOUT"
 	ImportFormatFlag             = "IMPORT_FORMAT"
 	DelimiterFlag                = "DELIMITER"
@@ -43,6 +43,7 @@ const (
 	WithoutHeaderFlag            = "WITHOUT_HEADER"
 	LineBreakFlag                = "LINE_BREAK"
 	EncloseAllFlag               = "ENCLOSE_ALL"
+	ArraySeparatorFlag           = "ARRAY_SEPARATOR"
 	JsonEscapeFlag               = "JSON_ESCAPE"
 	PrettyPrintFlag              = "PRETTY_PRINT"
 	EastAsianEncodingFlag        = "EAST_ASIAN_ENCODING"
@@ -53,6 +54,7 @@ const (
 	LimitRecursion               = "LIMIT_RECURSION"
 	CPUFlag                      = "CPU"
 	StatsFlag                    = "STATS"
+	StreamingFlag                = "STREAMING"
 )
 
 var FlagList = []string{
@@ -60,6 +62,7 @@ var FlagList = []string{
 	TimezoneFlag,
 	DatetimeFormatFlag,
 	AnsiQuotesFlag,
+	ExactDecimalFlag,
 	WaitTimeoutFlag,
 	ImportFormatFlag,
 	DelimiterFlag,
@@ -76,6 +79,7 @@ var FlagList = []string{
 	WithoutHeaderFlag,
 	LineBreakFlag,
 	EncloseAllFlag,
+	ArraySeparatorFlag,
 	JsonEscapeFlag,
 	PrettyPrintFlag,
 	EastAsianEncodingFlag,
@@ -86,6 +90,7 @@ var FlagList = []string{
 	LimitRecursion,
 	CPUFlag,
 	StatsFlag,
+	StreamingFlag,
 }
 
 type Format int
@@ -97,20 +102,24 @@ const (
 	FIXED
 	JSON
 	LTSV
+	PARQUET
+	XLSX
 	GFM
 	ORG
 	TEXT
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	TEXT:  "TEXT",
+	CSV:     "CSV",
+	TSV:     "TSV",
+	FIXED:   "FIXED",
+	JSON:    "JSON",
+	LTSV:    "LTSV",
+	PARQUET: "PARQUET",
+	XLSX:    "XLSX",
+	GFM:     "GFM",
+	ORG:     "ORG",
+	TEXT:    "TEXT",
 }
 
 func (f Format) String() string {
@@ -123,6 +132,8 @@ var ImportFormats = []Format{
 	FIXED,
 	JSON,
 	LTSV,
+	PARQUET,
+	XLSX,
 }
 
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
@@ -140,6 +151,8 @@ const (
 	TsvExt      = ".tsv"
 	JsonExt     = ".json"
 	LtsvExt     = ".ltsv"
+	ParquetExt  = ".parquet"
+	XlsxExt     = ".xlsx"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
 	SqlExt      = ".sql"
@@ -153,6 +166,8 @@ type ImportOptions struct {
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	SheetName          string
+	CellRange          string
 	Encoding           text.Encoding
 	NoHeader           bool
 	WithoutNull        bool
@@ -177,6 +192,8 @@ func NewImportOptions() ImportOptions {
 		DelimiterPositions: nil,
 		SingleLine:         false,
 		JsonQuery:          "",
+		SheetName:          "",
+		CellRange:          "",
 		Encoding:           text.AUTO,
 		NoHeader:           false,
 		WithoutNull:        false,
@@ -193,6 +210,7 @@ type ExportOptions struct {
 	WithoutHeader        bool
 	LineBreak            text.LineBreak
 	EncloseAll           bool
+	ArraySeparator       string
 	JsonEscape           txjson.EscapeType
 	PrettyPrint          bool
 
@@ -227,6 +245,7 @@ func NewExportOptions() ExportOptions {
 		WithoutHeader:        false,
 		LineBreak:            text.LF,
 		EncloseAll:           false,
+		ArraySeparator:       "",
 		JsonEscape:           txjson.Backslash,
 		PrettyPrint:          false,
 		EastAsianEncoding:    false,
@@ -242,6 +261,7 @@ type Flags struct {
 	Location       string
 	DatetimeFormat []string
 	AnsiQuotes     bool
+	ExactDecimal   bool
 
 	WaitTimeout float64
 
@@ -256,6 +276,7 @@ type Flags struct {
 	LimitRecursion int64
 	CPU            int
 	Stats          bool
+	Streaming      bool
 }
 
 func GetDefaultNumberOfCPU() int {
@@ -282,6 +303,7 @@ func NewFlags(env *Environment) *Flags {
 		Location:       "Local",
 		DatetimeFormat: datetimeFormat,
 		AnsiQuotes:     false,
+		ExactDecimal:   false,
 		WaitTimeout:    10,
 		ImportOptions:  NewImportOptions(),
 		ExportOptions:  NewExportOptions(),
@@ -289,6 +311,7 @@ func NewFlags(env *Environment) *Flags {
 		LimitRecursion: 1000,
 		CPU:            GetDefaultNumberOfCPU(),
 		Stats:          false,
+		Streaming:      false,
 	}
 }
 
@@ -322,9 +345,9 @@ func (f *Flags) SetLocation(s string) error {
 		s = "UTC"
 	}
 
-	loc, err := time.LoadLocation(s)
+	loc, err := LoadLocation(s)
 	if err != nil {
-		return errors.New(fmt.Sprintf("timezone %q does not exist", s))
+		return err
 	}
 
 	f.Location = s
@@ -351,6 +374,10 @@ func (f *Flags) SetAnsiQuotes(b bool) {
 	f.AnsiQuotes = b
 }
 
+func (f *Flags) SetExactDecimal(b bool) {
+	f.ExactDecimal = b
+}
+
 func (f *Flags) SetWaitTimeout(t float64) {
 	if t < 0 {
 		t = 0
@@ -363,16 +390,16 @@ func (f *Flags) SetWaitTimeout(t float64) {
 func (f *Flags) SetImportFormat(s string) error {
 	fm, _, err := ParseFormat(s, f.ExportOptions.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, LTSV:
+	case CSV, TSV, FIXED, JSON, LTSV, PARQUET, XLSX:
 		f.ImportOptions.Format = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -445,6 +472,10 @@ func (f *Flags) SetFormat(s string, outfile string) error {
 			fm = JSON
 		case LtsvExt:
 			fm = LTSV
+		case ParquetExt:
+			fm = PARQUET
+		case XlsxExt:
+			fm = XLSX
 		case GfmExt:
 			fm = GFM
 		case OrgExt:
@@ -547,6 +578,10 @@ func (f *Flags) SetEncloseAll(b bool) {
 	f.ExportOptions.EncloseAll = b
 }
 
+func (f *Flags) SetArraySeparator(s string) {
+	f.ExportOptions.ArraySeparator = s
+}
+
 func (f *Flags) SetColor(b bool) {
 	f.ExportOptions.Color = b
 }
@@ -589,3 +624,7 @@ func (f *Flags) SetCPU(i int) {
 func (f *Flags) SetStats(b bool) {
 	f.Stats = b
 }
+
+func (f *Flags) SetStreaming(b bool) {
+	f.Streaming = b
+}
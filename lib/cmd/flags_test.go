@@ -140,6 +140,15 @@ func TestFlags_SetAnsiQuotes(t *testing.T) {
 	}
 }
 
+func TestFlags_SetExactDecimal(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetExactDecimal(true)
+	if !flags.ExactDecimal {
+		t.Errorf("exact_decimal = %t, expect to set %t", flags.ExactDecimal, true)
+	}
+}
+
 func TestFlags_SetWaitTimeout(t *testing.T) {
 	flags := NewFlags(nil)
 
@@ -169,7 +178,17 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportOptions.Format, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV"
+	_ = flags.SetImportFormat("parquet")
+	if flags.ImportOptions.Format != PARQUET {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, PARQUET, "parquet")
+	}
+
+	_ = flags.SetImportFormat("xlsx")
+	if flags.ImportOptions.Format != XLSX {
+		t.Errorf("importFormat = %s, expect to set %s for %s", flags.ImportOptions.Format, XLSX, "xlsx")
+	}
+
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -335,6 +354,16 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, LTSV, "foo.ltsv")
 	}
 
+	_ = flags.SetFormat("", "foo.parquet")
+	if flags.ExportOptions.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, PARQUET, "foo.parquet")
+	}
+
+	_ = flags.SetFormat("", "foo.xlsx")
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, XLSX, "foo.xlsx")
+	}
+
 	_ = flags.SetFormat("", "foo.md")
 	if flags.ExportOptions.Format != GFM {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, GFM, "foo.md")
@@ -370,6 +399,16 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, LTSV, "ltsv")
 	}
 
+	_ = flags.SetFormat("parquet", "")
+	if flags.ExportOptions.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, PARQUET, "parquet")
+	}
+
+	_ = flags.SetFormat("xlsx", "")
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, XLSX, "xlsx")
+	}
+
 	_ = flags.SetFormat("jsonh", "")
 	if flags.ExportOptions.Format != JSON {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, JSON, "jsonh")
@@ -401,7 +440,7 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT"
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX|GFM|ORG|TEXT"
 	err := flags.SetFormat("error", "")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -527,6 +566,15 @@ func TestFlags_SetEncloseAll(t *testing.T) {
 	}
 }
 
+func TestFlags_SetArraySeparator(t *testing.T) {
+	flags := NewFlags(nil)
+
+	flags.SetArraySeparator("|")
+	if flags.ExportOptions.ArraySeparator != "|" {
+		t.Errorf("array-separator = %q, expect to set %q", flags.ExportOptions.ArraySeparator, "|")
+	}
+}
+
 func TestFlags_SetJsonEscape(t *testing.T) {
 	flags := NewFlags(nil)
 
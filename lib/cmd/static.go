@@ -1,7 +1,11 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -12,6 +16,8 @@ var (
 
 	random  *rand.Rand
 	getRand sync.Once
+
+	locations sync.Map
 )
 
 func GetRand() *rand.Rand {
@@ -25,6 +31,71 @@ func GetLocation() *time.Location {
 	return location
 }
 
+// LoadLocation returns the location with the IANA time zone name such as "Asia/Tokyo",
+// "Local", "UTC", or the fixed offset from UTC such as "+09:00".
+// Loaded locations are cached.
+func LoadLocation(s string) (*time.Location, error) {
+	if len(s) < 1 || strings.EqualFold(s, "Local") {
+		return time.Local, nil
+	}
+	if strings.EqualFold(s, "UTC") {
+		return time.UTC, nil
+	}
+
+	if loc, ok := locations.Load(s); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(s)
+	if err != nil {
+		if loc = parseFixedZone(s); loc == nil {
+			return nil, errors.New(fmt.Sprintf("timezone %q does not exist", s))
+		}
+	}
+
+	locations.Store(s, loc)
+	return loc, nil
+}
+
+func parseFixedZone(s string) *time.Location {
+	if len(s) < 1 || (s[0] != '+' && s[0] != '-') {
+		return nil
+	}
+
+	var digits string
+	switch len(s) {
+	case 3, 5:
+		digits = s[1:]
+	case 6:
+		if s[3] != ':' {
+			return nil
+		}
+		digits = s[1:3] + s[4:]
+	default:
+		return nil
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || '9' < digits[i] {
+			return nil
+		}
+	}
+
+	h, _ := strconv.Atoi(digits[:2])
+	m := 0
+	if len(digits) == 4 {
+		m, _ = strconv.Atoi(digits[2:])
+	}
+	if 14 < h || 59 < m {
+		return nil
+	}
+
+	offset := h*3600 + m*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(fmt.Sprintf("%c%02d:%02d", s[0], h, m), offset)
+}
+
 func Now() time.Time {
 	if !TestTime.IsZero() {
 		return TestTime
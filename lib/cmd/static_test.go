@@ -23,6 +23,46 @@ func TestGetLocation(t *testing.T) {
 	}
 }
 
+func TestLoadLocation(t *testing.T) {
+	for _, v := range []struct {
+		Name   string
+		Result string
+		Offset int
+		Error  string
+	}{
+		{Name: "", Result: "Local"},
+		{Name: "utc", Result: "UTC"},
+		{Name: "Asia/Tokyo", Result: "Asia/Tokyo", Offset: 9 * 3600},
+		{Name: "+09:00", Result: "+09:00", Offset: 9 * 3600},
+		{Name: "-0530", Result: "-05:30", Offset: -(5*3600 + 30*60)},
+		{Name: "+01", Result: "+01:00", Offset: 3600},
+		{Name: "+25:00", Error: "timezone \"+25:00\" does not exist"},
+		{Name: "America/NotExist", Error: "timezone \"America/NotExist\" does not exist"},
+	} {
+		loc, err := LoadLocation(v.Name)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Name)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err, v.Error, v.Name)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Name)
+			continue
+		}
+		if loc.String() != v.Result {
+			t.Errorf("location = %s, want %s for %q", loc, v.Result, v.Name)
+		}
+		if v.Result != "Local" {
+			if _, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone(); offset != v.Offset {
+				t.Errorf("offset = %d, want %d for %q", offset, v.Offset, v.Name)
+			}
+		}
+	}
+}
+
 func TestNow(t *testing.T) {
 	TestTime, _ = time.ParseInLocation("2006-01-02 15:04:05.999999999", "2012-02-01 12:03:23", GetLocation())
 
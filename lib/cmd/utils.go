@@ -341,6 +341,10 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 	case "LTSV":
 		fm = LTSV
+	case "PARQUET":
+		fm = PARQUET
+	case "XLSX":
+		fm = XLSX
 	case "GFM":
 		fm = GFM
 	case "ORG":
@@ -354,7 +358,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX|GFM|ORG|TEXT")
 	}
 	return fm, et, nil
 }
@@ -0,0 +1,150 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// Conn implements driver.Conn.
+//
+// Each connection has its own transaction. Statements executed outside of transactions
+// started by Begin are committed automatically.
+type Conn struct {
+	proc          *query.Processor
+	inTransaction bool
+	closed        bool
+}
+
+func newConn(proc *query.Processor) *Conn {
+	return &Conn{
+		proc: proc,
+	}
+}
+
+func (c *Conn) Prepare(q string) (driver.Stmt, error) {
+	return c.prepare(q)
+}
+
+func (c *Conn) PrepareContext(_ context.Context, q string) (driver.Stmt, error) {
+	return c.prepare(q)
+}
+
+func (c *Conn) prepare(q string) (*Stmt, error) {
+	if c.closed {
+		return nil, driver.ErrBadConn
+	}
+
+	statements, holderNumber, err := parser.Parse(q, "", c.proc.Tx.Flags.DatetimeFormat, true, c.proc.Tx.Flags.AnsiQuotes)
+	if err != nil {
+		return nil, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	return &Stmt{
+		conn:         c,
+		statements:   statements,
+		holderNumber: holderNumber,
+	}, nil
+}
+
+func (c *Conn) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	err := c.proc.AutoRollback()
+	if e := c.proc.ReleaseResourcesWithErrors(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
+
+func (c *Conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *Conn) BeginTx(_ context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.closed {
+		return nil, driver.ErrBadConn
+	}
+	if c.inTransaction {
+		return nil, errors.New("transaction has already been started")
+	}
+	if opts.Isolation != driver.IsolationLevel(0) {
+		return nil, errors.New("isolation levels are not supported")
+	}
+
+	c.inTransaction = true
+	return &Tx{conn: c}, nil
+}
+
+func (c *Conn) ExecContext(ctx context.Context, q string, args []driver.NamedValue) (driver.Result, error) {
+	stmt, err := c.prepare(q)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.ExecContext(ctx, args)
+}
+
+func (c *Conn) QueryContext(ctx context.Context, q string, args []driver.NamedValue) (driver.Rows, error) {
+	stmt, err := c.prepare(q)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.QueryContext(ctx, args)
+}
+
+func (c *Conn) Ping(_ context.Context) error {
+	if c.closed {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+// execute executes the statements in the transaction of the connection, and returns the result sets of the select queries.
+func (c *Conn) execute(ctx context.Context, statements []parser.Statement, args []driver.NamedValue) ([]*query.View, int, error) {
+	if c.closed {
+		return nil, 0, driver.ErrBadConn
+	}
+
+	values, err := replaceValues(args)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ctx = query.ContextForPreparedStatement(query.ContextForStoringResults(ctx), values)
+
+	c.proc.Tx.AutoCommit = !c.inTransaction
+	if _, err = c.proc.Execute(ctx, statements); err != nil {
+		if !c.inTransaction {
+			_ = c.proc.AutoRollback()
+		}
+		return nil, 0, err
+	}
+	return c.proc.Tx.SelectedViews, c.proc.Tx.AffectedRows, nil
+}
+
+// Tx implements driver.Tx.
+type Tx struct {
+	conn *Conn
+}
+
+func (tx *Tx) Commit() error {
+	if !tx.conn.inTransaction {
+		return errors.New("transaction has already been finished")
+	}
+	tx.conn.inTransaction = false
+	return tx.conn.proc.Commit(context.Background(), nil)
+}
+
+func (tx *Tx) Rollback() error {
+	if !tx.conn.inTransaction {
+		return errors.New("transaction has already been finished")
+	}
+	tx.conn.inTransaction = false
+	return tx.conn.proc.Rollback(nil)
+}
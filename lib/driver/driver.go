@@ -0,0 +1,138 @@
+// Package driver provides a database/sql driver for csvq.
+//
+// The driver is registered as "csvq", and the data source name is the path of the repository
+// optionally followed by the flags as URL query parameters.
+//
+//	db, err := sql.Open("csvq", "/path/to/repo?timezone=UTC&ansi_quotes=true")
+//
+// The available parameters are "timezone", "datetime_format", "ansi_quotes" and "wait_timeout".
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// DriverName is the name with which the driver is registered.
+const DriverName = "csvq"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Driver implements driver.Driver and driver.DriverContext.
+type Driver struct{}
+
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	connector, err := d.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(context.Background())
+}
+
+func (d *Driver) OpenConnector(dsn string) (driver.Connector, error) {
+	repository, params, err := parseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Connector{
+		driver:     d,
+		repository: repository,
+		params:     params,
+	}, nil
+}
+
+// Connector implements driver.Connector.
+type Connector struct {
+	driver     *Driver
+	repository string
+	params     url.Values
+}
+
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	session := query.NewSession()
+	session.SetStdout(query.NewDiscard())
+	session.SetStderr(query.NewDiscard())
+	if err := session.SetStdin(nil); err != nil {
+		return nil, err
+	}
+
+	tx, err := query.NewTransaction(ctx, file.DefaultWaitTimeout, file.DefaultRetryDelay, session)
+	if err != nil {
+		return nil, err
+	}
+	if err = setFlags(tx, c.repository, c.params); err != nil {
+		return nil, err
+	}
+
+	return newConn(query.NewProcessor(tx)), nil
+}
+
+func (c *Connector) Driver() driver.Driver {
+	return c.driver
+}
+
+func parseDSN(dsn string) (string, url.Values, error) {
+	repository := dsn
+	var params url.Values
+
+	if i := strings.LastIndexByte(dsn, '?'); -1 < i {
+		repository = dsn[:i]
+
+		var err error
+		if params, err = url.ParseQuery(dsn[i+1:]); err != nil {
+			return "", nil, fmt.Errorf("invalid data source name: %s", err.Error())
+		}
+	}
+	return repository, params, nil
+}
+
+func setFlags(tx *query.Transaction, repository string, params url.Values) error {
+	_ = tx.SetFlag(cmd.QuietFlag, true)
+
+	if 0 < len(repository) {
+		if err := tx.SetFlag(cmd.RepositoryFlag, repository); err != nil {
+			return err
+		}
+	}
+
+	for key := range params {
+		val := params.Get(key)
+
+		var err error
+		switch key {
+		case "timezone":
+			err = tx.SetFlag(cmd.TimezoneFlag, val)
+		case "datetime_format":
+			err = tx.SetFlag(cmd.DatetimeFormatFlag, val)
+		case "ansi_quotes":
+			var b bool
+			if b, err = strconv.ParseBool(val); err == nil {
+				err = tx.SetFlag(cmd.AnsiQuotesFlag, b)
+			}
+		case "wait_timeout":
+			var f float64
+			if f, err = strconv.ParseFloat(val, 64); err == nil {
+				err = tx.SetFlag(cmd.WaitTimeoutFlag, f)
+			}
+		default:
+			err = errors.New("unknown parameter")
+		}
+		if err != nil {
+			return fmt.Errorf("invalid data source name: parameter %q: %s", key, err.Error())
+		}
+	}
+	return nil
+}
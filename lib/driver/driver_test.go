@@ -0,0 +1,267 @@
+package driver
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func openTestDB(t *testing.T, params string) (*sql.DB, string) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "table1.csv"), []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open(DriverName, dir+params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db, dir
+}
+
+var parseDSNTests = []struct {
+	DSN        string
+	Repository string
+	Params     map[string]string
+	Error      string
+}{
+	{
+		DSN:        "/path/to/repo",
+		Repository: "/path/to/repo",
+	},
+	{
+		DSN:        "/path/to/repo?timezone=UTC&ansi_quotes=true",
+		Repository: "/path/to/repo",
+		Params:     map[string]string{"timezone": "UTC", "ansi_quotes": "true"},
+	},
+	{
+		DSN:   "/path/to/repo?timezone=%zz",
+		Error: "invalid data source name: invalid URL escape \"%zz\"",
+	},
+}
+
+func TestParseDSN(t *testing.T) {
+	for _, v := range parseDSNTests {
+		repository, params, err := parseDSN(v.DSN)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.DSN, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.DSN, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.DSN, v.Error)
+			continue
+		}
+		if repository != v.Repository {
+			t.Errorf("%s: repository = %q, want %q", v.DSN, repository, v.Repository)
+		}
+		for key, val := range v.Params {
+			if params.Get(key) != val {
+				t.Errorf("%s: parameter %q = %q, want %q", v.DSN, key, params.Get(key), val)
+			}
+		}
+	}
+}
+
+var driverQueryTests = []struct {
+	Name   string
+	Query  string
+	Args   []interface{}
+	Result [][]interface{}
+	Error  string
+}{
+	{
+		Name:  "Query",
+		Query: "SELECT column1, column2 FROM table1 WHERE column1 < 3",
+		Result: [][]interface{}{
+			{"1", "str1"},
+			{"2", "str2"},
+		},
+	},
+	{
+		Name:  "Query with Positional Placeholders",
+		Query: "SELECT column2, ? * 2, ? FROM table1 WHERE column1 = 3",
+		Args:  []interface{}{1.25, true},
+		Result: [][]interface{}{
+			{"str3", 2.5, true},
+		},
+	},
+	{
+		Name:  "Query with Named Placeholders",
+		Query: "SELECT :n + 1, :s, NULL",
+		Args:  []interface{}{sql.Named("s", "abc"), sql.Named("n", 10)},
+		Result: [][]interface{}{
+			{int64(11), "abc", nil},
+		},
+	},
+	{
+		Name:  "Query with Datetime",
+		Query: "SELECT ?",
+		Args:  []interface{}{time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+		Result: [][]interface{}{
+			{time.Date(2012, 2, 3, 9, 18, 15, 0, time.UTC)},
+		},
+	},
+	{
+		Name:  "Syntax Error",
+		Query: "SELECT FROM",
+		Error: "[L:1 C:8] syntax error: unexpected token \"FROM\"",
+	},
+	{
+		Name:  "Query Error",
+		Query: "SELECT * FROM notexist",
+		Error: "[L:1 C:15] file notexist does not exist",
+	},
+}
+
+func TestDriver_Query(t *testing.T) {
+	db, _ := openTestDB(t, "?timezone=UTC")
+
+	for _, v := range driverQueryTests {
+		rows, err := db.Query(v.Query, v.Args...)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			_ = rows.Close()
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		columns, _ := rows.Columns()
+		var result [][]interface{}
+		for rows.Next() {
+			values := make([]interface{}, len(columns))
+			ptrs := make([]interface{}, len(columns))
+			for i := range values {
+				ptrs[i] = &values[i]
+			}
+			if err = rows.Scan(ptrs...); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+			result = append(result, values)
+		}
+		_ = rows.Close()
+
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestDriver_Scan(t *testing.T) {
+	db, _ := openTestDB(t, "")
+
+	var i int
+	var f float64
+	var b bool
+	var s string
+	var n sql.NullString
+	if err := db.QueryRow("SELECT 1, 1.5, TRUE, 'str', NULL").Scan(&i, &f, &b, &s, &n); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if i != 1 || f != 1.5 || !b || s != "str" || n.Valid {
+		t.Errorf("values = %v, %v, %v, %v, %v, want 1, 1.5, true, str, NULL", i, f, b, s, n)
+	}
+}
+
+func TestDriver_MultipleResultSets(t *testing.T) {
+	db, _ := openTestDB(t, "")
+
+	rows, err := db.Query("SELECT 1; SELECT 'a', 'b';")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var columns []int
+	for {
+		c, _ := rows.Columns()
+		columns = append(columns, len(c))
+		for rows.Next() {
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if !reflect.DeepEqual(columns, []int{1, 2}) {
+		t.Errorf("number of columns = %v, want %v", columns, []int{1, 2})
+	}
+}
+
+func TestDriver_Exec(t *testing.T) {
+	db, dir := openTestDB(t, "")
+	ctx := context.Background()
+
+	result, err := db.ExecContext(ctx, "INSERT INTO table1 VALUES (?, ?)", 4, "str4")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if n, _ := result.RowsAffected(); n != 1 {
+		t.Errorf("rows affected = %d, want %d", n, 1)
+	}
+
+	expect := "column1,column2\n1,str1\n2,str2\n3,str3\n4,str4\n"
+	contents, _ := os.ReadFile(filepath.Join(dir, "table1.csv"))
+	if string(contents) != expect {
+		t.Errorf("file contents = %q, want %q", string(contents), expect)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("DELETE FROM table1 WHERE column1 > 1"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	var count int
+	if err = tx.QueryRow("SELECT COUNT(*) FROM table1").Scan(&count); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if count != 1 {
+		t.Errorf("count in transaction = %d, want %d", count, 1)
+	}
+
+	if err = tx.Rollback(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	contents, _ = os.ReadFile(filepath.Join(dir, "table1.csv"))
+	if string(contents) != expect {
+		t.Errorf("file contents after rollback = %q, want %q", string(contents), expect)
+	}
+
+	tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec("UPDATE table1 SET column2 = :v WHERE column1 = 1", sql.Named("v", "updated")); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expect = "column1,column2\n1,updated\n2,str2\n3,str3\n4,str4\n"
+	contents, _ = os.ReadFile(filepath.Join(dir, "table1.csv"))
+	if string(contents) != expect {
+		t.Errorf("file contents after commit = %q, want %q", string(contents), expect)
+	}
+}
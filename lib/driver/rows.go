@@ -0,0 +1,132 @@
+package driver
+
+import (
+	"database/sql/driver"
+	"io"
+	"reflect"
+
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// Rows implements driver.Rows.
+//
+// If multiple select queries are executed, each of the result sets can be read by Rows.NextResultSet.
+type Rows struct {
+	views   []*query.View
+	current int
+	index   int
+}
+
+func newRows(views []*query.View) *Rows {
+	return &Rows{
+		views: views,
+	}
+}
+
+func (r *Rows) view() *query.View {
+	if len(r.views) <= r.current {
+		return nil
+	}
+	return r.views[r.current]
+}
+
+func (r *Rows) Columns() []string {
+	view := r.view()
+	if view == nil {
+		return []string{}
+	}
+
+	columns := make([]string, view.FieldLen())
+	for i := range view.Header {
+		columns[i] = view.Header[i].Column
+	}
+	return columns
+}
+
+func (r *Rows) Close() error {
+	r.views = nil
+	return nil
+}
+
+func (r *Rows) Next(dest []driver.Value) error {
+	view := r.view()
+	if view == nil || view.RecordLen() <= r.index {
+		return io.EOF
+	}
+
+	record := view.RecordSet[r.index]
+	for i := range dest {
+		dest[i] = driverValue(record[i][0])
+	}
+	r.index++
+	return nil
+}
+
+func (r *Rows) HasNextResultSet() bool {
+	return r.current+1 < len(r.views)
+}
+
+func (r *Rows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	r.current++
+	r.index = 0
+	return nil
+}
+
+// ColumnTypeScanType returns the type of the values in the column.
+// If the column has values of different types, then the type is interface{}.
+func (r *Rows) ColumnTypeScanType(index int) reflect.Type {
+	var t reflect.Type
+
+	view := r.view()
+	for i := range view.RecordSet {
+		v := driverValue(view.RecordSet[i][index][0])
+		if v == nil {
+			continue
+		}
+		if vt := reflect.TypeOf(v); t == nil {
+			t = vt
+		} else if t != vt {
+			t = nil
+			break
+		}
+	}
+
+	if t == nil {
+		return reflect.TypeOf((*interface{})(nil)).Elem()
+	}
+	return t
+}
+
+func driverValue(p value.Primary) driver.Value {
+	switch v := p.(type) {
+	case *value.String:
+		return v.Raw()
+	case *value.Integer:
+		return v.Raw()
+	case *value.Float:
+		return v.Raw()
+	case *value.Decimal:
+		return v.String()
+	case *value.Boolean:
+		return v.Raw()
+	case *value.Ternary:
+		if v.Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+		return v.Ternary().ParseBool()
+	case *value.Datetime:
+		return v.Raw()
+	case *value.Interval:
+		return v.String()
+	case *value.Array:
+		s, _, _ := query.ConvertFieldContents(v, false)
+		return s
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package driver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/mithrandie/ternary"
+)
+
+// Stmt implements driver.Stmt.
+//
+// Values are bound to positional placeholders such as "?" or "$1" in order,
+// and to named placeholders such as ":name" by the names of the arguments passed with sql.Named.
+type Stmt struct {
+	conn         *Conn
+	statements   []parser.Statement
+	holderNumber int
+}
+
+func (s *Stmt) Close() error {
+	return nil
+}
+
+func (s *Stmt) NumInput() int {
+	return s.holderNumber
+}
+
+func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	_, affectedRows, err := s.conn.execute(ctx, s.statements, args)
+	if err != nil {
+		return nil, err
+	}
+	return Result{affectedRows: int64(affectedRows)}, nil
+}
+
+func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	views, _, err := s.conn.execute(ctx, s.statements, args)
+	if err != nil {
+		return nil, err
+	}
+	return newRows(views), nil
+}
+
+// Result implements driver.Result.
+type Result struct {
+	affectedRows int64
+}
+
+func (r Result) LastInsertId() (int64, error) {
+	return 0, errors.New("LastInsertId is not supported")
+}
+
+func (r Result) RowsAffected() (int64, error) {
+	return r.affectedRows, nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	list := make([]driver.NamedValue, len(args))
+	for i := range args {
+		list[i] = driver.NamedValue{Ordinal: i + 1, Value: args[i]}
+	}
+	return list
+}
+
+func replaceValues(args []driver.NamedValue) (*query.ReplaceValues, error) {
+	replace := &query.ReplaceValues{
+		Values: make([]parser.QueryExpression, len(args)),
+		Names:  make(map[string]int, len(args)),
+	}
+
+	for i := range args {
+		v, err := queryValue(args[i].Value)
+		if err != nil {
+			return nil, err
+		}
+		replace.Values[i] = v
+		if 0 < len(args[i].Name) {
+			replace.Names[args[i].Name] = i
+		}
+	}
+	return replace, nil
+}
+
+func queryValue(v driver.Value) (parser.QueryExpression, error) {
+	switch val := v.(type) {
+	case nil:
+		return parser.NewNullValue(), nil
+	case int64:
+		return parser.NewIntegerValue(val), nil
+	case float64:
+		return parser.NewFloatValue(val), nil
+	case bool:
+		return parser.NewTernaryValue(ternary.ConvertFromBool(val)), nil
+	case string:
+		return parser.NewStringValue(val), nil
+	case []byte:
+		return parser.NewStringValue(string(val)), nil
+	case time.Time:
+		return parser.NewDatetimeValue(val), nil
+	}
+	return nil, fmt.Errorf("unsupported type %T", v)
+}
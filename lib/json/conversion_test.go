@@ -205,6 +205,26 @@ var convertTableValueToJsonStructureTests = []struct {
 	Expect json.Structure
 	Error  string
 }{
+	{
+		Fields: []string{
+			"column1",
+		},
+		Rows: [][]value.Primary{
+			{
+				value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewNull(), value.NewArray([]value.Primary{})}),
+			},
+		},
+		Expect: json.Array{
+			json.Object{
+				Members: []json.ObjectMember{
+					{
+						Key:   "column1",
+						Value: json.Array{json.Integer(1), json.String("a"), json.Null{}, json.Array{}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Fields: []string{
 			"column1",
@@ -85,6 +85,15 @@ func NewStringValue(s string) PrimitiveType {
 }
 
 func NewIntegerValueFromString(s string) PrimitiveType {
+	if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+		if d, ok := value.ParseDecimal(s); ok {
+			return PrimitiveType{
+				Literal: s,
+				Value:   d,
+			}
+		}
+	}
+
 	return PrimitiveType{
 		Literal: s,
 		Value:   value.NewIntegerFromString(s),
@@ -166,7 +175,7 @@ type Placeholder struct {
 }
 
 func (e Placeholder) String() string {
-	if len(e.Name) < 1 {
+	if len(e.Name) < 1 && e.Literal == "?" {
 		return fmt.Sprintf("%s{%d}", e.Literal, e.Ordinal)
 	}
 	return e.Literal
@@ -301,6 +310,8 @@ type SelectEntity struct {
 	WhereClause   QueryExpression
 	GroupByClause QueryExpression
 	HavingClause  QueryExpression
+	WindowClause  QueryExpression
+	QualifyClause QueryExpression
 }
 
 func (e SelectEntity) String() string {
@@ -320,6 +331,12 @@ func (e SelectEntity) String() string {
 	if e.HavingClause != nil {
 		s = append(s, e.HavingClause.String())
 	}
+	if e.WindowClause != nil {
+		s = append(s, e.WindowClause.String())
+	}
+	if e.QualifyClause != nil {
+		s = append(s, e.QualifyClause.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -385,6 +402,30 @@ func (gb GroupByClause) String() string {
 	return joinWithSpace(s)
 }
 
+type GroupingSets struct {
+	*BaseExpr
+	Type Token
+	Sets []QueryExpression
+}
+
+func (e GroupingSets) String() string {
+	s := []string{e.Type.String()}
+	if e.Type.Token == SETS {
+		s = []string{keyword(GROUPING), e.Type.String()}
+	}
+	s = append(s, putParentheses(listQueryExpressions(e.Sets)))
+	return joinWithSpace(s)
+}
+
+type GroupingSet struct {
+	*BaseExpr
+	Values []QueryExpression
+}
+
+func (e GroupingSet) String() string {
+	return putParentheses(listQueryExpressions(e.Values))
+}
+
 type HavingClause struct {
 	*BaseExpr
 	Filter QueryExpression
@@ -395,6 +436,16 @@ func (h HavingClause) String() string {
 	return joinWithSpace(s)
 }
 
+type QualifyClause struct {
+	*BaseExpr
+	Filter QueryExpression
+}
+
+func (q QualifyClause) String() string {
+	s := []string{keyword(QUALIFY), q.Filter.String()}
+	return joinWithSpace(s)
+}
+
 type OrderByClause struct {
 	*BaseExpr
 	Items []QueryExpression
@@ -525,6 +576,15 @@ func (e Subquery) String() string {
 	return putParentheses(e.Query.String())
 }
 
+type DataModifyingSubquery struct {
+	*BaseExpr
+	Query QueryExpression
+}
+
+func (e DataModifyingSubquery) String() string {
+	return putParentheses(e.Query.String())
+}
+
 type TableObject struct {
 	*BaseExpr
 	Type          Token
@@ -673,6 +733,26 @@ func (l Like) String() string {
 	return joinWithSpace(s)
 }
 
+type RegExp struct {
+	*BaseExpr
+	LHS      QueryExpression
+	Pattern  QueryExpression
+	Negation Token
+}
+
+func (r RegExp) IsNegated() bool {
+	return !r.Negation.IsEmpty()
+}
+
+func (r RegExp) String() string {
+	s := []string{r.LHS.String()}
+	if r.IsNegated() {
+		s = append(s, r.Negation.String())
+	}
+	s = append(s, keyword(REGEXP), r.Pattern.String())
+	return joinWithSpace(s)
+}
+
 type Exists struct {
 	*BaseExpr
 	Query Subquery
@@ -744,6 +824,25 @@ func (c Concat) String() string {
 	return strings.Join(s, " || ")
 }
 
+type ArrayConstructor struct {
+	*BaseExpr
+	Values []QueryExpression
+}
+
+func (e ArrayConstructor) String() string {
+	return keyword(ARRAY) + "[" + listQueryExpressions(e.Values) + "]"
+}
+
+type ArraySubscript struct {
+	*BaseExpr
+	Array QueryExpression
+	Index QueryExpression
+}
+
+func (e ArraySubscript) String() string {
+	return e.Array.String() + "[" + e.Index.String() + "]"
+}
+
 type Function struct {
 	*BaseExpr
 	Name string
@@ -772,6 +871,7 @@ type AggregateFunction struct {
 	Name     string
 	Distinct Token
 	Args     []QueryExpression
+	Filter   QueryExpression
 }
 
 func (e AggregateFunction) String() string {
@@ -781,13 +881,26 @@ func (e AggregateFunction) String() string {
 	}
 	s = append(s, listQueryExpressions(e.Args))
 
-	return strings.ToUpper(e.Name) + "(" + joinWithSpace(s) + ")"
+	fn := strings.ToUpper(e.Name) + "(" + joinWithSpace(s) + ")"
+	if e.Filter != nil {
+		fn = joinWithSpace([]string{fn, e.Filter.String()})
+	}
+	return fn
 }
 
 func (e AggregateFunction) IsDistinct() bool {
 	return e.Distinct.Token == DISTINCT
 }
 
+type FilterClause struct {
+	*BaseExpr
+	Filter QueryExpression
+}
+
+func (f FilterClause) String() string {
+	return keyword(FILTER) + " (" + joinWithSpace([]string{keyword(WHERE), f.Filter.String()}) + ")"
+}
+
 type Table struct {
 	*BaseExpr
 	Lateral Token
@@ -821,11 +934,23 @@ func tableName(expr QueryExpression) Identifier {
 		}
 	case TableObject:
 		obj, _ := expr.(TableObject)
+		if obj.Type.Token == SQLITE && 0 < len(obj.Args) {
+			if fr, ok := obj.Args[0].(FieldReference); ok && len(fr.View.Literal) < 1 {
+				return Identifier{
+					BaseExpr: fr.BaseExpr,
+					Literal:  fr.Column.Literal,
+				}
+			}
+		}
 		return tableName(obj.Path)
-	case JsonQuery, Subquery:
+	case JsonQuery, Subquery, DataModifyingSubquery, Unnest:
 		return Identifier{
 			BaseExpr: expr.GetBaseExpr(),
 		}
+	case Pivot:
+		return pivotSourceName(expr.(Pivot).Table)
+	case Unpivot:
+		return pivotSourceName(expr.(Unpivot).Table)
 	default:
 		return Identifier{
 			BaseExpr: expr.GetBaseExpr(),
@@ -834,6 +959,17 @@ func tableName(expr QueryExpression) Identifier {
 	}
 }
 
+func pivotSourceName(expr QueryExpression) Identifier {
+	if t, ok := expr.(Table); ok {
+		if _, isJoin := t.Object.(Join); !isJoin {
+			return t.Name()
+		}
+	}
+	return Identifier{
+		BaseExpr: expr.GetBaseExpr(),
+	}
+}
+
 func (t Table) Name() Identifier {
 	if t.Alias != nil {
 		return t.Alias.(Identifier)
@@ -887,6 +1023,37 @@ func (jc JoinCondition) String() string {
 	return joinWithSpace(s)
 }
 
+type Pivot struct {
+	*BaseExpr
+	Table     QueryExpression
+	Aggregate QueryExpression
+	For       QueryExpression
+	In        []QueryExpression
+	Any       Token
+}
+
+func (e Pivot) String() string {
+	in := e.Any.String()
+	if e.Any.IsEmpty() {
+		in = listQueryExpressions(e.In)
+	}
+	s := []string{e.Aggregate.String(), keyword(FOR), e.For.String(), keyword(IN), putParentheses(in)}
+	return joinWithSpace([]string{e.Table.String(), keyword(PIVOT), putParentheses(joinWithSpace(s))})
+}
+
+type Unpivot struct {
+	*BaseExpr
+	Table QueryExpression
+	Value Identifier
+	Name  Identifier
+	In    []QueryExpression
+}
+
+func (e Unpivot) String() string {
+	s := []string{e.Value.String(), keyword(FOR), e.Name.String(), keyword(IN), putParentheses(listQueryExpressions(e.In))}
+	return joinWithSpace([]string{e.Table.String(), keyword(UNPIVOT), putParentheses(joinWithSpace(s))})
+}
+
 type Field struct {
 	*BaseExpr
 	Object QueryExpression
@@ -926,6 +1093,15 @@ func (ac AllColumns) String() string {
 	return "*"
 }
 
+type Unnest struct {
+	*BaseExpr
+	Array QueryExpression
+}
+
+func (e Unnest) String() string {
+	return keyword(UNNEST) + putParentheses(e.Array.String())
+}
+
 type Dual struct {
 	*BaseExpr
 }
@@ -1009,6 +1185,7 @@ type ListFunction struct {
 	Distinct Token
 	Args     []QueryExpression
 	OrderBy  QueryExpression
+	Filter   QueryExpression
 }
 
 func (e ListFunction) String() string {
@@ -1022,6 +1199,9 @@ func (e ListFunction) String() string {
 	if e.OrderBy != nil {
 		s = append(s, keyword(WITHIN), keyword(GROUP), "("+e.OrderBy.String()+")")
 	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
 	return joinWithSpace(s)
 }
 
@@ -1035,6 +1215,8 @@ type AnalyticFunction struct {
 	Distinct       Token
 	Args           []QueryExpression
 	IgnoreType     Token
+	OrderBy        QueryExpression
+	Filter         QueryExpression
 	AnalyticClause AnalyticClause
 }
 
@@ -1050,10 +1232,18 @@ func (e AnalyticFunction) String() string {
 		option = append(option, keyword(IGNORE), e.IgnoreType.String())
 	}
 
-	s := []string{
-		strings.ToUpper(e.Name) + "(" + joinWithSpace(option) + ")",
-		keyword(OVER),
-		"(" + e.AnalyticClause.String() + ")",
+	s := []string{strings.ToUpper(e.Name) + "(" + joinWithSpace(option) + ")"}
+	if e.OrderBy != nil {
+		s = append(s, keyword(WITHIN), keyword(GROUP), "("+e.OrderBy.String()+")")
+	}
+	if e.Filter != nil {
+		s = append(s, e.Filter.String())
+	}
+	s = append(s, keyword(OVER))
+	if e.AnalyticClause.IsWindowReference() {
+		s = append(s, e.AnalyticClause.WindowName.String())
+	} else {
+		s = append(s, "("+e.AnalyticClause.String()+")")
 	}
 	return joinWithSpace(s)
 }
@@ -1068,6 +1258,7 @@ func (e AnalyticFunction) IgnoreNulls() bool {
 
 type AnalyticClause struct {
 	*BaseExpr
+	WindowName      QueryExpression
 	PartitionClause QueryExpression
 	OrderByClause   QueryExpression
 	WindowingClause QueryExpression
@@ -1075,6 +1266,9 @@ type AnalyticClause struct {
 
 func (e AnalyticClause) String() string {
 	s := make([]string, 0)
+	if e.WindowName != nil {
+		s = append(s, e.WindowName.String())
+	}
 	if e.PartitionClause != nil {
 		s = append(s, e.PartitionClause.String())
 	}
@@ -1087,6 +1281,10 @@ func (e AnalyticClause) String() string {
 	return joinWithSpace(s)
 }
 
+func (e AnalyticClause) IsWindowReference() bool {
+	return e.WindowName != nil && e.PartitionClause == nil && e.OrderByClause == nil && e.WindowingClause == nil
+}
+
 func (e AnalyticClause) PartitionValues() []QueryExpression {
 	if e.PartitionClause == nil {
 		return nil
@@ -1106,25 +1304,41 @@ func (e PartitionClause) String() string {
 
 type WindowingClause struct {
 	*BaseExpr
+	FrameType Token
 	FrameLow  QueryExpression
 	FrameHigh QueryExpression
+	Exclusion Token
 }
 
 func (e WindowingClause) String() string {
-	s := []string{keyword(ROWS)}
+	s := []string{keyword(e.Type())}
 	if e.FrameHigh == nil {
 		s = append(s, e.FrameLow.String())
 	} else {
 		s = append(s, keyword(BETWEEN), e.FrameLow.String(), keyword(AND), e.FrameHigh.String())
 	}
+	if !e.Exclusion.IsEmpty() {
+		s = append(s, keyword(EXCLUDE), keyword(e.Exclusion.Token))
+		if e.Exclusion.Token == CURRENT {
+			s = append(s, keyword(ROW))
+		}
+	}
 	return joinWithSpace(s)
 }
 
+func (e WindowingClause) Type() int {
+	if e.FrameType.IsEmpty() {
+		return ROWS
+	}
+	return e.FrameType.Token
+}
+
 type WindowFramePosition struct {
 	*BaseExpr
-	Direction Token
-	Unbounded Token
-	Offset    int
+	Direction   Token
+	Unbounded   Token
+	Offset      int
+	OffsetValue QueryExpression
 }
 
 func (e WindowFramePosition) String() string {
@@ -1133,12 +1347,57 @@ func (e WindowFramePosition) String() string {
 		s = append(s, keyword(CURRENT), keyword(ROW))
 	} else if !e.Unbounded.IsEmpty() {
 		s = append(s, e.Unbounded.String(), e.Direction.String())
+	} else if e.OffsetValue != nil {
+		s = append(s, e.OffsetValue.String(), e.Direction.String())
 	} else {
 		s = append(s, strconv.Itoa(e.Offset), e.Direction.String())
 	}
 	return joinWithSpace(s)
 }
 
+type WindowClause struct {
+	*BaseExpr
+	Windows []QueryExpression
+}
+
+func (e WindowClause) String() string {
+	return joinWithSpace([]string{keyword(WINDOW), listQueryExpressions(e.Windows)})
+}
+
+type WindowDefinition struct {
+	*BaseExpr
+	Name   Identifier
+	Clause AnalyticClause
+}
+
+func (e WindowDefinition) String() string {
+	return joinWithSpace([]string{e.Name.String(), keyword(AS), putParentheses(e.Clause.String())})
+}
+
+type Interval struct {
+	*BaseExpr
+	Value QueryExpression
+	Unit  Identifier
+}
+
+func (e Interval) String() string {
+	s := []string{keyword(INTERVAL), e.Value.String()}
+	if 0 < len(e.Unit.Literal) {
+		s = append(s, strings.ToUpper(e.Unit.Literal))
+	}
+	return joinWithSpace(s)
+}
+
+type AtTimeZone struct {
+	*BaseExpr
+	Value    QueryExpression
+	TimeZone QueryExpression
+}
+
+func (e AtTimeZone) String() string {
+	return joinWithSpace([]string{e.Value.String(), keyword(AT), keyword(TIME), keyword(ZONE), e.TimeZone.String()})
+}
+
 type Variable struct {
 	*BaseExpr
 	Name string
@@ -1220,20 +1479,64 @@ type UnsetEnvVar struct {
 
 type InsertQuery struct {
 	*BaseExpr
-	WithClause QueryExpression
-	Table      Table
-	Fields     []QueryExpression
-	ValuesList []QueryExpression
-	Query      QueryExpression
+	WithClause      QueryExpression
+	Table           Table
+	Fields          []QueryExpression
+	ValuesList      []QueryExpression
+	Query           QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e InsertQuery) String() string {
+	s := make([]string, 0)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(INSERT), keyword(INTO), e.Table.String())
+	if e.Fields != nil {
+		s = append(s, putParentheses(listQueryExpressions(e.Fields)))
+	}
+	if e.ValuesList != nil {
+		s = append(s, keyword(VALUES), listQueryExpressions(e.ValuesList))
+	} else {
+		s = append(s, e.Query.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type UpdateQuery struct {
 	*BaseExpr
-	WithClause  QueryExpression
-	Tables      []QueryExpression
-	SetList     []UpdateSet
-	FromClause  QueryExpression
-	WhereClause QueryExpression
+	WithClause      QueryExpression
+	Tables          []QueryExpression
+	SetList         []UpdateSet
+	FromClause      QueryExpression
+	WhereClause     QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e UpdateQuery) String() string {
+	s := make([]string, 0)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	setList := make([]string, len(e.SetList))
+	for i, v := range e.SetList {
+		setList[i] = v.String()
+	}
+	s = append(s, keyword(UPDATE), listQueryExpressions(e.Tables), keyword(SET), strings.Join(setList, ", "))
+	if e.FromClause != nil {
+		s = append(s, e.FromClause.String())
+	}
+	if e.WhereClause != nil {
+		s = append(s, e.WhereClause.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type UpdateSet struct {
@@ -1242,22 +1545,102 @@ type UpdateSet struct {
 	Value QueryExpression
 }
 
+func (us UpdateSet) String() string {
+	s := []string{us.Field.String(), "=", us.Value.String()}
+	return joinWithSpace(s)
+}
+
 type ReplaceQuery struct {
 	*BaseExpr
-	WithClause QueryExpression
-	Table      Table
-	Fields     []QueryExpression
-	Keys       []QueryExpression
-	ValuesList []QueryExpression
-	Query      QueryExpression
+	WithClause      QueryExpression
+	Table           Table
+	Fields          []QueryExpression
+	Keys            []QueryExpression
+	ValuesList      []QueryExpression
+	Query           QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e ReplaceQuery) String() string {
+	s := make([]string, 0)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(REPLACE), keyword(INTO), e.Table.String())
+	if e.Fields != nil {
+		s = append(s, putParentheses(listQueryExpressions(e.Fields)))
+	}
+	s = append(s, keyword(USING), putParentheses(listQueryExpressions(e.Keys)))
+	if e.ValuesList != nil {
+		s = append(s, keyword(VALUES), listQueryExpressions(e.ValuesList))
+	} else {
+		s = append(s, e.Query.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type DeleteQuery struct {
+	*BaseExpr
+	WithClause      QueryExpression
+	Tables          []QueryExpression
+	FromClause      FromClause
+	WhereClause     QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e DeleteQuery) String() string {
+	s := make([]string, 0)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(DELETE))
+	if e.Tables != nil {
+		s = append(s, listQueryExpressions(e.Tables))
+	}
+	s = append(s, e.FromClause.String())
+	if e.WhereClause != nil {
+		s = append(s, e.WhereClause.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
+}
+
+type ReturningClause struct {
+	*BaseExpr
+	Fields []QueryExpression
+}
+
+func (e ReturningClause) String() string {
+	s := []string{keyword(RETURNING), listQueryExpressions(e.Fields)}
+	return joinWithSpace(s)
+}
+
+type MergeQuery struct {
 	*BaseExpr
 	WithClause  QueryExpression
-	Tables      []QueryExpression
-	FromClause  FromClause
-	WhereClause QueryExpression
+	Table       Table
+	Source      QueryExpression
+	Condition   QueryExpression
+	WhenClauses []MergeWhenClause
+}
+
+type MergeWhenClause struct {
+	*BaseExpr
+	Negation  Token
+	Condition QueryExpression
+	Operation Token
+	SetList   []UpdateSet
+	Fields    []QueryExpression
+	Values    QueryExpression
+}
+
+func (mw MergeWhenClause) IsMatched() bool {
+	return mw.Negation.IsEmpty()
 }
 
 type CreateTable struct {
@@ -1326,6 +1709,41 @@ type DisposeFunction struct {
 	Name Identifier
 }
 
+type ProcedureDeclaration struct {
+	*BaseExpr
+	Name       Identifier
+	Parameters []ProcedureParameter
+	Statements []Statement
+}
+
+type ProcedureParameter struct {
+	*BaseExpr
+	Variable Variable
+	Out      Token
+}
+
+func (e ProcedureParameter) IsOut() bool {
+	return !e.Out.IsEmpty()
+}
+
+func (e ProcedureParameter) String() string {
+	if e.IsOut() {
+		return e.Out.Literal + " " + e.Variable.String()
+	}
+	return e.Variable.String()
+}
+
+type DisposeProcedure struct {
+	*BaseExpr
+	Name Identifier
+}
+
+type CallProcedure struct {
+	*BaseExpr
+	Name Identifier
+	Args []QueryExpression
+}
+
 type Return struct {
 	*BaseExpr
 	Value QueryExpression
@@ -1462,6 +1880,12 @@ type WhileInCursor struct {
 	Statements      []Statement
 }
 
+type TryCatch struct {
+	*BaseExpr
+	Try   []Statement
+	Catch []Statement
+}
+
 type CursorDeclaration struct {
 	*BaseExpr
 	Cursor    Identifier
@@ -1580,6 +2004,16 @@ type Trigger struct {
 	Code    value.Primary
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze   Token
+	Statement Statement
+}
+
+func (e Explain) IsAnalyze() bool {
+	return !e.Analyze.IsEmpty()
+}
+
 type Exit struct {
 	*BaseExpr
 	Code value.Primary
@@ -128,6 +128,14 @@ func TestPlaceHolder_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	s = "$2"
+	ordinal = 2
+	e = Placeholder{Literal: s, Ordinal: ordinal, Name: ""}
+	expect = "$2"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIdentifier_String(t *testing.T) {
@@ -211,6 +219,60 @@ func TestValueList_String(t *testing.T) {
 	}
 }
 
+func TestArrayConstructor_String(t *testing.T) {
+	e := ArrayConstructor{
+		Values: []QueryExpression{
+			NewIntegerValueFromString("1"),
+			NewStringValue("a"),
+		},
+	}
+	expect := "ARRAY[1, 'a']"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestInterval_String(t *testing.T) {
+	e := Interval{
+		Value: NewIntegerValueFromString("3"),
+		Unit:  Identifier{Literal: "day"},
+	}
+	expect := "INTERVAL 3 DAY"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = Interval{
+		Value: NewStringValue("1h30m"),
+	}
+	expect = "INTERVAL '1h30m'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestAtTimeZone_String(t *testing.T) {
+	e := AtTimeZone{
+		Value:    FieldReference{Column: Identifier{Literal: "column1"}},
+		TimeZone: NewStringValue("Asia/Tokyo"),
+	}
+	expect := "column1 AT TIME ZONE 'Asia/Tokyo'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestArraySubscript_String(t *testing.T) {
+	e := ArraySubscript{
+		Array: FieldReference{Column: Identifier{Literal: "column1"}},
+		Index: NewIntegerValueFromString("2"),
+	}
+	expect := "column1[2]"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestRowValueList_String(t *testing.T) {
 	e := RowValueList{
 		RowValues: []QueryExpression{
@@ -365,9 +427,30 @@ func TestSelectEntity_String(t *testing.T) {
 				RHS:      NewIntegerValueFromString("1"),
 			},
 		},
+		WindowClause: WindowClause{
+			Windows: []QueryExpression{
+				WindowDefinition{
+					Name: Identifier{Literal: "w"},
+					Clause: AnalyticClause{
+						PartitionClause: PartitionClause{
+							Values: []QueryExpression{
+								Identifier{Literal: "column1"},
+							},
+						},
+					},
+				},
+			},
+		},
+		QualifyClause: QualifyClause{
+			Filter: Comparison{
+				LHS:      Identifier{Literal: "column2"},
+				Operator: Token{Token: '=', Literal: "="},
+				RHS:      NewIntegerValueFromString("1"),
+			},
+		},
 	}
 
-	expect := "SELECT column INTO @var1, @var2 FROM table WHERE column > 1 GROUP BY column1 HAVING column > 1"
+	expect := "SELECT column INTO @var1, @var2 FROM table WHERE column > 1 GROUP BY column1 HAVING column > 1 WINDOW w AS (PARTITION BY column1) QUALIFY column2 = 1"
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
@@ -458,6 +541,33 @@ func TestGroupByClause_String(t *testing.T) {
 	}
 }
 
+func TestGroupingSets_String(t *testing.T) {
+	e := GroupingSets{
+		Type: Token{Token: ROLLUP, Literal: "rollup"},
+		Sets: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "ROLLUP (column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = GroupingSets{
+		Type: Token{Token: SETS, Literal: "sets"},
+		Sets: []QueryExpression{
+			GroupingSet{Values: []QueryExpression{Identifier{Literal: "column1"}}},
+			GroupingSet{Values: []QueryExpression{Identifier{Literal: "column1"}, Identifier{Literal: "column2"}}},
+			GroupingSet{},
+		},
+	}
+	expect = "GROUPING SETS ((column1), (column1, column2), ())"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestHavingClause_String(t *testing.T) {
 	e := HavingClause{
 		Filter: Comparison{
@@ -472,6 +582,20 @@ func TestHavingClause_String(t *testing.T) {
 	}
 }
 
+func TestQualifyClause_String(t *testing.T) {
+	e := QualifyClause{
+		Filter: Comparison{
+			LHS:      Identifier{Literal: "column"},
+			Operator: Token{Token: '=', Literal: "="},
+			RHS:      NewIntegerValueFromString("1"),
+		},
+	}
+	expect := "QUALIFY column = 1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestOrderByClause_String(t *testing.T) {
 	e := OrderByClause{
 		Items: []QueryExpression{
@@ -724,6 +848,16 @@ func TestJsonQuery_String(t *testing.T) {
 	}
 }
 
+func TestUnnest_String(t *testing.T) {
+	e := Unnest{
+		Array: FieldReference{Column: Identifier{Literal: "column1"}},
+	}
+	expect := "UNNEST(column1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestComparison_String(t *testing.T) {
 	e := Comparison{
 		LHS:      Identifier{Literal: "column"},
@@ -904,6 +1038,30 @@ func TestLike_String(t *testing.T) {
 	}
 }
 
+func TestRegExp_IsNegated(t *testing.T) {
+	e := RegExp{}
+	if e.IsNegated() == true {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), false, e)
+	}
+
+	e = RegExp{Negation: Token{Token: NOT, Literal: "not"}}
+	if e.IsNegated() == false {
+		t.Errorf("negation = %t, want %t for %#v", e.IsNegated(), true, e)
+	}
+}
+
+func TestRegExp_String(t *testing.T) {
+	e := RegExp{
+		LHS:      Identifier{Literal: "column"},
+		Pattern:  NewStringValue("^pattern$"),
+		Negation: Token{Token: NOT, Literal: "not"},
+	}
+	expect := "column NOT REGEXP '^pattern$'"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestExists_String(t *testing.T) {
 	e := Exists{
 		Query: Subquery{
@@ -1061,6 +1219,24 @@ func TestAggregateFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AggregateFunction{
+		Name: "count",
+		Args: []QueryExpression{
+			AllColumns{},
+		},
+		Filter: FilterClause{
+			Filter: Comparison{
+				LHS:      Identifier{Literal: "column"},
+				Operator: Token{Token: '>', Literal: ">"},
+				RHS:      NewIntegerValueFromString("1"),
+			},
+		},
+	}
+	expect = "COUNT(*) FILTER (WHERE column > 1)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_IsDistinct(t *testing.T) {
@@ -1194,6 +1370,49 @@ func TestJoin_String(t *testing.T) {
 	}
 }
 
+func TestPivot_String(t *testing.T) {
+	e := Pivot{
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		Aggregate: AggregateFunction{Name: "sum", Args: []QueryExpression{Identifier{Literal: "column1"}}},
+		For:       Identifier{Literal: "column2"},
+		In: []QueryExpression{
+			Field{Object: NewStringValue("a")},
+			Field{Object: NewStringValue("b"), As: Token{Token: AS, Literal: "as"}, Alias: Identifier{Literal: "b2"}},
+		},
+	}
+	expect := "table1 PIVOT (SUM(column1) FOR column2 IN ('a', 'b' AS b2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = Pivot{
+		Table:     Table{Object: Identifier{Literal: "table1"}},
+		Aggregate: AggregateFunction{Name: "count", Args: []QueryExpression{AllColumns{}}},
+		For:       Identifier{Literal: "column2"},
+		Any:       Token{Token: ANY, Literal: "any"},
+	}
+	expect = "table1 PIVOT (COUNT(*) FOR column2 IN (ANY))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnpivot_String(t *testing.T) {
+	e := Unpivot{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Value: Identifier{Literal: "val"},
+		Name:  Identifier{Literal: "col"},
+		In: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "table1 UNPIVOT (val FOR col IN (column1, column2))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestJoinCondition_String(t *testing.T) {
 	e := JoinCondition{
 		On: Comparison{
@@ -1427,6 +1646,23 @@ func TestListFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = ListFunction{
+		Name: "listagg",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		OrderBy: OrderByClause{
+			Items: []QueryExpression{Identifier{Literal: "column1"}},
+		},
+		Filter: FilterClause{
+			Filter: Identifier{Literal: "column2"},
+		},
+	}
+	expect = "LISTAGG(column1) WITHIN GROUP (ORDER BY column1) FILTER (WHERE column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestListFunction_IsDistinct(t *testing.T) {
@@ -1467,6 +1703,86 @@ func TestAnalyticFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		AnalyticClause: AnalyticClause{
+			WindowName: Identifier{Literal: "w"},
+		},
+	}
+	expect = "SUM(column1) OVER w"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		AnalyticClause: AnalyticClause{
+			WindowName: Identifier{Literal: "w"},
+			OrderByClause: OrderByClause{
+				Items: []QueryExpression{
+					OrderItem{Value: Identifier{Literal: "column2"}},
+				},
+			},
+		},
+	}
+	expect = "SUM(column1) OVER (w ORDER BY column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "sum",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Filter: FilterClause{
+			Filter: Identifier{Literal: "column2"},
+		},
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				Values: []QueryExpression{
+					Identifier{Literal: "column3"},
+				},
+			},
+		},
+	}
+	expect = "SUM(column1) FILTER (WHERE column2) OVER (PARTITION BY column3)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = AnalyticFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValue(0.5),
+		},
+		OrderBy: OrderByClause{
+			Items: []QueryExpression{
+				OrderItem{Value: Identifier{Literal: "column1"}},
+			},
+		},
+		Filter: FilterClause{
+			Filter: Identifier{Literal: "column2"},
+		},
+		AnalyticClause: AnalyticClause{
+			PartitionClause: PartitionClause{
+				Values: []QueryExpression{
+					Identifier{Literal: "column3"},
+				},
+			},
+		},
+	}
+	expect = "PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY column1) FILTER (WHERE column2) OVER (PARTITION BY column3)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAnalyticFunction_IsDistinct(t *testing.T) {
@@ -1580,6 +1896,70 @@ func TestWindowingClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = WindowingClause{
+		FrameType: Token{Token: RANGE, Literal: "range"},
+		FrameLow: WindowFramePosition{
+			Direction: Token{Token: PRECEDING, Literal: "preceding"},
+			OffsetValue: Interval{
+				Value: NewStringValue("7"),
+				Unit:  Identifier{Literal: "day"},
+			},
+		},
+		FrameHigh: WindowFramePosition{
+			Direction: Token{Token: CURRENT, Literal: "current"},
+		},
+		Exclusion: Token{Token: CURRENT, Literal: "current"},
+	}
+	expect = "RANGE BETWEEN INTERVAL '7' DAY PRECEDING AND CURRENT ROW EXCLUDE CURRENT ROW"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = WindowingClause{
+		FrameType: Token{Token: GROUPS, Literal: "groups"},
+		FrameLow: WindowFramePosition{
+			Direction: Token{Token: PRECEDING, Literal: "preceding"},
+			Offset:    2,
+		},
+		Exclusion: Token{Token: TIES, Literal: "ties"},
+	}
+	expect = "GROUPS 2 PRECEDING EXCLUDE TIES"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestWindowClause_String(t *testing.T) {
+	e := WindowClause{
+		Windows: []QueryExpression{
+			WindowDefinition{
+				Name: Identifier{Literal: "w1"},
+				Clause: AnalyticClause{
+					PartitionClause: PartitionClause{
+						Values: []QueryExpression{
+							Identifier{Literal: "column1"},
+						},
+					},
+				},
+			},
+			WindowDefinition{
+				Name: Identifier{Literal: "w2"},
+				Clause: AnalyticClause{
+					WindowName: Identifier{Literal: "w1"},
+					OrderByClause: OrderByClause{
+						Items: []QueryExpression{
+							OrderItem{Value: Identifier{Literal: "column2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	expect := "WINDOW w1 AS (PARTITION BY column1), w2 AS (w1 ORDER BY column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestVariable_String(t *testing.T) {
@@ -1675,3 +2055,15 @@ func TestCursorAttrebute_String(t *testing.T) {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
 }
+
+func TestExplain_IsAnalyze(t *testing.T) {
+	e := Explain{}
+	if e.IsAnalyze() == true {
+		t.Errorf("analyze = %t, want %t for %#v", e.IsAnalyze(), false, e)
+	}
+
+	e = Explain{Analyze: Token{Token: ANALYZE, Literal: "analyze"}}
+	if e.IsAnalyze() == false {
+		t.Errorf("analyze = %t, want %t for %#v", e.IsAnalyze(), true, e)
+	}
+}
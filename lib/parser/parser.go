@@ -41,6 +41,10 @@ type yySymType struct {
 	fetchpos    FetchPosition
 	replaceval  ReplaceValue
 	replacevals []ReplaceValue
+	procparam   ProcedureParameter
+	procparams  []ProcedureParameter
+	mergewhen   MergeWhenClause
+	mergewhens  []MergeWhenClause
 	token       Token
 }
 
@@ -72,132 +76,162 @@ const AS = 57370
 const DUAL = 57371
 const STDIN = 57372
 const RECURSIVE = 57373
-const CREATE = 57374
-const ADD = 57375
-const DROP = 57376
-const ALTER = 57377
-const TABLE = 57378
-const FIRST = 57379
-const LAST = 57380
-const AFTER = 57381
-const BEFORE = 57382
-const DEFAULT = 57383
-const RENAME = 57384
-const TO = 57385
-const VIEW = 57386
-const ORDER = 57387
-const GROUP = 57388
-const HAVING = 57389
-const BY = 57390
-const ASC = 57391
-const DESC = 57392
-const LIMIT = 57393
-const OFFSET = 57394
-const PERCENT = 57395
-const JOIN = 57396
-const INNER = 57397
-const OUTER = 57398
-const LEFT = 57399
-const RIGHT = 57400
-const FULL = 57401
-const CROSS = 57402
-const ON = 57403
-const USING = 57404
-const NATURAL = 57405
-const LATERAL = 57406
-const UNION = 57407
-const INTERSECT = 57408
-const EXCEPT = 57409
-const ALL = 57410
-const ANY = 57411
-const EXISTS = 57412
-const IN = 57413
-const AND = 57414
-const OR = 57415
-const NOT = 57416
-const BETWEEN = 57417
-const LIKE = 57418
-const IS = 57419
-const NULL = 57420
-const DISTINCT = 57421
-const WITH = 57422
-const RANGE = 57423
-const UNBOUNDED = 57424
-const PRECEDING = 57425
-const FOLLOWING = 57426
-const CURRENT = 57427
-const ROW = 57428
-const CASE = 57429
-const IF = 57430
-const ELSEIF = 57431
-const WHILE = 57432
-const WHEN = 57433
-const THEN = 57434
-const ELSE = 57435
-const DO = 57436
-const END = 57437
-const DECLARE = 57438
-const CURSOR = 57439
-const FOR = 57440
-const FETCH = 57441
-const OPEN = 57442
-const CLOSE = 57443
-const DISPOSE = 57444
-const PREPARE = 57445
-const NEXT = 57446
-const PRIOR = 57447
-const ABSOLUTE = 57448
-const RELATIVE = 57449
-const SEPARATOR = 57450
-const PARTITION = 57451
-const OVER = 57452
-const COMMIT = 57453
-const ROLLBACK = 57454
-const CONTINUE = 57455
-const BREAK = 57456
-const EXIT = 57457
-const ECHO = 57458
-const PRINT = 57459
-const PRINTF = 57460
-const SOURCE = 57461
-const EXECUTE = 57462
-const CHDIR = 57463
-const PWD = 57464
-const RELOAD = 57465
-const REMOVE = 57466
-const SYNTAX = 57467
-const TRIGGER = 57468
-const FUNCTION = 57469
-const AGGREGATE = 57470
-const BEGIN = 57471
-const RETURN = 57472
-const IGNORE = 57473
-const WITHIN = 57474
-const VAR = 57475
-const SHOW = 57476
-const TIES = 57477
-const NULLS = 57478
-const ROWS = 57479
-const ONLY = 57480
-const CSV = 57481
-const JSON = 57482
-const FIXED = 57483
-const LTSV = 57484
-const JSON_ROW = 57485
-const JSON_TABLE = 57486
-const SUBSTRING = 57487
-const COUNT = 57488
-const JSON_OBJECT = 57489
-const AGGREGATE_FUNCTION = 57490
-const LIST_FUNCTION = 57491
-const ANALYTIC_FUNCTION = 57492
-const FUNCTION_NTH = 57493
-const FUNCTION_WITH_INS = 57494
-const COMPARISON_OP = 57495
-const STRING_OP = 57496
-const SUBSTITUTION_OP = 57497
-const UMINUS = 57498
-const UPLUS = 57499
+const MERGE = 57374
+const MATCHED = 57375
+const RETURNING = 57376
+const CREATE = 57377
+const ADD = 57378
+const DROP = 57379
+const ALTER = 57380
+const TABLE = 57381
+const FIRST = 57382
+const LAST = 57383
+const AFTER = 57384
+const BEFORE = 57385
+const DEFAULT = 57386
+const RENAME = 57387
+const TO = 57388
+const VIEW = 57389
+const ORDER = 57390
+const GROUP = 57391
+const HAVING = 57392
+const BY = 57393
+const ASC = 57394
+const DESC = 57395
+const LIMIT = 57396
+const OFFSET = 57397
+const PERCENT = 57398
+const ROLLUP = 57399
+const CUBE = 57400
+const GROUPING = 57401
+const SETS = 57402
+const JOIN = 57403
+const INNER = 57404
+const OUTER = 57405
+const LEFT = 57406
+const RIGHT = 57407
+const FULL = 57408
+const CROSS = 57409
+const ON = 57410
+const USING = 57411
+const NATURAL = 57412
+const LATERAL = 57413
+const PIVOT = 57414
+const UNPIVOT = 57415
+const UNION = 57416
+const INTERSECT = 57417
+const EXCEPT = 57418
+const ALL = 57419
+const ANY = 57420
+const EXISTS = 57421
+const IN = 57422
+const AND = 57423
+const OR = 57424
+const NOT = 57425
+const BETWEEN = 57426
+const LIKE = 57427
+const REGEXP = 57428
+const IS = 57429
+const NULL = 57430
+const DISTINCT = 57431
+const WITH = 57432
+const RANGE = 57433
+const UNBOUNDED = 57434
+const PRECEDING = 57435
+const FOLLOWING = 57436
+const CURRENT = 57437
+const ROW = 57438
+const GROUPS = 57439
+const EXCLUDE = 57440
+const WINDOW = 57441
+const INTERVAL = 57442
+const QUALIFY = 57443
+const ARRAY = 57444
+const UNNEST = 57445
+const CASE = 57446
+const IF = 57447
+const ELSEIF = 57448
+const WHILE = 57449
+const WHEN = 57450
+const THEN = 57451
+const ELSE = 57452
+const DO = 57453
+const END = 57454
+const DECLARE = 57455
+const CURSOR = 57456
+const FOR = 57457
+const FETCH = 57458
+const OPEN = 57459
+const CLOSE = 57460
+const DISPOSE = 57461
+const PREPARE = 57462
+const NEXT = 57463
+const PRIOR = 57464
+const ABSOLUTE = 57465
+const RELATIVE = 57466
+const SEPARATOR = 57467
+const PARTITION = 57468
+const OVER = 57469
+const COMMIT = 57470
+const ROLLBACK = 57471
+const CONTINUE = 57472
+const BREAK = 57473
+const EXIT = 57474
+const ECHO = 57475
+const PRINT = 57476
+const PRINTF = 57477
+const SOURCE = 57478
+const EXECUTE = 57479
+const CHDIR = 57480
+const PWD = 57481
+const RELOAD = 57482
+const REMOVE = 57483
+const SYNTAX = 57484
+const TRIGGER = 57485
+const FUNCTION = 57486
+const AGGREGATE = 57487
+const BEGIN = 57488
+const RETURN = 57489
+const TRY = 57490
+const CATCH = 57491
+const PROCEDURE = 57492
+const CALL = 57493
+const OUT = 57494
+const IGNORE = 57495
+const WITHIN = 57496
+const FILTER = 57497
+const VAR = 57498
+const SHOW = 57499
+const EXPLAIN = 57500
+const ANALYZE = 57501
+const TIES = 57502
+const NULLS = 57503
+const ROWS = 57504
+const ONLY = 57505
+const AT = 57506
+const TIME = 57507
+const ZONE = 57508
+const CSV = 57509
+const JSON = 57510
+const FIXED = 57511
+const LTSV = 57512
+const XLSX = 57513
+const SQLITE = 57514
+const JSON_ROW = 57515
+const JSON_TABLE = 57516
+const SUBSTRING = 57517
+const COUNT = 57518
+const JSON_OBJECT = 57519
+const AGGREGATE_FUNCTION = 57520
+const LIST_FUNCTION = 57521
+const ANALYTIC_FUNCTION = 57522
+const FUNCTION_NTH = 57523
+const FUNCTION_WITH_INS = 57524
+const COMPARISON_OP = 57525
+const STRING_OP = 57526
+const SUBSTITUTION_OP = 57527
+const UMINUS = 57528
+const UPLUS = 57529
 
 var yyToknames = [...]string{
 	"$end",
@@ -231,6 +265,9 @@ var yyToknames = [...]string{
 	"DUAL",
 	"STDIN",
 	"RECURSIVE",
+	"MERGE",
+	"MATCHED",
+	"RETURNING",
 	"CREATE",
 	"ADD",
 	"DROP",
@@ -253,6 +290,10 @@ var yyToknames = [...]string{
 	"LIMIT",
 	"OFFSET",
 	"PERCENT",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
 	"JOIN",
 	"INNER",
 	"OUTER",
@@ -264,6 +305,8 @@ var yyToknames = [...]string{
 	"USING",
 	"NATURAL",
 	"LATERAL",
+	"PIVOT",
+	"UNPIVOT",
 	"UNION",
 	"INTERSECT",
 	"EXCEPT",
@@ -276,6 +319,7 @@ var yyToknames = [...]string{
 	"NOT",
 	"BETWEEN",
 	"LIKE",
+	"REGEXP",
 	"IS",
 	"NULL",
 	"DISTINCT",
@@ -286,6 +330,13 @@ var yyToknames = [...]string{
 	"FOLLOWING",
 	"CURRENT",
 	"ROW",
+	"GROUPS",
+	"EXCLUDE",
+	"WINDOW",
+	"INTERVAL",
+	"QUALIFY",
+	"ARRAY",
+	"UNNEST",
 	"CASE",
 	"IF",
 	"ELSEIF",
@@ -330,18 +381,31 @@ var yyToknames = [...]string{
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"TRY",
+	"CATCH",
+	"PROCEDURE",
+	"CALL",
+	"OUT",
 	"IGNORE",
 	"WITHIN",
+	"FILTER",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
 	"TIES",
 	"NULLS",
 	"ROWS",
 	"ONLY",
+	"AT",
+	"TIME",
+	"ZONE",
 	"CSV",
 	"JSON",
 	"FIXED",
 	"LTSV",
+	"XLSX",
+	"SQLITE",
 	"JSON_ROW",
 	"JSON_TABLE",
 	"SUBSTRING",
@@ -367,16 +431,19 @@ var yyToknames = [...]string{
 	"'!'",
 	"'('",
 	"')'",
+	"'['",
 	"','",
 	"'.'",
+	"']'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.y:2714
+//line parser.y:3465
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -394,1388 +461,2135 @@ func Parse(s string, sourceFile string, datetimeFormats []string, forPrepared bo
 var yyExca = [...]int{
 	-1, 0,
 	1, 1,
-	-2, 216,
+	-2, 256,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 21,
-	1, 26,
-	89, 26,
-	91, 26,
-	93, 26,
-	95, 26,
-	158, 26,
-	-2, 236,
-	-1, 33,
-	1, 78,
-	89, 78,
-	91, 78,
-	93, 78,
-	95, 78,
-	158, 78,
-	-2, 248,
-	-1, 113,
-	17, 216,
-	19, 216,
-	22, 216,
-	24, 216,
+	-1, 24,
+	1, 29,
+	106, 29,
+	108, 29,
+	110, 29,
+	112, 29,
+	188, 29,
+	-2, 276,
+	-1, 38,
+	1, 85,
+	106, 85,
+	108, 85,
+	110, 85,
+	112, 85,
+	188, 85,
+	-2, 289,
+	-1, 62,
+	17, 256,
+	19, 256,
+	22, 256,
+	24, 256,
+	196, 256,
+	-2, 619,
+	-1, 151,
+	17, 256,
+	19, 256,
+	22, 256,
+	24, 256,
 	-2, 1,
-	-1, 115,
-	167, 307,
-	-2, 216,
-	-1, 124,
-	65, 184,
-	66, 184,
-	67, 184,
-	-2, 196,
-	-1, 162,
-	1, 122,
-	89, 122,
-	91, 122,
-	93, 122,
-	95, 122,
-	158, 122,
-	-2, 230,
-	-1, 163,
-	1, 163,
-	89, 163,
-	91, 163,
-	93, 163,
-	95, 163,
-	158, 163,
-	-2, 236,
-	-1, 168,
-	1, 156,
-	89, 156,
-	91, 156,
-	93, 156,
-	95, 156,
-	158, 156,
-	-2, 236,
-	-1, 169,
-	1, 157,
-	89, 157,
-	91, 157,
-	93, 157,
-	95, 157,
-	158, 157,
-	-2, 236,
-	-1, 170,
-	1, 158,
-	89, 158,
-	91, 158,
-	93, 158,
-	95, 158,
-	158, 158,
-	-2, 236,
-	-1, 171,
-	1, 161,
-	89, 161,
-	91, 161,
-	93, 161,
-	95, 161,
-	158, 161,
-	-2, 230,
-	-1, 172,
-	1, 162,
-	89, 162,
-	91, 162,
-	93, 162,
-	95, 162,
-	158, 162,
+	-1, 153,
+	197, 357,
+	-2, 256,
+	-1, 165,
+	112, 1,
+	-2, 256,
+	-1, 166,
+	74, 206,
+	75, 206,
+	76, 206,
 	-2, 236,
-	-1, 175,
-	1, 169,
-	89, 169,
-	91, 169,
-	93, 169,
-	95, 169,
-	158, 169,
-	-2, 230,
-	-1, 176,
-	1, 170,
-	89, 170,
-	91, 170,
-	93, 170,
-	95, 170,
-	158, 170,
-	-2, 236,
-	-1, 233,
-	89, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 255,
-	166, 356,
-	-2, 477,
-	-1, 256,
-	166, 357,
-	-2, 478,
-	-1, 257,
-	166, 358,
-	-2, 479,
-	-1, 258,
-	166, 359,
-	-2, 480,
-	-1, 290,
-	4, 144,
-	135, 144,
-	136, 144,
-	137, 144,
-	139, 144,
-	140, 144,
-	141, 144,
-	142, 144,
-	-2, 236,
-	-1, 291,
-	4, 145,
-	135, 145,
-	136, 145,
-	137, 145,
-	139, 145,
-	140, 145,
-	141, 145,
-	142, 145,
-	-2, 236,
-	-1, 301,
+	-1, 213,
+	1, 130,
+	106, 130,
+	108, 130,
+	110, 130,
+	112, 130,
+	188, 130,
+	-2, 270,
+	-1, 214,
+	1, 179,
+	106, 179,
+	108, 179,
+	110, 179,
+	112, 179,
+	188, 179,
+	-2, 276,
+	-1, 221,
+	1, 172,
+	106, 172,
+	108, 172,
+	110, 172,
+	112, 172,
+	188, 172,
+	-2, 276,
+	-1, 222,
+	1, 173,
+	106, 173,
+	108, 173,
+	110, 173,
+	112, 173,
+	188, 173,
+	-2, 276,
+	-1, 223,
 	1, 174,
-	89, 174,
-	91, 174,
-	93, 174,
-	95, 174,
-	158, 174,
-	-2, 236,
-	-1, 309,
-	95, 4,
-	-2, 216,
-	-1, 318,
-	71, 0,
-	75, 0,
-	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 277,
-	-1, 319,
-	71, 0,
-	75, 0,
-	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 279,
+	106, 174,
+	108, 174,
+	110, 174,
+	112, 174,
+	188, 174,
+	-2, 276,
+	-1, 224,
+	1, 177,
+	106, 177,
+	108, 177,
+	110, 177,
+	112, 177,
+	188, 177,
+	-2, 270,
+	-1, 225,
+	1, 178,
+	106, 178,
+	108, 178,
+	110, 178,
+	112, 178,
+	188, 178,
+	-2, 276,
+	-1, 228,
+	1, 185,
+	106, 185,
+	108, 185,
+	110, 185,
+	112, 185,
+	188, 185,
+	-2, 270,
+	-1, 229,
+	1, 186,
+	106, 186,
+	108, 186,
+	110, 186,
+	112, 186,
+	188, 186,
+	-2, 276,
+	-1, 304,
+	106, 1,
+	110, 1,
+	112, 1,
+	-2, 256,
+	-1, 327,
+	196, 446,
+	-2, 610,
 	-1, 328,
-	71, 0,
-	75, 0,
-	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 289,
-	-1, 378,
-	95, 1,
-	-2, 216,
-	-1, 394,
-	54, 496,
-	-2, 413,
-	-1, 434,
-	1, 80,
-	89, 80,
-	91, 80,
-	93, 80,
-	95, 80,
-	158, 80,
-	-2, 236,
-	-1, 435,
-	1, 81,
-	89, 81,
-	91, 81,
-	93, 81,
-	95, 81,
-	158, 81,
-	-2, 230,
-	-1, 436,
-	1, 82,
-	89, 82,
-	91, 82,
-	93, 82,
-	95, 82,
-	158, 82,
-	-2, 236,
-	-1, 437,
-	1, 83,
-	89, 83,
-	91, 83,
-	93, 83,
-	95, 83,
-	158, 83,
-	-2, 230,
-	-1, 438,
-	1, 149,
-	89, 149,
-	91, 149,
-	93, 149,
-	95, 149,
-	158, 149,
-	-2, 230,
-	-1, 439,
-	1, 150,
-	89, 150,
-	91, 150,
-	93, 150,
-	95, 150,
-	158, 150,
-	-2, 236,
-	-1, 440,
-	1, 151,
-	89, 151,
-	91, 151,
-	93, 151,
-	95, 151,
-	158, 151,
-	-2, 230,
-	-1, 441,
-	1, 152,
-	89, 152,
-	91, 152,
-	93, 152,
-	95, 152,
-	158, 152,
-	-2, 236,
-	-1, 444,
-	1, 117,
-	89, 117,
-	91, 117,
-	93, 117,
-	95, 117,
-	158, 117,
-	168, 117,
-	-2, 236,
-	-1, 449,
-	1, 411,
-	89, 411,
-	91, 411,
-	93, 411,
-	95, 411,
-	158, 411,
-	-2, 236,
-	-1, 456,
-	1, 175,
-	89, 175,
-	91, 175,
-	93, 175,
-	95, 175,
-	158, 175,
-	-2, 236,
+	196, 447,
+	-2, 611,
+	-1, 329,
+	196, 448,
+	-2, 612,
+	-1, 330,
+	196, 449,
+	-2, 613,
+	-1, 331,
+	196, 450,
+	-2, 614,
+	-1, 332,
+	196, 451,
+	-2, 615,
+	-1, 369,
+	80, 276,
+	81, 276,
+	82, 276,
+	83, 276,
+	84, 276,
+	85, 276,
+	86, 276,
+	87, 276,
+	183, 276,
+	184, 276,
+	189, 276,
+	190, 276,
+	191, 276,
+	192, 276,
+	193, 276,
+	194, 276,
+	198, 276,
+	-2, 160,
+	-1, 370,
+	80, 276,
+	81, 276,
+	82, 276,
+	83, 276,
+	84, 276,
+	85, 276,
+	86, 276,
+	87, 276,
+	183, 276,
+	184, 276,
+	189, 276,
+	190, 276,
+	191, 276,
+	192, 276,
+	193, 276,
+	194, 276,
+	198, 276,
+	-2, 161,
+	-1, 382,
+	1, 190,
+	106, 190,
+	108, 190,
+	110, 190,
+	112, 190,
+	188, 190,
+	-2, 276,
+	-1, 399,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 325,
+	-1, 400,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 327,
+	-1, 410,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 337,
+	-1, 411,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 339,
+	-1, 421,
+	112, 4,
+	-2, 256,
+	-1, 464,
+	112, 1,
+	-2, 256,
 	-1, 481,
-	71, 0,
-	75, 0,
-	76, 0,
-	77, 0,
-	153, 0,
-	159, 0,
-	-2, 290,
-	-1, 514,
-	95, 1,
-	-2, 216,
-	-1, 521,
-	91, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 524,
-	1, 206,
-	52, 206,
-	80, 206,
-	89, 206,
-	91, 206,
-	93, 206,
-	95, 206,
-	98, 206,
-	138, 206,
-	158, 206,
-	167, 206,
-	-2, 236,
-	-1, 525,
-	1, 211,
-	89, 211,
-	91, 211,
-	93, 211,
-	95, 211,
-	98, 211,
-	99, 211,
-	158, 211,
-	167, 211,
-	-2, 236,
-	-1, 560,
-	167, 354,
-	168, 354,
-	-2, 230,
-	-1, 602,
-	89, 4,
-	91, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 605,
-	95, 4,
-	-2, 216,
-	-1, 606,
-	95, 4,
-	-2, 216,
-	-1, 671,
-	54, 496,
-	-2, 372,
-	-1, 692,
-	17, 507,
-	80, 507,
-	166, 507,
-	-2, 87,
-	-1, 718,
-	89, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 723,
-	95, 4,
-	-2, 216,
-	-1, 724,
-	95, 4,
-	-2, 216,
-	-1, 749,
-	89, 1,
-	93, 1,
-	95, 1,
-	-2, 216,
-	-1, 792,
-	1, 95,
-	89, 95,
-	91, 95,
-	93, 95,
-	95, 95,
-	158, 95,
-	-2, 230,
-	-1, 793,
-	1, 96,
-	89, 96,
-	91, 96,
-	93, 96,
-	95, 96,
-	158, 96,
-	-2, 236,
-	-1, 795,
-	95, 6,
-	-2, 216,
-	-1, 801,
-	167, 128,
-	168, 128,
-	-2, 236,
-	-1, 806,
-	95, 4,
-	-2, 216,
-	-1, 877,
-	95, 6,
-	-2, 216,
-	-1, 878,
-	95, 6,
-	-2, 216,
-	-1, 882,
-	95, 4,
-	-2, 216,
-	-1, 886,
-	91, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 929,
-	89, 6,
-	91, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 936,
-	158, 62,
-	-2, 236,
+	61, 655,
+	-2, 521,
+	-1, 529,
+	1, 87,
+	106, 87,
+	108, 87,
+	110, 87,
+	112, 87,
+	188, 87,
+	-2, 276,
+	-1, 530,
+	1, 88,
+	106, 88,
+	108, 88,
+	110, 88,
+	112, 88,
+	188, 88,
+	-2, 270,
+	-1, 531,
+	1, 89,
+	106, 89,
+	108, 89,
+	110, 89,
+	112, 89,
+	188, 89,
+	-2, 276,
+	-1, 532,
+	1, 90,
+	106, 90,
+	108, 90,
+	110, 90,
+	112, 90,
+	188, 90,
+	-2, 270,
+	-1, 533,
+	1, 165,
+	106, 165,
+	108, 165,
+	110, 165,
+	112, 165,
+	188, 165,
+	-2, 270,
+	-1, 534,
+	1, 166,
+	106, 166,
+	108, 166,
+	110, 166,
+	112, 166,
+	188, 166,
+	-2, 276,
+	-1, 535,
+	1, 167,
+	106, 167,
+	108, 167,
+	110, 167,
+	112, 167,
+	188, 167,
+	-2, 270,
+	-1, 536,
+	1, 168,
+	106, 168,
+	108, 168,
+	110, 168,
+	112, 168,
+	188, 168,
+	-2, 276,
+	-1, 539,
+	1, 125,
+	106, 125,
+	108, 125,
+	110, 125,
+	112, 125,
+	188, 125,
+	199, 125,
+	-2, 276,
+	-1, 544,
+	1, 519,
+	106, 519,
+	108, 519,
+	110, 519,
+	112, 519,
+	188, 519,
+	-2, 276,
+	-1, 553,
+	1, 191,
+	106, 191,
+	108, 191,
+	110, 191,
+	112, 191,
+	188, 191,
+	-2, 276,
+	-1, 562,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 338,
+	-1, 563,
+	80, 0,
+	84, 0,
+	85, 0,
+	86, 0,
+	87, 0,
+	183, 0,
+	189, 0,
+	-2, 340,
+	-1, 612,
+	112, 1,
+	-2, 256,
+	-1, 619,
+	108, 1,
+	110, 1,
+	112, 1,
+	-2, 256,
+	-1, 623,
+	1, 246,
+	34, 246,
+	55, 246,
+	90, 246,
+	106, 246,
+	108, 246,
+	110, 246,
+	112, 246,
+	115, 246,
+	163, 246,
+	188, 246,
+	197, 246,
+	-2, 276,
+	-1, 624,
+	1, 251,
+	34, 251,
+	106, 251,
+	108, 251,
+	110, 251,
+	112, 251,
+	115, 251,
+	116, 251,
+	188, 251,
+	197, 251,
+	-2, 276,
+	-1, 672,
+	197, 444,
+	199, 444,
+	-2, 270,
+	-1, 727,
+	106, 4,
+	108, 4,
+	110, 4,
+	112, 4,
+	-2, 256,
+	-1, 730,
+	112, 4,
+	-2, 256,
+	-1, 731,
+	112, 4,
+	-2, 256,
+	-1, 732,
+	112, 4,
+	-2, 256,
+	-1, 797,
+	61, 655,
+	-2, 468,
+	-1, 827,
+	17, 666,
+	90, 666,
+	196, 666,
+	-2, 94,
+	-1, 860,
+	106, 4,
+	110, 4,
+	112, 4,
+	-2, 256,
+	-1, 866,
+	112, 4,
+	-2, 256,
+	-1, 867,
+	112, 4,
+	-2, 256,
+	-1, 903,
+	106, 1,
+	110, 1,
+	112, 1,
+	-2, 256,
+	-1, 907,
+	112, 1,
+	-2, 256,
+	-1, 965,
+	1, 102,
+	106, 102,
+	108, 102,
+	110, 102,
+	112, 102,
+	188, 102,
+	-2, 270,
+	-1, 966,
+	1, 103,
+	106, 103,
+	108, 103,
+	110, 103,
+	112, 103,
+	188, 103,
+	-2, 276,
+	-1, 970,
+	112, 6,
+	-2, 256,
 	-1, 976,
-	89, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
+	197, 136,
+	199, 136,
+	-2, 276,
 	-1, 979,
-	95, 8,
-	-2, 216,
-	-1, 986,
-	95, 6,
-	-2, 216,
-	-1, 989,
-	89, 4,
-	93, 4,
-	95, 4,
-	-2, 216,
-	-1, 1016,
-	95, 6,
-	-2, 216,
-	-1, 1049,
-	95, 6,
-	-2, 216,
-	-1, 1053,
-	91, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 1055,
-	89, 8,
-	91, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1058,
-	95, 8,
-	-2, 216,
-	-1, 1059,
-	95, 8,
-	-2, 216,
-	-1, 1076,
-	89, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1081,
-	95, 8,
-	-2, 216,
-	-1, 1082,
-	95, 8,
-	-2, 216,
+	112, 6,
+	-2, 256,
+	-1, 984,
+	112, 4,
+	-2, 256,
+	-1, 1086,
+	112, 6,
+	-2, 256,
 	-1, 1087,
-	89, 6,
-	93, 6,
-	95, 6,
-	-2, 216,
-	-1, 1092,
-	95, 8,
-	-2, 216,
-	-1, 1107,
-	95, 8,
-	-2, 216,
-	-1, 1111,
-	91, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
-	-1, 1140,
-	89, 8,
-	93, 8,
-	95, 8,
-	-2, 216,
+	112, 6,
+	-2, 256,
+	-1, 1090,
+	112, 6,
+	-2, 256,
+	-1, 1093,
+	112, 4,
+	-2, 256,
+	-1, 1097,
+	108, 4,
+	110, 4,
+	112, 4,
+	-2, 256,
+	-1, 1165,
+	106, 6,
+	108, 6,
+	110, 6,
+	112, 6,
+	-2, 256,
+	-1, 1168,
+	112, 6,
+	-2, 256,
+	-1, 1173,
+	188, 67,
+	-2, 276,
+	-1, 1229,
+	106, 6,
+	110, 6,
+	112, 6,
+	-2, 256,
+	-1, 1233,
+	112, 8,
+	-2, 256,
+	-1, 1240,
+	112, 6,
+	-2, 256,
+	-1, 1244,
+	106, 4,
+	110, 4,
+	112, 4,
+	-2, 256,
+	-1, 1247,
+	112, 4,
+	-2, 256,
+	-1, 1284,
+	112, 6,
+	-2, 256,
+	-1, 1327,
+	197, 498,
+	199, 498,
+	-2, 276,
+	-1, 1338,
+	112, 6,
+	-2, 256,
+	-1, 1342,
+	108, 6,
+	110, 6,
+	112, 6,
+	-2, 256,
+	-1, 1345,
+	106, 8,
+	108, 8,
+	110, 8,
+	112, 8,
+	-2, 256,
+	-1, 1348,
+	112, 8,
+	-2, 256,
+	-1, 1349,
+	112, 8,
+	-2, 256,
+	-1, 1350,
+	112, 8,
+	-2, 256,
+	-1, 1382,
+	106, 8,
+	110, 8,
+	112, 8,
+	-2, 256,
+	-1, 1388,
+	112, 8,
+	-2, 256,
+	-1, 1389,
+	112, 8,
+	-2, 256,
+	-1, 1406,
+	106, 6,
+	110, 6,
+	112, 6,
+	-2, 256,
+	-1, 1409,
+	112, 6,
+	-2, 256,
+	-1, 1412,
+	112, 8,
+	-2, 256,
+	-1, 1426,
+	112, 8,
+	-2, 256,
+	-1, 1430,
+	108, 8,
+	110, 8,
+	112, 8,
+	-2, 256,
+	-1, 1452,
+	106, 8,
+	110, 8,
+	112, 8,
+	-2, 256,
+	-1, 1455,
+	112, 8,
+	-2, 256,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 4041
+const yyLast = 9305
 
 var yyAct = [...]int{
-
-	123, 21, 1106, 1105, 1118, 526, 1048, 350, 1077, 719,
-	977, 867, 881, 630, 116, 33, 949, 1047, 994, 951,
-	398, 27, 65, 121, 114, 880, 1025, 950, 754, 699,
-	188, 1024, 269, 574, 383, 670, 572, 840, 694, 464,
-	26, 649, 163, 513, 384, 164, 165, 590, 168, 169,
-	170, 172, 592, 176, 141, 141, 420, 144, 90, 463,
-	25, 389, 1, 553, 593, 250, 187, 666, 661, 239,
-	238, 181, 348, 185, 5, 459, 3, 448, 442, 244,
-	537, 532, 512, 101, 173, 536, 345, 700, 393, 465,
-	261, 248, 184, 503, 400, 186, 80, 192, 222, 266,
-	68, 78, 411, 182, 980, 130, 138, 568, 215, 919,
-	457, 214, 231, 131, 21, 127, 181, 1018, 129, 293,
-	126, 102, 215, 128, 1029, 214, 491, 202, 33, 214,
-	201, 200, 203, 199, 214, 124, 150, 184, 471, 142,
-	310, 856, 857, 241, 237, 183, 849, 166, 234, 711,
-	712, 683, 684, 26, 540, 184, 541, 542, 543, 535,
-	290, 291, 538, 540, 299, 541, 542, 543, 535, 788,
-	771, 538, 770, 25, 196, 742, 232, 709, 708, 301,
-	206, 205, 207, 208, 209, 693, 691, 685, 179, 3,
-	183, 681, 656, 600, 597, 262, 94, 550, 311, 489,
-	410, 311, 206, 205, 207, 208, 209, 405, 183, 197,
-	196, 313, 281, 315, 274, 198, 206, 205, 207, 208,
-	209, 311, 249, 215, 179, 1066, 214, 74, 1065, 1041,
-	270, 1040, 272, 1039, 21, 311, 131, 311, 1038, 273,
-	111, 382, 1037, 1036, 1011, 1010, 1008, 1006, 33, 1004,
-	1003, 74, 103, 104, 105, 314, 106, 107, 108, 109,
-	993, 325, 133, 326, 474, 992, 562, 539, 392, 417,
-	298, 974, 971, 26, 920, 675, 879, 858, 855, 362,
-	363, 821, 820, 578, 94, 434, 436, 439, 441, 444,
-	111, 819, 124, 25, 444, 449, 374, 141, 320, 449,
-	449, 818, 817, 456, 816, 812, 790, 787, 780, 3,
-	21, 779, 391, 326, 431, 341, 772, 741, 360, 361,
-	739, 738, 737, 388, 33, 730, 726, 707, 705, 370,
-	692, 690, 635, 455, 392, 628, 627, 551, 506, 626,
-	613, 584, 403, 488, 486, 484, 421, 416, 184, 408,
-	375, 306, 589, 415, 407, 307, 305, 1007, 135, 182,
-	1005, 504, 133, 958, 957, 956, 955, 413, 414, 954,
-	563, 953, 447, 418, 925, 911, 453, 454, 469, 21,
-	906, 427, 480, 903, 901, 133, 524, 525, 482, 483,
-	900, 893, 452, 33, 891, 530, 450, 451, 862, 686,
-	632, 183, 475, 609, 571, 547, 102, 559, 498, 497,
-	496, 495, 494, 473, 493, 492, 433, 477, 26, 432,
-	102, 184, 476, 502, 406, 184, 207, 208, 209, 139,
-	1055, 397, 253, 134, 501, 236, 230, 229, 25, 139,
-	219, 517, 184, 218, 217, 216, 112, 682, 224, 929,
-	531, 184, 430, 184, 3, 595, 287, 285, 602, 113,
-	507, 508, 603, 275, 587, 179, 672, 509, 392, 1084,
-	564, 368, 558, 654, 183, 904, 262, 756, 552, 902,
-	758, 834, 825, 745, 419, 650, 604, 986, 555, 899,
-	878, 877, 823, 795, 964, 576, 962, 557, 565, 134,
-	249, 566, 573, 826, 585, 102, 588, 580, 582, 610,
-	577, 745, 599, 824, 898, 21, 640, 567, 651, 569,
-	570, 102, 21, 967, 655, 897, 184, 220, 896, 33,
-	397, 253, 277, 221, 895, 755, 33, 103, 104, 105,
-	369, 255, 256, 257, 258, 549, 401, 394, 676, 894,
-	822, 103, 104, 105, 26, 106, 107, 108, 109, 815,
-	673, 26, 952, 631, 678, 917, 102, 646, 399, 652,
-	523, 522, 286, 284, 25, 429, 615, 639, 1139, 183,
-	1125, 25, 581, 634, 643, 276, 1115, 1114, 679, 1109,
-	3, 618, 619, 620, 621, 622, 638, 3, 444, 94,
-	687, 449, 1095, 21, 157, 158, 21, 21, 689, 631,
-	1094, 1086, 633, 660, 1068, 278, 279, 33, 702, 669,
-	33, 33, 1062, 1054, 668, 1107, 1051, 688, 988, 985,
-	984, 184, 146, 680, 647, 573, 103, 104, 105, 940,
-	255, 256, 257, 258, 928, 401, 753, 573, 890, 889,
-	884, 809, 103, 104, 105, 573, 106, 107, 108, 109,
-	808, 748, 757, 637, 530, 573, 601, 399, 518, 516,
-	1082, 155, 156, 159, 160, 1108, 713, 1081, 715, 1107,
-	1132, 1059, 1050, 1058, 725, 145, 1049, 1092, 740, 979,
-	724, 147, 723, 769, 735, 606, 761, 103, 104, 105,
-	605, 106, 107, 108, 109, 793, 883, 309, 102, 1049,
-	882, 801, 750, 717, 751, 148, 721, 722, 1016, 21,
-	882, 807, 260, 778, 21, 21, 515, 784, 782, 759,
-	514, 595, 800, 33, 253, 595, 806, 768, 33, 33,
-	514, 380, 773, 378, 1140, 774, 1111, 1087, 1076, 783,
-	21, 777, 1053, 382, 989, 976, 827, 886, 749, 718,
-	803, 798, 799, 521, 33, 555, 233, 1142, 1089, 1078,
-	573, 991, 852, 797, 978, 573, 752, 720, 838, 376,
-	240, 785, 786, 1131, 839, 1113, 843, 1112, 1074, 26,
-	947, 673, 946, 888, 184, 832, 21, 204, 887, 833,
-	716, 631, 184, 1108, 1050, 184, 850, 21, 883, 25,
-	33, 515, 831, 1146, 1138, 1103, 184, 1085, 865, 1032,
-	987, 33, 874, 830, 747, 3, 864, 873, 1129, 804,
-	1072, 944, 1119, 641, 810, 811, 1137, 1123, 1148, 103,
-	104, 105, 1134, 106, 107, 108, 109, 854, 1119, 1135,
-	1136, 1122, 1121, 1101, 744, 861, 74, 267, 863, 323,
-	1044, 908, 1012, 322, 324, 99, 914, 921, 915, 866,
-	673, 869, 930, 909, 926, 907, 932, 936, 21, 21,
-	184, 912, 913, 21, 943, 918, 923, 21, 223, 937,
-	938, 224, 33, 33, 860, 853, 931, 33, 933, 927,
-	1133, 33, 629, 1030, 874, 874, 935, 941, 1144, 873,
-	873, 1120, 631, 184, 74, 981, 74, 885, 961, 631,
-	960, 472, 1099, 960, 1117, 966, 312, 1120, 959, 1100,
-	21, 963, 1102, 924, 100, 365, 264, 972, 970, 364,
-	74, 975, 934, 412, 33, 968, 573, 546, 74, 74,
-	367, 366, 969, 869, 869, 990, 874, 859, 982, 330,
-	329, 873, 973, 263, 264, 265, 948, 841, 842, 235,
-	997, 998, 999, 1000, 1001, 781, 294, 21, 960, 1017,
-	21, 540, 631, 541, 542, 543, 1002, 21, 1014, 288,
-	21, 33, 807, 942, 33, 667, 184, 945, 1031, 848,
-	767, 33, 983, 874, 33, 869, 766, 665, 873, 573,
-	664, 386, 540, 874, 541, 542, 662, 21, 873, 385,
-	386, 1034, 1042, 1056, 658, 659, 1046, 960, 1052, 1035,
-	996, 33, 663, 184, 387, 1043, 829, 533, 242, 995,
-	1064, 704, 530, 874, 703, 295, 1063, 1057, 873, 1013,
-	21, 1071, 869, 81, 21, 1020, 21, 710, 1067, 21,
-	21, 1070, 869, 701, 33, 1073, 1069, 137, 33, 1026,
-	33, 631, 136, 33, 33, 195, 874, 21, 122, 1093,
-	874, 873, 21, 21, 1088, 873, 1045, 671, 21, 939,
-	1017, 33, 869, 21, 836, 837, 33, 33, 813, 1104,
-	1033, 802, 33, 631, 268, 174, 796, 33, 21, 1128,
-	1126, 1124, 21, 540, 874, 541, 542, 543, 535, 873,
-	794, 538, 33, 66, 180, 869, 33, 421, 706, 869,
-	598, 1020, 490, 308, 1020, 1020, 212, 213, 1145, 1141,
-	425, 21, 445, 1093, 246, 1026, 226, 227, 1026, 1026,
-	1149, 245, 1020, 422, 423, 33, 259, 1020, 1020, 149,
-	151, 125, 424, 869, 102, 247, 1026, 390, 1020, 180,
-	404, 1026, 1026, 1075, 122, 1009, 1079, 1080, 695, 696,
-	697, 698, 1026, 1020, 644, 340, 342, 1020, 174, 397,
-	253, 246, 409, 297, 1090, 296, 292, 1026, 95, 1096,
-	1097, 1026, 97, 95, 97, 94, 191, 194, 446, 67,
-	1110, 140, 762, 764, 102, 1091, 1020, 1015, 805, 377,
-	487, 10, 9, 554, 847, 1127, 8, 7, 379, 1130,
-	1026, 62, 346, 303, 347, 396, 102, 395, 251, 254,
-	112, 1143, 1116, 426, 1098, 1083, 89, 61, 60, 64,
-	317, 318, 319, 57, 321, 63, 58, 328, 1147, 331,
-	332, 333, 334, 335, 336, 337, 835, 102, 657, 174,
-	343, 349, 528, 202, 211, 210, 201, 200, 203, 199,
-	527, 56, 193, 653, 371, 648, 645, 243, 6, 20,
-	174, 19, 397, 253, 381, 103, 104, 105, 69, 255,
-	256, 257, 258, 154, 401, 17, 594, 591, 16, 443,
-	15, 485, 74, 14, 844, 846, 11, 102, 671, 18,
-	349, 13, 12, 1021, 870, 1019, 399, 174, 868, 428,
-	499, 500, 460, 458, 4, 2, 0, 0, 0, 0,
-	510, 0, 397, 253, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 174, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 103, 104, 105,
-	300, 106, 107, 108, 109, 0, 479, 845, 481, 0,
-	174, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 0, 0, 102, 0, 174, 916, 671, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 0, 0, 0, 174, 174, 0, 0, 0, 253,
-	0, 0, 202, 211, 174, 201, 200, 203, 199, 399,
-	381, 0, 0, 0, 519, 0, 0, 0, 0, 0,
-	0, 529, 0, 0, 534, 0, 0, 84, 103, 104,
-	105, 0, 255, 256, 257, 258, 0, 401, 0, 0,
-	0, 617, 0, 197, 196, 0, 623, 624, 625, 198,
-	206, 205, 207, 208, 209, 0, 0, 304, 300, 399,
-	143, 0, 0, 0, 0, 152, 153, 0, 161, 162,
-	0, 0, 0, 0, 167, 0, 0, 0, 171, 0,
-	175, 0, 177, 178, 197, 196, 0, 0, 0, 0,
-	198, 206, 205, 207, 208, 209, 122, 202, 211, 210,
-	201, 200, 203, 199, 103, 104, 105, 0, 106, 107,
-	108, 109, 611, 202, 211, 210, 201, 200, 203, 199,
-	0, 614, 0, 349, 0, 174, 228, 0, 0, 0,
-	174, 174, 174, 0, 0, 0, 0, 0, 0, 0,
-	729, 0, 0, 0, 0, 636, 0, 202, 211, 210,
-	201, 200, 203, 199, 642, 252, 59, 252, 0, 0,
-	0, 0, 0, 252, 271, 252, 0, 0, 731, 732,
-	733, 734, 736, 280, 252, 282, 283, 0, 0, 197,
-	196, 0, 289, 0, 132, 198, 206, 205, 207, 208,
-	209, 102, 0, 0, 828, 197, 196, 0, 0, 0,
-	0, 198, 206, 205, 207, 208, 209, 0, 0, 728,
-	202, 211, 210, 201, 200, 203, 199, 253, 0, 0,
-	102, 0, 316, 0, 0, 0, 0, 0, 97, 197,
-	196, 0, 0, 0, 776, 198, 206, 205, 207, 208,
-	209, 0, 338, 0, 511, 352, 727, 0, 225, 0,
-	0, 0, 174, 174, 174, 174, 174, 0, 0, 372,
-	102, 0, 0, 0, 0, 540, 743, 541, 542, 543,
-	535, 841, 842, 538, 252, 252, 0, 0, 202, 211,
-	210, 201, 200, 203, 199, 397, 253, 252, 252, 0,
-	529, 0, 197, 196, 352, 0, 760, 174, 198, 206,
-	205, 207, 208, 209, 0, 0, 0, 300, 0, 0,
-	0, 0, 435, 437, 438, 440, 775, 0, 174, 0,
-	765, 0, 103, 104, 105, 252, 255, 256, 257, 258,
-	0, 0, 0, 0, 0, 789, 0, 0, 468, 0,
-	470, 132, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 103, 104, 105, 381, 106, 107, 108, 109, 327,
-	197, 196, 0, 814, 0, 0, 198, 206, 205, 207,
-	208, 209, 0, 0, 965, 0, 102, 327, 327, 0,
-	0, 202, 211, 210, 201, 200, 203, 199, 0, 0,
-	0, 103, 104, 105, 102, 255, 256, 257, 258, 0,
-	401, 397, 253, 402, 102, 0, 373, 922, 0, 0,
-	0, 0, 0, 0, 102, 352, 0, 402, 545, 0,
-	0, 0, 399, 544, 0, 0, 102, 252, 339, 0,
-	548, 0, 556, 252, 560, 0, 763, 252, 252, 397,
-	253, 0, 0, 0, 0, 0, 556, 575, 0, 0,
-	579, 556, 556, 583, 0, 0, 0, 586, 575, 0,
-	0, 596, 905, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 910, 0, 892, 0, 0,
-	327, 0, 0, 0, 0, 0, 327, 327, 0, 0,
-	74, 174, 202, 211, 210, 201, 200, 203, 199, 607,
-	608, 0, 0, 575, 0, 0, 122, 103, 104, 105,
-	0, 255, 256, 257, 258, 0, 401, 352, 616, 0,
-	0, 327, 505, 505, 505, 103, 104, 105, 0, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 399, 106,
-	107, 108, 109, 0, 0, 103, 104, 105, 0, 255,
-	256, 257, 258, 0, 401, 0, 402, 103, 104, 105,
-	0, 106, 107, 108, 109, 0, 402, 252, 132, 0,
-	132, 132, 0, 674, 197, 196, 399, 677, 0, 556,
-	198, 206, 205, 207, 208, 209, 0, 0, 746, 0,
-	0, 556, 0, 0, 0, 0, 0, 0, 0, 556,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 556,
-	0, 0, 381, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 714, 71, 0, 0,
-	174, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 112, 0, 0, 0, 202, 211, 210, 201, 200,
-	203, 199, 0, 0, 0, 0, 0, 122, 0, 0,
-	0, 327, 0, 0, 0, 376, 0, 0, 529, 0,
+	164, 24, 1383, 1424, 665, 1230, 1425, 1324, 1082, 1337,
+	888, 1251, 1255, 1092, 1336, 162, 114, 994, 566, 1209,
+	344, 1109, 1225, 861, 625, 754, 270, 152, 1031, 1039,
+	737, 1105, 685, 1257, 918, 689, 909, 73, 271, 1091,
+	611, 796, 470, 829, 1067, 306, 471, 214, 31, 773,
+	834, 217, 218, 996, 221, 222, 223, 225, 486, 229,
+	995, 714, 706, 708, 573, 29, 514, 709, 785, 476,
+	309, 790, 310, 543, 185, 185, 436, 194, 226, 241,
+	1075, 630, 322, 537, 268, 610, 635, 316, 9, 634,
+	117, 648, 1081, 835, 320, 173, 602, 294, 335, 242,
+	105, 166, 480, 439, 275, 572, 28, 10, 8, 7,
+	91, 238, 182, 89, 346, 76, 232, 341, 502, 249,
+	282, 269, 282, 1149, 281, 372, 281, 244, 1, 249,
+	257, 267, 266, 256, 255, 258, 259, 254, 554, 251,
+	1234, 422, 1299, 488, 302, 262, 261, 263, 264, 265,
+	186, 1287, 24, 250, 241, 380, 201, 263, 264, 265,
+	1059, 281, 1060, 250, 581, 1365, 24, 574, 219, 1269,
+	237, 236, 235, 1132, 305, 308, 848, 640, 849, 641,
+	642, 643, 633, 1050, 815, 636, 816, 637, 638, 640,
+	1034, 641, 642, 643, 633, 961, 938, 636, 313, 637,
+	638, 935, 244, 174, 897, 169, 852, 846, 171, 845,
+	168, 369, 370, 170, 249, 828, 29, 825, 172, 174,
+	817, 169, 813, 343, 171, 244, 168, 780, 721, 170,
+	29, 718, 382, 252, 251, 109, 423, 591, 249, 253,
+	262, 261, 263, 264, 265, 239, 239, 249, 250, 109,
+	500, 556, 282, 495, 336, 250, 281, 28, 427, 423,
+	423, 423, 375, 174, 351, 169, 392, 245, 171, 662,
+	168, 28, 250, 262, 261, 263, 264, 265, 1436, 359,
+	303, 250, 238, 423, 123, 379, 1399, 1396, 1395, 85,
+	1402, 1394, 1367, 1364, 312, 1363, 1321, 1276, 426, 1272,
+	1268, 350, 1265, 1248, 1224, 24, 1219, 408, 934, 425,
+	1208, 1207, 468, 321, 639, 1150, 1123, 154, 38, 1104,
+	1088, 1061, 345, 347, 803, 349, 1058, 991, 431, 433,
+	1037, 442, 963, 85, 960, 446, 447, 448, 952, 949,
+	941, 237, 236, 235, 478, 896, 869, 851, 844, 842,
+	384, 827, 401, 824, 802, 479, 747, 746, 407, 745,
+	744, 740, 722, 699, 529, 531, 534, 536, 539, 29,
+	174, 674, 123, 539, 544, 600, 717, 599, 598, 593,
+	544, 544, 176, 508, 553, 605, 590, 588, 586, 185,
+	584, 546, 507, 449, 450, 408, 520, 525, 176, 109,
+	432, 176, 552, 475, 443, 444, 445, 461, 603, 515,
+	28, 389, 390, 388, 178, 1274, 1273, 1206, 506, 1156,
+	545, 713, 24, 1139, 1137, 1121, 1103, 1066, 661, 242,
+	1036, 1035, 874, 460, 455, 705, 818, 498, 511, 663,
+	795, 794, 176, 756, 735, 684, 656, 528, 527, 526,
+	579, 496, 501, 550, 551, 183, 542, 244, 504, 505,
+	1403, 479, 374, 493, 521, 24, 285, 216, 177, 38,
+	307, 301, 176, 623, 624, 291, 290, 497, 587, 289,
+	288, 347, 287, 38, 286, 285, 284, 283, 296, 594,
+	595, 597, 547, 548, 366, 364, 814, 671, 675, 1345,
+	1165, 727, 352, 151, 239, 561, 555, 396, 1308, 913,
+	509, 667, 558, 564, 565, 557, 894, 774, 1112, 911,
+	892, 1024, 549, 456, 1113, 109, 686, 1462, 887, 29,
+	1431, 1320, 583, 884, 695, 697, 650, 1108, 1348, 778,
+	85, 882, 244, 1455, 1449, 629, 1409, 1390, 596, 176,
+	606, 607, 601, 608, 1307, 885, 739, 775, 585, 739,
+	703, 739, 1247, 244, 711, 524, 716, 720, 676, 739,
+	28, 728, 650, 644, 244, 646, 653, 513, 479, 670,
+	1112, 657, 659, 336, 183, 177, 1113, 729, 292, 1111,
+	669, 1203, 910, 615, 293, 654, 652, 651, 680, 678,
+	682, 683, 677, 751, 681, 779, 681, 681, 880, 233,
+	692, 907, 653, 24, 763, 702, 1343, 876, 841, 1309,
+	24, 736, 38, 776, 749, 738, 1168, 1098, 730, 752,
+	620, 654, 652, 651, 109, 165, 739, 365, 363, 321,
+	1445, 1379, 1240, 1360, 1016, 739, 739, 1185, 1090, 1087,
+	750, 1111, 244, 739, 1086, 979, 970, 804, 767, 1015,
+	1010, 1007, 1005, 1003, 1000, 967, 717, 870, 742, 748,
+	196, 354, 758, 770, 622, 208, 209, 29, 1204, 1049,
+	686, 621, 523, 1461, 29, 1451, 1439, 1438, 808, 1435,
+	1434, 1428, 686, 1416, 1415, 1414, 809, 761, 799, 1405,
+	1373, 686, 1355, 1353, 755, 810, 1344, 1340, 819, 757,
+	1286, 784, 1243, 686, 1241, 1239, 1238, 823, 28, 539,
+	1179, 1177, 544, 793, 1164, 28, 792, 1128, 24, 837,
+	840, 24, 24, 24, 1102, 1452, 1389, 195, 353, 38,
+	1101, 762, 1095, 197, 988, 871, 859, 821, 766, 863,
+	864, 865, 987, 812, 771, 853, 206, 207, 210, 211,
+	589, 986, 893, 755, 902, 760, 726, 198, 355, 356,
+	908, 875, 616, 199, 357, 879, 881, 883, 886, 614,
+	469, 1427, 38, 1388, 244, 1426, 1339, 1350, 1349, 1233,
+	1338, 1426, 1094, 867, 866, 856, 1093, 613, 732, 731,
+	421, 612, 854, 1412, 1338, 912, 1284, 1093, 984, 612,
+	466, 481, 464, 1430, 1406, 1382, 1342, 1335, 944, 1279,
+	1244, 1229, 257, 267, 266, 256, 255, 258, 259, 254,
+	667, 1097, 903, 860, 619, 686, 304, 905, 904, 1454,
+	966, 1408, 686, 1384, 1246, 1231, 1069, 906, 976, 958,
+	959, 948, 862, 914, 895, 462, 311, 933, 954, 1447,
+	1446, 24, 946, 985, 957, 930, 1433, 24, 24, 1432,
+	1380, 1187, 1186, 1100, 1099, 858, 1427, 1339, 1094, 982,
+	613, 1457, 943, 711, 975, 989, 990, 711, 942, 1450,
+	716, 1421, 1404, 936, 956, 1302, 1242, 1019, 1011, 901,
+	947, 260, 1443, 1377, 24, 1183, 249, 468, 24, 973,
+	974, 764, 978, 972, 981, 1030, 916, 1252, 1356, 1316,
+	1262, 1314, 1315, 1017, 1392, 252, 251, 1312, 1313, 1311,
+	38, 253, 262, 261, 263, 264, 265, 38, 1261, 1054,
+	250, 381, 1260, 1013, 1194, 1197, 1028, 1259, 899, 94,
+	1012, 1329, 1277, 1256, 1197, 1154, 1023, 85, 1022, 1064,
+	1055, 115, 342, 452, 1162, 296, 1310, 451, 29, 404,
+	1300, 24, 29, 403, 405, 406, 1226, 1051, 753, 1235,
+	24, 1217, 1216, 582, 1038, 24, 1042, 187, 424, 503,
+	244, 339, 203, 204, 799, 212, 213, 215, 755, 454,
+	453, 244, 220, 1096, 1071, 244, 224, 295, 228, 28,
+	230, 231, 1072, 28, 1163, 85, 85, 791, 244, 85,
+	1062, 1122, 1192, 85, 85, 413, 412, 1125, 953, 1107,
+	1193, 679, 1020, 1196, 1040, 1041, 1021, 510, 1198, 1358,
+	116, 373, 1258, 367, 1047, 38, 1107, 1198, 38, 38,
+	38, 338, 339, 340, 929, 928, 1135, 1136, 789, 1129,
+	1089, 1127, 788, 300, 640, 1146, 641, 642, 643, 1134,
+	473, 1130, 1140, 1141, 1077, 3, 640, 1190, 641, 642,
+	1166, 1151, 1189, 686, 1114, 1169, 1173, 24, 24, 787,
+	1158, 24, 1148, 474, 24, 1182, 1167, 786, 24, 1160,
+	472, 473, 1009, 1142, 631, 1143, 1153, 314, 1157, 799,
+	244, 1171, 1181, 1161, 782, 783, 1184, 1106, 839, 324,
+	838, 324, 1170, 1172, 376, 847, 836, 5, 324, 324,
+	348, 324, 1180, 1026, 1027, 1199, 830, 831, 832, 833,
+	74, 181, 180, 811, 1195, 358, 324, 360, 361, 362,
+	1256, 1197, 244, 1201, 1227, 368, 755, 278, 1366, 385,
+	1176, 1205, 1133, 992, 980, 755, 24, 1174, 1175, 24,
+	519, 1178, 1214, 686, 977, 1213, 971, 969, 38, 515,
+	1220, 200, 202, 850, 38, 38, 516, 517, 843, 1222,
+	234, 167, 719, 592, 1448, 518, 393, 394, 395, 1237,
+	318, 178, 540, 337, 1215, 333, 243, 317, 1245, 319,
+	1236, 179, 1372, 241, 1249, 1250, 1333, 999, 477, 1334,
+	1371, 38, 494, 1266, 1267, 38, 3, 428, 768, 318,
+	24, 429, 1285, 242, 24, 499, 1254, 378, 377, 1258,
+	3, 24, 1294, 371, 1198, 24, 1228, 985, 24, 1232,
+	1281, 110, 458, 112, 640, 755, 641, 642, 643, 633,
+	951, 244, 636, 1303, 637, 638, 1304, 109, 324, 324,
+	274, 1305, 1306, 244, 1327, 112, 110, 246, 247, 248,
+	541, 243, 277, 324, 324, 24, 75, 324, 38, 1322,
+	184, 686, 1346, 1263, 1264, 1411, 1319, 38, 1283, 649,
+	983, 463, 38, 1331, 243, 1068, 11, 1328, 1347, 666,
+	1282, 465, 70, 530, 532, 533, 535, 1354, 437, 438,
+	1326, 1301, 687, 1359, 484, 244, 1293, 483, 324, 482,
+	323, 1361, 326, 1357, 1253, 1191, 1110, 1032, 915, 24,
+	1376, 69, 100, 24, 1362, 66, 24, 68, 67, 24,
+	24, 24, 1374, 72, 1294, 1368, 64, 1294, 1294, 1294,
+	71, 234, 65, 491, 1025, 1341, 1327, 781, 627, 1391,
+	626, 1393, 578, 1397, 580, 63, 1401, 175, 755, 3,
+	276, 777, 820, 24, 1407, 1413, 772, 769, 667, 24,
+	24, 1294, 1029, 1210, 919, 315, 6, 1294, 1294, 23,
+	22, 1295, 21, 77, 38, 38, 205, 24, 38, 1285,
+	24, 38, 19, 24, 715, 38, 18, 710, 707, 1375,
+	686, 1294, 17, 1378, 538, 16, 15, 24, 1442, 1440,
+	755, 24, 1437, 12, 20, 1294, 1420, 324, 1293, 1294,
+	14, 1293, 1293, 1293, 668, 324, 672, 1453, 13, 324,
+	324, 797, 297, 24, 1290, 1413, 24, 1078, 1288, 668,
+	324, 1294, 688, 690, 1294, 1460, 694, 668, 668, 698,
+	1076, 569, 567, 701, 690, 1293, 4, 712, 2, 0,
+	0, 1293, 1293, 38, 0, 0, 38, 1422, 0, 0,
+	1423, 822, 0, 0, 0, 891, 568, 1381, 0, 0,
+	1385, 1386, 1387, 1419, 0, 1293, 0, 0, 0, 0,
+	0, 0, 0, 1295, 940, 0, 1295, 1295, 1295, 1293,
+	0, 0, 0, 1293, 0, 0, 0, 950, 0, 0,
+	0, 733, 734, 0, 1410, 690, 243, 0, 0, 3,
+	1417, 1418, 743, 0, 0, 1293, 0, 38, 1293, 1456,
+	1295, 38, 0, 0, 0, 0, 1295, 1295, 38, 0,
+	0, 0, 38, 0, 1429, 38, 640, 0, 641, 642,
+	643, 633, 1040, 1041, 636, 0, 637, 638, 1441, 0,
+	1295, 0, 1444, 0, 175, 0, 0, 968, 0, 324,
+	175, 0, 0, 0, 1295, 800, 0, 801, 1295, 0,
+	924, 926, 38, 409, 1458, 0, 0, 1459, 805, 0,
+	806, 0, 0, 668, 0, 243, 993, 0, 0, 0,
+	1295, 664, 1001, 1295, 0, 668, 1004, 0, 1006, 324,
+	1008, 0, 0, 0, 668, 0, 0, 0, 409, 409,
+	0, 0, 691, 694, 0, 0, 668, 0, 0, 1057,
+	0, 700, 0, 704, 0, 0, 38, 0, 0, 0,
+	38, 0, 0, 38, 490, 0, 38, 38, 38, 855,
+	0, 640, 0, 641, 642, 643, 633, 826, 0, 636,
+	490, 637, 638, 0, 0, 0, 0, 3, 873, 0,
+	0, 0, 0, 0, 3, 0, 0, 0, 890, 873,
+	38, 890, 0, 0, 0, 0, 38, 38, 0, 0,
+	0, 0, 640, 1073, 641, 642, 643, 633, 0, 0,
+	636, 0, 637, 638, 38, 0, 0, 38, 0, 0,
+	38, 243, 0, 0, 0, 0, 0, 0, 324, 324,
+	1043, 1045, 0, 0, 38, 932, 1116, 797, 38, 1118,
+	409, 1119, 0, 1120, 0, 0, 0, 0, 409, 409,
+	0, 1124, 1152, 668, 0, 0, 0, 324, 668, 0,
+	38, 1159, 0, 38, 0, 668, 0, 0, 690, 0,
+	0, 0, 668, 668, 0, 0, 0, 0, 964, 965,
+	0, 873, 0, 0, 0, 0, 0, 409, 604, 604,
+	604, 0, 568, 0, 0, 568, 568, 568, 0, 0,
+	257, 267, 266, 256, 255, 258, 259, 254, 0, 0,
+	873, 0, 997, 0, 0, 0, 873, 0, 0, 0,
+	873, 0, 873, 490, 873, 0, 0, 890, 0, 1014,
+	0, 0, 0, 0, 0, 490, 0, 0, 175, 0,
+	175, 175, 0, 0, 0, 0, 490, 1218, 0, 1144,
+	0, 1221, 797, 868, 1223, 0, 1033, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 257, 324, 324,
+	256, 255, 258, 259, 254, 324, 0, 1052, 1053, 0,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 694, 0, 252, 251, 0, 0, 873, 0, 253,
+	262, 261, 263, 264, 265, 0, 1275, 387, 250, 1323,
+	0, 0, 0, 0, 0, 568, 0, 0, 0, 0,
+	0, 568, 568, 0, 0, 0, 0, 0, 0, 409,
+	873, 0, 0, 873, 0, 873, 0, 873, 0, 0,
+	890, 249, 0, 0, 0, 873, 890, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3, 0,
+	252, 251, 3, 0, 1332, 490, 253, 262, 261, 263,
+	264, 265, 0, 0, 0, 250, 175, 324, 668, 1147,
+	324, 0, 0, 0, 0, 0, 0, 0, 409, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 0, 0, 257, 267,
+	266, 256, 255, 258, 259, 254, 1369, 1370, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
+	267, 266, 256, 255, 258, 259, 254, 0, 0, 568,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1056,
+	0, 0, 0, 0, 1400, 0, 0, 0, 0, 0,
+	1065, 0, 1033, 0, 1070, 0, 0, 0, 0, 690,
+	0, 0, 0, 0, 0, 0, 0, 1074, 0, 409,
+	0, 0, 0, 0, 0, 0, 668, 0, 0, 0,
+	0, 0, 249, 0, 0, 0, 257, 267, 266, 256,
+	255, 258, 259, 254, 0, 0, 0, 0, 0, 0,
+	0, 252, 251, 249, 490, 490, 0, 253, 262, 261,
+	263, 264, 265, 0, 490, 387, 250, 381, 0, 0,
+	0, 0, 252, 251, 0, 0, 997, 0, 253, 262,
+	261, 263, 264, 265, 0, 0, 1202, 250, 568, 0,
+	0, 0, 568, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1297, 1298, 0, 0, 0, 1155,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1317, 1318, 0, 0, 0, 0, 252,
+	251, 0, 0, 0, 668, 253, 262, 261, 263, 264,
+	265, 1188, 0, 0, 250, 1018, 0, 0, 0, 0,
+	0, 0, 0, 409, 0, 0, 0, 0, 0, 1351,
+	1352, 0, 0, 0, 0, 0, 0, 81, 257, 267,
+	266, 256, 255, 258, 259, 254, 0, 0, 0, 0,
+	890, 490, 0, 490, 490, 490, 0, 0, 0, 0,
+	0, 490, 0, 0, 0, 163, 0, 0, 0, 0,
+	0, 0, 0, 878, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1289, 0,
+	890, 0, 0, 0, 0, 227, 1398, 0, 0, 568,
+	0, 668, 568, 0, 0, 0, 0, 0, 257, 267,
+	266, 256, 255, 258, 259, 254, 240, 0, 0, 0,
+	243, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	279, 280, 1278, 668, 0, 0, 0, 0, 0, 0,
+	0, 252, 251, 0, 0, 298, 299, 253, 262, 261,
+	263, 264, 265, 0, 0, 877, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 490, 490, 0, 0, 490, 0, 0, 0,
+	0, 409, 0, 0, 1330, 0, 0, 0, 0, 0,
+	409, 240, 249, 0, 0, 0, 0, 163, 0, 0,
+	1289, 0, 0, 1289, 1289, 1289, 0, 0, 0, 0,
+	0, 252, 251, 0, 0, 227, 0, 253, 262, 261,
+	263, 264, 265, 0, 0, 0, 250, 609, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1289, 0, 0,
+	0, 0, 0, 1289, 1289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 227, 0, 257, 267, 266, 256,
+	255, 258, 259, 254, 0, 0, 0, 1289, 0, 0,
+	0, 490, 0, 0, 0, 0, 0, 386, 0, 0,
+	409, 1289, 0, 227, 0, 1289, 0, 0, 397, 398,
+	399, 400, 0, 402, 0, 0, 410, 411, 0, 414,
+	415, 416, 417, 418, 419, 420, 0, 1289, 0, 0,
+	1289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	227, 434, 440, 227, 0, 0, 0, 227, 227, 227,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 457,
+	249, 0, 0, 0, 0, 227, 0, 0, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	251, 0, 0, 0, 0, 253, 262, 261, 263, 264,
+	265, 0, 0, 0, 250, 381, 0, 0, 0, 440,
+	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
+	522, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	0, 0, 0, 409, 0, 0, 0, 0, 0, 0,
+	0, 0, 227, 0, 0, 0, 0, 0, 0, 227,
+	0, 655, 0, 485, 325, 0, 161, 143, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 560, 0, 562, 563, 0, 227, 0, 0, 0,
+	0, 144, 145, 189, 146, 409, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 0, 0,
+	227, 0, 0, 257, 267, 266, 256, 255, 258, 259,
+	254, 227, 227, 227, 85, 0, 0, 0, 0, 0,
+	0, 147, 148, 121, 193, 122, 192, 492, 0, 0,
+	467, 1069, 0, 0, 617, 0, 0, 0, 0, 0,
+	0, 0, 628, 0, 0, 632, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 409, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 140, 142, 188, 141, 0, 0, 149,
+	0, 0, 160, 138, 126, 127, 128, 249, 135, 136,
+	137, 327, 328, 329, 330, 331, 332, 0, 489, 0,
+	0, 0, 190, 191, 409, 0, 252, 251, 0, 0,
+	0, 0, 253, 262, 261, 263, 264, 265, 0, 0,
+	487, 250, 0, 723, 0, 0, 0, 724, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 125, 86, 87, 88, 0, 115,
+	90, 109, 112, 110, 111, 25, 82, 741, 0, 440,
+	40, 41, 0, 0, 0, 0, 0, 32, 0, 0,
+	124, 0, 33, 143, 118, 34, 50, 759, 35, 0,
+	0, 0, 0, 0, 0, 0, 765, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 145, 97,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 120, 0, 0, 0, 0, 0, 106,
+	0, 0, 0, 107, 0, 0, 0, 0, 116, 807,
+	85, 0, 0, 0, 0, 0, 0, 147, 148, 121,
+	80, 122, 79, 150, 1292, 1291, 0, 1084, 0, 0,
+	0, 0, 0, 37, 113, 0, 44, 42, 43, 39,
+	45, 0, 0, 0, 0, 0, 0, 0, 48, 49,
+	576, 577, 0, 53, 54, 55, 56, 46, 58, 59,
+	60, 51, 57, 61, 0, 0, 1296, 1085, 139, 140,
+	142, 47, 141, 857, 0, 149, 36, 52, 62, 138,
+	126, 127, 128, 0, 135, 136, 137, 129, 130, 131,
+	132, 133, 134, 123, 0, 95, 99, 96, 98, 101,
+	102, 103, 104, 0, 898, 0, 0, 0, 0, 0,
+	92, 93, 0, 0, 0, 108, 78, 0, 257, 267,
+	266, 256, 255, 258, 259, 254, 0, 0, 628, 0,
+	0, 0, 0, 0, 917, 920, 0, 0, 0, 0,
+	0, 0, 931, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 440,
+	0, 0, 945, 0, 227, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 955, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 962, 0, 0, 0, 0, 0,
+	0, 257, 267, 266, 256, 255, 258, 259, 254, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 0, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 251, 0, 0, 0, 1002, 253, 262, 261,
+	263, 264, 265, 0, 0, 1200, 250, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 86,
+	87, 88, 0, 115, 90, 109, 112, 110, 111, 0,
+	82, 0, 0, 0, 0, 249, 0, 0, 0, 0,
+	0, 156, 0, 0, 124, 0, 161, 143, 118, 0,
+	0, 0, 0, 0, 252, 251, 0, 0, 0, 1063,
+	253, 262, 261, 263, 264, 265, 0, 0, 1117, 250,
+	0, 144, 145, 97, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 0, 0,
+	0, 0, 0, 106, 0, 0, 0, 107, 0, 0,
+	0, 0, 116, 0, 0, 0, 0, 1115, 0, 0,
+	0, 147, 148, 121, 80, 122, 79, 150, 159, 155,
+	0, 0, 0, 0, 0, 0, 1126, 0, 113, 257,
+	267, 266, 256, 255, 258, 259, 254, 0, 1131, 0,
+	0, 0, 920, 227, 227, 0, 0, 0, 1138, 0,
+	257, 267, 266, 256, 255, 258, 259, 254, 0, 0,
+	0, 0, 139, 140, 142, 157, 141, 0, 227, 149,
+	158, 0, 160, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 123, 163, 95,
+	99, 96, 98, 101, 102, 103, 104, 0, 0, 0,
+	0, 0, 0, 0, 92, 93, 0, 0, 0, 108,
+	78, 0, 0, 249, 0, 391, 0, 0, 0, 0,
+	0, 0, 227, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 251, 249, 0, 0, 0, 253, 262,
+	261, 263, 264, 265, 0, 1211, 939, 250, 0, 0,
+	0, 0, 0, 252, 251, 0, 0, 0, 0, 253,
+	262, 261, 263, 264, 265, 0, 0, 900, 250, 0,
+	0, 0, 0, 0, 0, 0, 257, 267, 266, 256,
+	255, 258, 259, 254, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 628, 628, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1048, 0, 0, 0, 0, 0, 0, 0, 0,
+	1271, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1280, 0, 0, 0, 0, 467,
+	0, 0, 125, 86, 87, 88, 0, 115, 90, 109,
+	112, 110, 111, 25, 82, 0, 0, 0, 40, 41,
+	249, 0, 0, 0, 0, 32, 0, 0, 124, 0,
+	33, 143, 118, 34, 50, 0, 35, 1211, 0, 252,
+	251, 0, 0, 0, 0, 253, 262, 261, 263, 264,
+	265, 0, 0, 0, 250, 144, 145, 97, 146, 0,
+	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 120, 0, 0, 0, 0, 0, 106, 0, 0,
+	0, 107, 0, 0, 0, 0, 116, 0, 85, 0,
+	0, 227, 0, 0, 0, 147, 148, 121, 80, 122,
+	79, 150, 571, 570, 0, 83, 0, 0, 0, 0,
+	0, 37, 113, 0, 44, 42, 43, 39, 45, 0,
+	0, 0, 0, 0, 0, 0, 48, 49, 576, 577,
+	84, 53, 54, 55, 56, 46, 58, 59, 60, 51,
+	57, 61, 0, 0, 575, 0, 139, 140, 142, 47,
+	141, 0, 467, 149, 36, 52, 62, 138, 126, 127,
+	128, 0, 135, 136, 137, 129, 130, 131, 132, 133,
+	134, 123, 0, 95, 99, 96, 98, 101, 102, 103,
+	104, 0, 0, 0, 0, 0, 0, 0, 92, 93,
+	0, 0, 0, 108, 78, 125, 86, 87, 88, 0,
+	115, 90, 109, 112, 110, 111, 25, 82, 0, 0,
+	0, 40, 41, 0, 0, 0, 0, 0, 32, 0,
+	0, 124, 0, 33, 143, 118, 34, 50, 0, 35,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 145,
+	97, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 120, 0, 0, 0, 0, 0,
+	106, 0, 0, 0, 107, 0, 0, 0, 0, 116,
+	0, 85, 0, 0, 0, 0, 0, 0, 147, 148,
+	121, 80, 122, 79, 150, 1080, 1079, 0, 1084, 0,
+	0, 0, 0, 0, 37, 113, 0, 44, 42, 43,
+	39, 45, 0, 0, 0, 0, 0, 0, 0, 48,
+	49, 0, 0, 0, 53, 54, 55, 56, 46, 58,
+	59, 60, 51, 57, 61, 0, 0, 1083, 1085, 139,
+	140, 142, 47, 141, 0, 0, 149, 36, 52, 62,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 123, 0, 95, 99, 96, 98,
+	101, 102, 103, 104, 0, 0, 0, 0, 0, 0,
+	0, 92, 93, 0, 0, 0, 108, 78, 125, 86,
+	87, 88, 0, 115, 90, 109, 112, 110, 111, 25,
+	82, 0, 0, 0, 40, 41, 0, 0, 0, 0,
+	0, 32, 0, 0, 124, 0, 33, 143, 118, 34,
+	50, 0, 35, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 144, 145, 97, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 0, 0,
+	0, 0, 0, 106, 0, 0, 0, 107, 0, 0,
+	0, 0, 116, 0, 85, 0, 0, 0, 0, 0,
+	0, 147, 148, 121, 80, 122, 79, 150, 27, 26,
+	0, 83, 0, 0, 0, 0, 0, 37, 113, 0,
+	44, 42, 43, 39, 45, 0, 0, 0, 0, 0,
+	0, 0, 48, 49, 0, 0, 84, 53, 54, 55,
+	56, 46, 58, 59, 60, 51, 57, 61, 0, 0,
+	30, 0, 139, 140, 142, 47, 141, 0, 0, 149,
+	36, 52, 62, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 123, 0, 95,
+	99, 96, 98, 101, 102, 103, 104, 0, 0, 0,
+	0, 0, 0, 0, 92, 93, 0, 0, 0, 108,
+	78, 125, 86, 87, 88, 0, 115, 90, 109, 112,
+	110, 111, 0, 82, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 0, 124, 0, 161,
+	143, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 145, 97, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	120, 0, 0, 0, 0, 0, 106, 0, 0, 0,
+	107, 0, 0, 0, 0, 116, 0, 85, 0, 0,
+	0, 0, 0, 0, 147, 148, 121, 80, 122, 79,
+	150, 159, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 267, 266, 256,
+	255, 258, 259, 254, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 140, 142, 157, 141,
+	0, 0, 149, 158, 462, 160, 138, 126, 127, 128,
+	0, 135, 136, 137, 129, 130, 131, 132, 133, 134,
+	123, 0, 95, 99, 96, 98, 101, 102, 103, 104,
+	0, 0, 0, 0, 0, 0, 0, 92, 93, 0,
+	0, 0, 108, 78, 1270, 125, 86, 87, 88, 0,
+	115, 90, 109, 112, 110, 111, 0, 82, 0, 0,
+	249, 0, 0, 0, 0, 0, 0, 0, 156, 0,
+	0, 124, 0, 161, 143, 118, 0, 0, 0, 252,
+	251, 0, 0, 0, 0, 253, 262, 261, 263, 264,
+	265, 0, 0, 0, 250, 0, 0, 0, 144, 145,
+	97, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 120, 0, 0, 0, 0, 0,
+	106, 0, 0, 0, 107, 0, 0, 0, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	121, 80, 122, 79, 150, 159, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
+	267, 266, 256, 255, 258, 259, 254, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	140, 142, 157, 141, 0, 0, 149, 158, 618, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 123, 0, 95, 99, 96, 98,
+	101, 102, 103, 104, 0, 0, 0, 0, 0, 0,
+	0, 92, 93, 441, 0, 0, 108, 78, 435, 125,
+	86, 87, 88, 0, 115, 90, 109, 112, 110, 111,
+	0, 82, 0, 249, 0, 0, 0, 0, 0, 0,
+	0, 0, 156, 0, 0, 124, 0, 161, 143, 118,
+	0, 0, 252, 251, 0, 0, 0, 0, 253, 262,
+	261, 263, 264, 265, 0, 0, 0, 250, 0, 0,
+	0, 0, 144, 145, 97, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 120, 0,
+	0, 0, 0, 1325, 106, 0, 0, 0, 107, 0,
+	0, 0, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 121, 80, 122, 79, 150, 159,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 257, 267, 266, 256, 255, 258, 259, 254, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 140, 142, 157, 141, 0, 0,
+	149, 158, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 129, 130, 131, 132, 133, 134, 123, 0,
+	95, 99, 96, 98, 101, 102, 103, 104, 0, 0,
+	0, 0, 0, 0, 0, 92, 93, 0, 0, 0,
+	108, 78, 125, 86, 87, 88, 0, 115, 90, 109,
+	112, 110, 111, 0, 82, 249, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 124, 0,
+	161, 143, 118, 0, 252, 251, 0, 0, 0, 0,
+	253, 262, 261, 263, 264, 265, 0, 0, 0, 250,
+	0, 0, 0, 0, 0, 144, 145, 97, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 120, 0, 0, 0, 0, 0, 106, 0, 0,
+	0, 107, 0, 0, 0, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 148, 121, 80, 122,
+	79, 150, 159, 155, 0, 0, 0, 0, 0, 0,
+	0, 273, 113, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 725, 266, 256, 255, 258,
+	259, 254, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 140, 142, 157,
+	141, 0, 0, 149, 272, 0, 160, 138, 126, 127,
+	128, 0, 135, 136, 137, 129, 130, 131, 132, 133,
+	134, 123, 0, 95, 99, 96, 98, 101, 102, 103,
+	104, 0, 0, 0, 0, 0, 0, 0, 92, 93,
+	0, 0, 0, 108, 78, 125, 86, 87, 88, 0,
+	115, 90, 109, 112, 110, 111, 0, 82, 249, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 156, 0,
+	0, 124, 0, 161, 143, 118, 0, 252, 251, 0,
+	0, 0, 0, 253, 262, 261, 263, 264, 265, 0,
+	0, 0, 250, 0, 0, 0, 0, 0, 144, 145,
+	97, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 120, 0, 0, 0, 0, 0,
+	106, 0, 0, 0, 107, 0, 0, 0, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	121, 80, 122, 79, 150, 159, 155, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 257, 559, 266,
+	256, 255, 258, 259, 254, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	140, 142, 157, 141, 0, 0, 149, 158, 0, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 123, 0, 95, 99, 96, 98,
+	101, 102, 103, 104, 0, 0, 0, 0, 0, 0,
+	0, 92, 93, 441, 0, 0, 108, 78, 125, 86,
+	87, 88, 0, 115, 90, 109, 112, 110, 111, 0,
+	82, 249, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 0, 124, 0, 161, 143, 118, 0,
+	252, 251, 0, 0, 0, 0, 253, 262, 261, 263,
+	264, 265, 0, 0, 0, 250, 0, 0, 0, 0,
+	0, 144, 145, 97, 146, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 0, 0,
+	0, 0, 0, 106, 0, 0, 0, 107, 0, 0,
+	0, 0, 116, 0, 85, 0, 0, 0, 0, 0,
+	0, 147, 148, 121, 80, 122, 79, 150, 159, 155,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	257, 267, 0, 256, 255, 258, 259, 254, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 140, 142, 157, 141, 0, 0, 149,
+	158, 0, 160, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 123, 0, 95,
+	99, 96, 98, 101, 102, 103, 104, 0, 0, 0,
+	0, 0, 0, 0, 92, 93, 0, 0, 0, 108,
+	78, 125, 86, 87, 88, 0, 115, 90, 109, 112,
+	110, 111, 0, 82, 249, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 0, 124, 0, 161,
+	143, 118, 0, 252, 251, 0, 0, 0, 0, 253,
+	262, 261, 263, 264, 265, 0, 0, 0, 250, 0,
+	0, 0, 0, 0, 144, 145, 97, 146, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	120, 0, 0, 0, 0, 0, 106, 0, 0, 0,
+	107, 0, 0, 0, 0, 116, 342, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 121, 80, 122, 79,
+	150, 159, 155, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 140, 142, 157, 141,
+	0, 0, 149, 158, 0, 160, 138, 126, 127, 128,
+	0, 135, 136, 137, 129, 130, 131, 132, 133, 134,
+	123, 0, 95, 99, 96, 98, 101, 102, 103, 104,
+	0, 0, 0, 0, 0, 0, 0, 92, 93, 0,
+	0, 0, 108, 78, 125, 86, 87, 88, 0, 115,
+	90, 109, 112, 110, 111, 0, 82, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	124, 0, 161, 143, 118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 145, 97,
+	146, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 120, 0, 0, 0, 0, 0, 106,
+	0, 0, 0, 107, 0, 0, 0, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 147, 148, 121,
+	80, 122, 79, 150, 159, 155, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 140,
+	142, 157, 141, 0, 0, 149, 158, 0, 160, 138,
+	126, 127, 128, 0, 135, 136, 137, 129, 130, 131,
+	132, 133, 134, 123, 0, 95, 99, 96, 98, 101,
+	102, 103, 104, 0, 0, 0, 0, 0, 0, 0,
+	92, 93, 0, 0, 0, 108, 78, 125, 86, 87,
+	88, 0, 115, 90, 109, 112, 110, 111, 0, 82,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 0, 124, 0, 161, 143, 118, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 145, 97, 146, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 120, 0, 0, 0,
+	0, 0, 106, 0, 0, 0, 107, 0, 0, 0,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 121, 80, 122, 79, 150, 159, 155, 0,
+	0, 0, 0, 0, 0, 0, 0, 113, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 140, 142, 157, 141, 0, 0, 149, 158,
+	0, 160, 138, 126, 127, 128, 0, 135, 136, 137,
+	129, 130, 131, 132, 133, 134, 123, 0, 95, 99,
+	96, 98, 101, 102, 103, 104, 0, 0, 0, 0,
+	0, 0, 0, 92, 93, 0, 0, 0, 108, 153,
+	125, 86, 87, 88, 0, 115, 90, 109, 112, 110,
+	111, 0, 82, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 124, 0, 161, 143,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 145, 97, 146, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 120,
+	0, 0, 0, 0, 0, 106, 0, 0, 0, 107,
+	0, 0, 0, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 148, 121, 80, 122, 79, 150,
+	159, 155, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 140, 142, 157, 141, 0,
+	0, 149, 158, 0, 160, 138, 126, 127, 128, 0,
+	135, 136, 137, 129, 130, 131, 132, 133, 134, 123,
+	0, 95, 99, 96, 98, 101, 102, 103, 104, 0,
+	0, 0, 0, 0, 0, 0, 92, 93, 0, 0,
+	0, 108, 1212, 125, 86, 87, 88, 0, 115, 90,
+	109, 112, 110, 111, 0, 82, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 156, 0, 0, 124,
+	0, 161, 143, 118, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 921, 922, 923, 146,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 120, 0, 0, 0, 0, 0, 106, 0,
+	0, 0, 107, 0, 0, 0, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 121, 80,
+	122, 79, 150, 159, 155, 0, 0, 0, 0, 0,
+	0, 0, 0, 113, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 140, 142,
+	157, 141, 0, 0, 149, 158, 0, 160, 138, 126,
+	127, 128, 0, 135, 136, 137, 129, 130, 131, 132,
+	133, 134, 123, 0, 95, 99, 96, 98, 101, 102,
+	103, 104, 0, 0, 0, 0, 0, 0, 0, 92,
+	93, 0, 0, 0, 108, 78, 125, 86, 87, 88,
+	0, 115, 90, 109, 112, 110, 111, 0, 82, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 673, 0, 161, 143, 118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	145, 97, 146, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 120, 0, 0, 0, 0,
+	0, 106, 0, 0, 0, 107, 0, 0, 0, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	148, 121, 80, 122, 79, 150, 159, 155, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 140, 142, 157, 141, 0, 0, 149, 158, 0,
+	160, 138, 126, 127, 128, 0, 135, 136, 137, 129,
+	130, 131, 132, 133, 134, 123, 0, 95, 99, 96,
+	98, 101, 102, 103, 104, 0, 0, 0, 0, 0,
+	0, 0, 92, 93, 0, 0, 0, 108, 78, 125,
+	86, 383, 88, 0, 115, 90, 109, 112, 110, 111,
+	0, 82, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 156, 0, 0, 124, 0, 161, 143, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 352, 92, 0, 0, 0, 100,
-	0, 0, 252, 252, 0, 0, 402, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 327, 98, 556,
-	0, 0, 381, 252, 556, 102, 0, 0, 0, 556,
-	0, 575, 94, 0, 0, 556, 556, 197, 196, 0,
-	0, 791, 792, 198, 206, 205, 207, 208, 209, 0,
-	0, 0, 0, 0, 354, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 355, 86, 353,
-	356, 357, 358, 359, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 351, 0, 0, 93, 70, 344, 202,
-	211, 210, 201, 200, 203, 199, 327, 0, 0, 0,
-	0, 0, 0, 0, 252, 252, 0, 0, 252, 851,
-	520, 0, 0, 202, 211, 210, 201, 200, 203, 199,
-	0, 0, 0, 0, 0, 0, 579, 0, 0, 0,
-	0, 402, 402, 0, 0, 0, 0, 0, 0, 402,
-	0, 0, 102, 75, 76, 77, 0, 99, 79, 94,
-	97, 95, 96, 0, 71, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 0, 118, 0, 0, 112, 0,
-	0, 197, 196, 0, 0, 0, 0, 198, 206, 205,
-	207, 208, 209, 0, 0, 0, 252, 252, 0, 0,
-	0, 0, 0, 0, 0, 197, 196, 0, 0, 0,
-	556, 198, 206, 205, 207, 208, 209, 0, 91, 327,
-	0, 0, 92, 0, 0, 0, 100, 0, 0, 0,
-	0, 0, 0, 0, 0, 120, 117, 0, 0, 0,
-	402, 0, 402, 402, 402, 98, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 575,
+	0, 0, 144, 145, 97, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 120, 0,
+	0, 0, 0, 0, 106, 0, 0, 0, 107, 0,
+	0, 0, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 121, 80, 122, 79, 150, 159,
+	155, 0, 0, 0, 0, 0, 0, 0, 0, 113,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 556, 0, 0, 0, 0, 0, 0,
-	0, 354, 0, 103, 104, 105, 0, 106, 107, 108,
-	109, 111, 0, 85, 355, 86, 353, 356, 357, 358,
-	359, 0, 0, 0, 0, 0, 0, 0, 82, 83,
-	351, 0, 0, 93, 70, 0, 0, 0, 0, 0,
-	0, 0, 402, 0, 402, 402, 402, 0, 1027, 1028,
-	327, 0, 0, 0, 0, 0, 0, 327, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 22,
-	71, 0, 0, 0, 35, 36, 0, 0, 0, 0,
-	0, 28, 0, 0, 112, 0, 29, 44, 0, 30,
-	0, 0, 0, 0, 0, 0, 0, 1060, 1061, 0,
-	0, 0, 352, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
-	327, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 74, 0, 0, 0, 0, 0,
-	0, 1023, 1022, 0, 875, 0, 0, 0, 0, 0,
-	32, 98, 0, 39, 37, 38, 34, 40, 0, 0,
-	0, 0, 0, 0, 0, 42, 43, 466, 467, 0,
-	47, 48, 49, 50, 41, 52, 53, 54, 45, 51,
-	55, 0, 0, 0, 876, 0, 0, 31, 46, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 22, 71, 0, 0, 0, 35, 36, 0,
-	0, 327, 0, 0, 28, 0, 0, 112, 0, 29,
-	44, 0, 30, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 74, 0, 0,
-	0, 0, 0, 0, 462, 461, 0, 72, 0, 0,
-	0, 0, 0, 32, 98, 0, 39, 37, 38, 34,
-	40, 0, 0, 0, 0, 0, 0, 0, 42, 43,
-	466, 467, 73, 47, 48, 49, 50, 41, 52, 53,
-	54, 45, 51, 55, 0, 0, 0, 0, 0, 0,
-	31, 46, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 22, 71, 0, 0, 0,
-	35, 36, 0, 0, 0, 0, 0, 28, 0, 0,
-	112, 0, 29, 44, 0, 30, 0, 0, 0, 0,
+	0, 0, 0, 139, 140, 142, 157, 141, 0, 0,
+	149, 158, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 129, 130, 131, 132, 133, 134, 123, 125,
+	95, 99, 96, 98, 101, 102, 103, 104, 0, 0,
+	0, 0, 0, 0, 0, 92, 93, 0, 0, 0,
+	108, 78, 0, 0, 485, 325, 0, 161, 143, 118,
+	0, 0, 125, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 145, 189, 146, 0, 485, 325, 0,
+	161, 143, 118, 0, 0, 0, 798, 119, 120, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 145, 189, 146, 0,
+	0, 0, 147, 148, 121, 193, 122, 192, 492, 1145,
+	119, 120, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 872, 871, 0,
-	875, 0, 0, 0, 0, 0, 32, 98, 0, 39,
-	37, 38, 34, 40, 0, 0, 0, 0, 0, 0,
-	0, 42, 43, 0, 0, 0, 47, 48, 49, 50,
-	41, 52, 53, 54, 45, 51, 55, 0, 0, 0,
-	876, 0, 0, 31, 46, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
+	0, 0, 0, 0, 0, 147, 148, 121, 193, 122,
+	192, 492, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 140, 142, 188, 141, 0, 0,
+	149, 0, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 327, 328, 329, 330, 331, 332, 0, 489,
+	0, 0, 0, 190, 191, 0, 139, 140, 142, 188,
+	141, 0, 0, 149, 125, 0, 160, 138, 126, 127,
+	128, 487, 135, 136, 137, 327, 328, 329, 330, 331,
+	332, 0, 489, 0, 0, 0, 190, 191, 0, 485,
+	325, 0, 161, 143, 118, 0, 0, 125, 0, 0,
+	0, 0, 0, 0, 487, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 145, 189,
+	146, 0, 485, 325, 0, 161, 143, 118, 0, 0,
+	0, 1046, 119, 120, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 22, 71,
-	0, 0, 0, 35, 36, 0, 0, 0, 0, 0,
-	28, 0, 0, 112, 0, 29, 44, 0, 30, 0,
+	144, 145, 189, 146, 0, 0, 0, 147, 148, 121,
+	193, 122, 192, 492, 1044, 119, 120, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 121, 193, 122, 192, 492, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 140,
+	142, 188, 141, 0, 0, 149, 0, 0, 160, 138,
+	126, 127, 128, 0, 135, 136, 137, 327, 328, 329,
+	330, 331, 332, 0, 489, 0, 0, 0, 190, 191,
+	0, 139, 140, 142, 188, 141, 0, 0, 149, 125,
+	0, 160, 138, 126, 127, 128, 487, 135, 136, 137,
+	327, 328, 329, 330, 331, 332, 0, 489, 0, 0,
+	0, 190, 191, 0, 485, 325, 0, 161, 143, 118,
+	0, 0, 125, 0, 0, 0, 0, 0, 0, 487,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 74, 0, 0, 0, 0, 0, 0,
-	24, 23, 0, 72, 0, 0, 0, 0, 0, 32,
-	98, 0, 39, 37, 38, 34, 40, 0, 0, 0,
-	0, 0, 0, 0, 42, 43, 0, 0, 73, 47,
-	48, 49, 50, 41, 52, 53, 54, 45, 51, 55,
-	0, 0, 0, 0, 0, 0, 31, 46, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 0, 0, 0, 93, 70,
-	102, 75, 76, 77, 0, 99, 79, 94, 97, 95,
-	96, 0, 71, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 118, 0, 0, 112, 0, 0, 0,
-	0, 0, 0, 0, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 91, 0, 0, 0,
-	92, 0, 0, 0, 100, 0, 0, 0, 0, 0,
-	0, 0, 0, 120, 117, 0, 0, 0, 0, 0,
-	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	0, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 190, 98, 0, 354,
-	0, 103, 104, 105, 0, 106, 107, 108, 109, 111,
-	0, 85, 355, 86, 353, 356, 357, 358, 359, 0,
-	0, 0, 0, 0, 0, 0, 82, 83, 0, 0,
-	0, 93, 70, 189, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
+	0, 0, 144, 145, 189, 146, 0, 485, 325, 0,
+	161, 143, 118, 0, 0, 0, 927, 119, 120, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 102, 75, 76,
-	77, 0, 99, 79, 94, 97, 95, 96, 0, 71,
+	0, 0, 0, 0, 0, 144, 145, 189, 146, 0,
+	0, 0, 147, 148, 121, 193, 122, 192, 492, 925,
+	119, 120, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	118, 0, 0, 112, 0, 0, 0, 0, 0, 0,
-	0, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 91, 0, 0, 0, 92, 0, 0,
-	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
-	120, 117, 0, 0, 0, 0, 0, 0, 0, 0,
-	98, 0, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 267, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 119, 0, 103, 104,
-	105, 0, 106, 107, 108, 109, 111, 0, 85, 88,
-	86, 87, 110, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 83, 351, 0, 0, 93, 70,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 70, 102, 75, 76, 77, 0, 99,
-	79, 94, 97, 95, 96, 0, 71, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 118, 0, 0,
-	112, 0, 0, 0, 0, 0, 0, 0, 102, 75,
-	76, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 0,
-	91, 0, 0, 0, 92, 0, 0, 0, 100, 0,
-	74, 0, 0, 0, 0, 0, 0, 120, 117, 0,
-	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 119, 0, 103, 104, 105, 0, 106,
-	107, 108, 109, 111, 0, 85, 88, 86, 87, 110,
+	0, 0, 0, 0, 0, 147, 148, 121, 193, 122,
+	192, 492, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 140, 142, 188, 141, 0, 0,
+	149, 0, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 327, 328, 329, 330, 331, 332, 0, 489,
+	0, 0, 0, 190, 191, 0, 139, 140, 142, 188,
+	141, 0, 0, 149, 125, 0, 160, 138, 126, 127,
+	128, 487, 135, 136, 137, 327, 328, 329, 330, 331,
+	332, 0, 489, 0, 0, 0, 190, 191, 0, 485,
+	325, 0, 161, 143, 118, 0, 0, 125, 0, 0,
+	0, 0, 0, 0, 487, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 145, 189,
+	146, 0, 0, 124, 0, 161, 143, 118, 0, 0,
+	0, 0, 119, 120, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 83, 0, 0, 0, 93, 70, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70, 102, 75, 76, 77, 0, 99, 79, 94, 97,
-	95, 96, 0, 71, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 118, 0, 0, 112, 0, 0,
-	0, 0, 0, 0, 0, 102, 75, 76, 77, 0,
-	99, 79, 94, 97, 95, 96, 0, 71, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
-	0, 561, 0, 0, 0, 0, 0, 91, 0, 0,
-	0, 92, 0, 0, 0, 100, 0, 0, 0, 0,
-	0, 0, 0, 0, 120, 117, 0, 0, 0, 0,
-	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
-	0, 91, 0, 0, 0, 92, 0, 0, 0, 100,
-	0, 0, 0, 0, 0, 0, 0, 0, 120, 117,
-	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
-	119, 0, 103, 104, 105, 0, 106, 107, 108, 109,
-	111, 0, 85, 88, 86, 87, 110, 202, 612, 210,
-	201, 200, 203, 199, 0, 0, 0, 82, 83, 0,
-	0, 0, 93, 115, 119, 0, 103, 104, 105, 0,
-	106, 107, 108, 109, 111, 0, 85, 88, 86, 87,
-	110, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 83, 0, 0, 0, 93, 70, 102, 75,
-	302, 77, 0, 99, 79, 94, 97, 95, 96, 0,
-	71, 202, 478, 210, 201, 200, 203, 199, 0, 0,
-	0, 118, 0, 0, 112, 0, 0, 0, 0, 197,
-	196, 0, 0, 0, 0, 198, 206, 205, 207, 208,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 145, 189, 146, 0, 0, 0, 147, 148, 121,
+	193, 122, 192, 492, 0, 119, 120, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 91, 0, 0, 0, 92, 0,
-	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
-	0, 120, 117, 0, 0, 0, 0, 0, 0, 0,
-	0, 98, 0, 197, 196, 0, 0, 0, 0, 198,
-	206, 205, 207, 208, 209, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 103,
-	104, 105, 0, 106, 107, 108, 109, 111, 0, 85,
-	88, 86, 87, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 83, 0, 0, 0, 93,
-	70,
+	147, 148, 121, 193, 122, 192, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 140,
+	142, 188, 141, 0, 0, 149, 0, 0, 160, 138,
+	126, 127, 128, 0, 135, 136, 137, 327, 328, 329,
+	330, 331, 332, 0, 489, 0, 0, 0, 190, 191,
+	125, 139, 140, 142, 188, 141, 0, 0, 149, 0,
+	0, 160, 138, 126, 127, 128, 487, 135, 136, 137,
+	129, 130, 131, 132, 133, 134, 0, 0, 161, 143,
+	118, 190, 191, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 696,
+	0, 0, 0, 144, 145, 189, 146, 0, 0, 0,
+	0, 161, 143, 118, 0, 0, 0, 0, 119, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 145, 189, 146,
+	0, 0, 0, 147, 148, 121, 193, 122, 192, 150,
+	0, 119, 120, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 121, 193,
+	122, 192, 150, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 140, 142, 188, 141, 0,
+	0, 149, 0, 0, 160, 138, 126, 127, 128, 0,
+	135, 136, 137, 129, 130, 131, 132, 133, 134, 0,
+	0, 0, 0, 0, 190, 191, 125, 139, 140, 142,
+	188, 141, 0, 0, 149, 0, 0, 160, 138, 126,
+	127, 128, 889, 135, 136, 137, 129, 130, 131, 132,
+	133, 134, 0, 0, 161, 143, 118, 190, 191, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 872, 0, 0, 0, 144,
+	145, 189, 146, 0, 0, 0, 0, 161, 143, 118,
+	0, 0, 0, 0, 119, 120, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 145, 189, 146, 0, 0, 0, 147,
+	148, 121, 193, 122, 192, 150, 0, 119, 120, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 121, 193, 122, 192, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 140, 142, 188, 141, 0, 0, 149, 0, 0,
+	160, 138, 126, 127, 128, 0, 135, 136, 137, 129,
+	130, 131, 132, 133, 134, 0, 0, 0, 0, 0,
+	190, 191, 0, 139, 140, 142, 188, 141, 125, 0,
+	149, 0, 0, 160, 138, 126, 127, 128, 693, 135,
+	136, 137, 129, 130, 131, 132, 133, 134, 0, 0,
+	0, 655, 0, 190, 191, 0, 161, 143, 118, 0,
+	0, 0, 125, 0, 0, 0, 0, 0, 0, 0,
+	0, 216, 0, 0, 0, 0, 334, 0, 0, 0,
+	0, 144, 145, 189, 146, 0, 0, 0, 325, 0,
+	161, 143, 118, 0, 0, 0, 119, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 144, 145, 189, 146, 0,
+	0, 147, 148, 121, 193, 122, 192, 150, 0, 0,
+	119, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 147, 148, 121, 193, 122,
+	192, 150, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 140, 142, 188, 141, 0, 0, 149,
+	0, 0, 160, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 0, 0, 0,
+	0, 0, 190, 191, 0, 125, 139, 140, 142, 188,
+	141, 0, 0, 149, 0, 0, 160, 138, 126, 127,
+	128, 0, 135, 136, 137, 129, 130, 131, 132, 133,
+	134, 124, 0, 161, 143, 118, 190, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 145,
+	189, 146, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 120, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	121, 193, 122, 192, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 161, 143, 118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 145, 189, 146, 0, 0, 0, 139,
+	140, 142, 188, 141, 0, 0, 149, 119, 120, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 0, 0, 0, 0, 0, 190,
+	191, 0, 147, 148, 121, 193, 122, 192, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 998, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 139, 140, 142, 188, 141, 0, 0,
+	149, 0, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 129, 130, 131, 132, 133, 134, 325, 0,
+	161, 143, 118, 190, 191, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 145, 189, 146, 0,
+	0, 325, 0, 161, 143, 118, 0, 0, 0, 0,
+	119, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 145,
+	189, 146, 0, 0, 0, 147, 148, 121, 193, 122,
+	192, 150, 0, 119, 120, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	121, 193, 122, 192, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 140, 142, 188,
+	141, 0, 0, 149, 0, 0, 160, 138, 126, 127,
+	128, 0, 135, 136, 137, 129, 130, 131, 132, 133,
+	134, 0, 0, 0, 0, 0, 190, 191, 0, 139,
+	140, 142, 188, 141, 0, 125, 149, 459, 0, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 327, 328,
+	329, 330, 331, 332, 0, 0, 0, 0, 0, 190,
+	191, 0, 0, 161, 143, 118, 0, 125, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 145,
+	189, 146, 0, 0, 0, 161, 143, 118, 0, 0,
+	0, 0, 0, 119, 120, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 145, 189, 146, 0, 0, 0, 0, 147, 148,
+	121, 193, 122, 192, 150, 119, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 121, 193, 122, 192, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	140, 142, 188, 141, 0, 0, 149, 0, 0, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 0, 0, 0, 0, 125, 190,
+	191, 139, 140, 142, 188, 141, 112, 0, 149, 0,
+	0, 160, 138, 126, 127, 128, 0, 135, 136, 137,
+	129, 130, 131, 132, 133, 134, 161, 143, 118, 0,
+	125, 190, 191, 0, 0, 0, 0, 109, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 144, 145, 189, 146, 0, 0, 0, 161, 143,
+	118, 0, 0, 0, 0, 0, 119, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 145, 189, 146, 0, 0, 0,
+	0, 147, 148, 121, 193, 122, 192, 150, 119, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 148, 121, 193, 122, 192, 150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 140, 142, 188, 141, 0, 0, 149,
+	0, 0, 160, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 0, 0, 0,
+	0, 125, 190, 191, 139, 140, 142, 188, 141, 0,
+	0, 149, 0, 0, 160, 138, 126, 127, 128, 0,
+	135, 136, 137, 129, 130, 131, 132, 133, 134, 161,
+	143, 118, 0, 0, 190, 191, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 145, 189, 146, 0, 0,
+	937, 0, 0, 0, 161, 143, 0, 0, 0, 119,
+	120, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	145, 189, 146, 0, 147, 148, 121, 193, 122, 192,
+	150, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 147,
+	148, 0, 193, 0, 192, 150, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 140, 142, 188, 141,
+	0, 0, 149, 0, 0, 160, 138, 126, 127, 128,
+	0, 135, 136, 137, 129, 130, 131, 132, 133, 134,
+	0, 0, 0, 0, 0, 190, 191, 0, 0, 0,
+	139, 140, 142, 188, 141, 125, 0, 149, 0, 0,
+	160, 138, 126, 127, 128, 0, 135, 136, 137, 129,
+	130, 131, 132, 133, 134, 0, 0, 0, 0, 660,
+	190, 191, 0, 161, 143, 0, 0, 0, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 145,
+	189, 146, 658, 0, 0, 0, 161, 143, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 144, 145, 189, 146, 0, 0, 0, 147, 148,
+	0, 193, 0, 192, 150, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 147, 148, 0, 193, 0, 192, 150, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	140, 142, 188, 141, 0, 0, 149, 0, 0, 160,
+	138, 126, 127, 128, 0, 135, 136, 137, 129, 130,
+	131, 132, 133, 134, 0, 0, 0, 0, 0, 190,
+	191, 0, 139, 140, 142, 188, 141, 125, 0, 149,
+	0, 0, 160, 138, 126, 127, 128, 0, 135, 136,
+	137, 129, 130, 131, 132, 133, 134, 0, 0, 0,
+	0, 647, 190, 191, 0, 161, 143, 0, 0, 0,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 145, 189, 146, 645, 0, 0, 0, 161, 143,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 145, 189, 146, 0, 0, 0,
+	147, 148, 0, 193, 0, 192, 150, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 147, 148, 0, 193, 0, 192, 150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 140, 142, 188, 141, 0, 0, 149, 0,
+	0, 160, 138, 126, 127, 128, 0, 135, 136, 137,
+	129, 130, 131, 132, 133, 134, 0, 0, 0, 0,
+	0, 190, 191, 0, 139, 140, 142, 188, 141, 125,
+	0, 149, 0, 0, 160, 138, 126, 127, 128, 0,
+	135, 136, 137, 129, 130, 131, 132, 133, 134, 0,
+	0, 0, 0, 512, 190, 191, 0, 161, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 145, 189, 146, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 0, 193, 0, 192, 150, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 140, 142, 188, 141, 0, 0,
+	149, 0, 0, 160, 138, 126, 127, 128, 0, 135,
+	136, 137, 129, 130, 131, 132, 133, 134, 0, 0,
+	0, 0, 0, 190, 191,
 }
-var yyPact = [...]int{
 
-	2923, -1000, 301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+var yyPact = [...]int{
+	3874, -1000, 315, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 5613, 5420, -1000, -1000,
+	487, 186, 389, 1186, 1103, 1102, 388, 8436, -1000, 623,
+	1263, 1238, 8577, 8577, 635, 8577, 5420, 7495, -1000, -1000,
+	5420, 5420, 8404, 5420, 5420, 5420, 5420, 5420, 5420, -1000,
+	8577, 8577, 450, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 319, -1000, -1000, -1000, -1000, 5034, 69,
+	1272, 4501, -1000, 4648, 1264, 1126, -1000, -1000, -1000, -1000,
+	-1000, -1000, 5420, 5420, -76, 291, 290, 289, 288, 286,
+	-1000, 284, 283, 280, 279, 405, 276, 5420, 5420, -1000,
+	-1000, -1000, -1000, 8577, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 275, -56, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3677, 3514, -1000, -1000, 96, 333, 1036,
-	1031, 273, 2131, -1000, 588, 1190, 1185, 562, 562, 567,
-	562, 3514, -1000, -1000, 3514, 3514, 1636, 3514, 3514, 3514,
-	3514, 3514, 3514, -1000, 562, 562, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 310, -1000, -1000, -1000, -1000,
-	3480, -1000, 3120, 1200, 1044, -1000, -1000, -1000, -1000, -1000,
-	-1000, 2152, 3514, 3514, -44, 279, 278, 277, 274, -1000,
-	374, 196, 3514, 3514, -1000, -1000, -1000, -1000, 562, -1000,
+	-1000, 3874, 727, 5034, -1000, 274, 272, 271, 259, 5420,
+	-1000, -1000, 748, 4501, -1000, 3874, 1059, 1182, 1184, 8081,
+	1180, 7678, 1178, 977, 873, -1000, 867, 5420, 8081, 8081,
+	8577, 8081, -1000, 873, 65, 317, -1000, 624, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 8577, 8048, 8577, 8577, 8577,
+	449, 448, -1000, 974, -1000, 8577, -1000, -1000, -1000, -1000,
+	5420, 5420, 1225, 56, 972, 266, 5420, 1078, 1220, -1000,
+	1219, -1000, -1000, 86, -76, -1000, -1000, 2396, -76, -1000,
+	-1000, 6385, -1000, 867, -1000, -1000, -1000, -1000, 246, 5420,
+	1948, 216, 214, 215, 353, 3164, 8577, 8577, 8577, 342,
+	5420, 5420, 5420, 5420, 882, 5420, 889, 111, 5420, 5420,
+	948, 5420, 5420, 5420, 5420, 5420, 5420, 5420, 689, 61,
+	908, 1256, 259, -1000, -1000, -1000, 59, 8577, -1000, 57,
+	57, 8263, 5227, 5420, 4261, 5420, 873, 873, 873, 5420,
+	5420, 5420, 111, 111, 883, 922, -1000, -1000, 1797, 57,
+	347, 5420, 8231, -1000, 3874, 214, 210, 5420, 747, 702,
+	700, 5420, 668, 1046, 1042, 1211, 1195, 1256, 7110, 8081,
+	1202, 54, -1000, -1000, -1000, -1000, 255, -1000, -1000, -1000,
+	-1000, -1000, -1000, 8081, 7110, 1217, 51, 8081, 912, 912,
+	912, 4841, -1000, 195, -1000, 314, 968, 9125, 381, 1150,
+	5420, 1256, 5420, 567, 369, 253, 252, 251, -1000, -1000,
+	-1000, -1000, -1000, 5420, 5420, 5420, 5420, 5420, 1177, -1000,
+	-1000, 1275, 5420, 5420, 5420, 194, 1241, 1241, 8081, 5420,
+	5420, 5420, -1000, 5420, -1000, 1211, 4501, -1000, -1000, -1000,
+	-1000, -1000, -63, -1000, -1000, -1000, 340, 50, 83, -45,
+	-45, 952, 4887, 5420, 111, 5420, 5420, -1000, 5034, -1000,
+	-45, -45, 111, 111, -35, -35, 74, 74, 74, 5080,
+	1797, 3488, 8577, 1256, 8577, 84, 903, 1126, 362, -1000,
+	-1000, 191, 5420, 190, 742, -1000, 189, 38, 1165, -1000,
+	4501, -1000, 182, 5420, 4841, 5420, 181, 180, 178, -1000,
+	-1000, 111, 212, 212, 212, 882, -1000, 2248, -1000, -1000,
+	691, -1000, 5420, 667, 3874, 660, 5420, 4309, 725, 482,
+	566, 558, 5420, 5420, 5420, 1195, 1055, 5420, -1000, 37,
+	-1000, 115, 8976, -1000, 8943, -1000, -1000, 2614, -1000, 250,
+	8794, 8761, 232, 243, 7821, 8081, 6192, 302, 1195, 7110,
+	8048, 962, 353, -1000, 353, 353, -1000, -1000, 249, 7821,
+	7110, -1000, 8577, 8577, 867, -1000, 7462, 7143, 7821, 8577,
+	166, -1000, 4501, 7644, 8577, 867, 238, 8577, 224, -1000,
+	-76, -1000, -76, -76, -1000, -76, -1000, -1000, 32, 1164,
+	1256, -1000, -1000, -1000, 29, 165, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5420, -1000, -1000, -1000, 5420,
+	4694, -1000, -45, -45, -1000, -1000, 654, 313, -1000, -1000,
+	5613, 5420, -1000, -1000, -1000, 480, -1000, -1000, 688, -1000,
+	687, 8577, 8577, -1000, 248, 8577, 498, 164, -1000, 5420,
+	-1000, 4841, 8577, -1000, 163, 162, 160, 159, 542, 497,
+	476, 897, -1000, 199, -1000, 247, -1000, -1000, 592, 5420,
+	653, 699, 3874, 5420, 807, -1000, -1000, 4501, 5420, 3874,
+	512, 1209, 633, 461, 443, -1000, 28, 1062, 4501, 1055,
+	1047, 1038, 4501, 1001, 997, 954, 1002, 245, 244, 6555,
+	-1000, -1000, -1000, -1000, -1000, 8577, -1000, 8577, 157, 127,
+	202, -1000, -1000, -1000, -1000, 1176, 5420, -1000, 8577, -1000,
+	8577, 5420, 111, 7821, 1109, 1211, 23, 307, -39, -1000,
+	-13, 21, -76, -56, 240, 7821, 1109, 1195, -1000, 7110,
+	916, -1000, -1000, 916, 7821, 156, 18, 1609, -1000, 154,
+	16, -1000, 1096, 8577, 1082, -1000, 7821, 1074, 1072, 491,
+	-1000, -1000, -1000, 152, -1000, 1160, 151, 10, -1000, -1000,
+	8, 1081, -21, 1155, 150, 7, -1000, 1256, 5420, 8577,
+	-1000, 5420, -1000, 57, 1797, 5420, 768, 3488, 724, 744,
+	3488, 3488, 3488, 683, 682, 867, 149, 540, 7319, 236,
+	490, 2178, -1000, -1000, 481, 414, 406, 401, 7286, 7319,
+	359, 7286, 355, 111, 148, 5, 5420, -1000, 857, 3220,
+	794, 652, -1000, 723, -1000, 4116, 739, 462, -1000, 5420,
+	-1000, -1000, 429, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5420, 348, -1000, -1000, 1047, 817, 5420, 5999, 6958, 6925,
+	994, -1000, 993, 954, 5420, 8577, -1000, 1650, 205, 2,
+	-1000, -1000, 8612, -1000, -3, -1000, -1000, 3199, 1109, 143,
+	-1000, 4841, 1195, 7821, 5420, -1000, 5420, 8048, 7821, 142,
+	-1000, 1109, 1192, 141, 959, 7821, 5420, 1151, 8577, -1000,
+	-1000, -1000, 7821, 7821, 137, -4, 5420, 135, 8577, 5420,
+	538, 7319, 1149, 510, 1148, 1256, 1256, 5420, 1146, 1256,
+	509, 1136, 514, -1000, -1000, -1000, -1000, 1797, -1000, -1000,
+	3488, 698, 5420, 649, 640, 632, 3488, 3488, 130, 1135,
+	7319, -1000, 7905, -1000, 1194, 537, 7319, -1000, 5420, 536,
+	7319, 535, 7319, 534, 7319, 1053, 533, 7286, -1000, 7905,
+	-1000, -1000, 532, -1000, 517, -1000, -1000, 111, 2036, -1000,
+	-1000, -1000, 792, 3874, -1000, -1000, 5420, 3874, 461, 1015,
+	-1000, 361, -1000, 1093, 1059, 814, 8577, 4501, -1000, -9,
+	4501, 235, 234, 270, 1014, 205, 1504, 205, 6773, 6740,
+	983, 3346, 564, -16, 232, 6555, -1000, 8577, 5420, -1000,
+	-1000, 934, -1000, 1109, -1000, 4501, 129, -37, 124, 951,
+	-1000, 5420, 933, 231, -1000, 2613, 867, -1000, -1000, -1000,
+	1096, 8577, 4501, -1000, -1000, -76, -1000, 7319, -1000, 867,
+	3681, 508, -1000, -1000, -1000, 1081, -1000, 503, 123, 3681,
+	502, -1000, 686, 630, 3488, 722, 479, 767, 766, 628,
+	622, -1000, 230, -1000, 122, -1000, 1069, 489, 1033, 5420,
+	7319, -1000, 3021, 7319, -1000, 7319, -1000, 7319, -1000, 229,
+	7286, -1000, 119, 1059, 1059, 7319, 7286, -1000, 5420, -1000,
+	774, 615, 429, -1000, -1000, -1000, -1000, -1000, 1046, -1000,
+	5420, -1000, -26, 1134, 5999, 5420, 5420, 228, -1000, -1000,
+	5420, 227, 966, 1504, 205, 1014, 205, 6588, 7821, 8577,
+	6555, -1000, -1000, -74, 118, 111, 1109, -1000, -1000, -1000,
+	5420, 929, 223, 2613, 111, 1109, 7821, -1000, 738, 931,
+	-1000, -1000, -1000, -1000, -1000, 612, 312, -1000, -1000, 5613,
+	5420, -1000, -1000, 478, 4648, 5420, 3681, 3681, 1132, 609,
+	3681, 608, 697, 3488, 5420, 801, -1000, 3488, 501, -1000,
+	-1000, 765, 764, 867, -1000, -1000, 1031, -1000, 1026, -1000,
+	938, -1000, -1000, -1000, 5420, 2948, -1000, -1000, -1000, -1000,
+	-1000, 1059, -1000, -1000, -1000, -1000, 1969, -1000, 442, -1000,
+	563, 4501, 8577, 221, -1000, 114, 113, 5806, 4501, 8577,
+	-1000, -1000, 966, -1000, 1014, 205, 902, 901, -1000, -1000,
+	-1000, 1109, -1000, 109, 111, 1109, 7821, -1000, 1109, -1000,
+	107, -1000, 895, 1121, -1000, 3681, 712, 737, 3681, 678,
+	60, 899, 1256, -1000, 604, 603, 496, -1000, 602, 791,
+	600, -1000, 711, -1000, 736, 413, -1000, -1000, 106, 5420,
+	5420, 819, 1144, 854, 849, 845, 824, -1000, 1288, -1000,
+	-1000, 105, -1000, -1000, 1204, -1000, 7905, -1000, -1000, 103,
+	-30, 4501, 4067, 102, -1000, -1000, 220, 219, -1000, -1000,
+	1109, -1000, 100, -1000, 926, 710, 5420, 895, -1000, 3681,
+	696, 5420, 598, 2830, 8577, 8577, 62, 890, -1000, -1000,
+	3681, -1000, -1000, 790, 3488, -1000, 5420, 3488, -1000, 427,
+	427, -1000, 459, 885, 836, -1000, 834, 828, 823, -1000,
+	-1000, -1000, -1000, 8577, 8577, 404, -1000, 99, -1000, 5806,
+	-1000, 1730, -1000, 4455, 7821, -1000, 925, 111, 1109, 1197,
+	4501, 708, 680, 595, 3681, 707, 468, 594, 311, -1000,
+	-1000, 5613, 5420, -1000, -1000, -1000, 390, 677, 676, 8577,
+	8577, 591, -1000, 772, 590, -1000, -1000, 822, -1000, -1000,
+	947, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 516,
+	7286, -1000, -1000, 5420, 98, 96, -34, 1130, 95, 111,
+	1109, 1109, -1000, 1200, -1000, 1188, 588, 694, 3681, 5420,
+	799, -1000, 3681, 495, 763, 2830, 706, 735, 2830, 2830,
+	2830, 672, 625, -1000, -1000, 398, -1000, 819, 830, -1000,
+	7286, -1000, 94, 91, 90, 5420, 8577, 89, 1109, -1000,
+	-1000, 7821, 264, 787, 587, -1000, 705, -1000, 733, 397,
+	-1000, -1000, 2830, 693, 5420, 583, 582, 581, 2830, 2830,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	271, 270, -57, 2923, 674, 3480, -1000, 269, 267, 263,
-	3514, 689, 2152, -1000, 993, 1126, 1140, 1607, 1131, 704,
-	898, 778, -1000, 776, 3514, 1607, 562, 1607, -1000, 778,
-	46, 308, -1000, 488, -1000, 562, 1389, 562, 562, 414,
-	413, -1000, 927, -1000, 562, -1000, -1000, -1000, -1000, 3514,
-	3514, 1178, 57, 914, 1002, 1177, -1000, 1175, -1000, -1000,
-	102, -44, -1000, -1000, 1559, -44, -1000, -1000, 3874, 3514,
-	1310, 189, 184, 188, 219, 613, 69, 855, 1194, 263,
-	-1000, -1000, -1000, 45, 562, -1000, 3514, 3514, 3514, 817,
-	3514, 788, 97, 3514, 891, 3514, 3514, 3514, 3514, 3514,
-	3514, 3514, -1000, -1000, 1842, 3317, 3514, 2031, 778, 778,
-	97, 97, 864, 882, -1000, -1000, 56, -1000, 394, 778,
-	3514, 1820, -1000, 2923, 184, 183, 3514, 688, 650, 648,
-	3514, 968, 986, 1173, 1144, 1194, 1263, 1607, 1150, 39,
-	-1000, -1000, -1000, -1000, 258, -1000, -1000, -1000, -1000, 1607,
-	1263, 1174, 32, 875, 875, 875, 2248, -1000, 180, -1000,
-	207, 318, 1120, 3514, 1194, 3514, 477, 286, 253, 250,
-	-1000, -1000, -1000, -1000, 3514, 3514, 3514, 3514, 3514, 1117,
-	-1000, -1000, 1203, 3514, 3514, 1192, 1192, 1607, 3514, 3514,
-	3514, -1000, 3514, 2152, -1000, -1000, -1000, -1000, 1173, 2597,
-	562, 1194, 562, 67, 850, 1044, 236, 42, 20, 20,
-	856, 3820, 3514, 97, 3514, -1000, 3480, -1000, 20, 97,
-	97, 264, 264, -1000, -1000, -1000, 1351, 56, -1000, -1000,
-	178, 3514, 177, 1202, -1000, 176, 31, 1104, -1000, 2152,
-	-1000, -1000, -40, 249, 248, 246, 245, 244, 243, 242,
-	3514, 3283, -1000, -1000, 97, 195, 195, 195, 817, -1000,
-	3514, 1496, -1000, -1000, 637, -1000, 3514, 574, 2923, 573,
-	3514, 2128, 671, 473, 471, 3514, 3514, 3086, 1144, 991,
-	3514, -1000, 30, -1000, 99, 1810, -1000, -1000, -1000, 1830,
-	-1000, 239, 517, 171, 1210, 1607, 3711, 204, 1144, 1263,
-	1389, 219, -1000, 219, 219, -1000, -1000, 238, 1210, 562,
-	776, -1000, 117, 416, 1210, 562, 174, -1000, 2152, 1232,
-	562, 776, 185, 562, -1000, -44, -1000, -44, -44, -1000,
-	-44, -1000, -1000, 26, 1102, 1194, -1000, -1000, -1000, 25,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 571, 300, -1000,
-	-1000, 3677, 3514, -1000, -1000, -1000, -1000, -1000, 606, -1000,
-	601, 562, 562, -1000, 237, 562, -1000, -1000, 3514, 3756,
-	-1000, 20, -1000, -1000, -1000, 173, -1000, 3514, -1000, 2248,
-	562, 3317, 778, 778, 778, 778, 3514, 3514, 3514, 172,
-	169, 168, 830, -1000, 147, -1000, 234, -1000, -1000, 512,
-	165, 3514, 568, 647, 2923, 3514, 746, -1000, -1000, 2152,
-	3514, 2923, 1165, 530, 432, 387, -1000, 24, 975, 2152,
-	-1000, 991, 969, 984, 2152, 956, 953, 939, 926, 402,
-	-1000, -1000, -1000, -1000, -1000, 562, 108, 3514, -1000, 562,
-	97, 1210, -1000, 1173, 23, 288, -35, -1000, -16, 19,
-	-44, -57, 233, 1210, -1000, 1144, -1000, 870, -1000, -1000,
-	870, 1210, 164, 18, 163, 17, -1000, 1141, 562, 1022,
-	-1000, 1210, 1001, 998, -1000, -1000, -1000, 161, -1000, 1100,
-	160, 10, -1000, -1000, 9, 1016, -18, 3514, 562, -1000,
-	3514, 710, 2597, 667, 686, 2597, 2597, 598, 596, 776,
-	159, 56, 3514, -1000, 1462, -1000, -1000, 158, 3514, 3514,
-	3514, 3283, 3514, 155, 154, 153, -1000, -1000, -1000, 97,
-	150, 7, 3514, -1000, 773, 351, 1841, 736, 566, -1000,
-	666, -1000, 1994, 685, -1000, 3514, -1000, -1000, 397, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 3086, 344, -1000, -1000,
-	969, -1000, 3514, 3514, 1792, 1676, 952, -1000, 946, 939,
-	-1000, 1058, 196, 4, -1000, -1000, 2, -1000, -1000, 149,
-	1144, 1210, 3514, -1000, 3514, 1389, 1210, 144, -1000, 141,
-	913, 1210, 1099, 562, -1000, -1000, -1000, 1210, 1210, 140,
-	1, 3514, 139, 562, 3514, 1092, 364, 1078, 1194, 1194,
-	3514, 1073, 1194, -1000, -1000, -1000, -1000, -1000, 2597, 643,
-	3514, 565, 556, 2597, 2597, 138, 1070, 56, -1000, 3514,
-	449, 137, 135, 134, 124, 115, 114, 440, 382, 372,
-	-1000, -1000, 97, 1446, -1000, 990, -1000, -1000, 735, 2923,
-	-1000, -1000, 3514, 432, 959, -1000, 346, -1000, 1057, 993,
-	2152, -1000, 957, 196, 1630, 196, 1313, 1160, 945, -22,
-	402, 3514, 869, -1000, -1000, 2152, 111, -26, 110, 895,
-	868, 232, -1000, 776, -1000, -1000, -1000, 1141, 562, 2152,
-	-1000, -1000, -44, -1000, 776, 2760, 362, -1000, -1000, -1000,
-	1016, -1000, 361, 109, 617, 555, 2597, 665, 708, 703,
-	554, 553, -1000, 228, 1730, 225, 439, 424, 418, 415,
-	404, 379, 224, 218, 343, 217, 339, -1000, 3514, 214,
-	-1000, 722, 397, -1000, -1000, -1000, -1000, -1000, 968, -1000,
-	-1000, 3514, 209, 906, 1630, 196, 957, 196, 501, 402,
-	-1000, -58, 107, 97, -1000, -1000, -1000, 3514, 860, 208,
-	97, -1000, 1210, -1000, -1000, -1000, -1000, 549, 291, -1000,
-	-1000, 3677, 3514, -1000, -1000, 3120, 3514, 2760, 2760, 1061,
-	544, 627, 2597, 3514, 744, -1000, 2597, -1000, -1000, 702,
-	700, 776, -1000, 453, 205, 203, 200, 199, 198, 197,
-	453, 453, 386, 453, 384, 1627, 993, -1000, -1000, 425,
-	2152, 562, -1000, -1000, 906, -1000, 957, 196, -1000, -1000,
-	-1000, -1000, 105, 97, -1000, 1210, -1000, 104, -1000, 2760,
-	663, 683, 595, 33, 844, 1194, -1000, 535, 534, 358,
-	732, 533, -1000, 662, -1000, 680, -1000, -1000, 98, 93,
-	-1000, 994, 982, 453, 453, 453, 453, 453, 453, 83,
-	993, 82, 194, 80, 191, -1000, 79, 1156, 78, -1000,
-	-1000, -1000, -1000, 77, 836, -1000, 2760, 625, 3514, 2434,
-	562, 562, 53, 832, -1000, -1000, 2760, -1000, 731, 2597,
-	-1000, 3514, -1000, -1000, -1000, 973, 3514, 76, 75, 71,
-	66, 64, 62, -1000, -1000, 453, -1000, 453, -1000, -1000,
-	-1000, 834, 97, -1000, 593, 531, 2760, 660, 528, 272,
-	-1000, -1000, 3677, 3514, -1000, -1000, -1000, 589, 587, 562,
-	562, 527, -1000, 719, 3086, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 61, 58, 97, -1000, -1000, 519, 616, 2760,
-	3514, 743, -1000, 2760, 698, 2434, 656, 678, 2434, 2434,
-	583, 576, -1000, -1000, 332, -1000, -1000, -1000, 729, 516,
-	-1000, 655, -1000, 677, -1000, -1000, 2434, 594, 3514, 515,
-	507, 2434, 2434, -1000, 847, -1000, 727, 2760, -1000, 3514,
-	586, 494, 2434, 654, 697, 695, 492, 491, -1000, 842,
-	769, 768, 751, -1000, 715, 485, 532, 2434, 3514, 741,
-	-1000, 2434, -1000, -1000, 693, 590, 828, 759, -1000, 766,
-	750, -1000, -1000, -1000, -1000, 726, 483, -1000, 652, -1000,
-	676, -1000, -1000, 826, -1000, -1000, -1000, -1000, -1000, 725,
-	2434, -1000, 3514, -1000, 754, -1000, -1000, 714, -1000, -1000,
+	-1000, -1000, 111, 7821, -1000, 786, 3681, -1000, 5420, 3681,
+	675, 579, 2830, 704, 382, 762, 759, 578, 577, -1000,
+	81, -1000, 771, 575, 574, 681, 2830, 5420, 798, -1000,
+	2830, 494, -1000, -1000, 753, 752, 1168, -1000, 395, 784,
+	573, -1000, 626, -1000, 731, 394, -1000, -1000, 111, -1000,
+	-1000, 776, 2830, -1000, 5420, 2830, -1000, -1000, 770, 571,
+	-1000, 378, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 62, 110, 11, 117, 75, 89, 1335, 59, 30,
-	39, 1334, 1333, 1332, 1328, 31, 26, 1325, 1324, 1323,
-	1322, 1321, 1319, 1316, 87, 29, 38, 1313, 1310, 1309,
-	78, 1308, 64, 1307, 1306, 52, 47, 1305, 1303, 1298,
-	1291, 1289, 74, 1288, 107, 105, 1133, 1287, 79, 61,
-	81, 68, 18, 34, 28, 1286, 1285, 41, 1283, 44,
-	21, 1282, 97, 1281, 101, 96, 83, 1053, 0, 72,
-	58, 13, 5, 1280, 1272, 1268, 1266, 1576, 1256, 93,
-	1255, 1253, 1249, 969, 1248, 1247, 1246, 7, 27, 16,
-	19, 1245, 1244, 4, 1242, 1241, 65, 1239, 1238, 94,
-	90, 91, 1237, 20, 35, 547, 1235, 37, 1234, 1232,
-	1231, 23, 69, 1228, 36, 32, 77, 88, 33, 86,
-	1227, 1226, 1223, 63, 1222, 1221, 43, 82, 12, 25,
-	6, 17, 2, 3, 70, 1219, 9, 1218, 10, 1217,
-	8, 1215, 1447, 22, 66, 14, 1211, 106, 1123, 1209,
-	100, 99, 98, 85, 67, 80, 102, 1207, 56, 797,
+var yyPgo = [...]int{
+	0, 128, 18, 80, 151, 1074, 167, 1478, 105, 38,
+	64, 1476, 1472, 1471, 1470, 92, 8, 1458, 1457, 1454,
+	1448, 1440, 1434, 1433, 93, 50, 43, 1426, 1425, 1424,
+	83, 1422, 67, 1418, 1417, 63, 62, 1416, 1414, 61,
+	1412, 1406, 1403, 1402, 1400, 1399, 116, 1127, 1396, 101,
+	95, 1159, 1395, 87, 69, 81, 1394, 34, 1393, 19,
+	68, 1392, 30, 31, 42, 36, 1387, 1386, 49, 1381,
+	46, 48, 1380, 104, 1375, 113, 110, 16, 2257, 0,
+	103, 100, 25, 24, 1370, 1368, 1367, 1364, 1345, 1363,
+	1362, 96, 1360, 1356, 1353, 45, 1348, 1347, 1342, 1341,
+	60, 17, 53, 10, 745, 1338, 1337, 28, 21, 1336,
+	11, 33, 1335, 12, 1334, 1333, 82, 1332, 1330, 143,
+	98, 94, 1329, 58, 41, 811, 1327, 1324, 1320, 7,
+	29, 1319, 1318, 1312, 15, 72, 1311, 32, 20, 73,
+	102, 35, 76, 109, 108, 1309, 4, 88, 107, 1306,
+	705, 91, 114, 1305, 44, 22, 40, 85, 13, 39,
+	9, 14, 6, 3, 70, 1301, 23, 1300, 5, 1298,
+	2, 1295, 949, 90, 37, 26, 317, 1290, 112, 1140,
+	1286, 115, 117, 97, 89, 71, 86, 118, 1282, 66,
+	901,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]int{
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 5, 6, 6,
-	7, 7, 8, 8, 8, 8, 8, 9, 9, 10,
-	10, 12, 12, 11, 11, 11, 11, 11, 13, 13,
-	13, 13, 13, 13, 14, 14, 15, 15, 15, 15,
-	15, 16, 16, 17, 17, 18, 18, 18, 18, 18,
-	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
-	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 25,
-	25, 26, 26, 26, 26, 26, 27, 27, 27, 27,
-	27, 27, 27, 28, 28, 28, 28, 29, 29, 30,
-	30, 31, 31, 31, 31, 32, 33, 33, 34, 35,
-	35, 36, 36, 36, 37, 37, 37, 37, 37, 38,
-	38, 38, 38, 38, 38, 38, 39, 39, 39, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 41, 41, 41, 42, 42, 43, 43,
-	44, 44, 44, 44, 45, 45, 46, 47, 48, 48,
-	49, 49, 50, 50, 51, 51, 52, 52, 53, 53,
-	53, 54, 54, 54, 55, 55, 56, 56, 57, 57,
-	57, 58, 58, 58, 59, 59, 60, 60, 61, 61,
-	62, 62, 63, 63, 63, 63, 63, 63, 64, 65,
-	66, 66, 66, 66, 66, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 69, 70, 70, 70, 71,
-	71, 72, 72, 73, 73, 74, 74, 75, 75, 75,
-	76, 76, 77, 78, 79, 79, 79, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 81, 81, 81, 81,
-	81, 81, 81, 82, 82, 82, 82, 83, 83, 84,
-	84, 84, 84, 84, 84, 84, 84, 85, 85, 85,
-	85, 85, 85, 86, 86, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 88, 89, 89,
-	90, 90, 91, 91, 92, 92, 92, 93, 93, 93,
-	94, 94, 95, 95, 96, 96, 97, 97, 97, 97,
-	98, 98, 98, 98, 99, 99, 102, 102, 102, 103,
-	103, 103, 104, 104, 104, 104, 105, 105, 105, 105,
-	105, 105, 105, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 106, 107, 107, 108, 108, 109, 109, 109,
-	110, 111, 111, 112, 112, 113, 113, 114, 114, 115,
-	115, 116, 116, 117, 117, 100, 100, 101, 101, 118,
-	118, 119, 119, 120, 120, 120, 120, 121, 122, 123,
-	123, 124, 124, 124, 124, 124, 124, 124, 124, 125,
-	125, 126, 126, 127, 127, 128, 128, 129, 129, 130,
-	130, 131, 131, 132, 132, 133, 133, 134, 134, 135,
-	135, 136, 136, 137, 137, 138, 138, 139, 139, 140,
-	140, 141, 141, 142, 142, 142, 142, 142, 142, 142,
-	142, 143, 144, 144, 145, 146, 146, 147, 147, 148,
-	149, 150, 151, 151, 152, 152, 153, 153, 154, 154,
-	155, 155, 155, 156, 156, 157, 157, 158, 158, 159,
-	159,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 6, 6, 7, 7, 8, 8, 8, 8, 8,
+	9, 9, 10, 10, 12, 12, 11, 11, 11, 11,
+	11, 11, 13, 13, 13, 13, 13, 13, 13, 14,
+	14, 15, 15, 15, 15, 15, 16, 16, 17, 17,
+	18, 18, 18, 18, 18, 18, 19, 19, 19, 19,
+	19, 19, 19, 20, 20, 20, 20, 21, 21, 21,
+	21, 21, 22, 22, 23, 23, 23, 23, 23, 23,
+	23, 23, 23, 23, 24, 24, 25, 25, 26, 26,
+	26, 26, 26, 27, 27, 27, 27, 27, 27, 27,
+	27, 28, 28, 28, 28, 29, 29, 30, 30, 31,
+	31, 31, 31, 32, 33, 33, 34, 35, 35, 36,
+	36, 36, 37, 37, 37, 37, 37, 38, 38, 39,
+	39, 40, 40, 40, 40, 41, 41, 41, 41, 41,
+	41, 41, 42, 42, 42, 43, 43, 43, 43, 43,
+	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
+	43, 43, 43, 43, 43, 43, 43, 43, 43, 44,
+	44, 44, 45, 45, 46, 46, 46, 46, 47, 47,
+	48, 48, 49, 49, 49, 49, 50, 50, 51, 52,
+	53, 53, 54, 54, 55, 55, 56, 56, 56, 56,
+	57, 57, 58, 58, 58, 59, 59, 60, 60, 105,
+	105, 61, 61, 106, 107, 107, 63, 63, 64, 64,
+	64, 65, 65, 65, 66, 66, 67, 67, 68, 68,
+	68, 69, 69, 69, 70, 70, 71, 71, 72, 72,
+	73, 73, 74, 74, 74, 74, 74, 74, 75, 76,
+	77, 77, 77, 77, 77, 78, 78, 78, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 80, 81, 81, 81, 82, 82,
+	83, 83, 84, 84, 85, 86, 86, 86, 87, 87,
+	88, 90, 91, 91, 91, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 93, 93, 93, 93,
+	93, 93, 93, 94, 94, 94, 94, 95, 95, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 96, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 98, 98, 98, 98, 62, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 99, 99, 99,
+	99, 99, 99, 99, 99, 99, 99, 103, 103, 104,
+	104, 100, 100, 101, 101, 101, 101, 102, 102, 108,
+	108, 109, 109, 109, 110, 110, 110, 110, 111, 111,
+	111, 112, 112, 112, 112, 113, 113, 113, 113, 113,
+	114, 114, 115, 115, 116, 116, 117, 117, 117, 117,
+	117, 117, 118, 118, 118, 118, 119, 119, 122, 122,
+	122, 123, 123, 123, 123, 123, 123, 89, 124, 124,
+	124, 124, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 126, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 127, 127, 127, 128, 128,
+	129, 129, 130, 130, 131, 132, 132, 132, 133, 134,
+	134, 135, 135, 136, 136, 137, 137, 138, 138, 139,
+	139, 140, 140, 120, 120, 121, 121, 141, 141, 142,
+	142, 143, 143, 143, 143, 144, 145, 146, 146, 147,
+	147, 147, 147, 147, 147, 147, 147, 148, 148, 150,
+	150, 151, 151, 151, 151, 149, 149, 152, 152, 152,
+	155, 155, 153, 153, 153, 153, 154, 154, 156, 156,
+	157, 157, 158, 158, 159, 159, 160, 160, 161, 161,
+	162, 162, 163, 163, 164, 164, 165, 165, 166, 166,
+	167, 167, 168, 168, 169, 169, 170, 170, 171, 171,
+	172, 172, 172, 172, 172, 172, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
+	174, 175, 175, 176, 177, 177, 178, 178, 179, 180,
+	181, 182, 182, 183, 183, 184, 184, 185, 185, 186,
+	186, 186, 187, 187, 188, 188, 189, 189, 190, 190,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int{
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 6, 8, 8, 9, 9, 1, 1, 1,
-	2, 1, 1, 7, 8, 6, 1, 1, 7, 8,
-	6, 1, 1, 1, 1, 1, 6, 8, 8, 9,
-	9, 1, 2, 1, 1, 7, 8, 6, 1, 1,
-	7, 8, 6, 1, 1, 1, 2, 2, 1, 2,
-	4, 4, 4, 4, 2, 1, 1, 6, 8, 5,
-	6, 8, 5, 7, 7, 7, 7, 1, 3, 1,
-	3, 0, 1, 1, 2, 2, 5, 5, 2, 4,
-	2, 3, 5, 6, 8, 5, 3, 1, 3, 1,
-	3, 4, 2, 4, 3, 1, 1, 3, 3, 1,
-	3, 1, 1, 3, 9, 10, 10, 12, 3, 0,
-	1, 1, 1, 1, 2, 2, 5, 6, 3, 4,
-	4, 4, 4, 4, 4, 2, 2, 2, 2, 4,
-	4, 2, 2, 2, 4, 1, 2, 2, 4, 2,
-	2, 1, 2, 2, 3, 4, 4, 6, 9, 11,
-	5, 4, 4, 4, 1, 1, 3, 2, 0, 2,
-	0, 2, 0, 3, 0, 2, 0, 3, 1, 6,
+	1, 1, 1, 1, 1, 6, 8, 8, 9, 9,
+	1, 1, 1, 2, 1, 1, 7, 8, 6, 1,
+	1, 10, 7, 8, 6, 1, 1, 1, 10, 1,
+	1, 6, 8, 8, 9, 9, 1, 2, 1, 1,
+	7, 8, 6, 1, 1, 10, 7, 8, 6, 1,
+	1, 1, 10, 2, 2, 1, 2, 4, 4, 4,
+	4, 2, 1, 1, 6, 8, 5, 6, 8, 5,
+	7, 7, 7, 7, 1, 3, 1, 3, 0, 1,
+	1, 2, 2, 5, 5, 5, 2, 4, 2, 3,
+	5, 6, 8, 5, 3, 1, 3, 1, 3, 4,
+	2, 4, 3, 1, 1, 3, 3, 1, 3, 1,
+	1, 3, 9, 10, 10, 12, 3, 1, 2, 1,
+	3, 9, 10, 3, 5, 0, 1, 1, 1, 1,
+	2, 2, 5, 6, 3, 4, 4, 4, 4, 4,
+	4, 2, 2, 2, 2, 4, 4, 2, 2, 2,
+	4, 1, 2, 2, 4, 2, 2, 1, 2, 2,
+	3, 4, 2, 3, 1, 1, 1, 1, 4, 6,
+	9, 11, 7, 4, 4, 4, 1, 1, 3, 2,
+	0, 2, 0, 2, 0, 3, 1, 4, 4, 5,
+	1, 3, 1, 2, 5, 1, 3, 0, 2, 0,
+	2, 0, 2, 5, 1, 3, 0, 3, 1, 6,
 	5, 0, 1, 2, 1, 1, 0, 1, 1, 1,
 	1, 0, 1, 1, 0, 3, 0, 2, 6, 9,
 	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 3, 3, 3, 3, 1, 1, 3, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 1, 3, 1, 6, 1,
-	3, 1, 3, 2, 4, 1, 1, 0, 1, 1,
-	1, 1, 3, 3, 3, 1, 6, 3, 3, 3,
-	3, 4, 4, 5, 6, 6, 3, 4, 4, 3,
+	1, 1, 1, 1, 3, 3, 4, 2, 3, 3,
+	3, 5, 4, 1, 1, 3, 1, 6, 1, 3,
+	1, 3, 2, 4, 1, 0, 1, 1, 1, 1,
+	3, 3, 3, 1, 6, 3, 3, 3, 3, 4,
+	4, 5, 6, 6, 3, 4, 4, 3, 4, 3,
 	4, 4, 4, 4, 4, 2, 3, 3, 3, 3,
 	3, 2, 2, 3, 3, 2, 2, 0, 1, 4,
-	4, 6, 8, 3, 4, 4, 4, 5, 5, 5,
-	5, 5, 1, 5, 10, 8, 9, 9, 9, 9,
-	9, 9, 8, 8, 10, 8, 10, 2, 1, 5,
-	0, 3, 2, 5, 2, 2, 2, 2, 2, 2,
+	4, 6, 8, 3, 4, 4, 4, 4, 4, 5,
+	5, 5, 5, 5, 5, 6, 6, 6, 6, 6,
+	1, 5, 10, 6, 11, 5, 6, 7, 7, 7,
+	7, 7, 7, 7, 8, 8, 8, 8, 8, 8,
+	12, 13, 6, 6, 8, 6, 8, 3, 1, 3,
+	1, 2, 2, 1, 5, 5, 2, 0, 3, 3,
+	6, 1, 1, 1, 0, 3, 2, 2, 1, 3,
+	3, 2, 2, 2, 2, 2, 2, 2, 2, 2,
 	2, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	4, 6, 6, 8, 1, 1, 1, 6, 6, 1,
-	2, 3, 1, 2, 3, 4, 1, 2, 3, 1,
-	1, 1, 3, 4, 5, 6, 5, 6, 5, 6,
-	7, 6, 7, 2, 4, 1, 1, 1, 3, 1,
-	5, 0, 1, 4, 5, 0, 2, 1, 3, 1,
+	1, 1, 4, 6, 6, 8, 1, 1, 1, 6,
+	6, 1, 2, 3, 1, 2, 3, 4, 1, 2,
+	3, 4, 1, 2, 3, 1, 1, 2, 3, 1,
+	1, 3, 4, 5, 3, 4, 5, 6, 5, 6,
+	5, 6, 7, 6, 7, 11, 11, 11, 1, 3,
+	1, 3, 2, 4, 1, 1, 3, 1, 5, 0,
+	1, 4, 5, 0, 2, 1, 3, 1, 3, 1,
 	3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 6, 9, 5, 8, 7, 3, 1,
-	3, 10, 13, 9, 12, 9, 12, 8, 11, 5,
-	6, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 1, 3, 1, 3, 1,
-	1, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-	1, 1, 1, 0, 1, 0, 1, 0, 1, 1,
-	1,
+	3, 7, 10, 6, 9, 8, 3, 1, 3, 11,
+	14, 10, 13, 10, 13, 9, 12, 6, 7, 0,
+	2, 1, 1, 1, 1, 9, 8, 1, 2, 3,
+	0, 2, 7, 5, 8, 11, 1, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 3, 1, 3, 1, 3, 1, 1,
+	1, 0, 1, 0, 1, 0, 1, 0, 1, 1,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 1,
 }
-var yyChk = [...]int{
 
-	-1000, -1, -7, -5, -11, -42, -43, -120, -121, -124,
-	-125, -23, -20, -21, -27, -28, -31, -37, -22, -40,
-	-41, -68, 15, 88, 87, -8, -10, -60, 27, 32,
-	35, 133, 96, -145, 102, 20, 21, 100, 101, 99,
-	103, 120, 111, 112, 33, 124, 134, 116, 117, 118,
-	119, 125, 121, 122, 123, 126, -63, -81, -78, -77,
-	-84, -85, -110, -80, -82, -143, -148, -149, -150, -39,
-	166, 16, 90, 115, 80, 5, 6, 7, -64, 10,
-	-65, -67, 160, 161, -142, 145, 147, 148, 146, -86,
-	-70, 70, 74, 165, 11, 13, 14, 12, 97, 9,
-	78, -66, 4, 135, 136, 137, 139, 140, 141, 142,
-	149, 143, 30, 158, -68, 166, -145, 88, 27, 133,
-	87, -111, -67, -68, -44, -46, 24, 19, 27, 22,
-	-45, 17, -77, 166, 166, 25, 36, 36, -147, 166,
-	-146, -143, -147, -142, -143, 97, 44, 103, 127, -148,
-	-150, -148, -142, -142, -38, 104, 105, 37, 38, 106,
-	107, -142, -142, -68, -68, -68, -150, -142, -68, -68,
-	-68, -142, -68, -115, -67, -142, -68, -142, -142, 155,
-	-67, -68, -115, -42, -60, -68, -143, -144, -9, 133,
-	96, 6, -62, -61, -157, 31, 154, 153, 159, 77,
-	75, 74, 71, 76, -159, 161, 160, 162, 163, 164,
-	73, 72, -67, -67, 169, 166, 166, 166, 166, 166,
-	153, 159, -152, -159, 74, -77, -67, -67, -142, 166,
-	166, 169, -1, 92, -115, -83, 166, -111, -134, -112,
-	91, -52, 45, -47, -48, 25, 18, 25, -101, -99,
-	-96, -98, -142, 30, -97, 139, 140, 141, 142, 25,
-	18, -100, -96, 65, 66, 67, -151, 79, -83, -115,
-	-99, -142, -99, -151, 168, 155, 97, 44, 127, 128,
-	-142, -96, -142, -142, 159, 43, 159, 43, 62, -142,
-	-68, -68, 18, 62, 62, 43, 18, 18, 168, 62,
-	168, -68, 6, -67, 167, 167, 167, 167, -46, 94,
-	71, 168, 71, -143, -144, 168, -142, -67, -67, -67,
-	-152, -67, 75, 71, 76, -70, 166, -77, -67, 69,
-	68, -67, -67, -67, -67, -67, -67, -67, -142, 6,
-	-83, -151, -83, -67, 167, -119, -109, -108, -69, -67,
-	-87, 162, -142, 148, 133, 146, 149, 150, 151, 152,
-	-151, -151, -70, -70, 75, 71, 69, 68, 77, 146,
-	-151, -67, -142, 6, -1, 167, 91, -135, 93, -113,
-	93, -67, -68, -53, -59, 51, 52, 48, -48, -49,
-	23, -144, -143, -117, -105, -102, -106, 29, -103, 166,
-	-99, 144, -77, -99, 20, 168, 166, -99, -117, 18,
-	168, -156, 68, -156, -156, -119, 167, 62, 166, 166,
-	-158, 28, 33, 34, 42, 20, -83, -147, -67, 98,
-	166, 28, 166, 166, -68, -142, -68, -142, -142, -68,
-	-142, -68, -30, -29, -68, 25, 5, -30, -116, -68,
-	-150, -150, -99, -116, -116, -115, -68, -2, -12, -5,
-	-13, 88, 87, -8, -10, -6, 113, 114, -142, -144,
-	-142, 71, 71, -62, 28, 166, -64, -65, 72, -67,
-	-70, -67, -70, -70, 167, -83, 167, 18, 167, 168,
-	28, 166, 166, 166, 166, 166, 166, 166, 166, -83,
-	-83, -69, -70, -79, 166, -77, 143, -79, -79, -152,
-	-83, 168, -127, -126, 93, 89, 95, -1, 95, -67,
-	92, 92, 98, 99, -68, -68, -72, -73, -74, -67,
-	-87, -49, -50, 46, -67, 60, -153, -155, 63, 168,
-	55, 57, 58, 59, -142, 28, -105, 166, -142, 28,
-	26, 166, -42, -123, -122, -66, -142, -101, -96, -68,
-	-142, 30, 62, 166, -49, -117, -100, -45, -44, -45,
-	-45, 166, -114, -66, -118, -142, -42, -24, 166, -142,
-	-66, 166, -66, -142, 167, -42, -142, -118, -42, 167,
-	-36, -33, -35, -32, -34, -143, -142, 168, 28, -144,
-	168, 95, 158, -68, -111, 94, 94, -142, -142, 166,
-	-118, -67, 72, 167, -67, -119, -142, -83, -151, -151,
-	-151, -151, -151, -83, -83, -83, 167, 167, 167, 72,
-	-71, -70, 166, 100, 71, 167, -67, 95, -127, -1,
-	-68, 87, -67, -1, 19, -55, 37, 104, -56, -57,
-	53, 86, 137, -58, 86, 137, 168, -75, 49, 50,
-	-50, -51, 47, 48, 54, 54, -154, 56, -153, -155,
-	-104, -105, 64, -103, -142, 167, -68, -142, -71, -114,
-	-48, 168, 159, 167, 168, 168, 166, -114, -49, -114,
-	167, 168, 167, 168, -26, 37, 38, 39, 40, -25,
-	-24, 41, -114, 43, 43, 167, 28, 167, 168, 168,
-	41, 167, 168, -30, -142, -116, 90, -2, 92, -136,
-	91, -2, -2, 94, 94, -42, 167, -67, 167, 98,
-	167, -83, -83, -83, -83, -69, -83, 167, 167, 167,
-	-70, 167, 168, -67, 81, 132, 167, 88, 95, 92,
-	-112, -134, 91, -68, -54, 138, 80, -72, 136, -51,
-	-67, -115, -105, 64, -105, 64, 54, 54, -154, -103,
-	168, 168, 167, -49, -123, -67, -83, -96, -114, 167,
-	167, 62, -114, -158, -118, -66, -66, 167, 168, -67,
-	167, -142, -142, -68, 28, 129, 28, -32, -35, -35,
-	-143, -68, 28, -36, -2, -137, 93, -68, 95, 95,
-	-2, -2, 167, 28, -67, 110, 167, 167, 167, 167,
-	167, 167, 110, 110, 131, 110, 131, -71, 168, 46,
-	88, -1, -57, -59, 135, -76, 37, 38, -52, -103,
-	-107, 61, 62, -103, -105, 64, -105, 64, 54, 168,
-	-104, -142, -68, 26, -42, 167, 167, 168, 167, 62,
-	26, -42, 166, -42, -26, -25, -42, -3, -14, -5,
-	-18, 88, 87, -15, -16, 90, 130, 129, 129, 167,
-	-129, -128, 93, 89, 95, -2, 92, 90, 90, 95,
-	95, 166, 167, 166, 110, 110, 110, 110, 110, 110,
-	166, 166, 136, 166, 136, -67, 166, -126, -54, -53,
-	-67, 166, -107, -107, -103, -103, -105, 64, -104, 167,
-	167, -71, -83, 26, -42, 166, -71, -114, 95, 158,
-	-68, -111, -68, -143, -144, -9, -68, -3, -3, 28,
-	95, -129, -2, -68, 87, -2, 90, 90, -42, -89,
-	-88, -90, 109, 166, 166, 166, 166, 166, 166, -88,
-	-90, -89, 110, -88, 110, 167, -52, 98, -118, -107,
-	-103, 167, -71, -114, 167, -3, 92, -138, 91, 94,
-	71, 71, -143, -144, 95, 95, 129, 88, 95, 92,
-	-136, 91, 167, 167, -52, 45, 48, -89, -89, -89,
-	-89, -89, -88, 167, 167, 166, 167, 166, 167, 19,
-	167, 167, 26, -42, -3, -139, 93, -68, -4, -17,
-	-5, -19, 88, 87, -15, -16, -6, -142, -142, 71,
-	71, -3, 88, -2, 48, -115, 167, 167, 167, 167,
-	167, 167, -89, -88, 26, -42, -71, -131, -130, 93,
-	89, 95, -3, 92, 95, 158, -68, -111, 94, 94,
-	-142, -142, 95, -128, -72, 167, 167, -71, 95, -131,
-	-3, -68, 87, -3, 90, -4, 92, -140, 91, -4,
-	-4, 94, 94, -91, 137, 88, 95, 92, -138, 91,
-	-4, -141, 93, -68, 95, 95, -4, -4, -92, 75,
-	82, 6, 85, 88, -3, -133, -132, 93, 89, 95,
-	-4, 92, 90, 90, 95, 95, -94, 82, -93, 6,
-	85, 83, 83, 86, -130, 95, -133, -4, -68, 87,
-	-4, 90, 90, 72, 83, 83, 84, 86, 88, 95,
-	92, -140, 91, -95, 82, -93, 88, -4, 84, -132,
+var yyChk = [...]int{
+	-1000, -1, -7, -5, -11, -47, -48, -143, -144, -147,
+	-148, -149, -23, -20, -21, -27, -28, -31, -37, -40,
+	-22, -43, -44, -45, -79, 15, 105, 104, -8, -10,
+	146, -71, 27, 32, 35, 38, 156, 113, -176, 119,
+	20, 21, 117, 118, 116, 120, 137, 151, 128, 129,
+	36, 141, 157, 133, 134, 135, 136, 142, 138, 139,
+	140, 143, 158, -74, -93, -90, -88, -96, -97, -99,
+	-133, -92, -94, -174, -179, -180, -181, -42, 196, 102,
+	100, -78, 16, 107, 132, 90, 5, 6, 7, -75,
+	10, -76, 190, 191, -172, 175, 177, 59, 178, 176,
+	-98, 179, 180, 181, 182, -81, 79, 83, 195, 11,
+	13, 14, 12, 114, -77, 9, 88, -173, 34, 72,
+	73, 99, 101, 173, 30, 4, 160, 161, 162, 167,
+	168, 169, 170, 171, 172, 164, 165, 166, 159, 148,
+	149, 152, 150, 33, 57, 58, 60, 97, 98, 155,
+	103, 188, -79, 196, -176, 105, 27, 151, 156, 104,
+	158, 32, -134, -78, -79, 148, -49, -51, 24, 19,
+	27, 22, 32, -50, 17, -88, 196, 196, 25, 25,
+	39, 39, -178, 196, -177, -174, -178, -172, 151, 59,
+	178, 179, 102, 100, -174, 114, 47, 120, 144, 150,
+	-179, -181, -179, -172, -172, -41, 121, 122, 40, 41,
+	123, 124, -172, -172, -79, -172, 196, -79, -79, -181,
+	-172, -79, -79, -79, -172, -79, -138, -78, -172, -79,
+	-172, -172, -46, 159, -47, -143, -144, -148, -71, 185,
+	-78, -79, -138, -47, -71, 198, 5, 6, 7, 164,
+	198, 184, 183, 189, 87, 84, 83, 80, 85, 86,
+	-190, 191, 190, 192, 193, 194, 82, 81, -79, -174,
+	-175, -9, 156, 113, 6, -73, -72, -188, 31, -78,
+	-78, 200, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 183, 189, -183, -190, 83, -88, -78, -78,
+	-172, 196, 200, -1, 109, -138, -95, 196, -134, -164,
+	-135, 108, -1, -63, 48, -52, -53, 25, 18, 25,
+	-121, -119, -116, -118, -172, 30, -117, 167, 168, 169,
+	170, 171, 172, 25, 18, -120, -116, 25, 74, 75,
+	76, -182, 89, -95, -138, -119, -152, -119, -172, -119,
+	-182, 199, 185, 114, 47, 144, 145, 150, -172, -116,
+	-172, -172, -172, 189, 46, 189, 46, 69, -172, -79,
+	-79, 18, 69, 69, 196, -95, 46, 18, 18, 199,
+	69, 199, -79, 6, -46, -51, -78, 197, 197, 197,
+	197, 201, -138, -172, -172, -172, 165, -78, -78, -78,
+	-78, -183, -78, 84, 80, 85, 86, -81, 196, -88,
+	-78, -78, 78, 77, -78, -78, -78, -78, -78, -78,
+	-78, 111, 80, 199, 80, -174, -175, 199, -172, -172,
+	6, -95, -182, -95, -78, 197, -142, -132, -131, -80,
+	-78, 192, -95, -182, -182, -182, -95, -95, -95, -81,
+	-81, 84, 80, 78, 77, 87, 176, -78, -172, 6,
+	-1, 197, 108, -165, 110, -136, 110, -78, -79, 112,
+	-64, -70, 54, 55, 51, -53, -54, 23, -175, -174,
+	-140, -125, -122, -126, -127, 29, -123, 196, -119, 174,
+	-88, -89, 103, -119, 20, 199, 196, -119, -140, 18,
+	199, -152, -187, 77, -187, -187, -142, 197, 69, 196,
+	69, -173, 28, 196, -189, 28, 36, 37, 45, 20,
+	-95, -178, -78, 115, 196, 28, 196, 196, 196, -79,
+	-172, -79, -172, -172, -79, -172, -79, -30, -29, -79,
+	25, 5, -30, -139, -79, -95, 197, -181, -181, -119,
+	-139, -139, -138, -79, 201, 166, 201, -75, -76, 81,
+	-78, -81, -78, -78, -81, -81, -2, -12, -5, -13,
+	105, 104, -8, -10, -6, 146, 130, 131, -172, -175,
+	-172, 80, 80, -73, 28, 196, 197, -95, 197, 18,
+	197, 199, 28, 197, -95, -95, -80, -95, 197, 197,
+	197, -81, -91, 196, -88, 173, -91, -91, -183, 199,
+	-157, -156, 110, 106, 112, -1, 112, -78, 109, 109,
+	148, 115, 116, -79, -79, -83, -84, -85, -78, -54,
+	-55, 49, -78, 67, -184, -186, 70, 72, 73, 199,
+	62, 64, 65, 66, -173, 28, -173, 28, -151, -125,
+	-71, -143, -144, -147, -148, 27, 196, -173, 28, -173,
+	28, 196, 26, 196, -47, -146, -145, -77, -172, -121,
+	-116, -79, -172, 30, 69, 196, -54, -140, -120, 69,
+	-50, -49, -50, -50, 196, -137, -77, -125, -172, -141,
+	-172, -47, -24, 196, -172, -77, 196, -77, -172, 197,
+	-47, -172, -151, -141, -47, 197, -36, -33, -35, -32,
+	-34, -174, -172, 197, -39, -38, -174, 152, 199, 28,
+	-175, 199, 197, -78, -78, 81, 112, 188, -79, -134,
+	148, 111, 111, -172, -172, 196, -141, -62, 127, 155,
+	197, -78, -142, -172, 197, 197, 197, 197, 127, 127,
+	153, 127, 153, 81, -82, -81, 196, 117, 80, -78,
+	112, -157, -1, -79, 104, -78, -1, 146, 19, -66,
+	40, 121, -67, -68, 56, 96, 162, -69, 96, 162,
+	199, -86, 52, 53, -55, -60, 50, 51, 61, 61,
+	-185, 63, -184, -186, 196, 196, -124, -125, 71, -123,
+	-172, -172, 197, 197, -79, -172, -172, -78, -82, -137,
+	-150, 34, -53, 199, 189, 197, 199, 199, 196, -137,
+	-150, -54, -125, -137, 197, 199, 68, 197, 199, -26,
+	40, 41, 42, 43, -25, -24, 44, -137, 46, 46,
+	-62, 127, 197, 28, 197, 199, 199, 44, 197, 199,
+	28, 197, 199, -174, -30, -172, -139, -78, 107, -2,
+	109, -166, 108, -2, -2, -2, 111, 111, -47, 197,
+	127, -104, 196, -172, 196, -62, 127, 197, 115, -62,
+	127, -62, 127, -62, 127, 154, -62, 127, -103, 196,
+	-172, -104, 161, -103, 161, -81, 197, 199, -78, 91,
+	197, 105, 112, 109, -135, -164, 108, 149, -79, -65,
+	163, 90, -83, 161, -60, -105, 99, -78, -57, -56,
+	-78, 57, 58, 59, -125, 71, -125, 71, 61, 61,
+	-185, -78, -172, -123, 103, 199, -173, 28, 199, 197,
+	-150, 197, -142, -54, -146, -78, -95, -116, -137, 197,
+	-150, 68, 197, 69, -137, -78, -189, -141, -77, -77,
+	197, 199, -78, 197, -172, -172, -79, 127, -104, 28,
+	146, 28, -32, -35, -35, -174, -79, 28, -36, 146,
+	28, -39, -2, -167, 110, -79, 112, 112, 112, -2,
+	-2, 197, 28, -104, -101, -100, -102, -172, 126, 23,
+	127, -104, -78, 127, -104, 127, -104, 127, -104, 49,
+	127, -103, -100, -102, -172, 127, 127, -82, 199, 105,
+	-1, -1, -68, -70, 160, -87, 40, 41, -63, -61,
+	101, -107, -106, -172, 199, 196, 196, 60, -123, -130,
+	68, 69, -123, -125, 71, -125, 71, 61, 115, 115,
+	199, -124, -172, -172, -79, 26, -47, -150, 197, 197,
+	199, 197, 69, -78, 26, -47, 196, -154, -153, 108,
+	-47, -26, -25, -104, -47, -3, -14, -5, -18, 105,
+	104, -15, -16, 146, 107, 147, 146, 146, 197, -3,
+	146, -159, -158, 110, 106, 112, -2, 109, 148, 107,
+	107, 112, 112, 196, 197, -63, 48, -63, 48, -108,
+	-109, 162, 91, 97, 51, -78, -104, 197, -104, -104,
+	-104, 196, -103, 197, -104, -103, -78, -156, 112, -65,
+	-64, -78, 199, 28, -57, -138, -138, 196, -78, 196,
+	-130, -130, -123, -123, -125, 71, -77, -172, -124, 197,
+	197, -82, -150, -95, 26, -47, 196, -154, -82, -150,
+	-137, -154, 33, 83, 112, 188, -79, -134, 148, -79,
+	-174, -175, -9, -79, -3, -3, 28, 112, -3, 112,
+	-159, -2, -79, 104, -2, 146, 107, 107, -47, 51,
+	51, -112, 84, 92, 6, -111, 95, 7, 100, -138,
+	197, -63, 197, 149, 115, -107, 196, 197, 197, -59,
+	-58, -78, 196, -141, -130, -123, 80, 80, -150, 197,
+	-82, -150, -137, -150, 197, -155, 81, 33, -3, 109,
+	-168, 108, -3, 111, 80, 80, -174, -175, 112, 112,
+	146, 112, 105, 112, 109, -166, 108, 149, 197, -83,
+	-83, -110, 98, -114, 92, -113, 6, -111, 95, 93,
+	93, 93, 96, 5, 6, 197, 19, -101, 197, 199,
+	197, -78, 197, 196, 196, -150, 197, 26, -47, 109,
+	-78, -155, -3, -169, 110, -79, 112, -4, -17, -5,
+	-19, 105, 104, -15, -16, -6, 146, -172, -172, 80,
+	80, -3, 105, -2, -2, -108, -108, 95, 49, 160,
+	81, 93, 93, 94, 93, 94, 96, -172, -172, -62,
+	127, 197, -59, 199, -129, 78, -128, -79, -137, 26,
+	-47, -82, -150, 19, 22, 109, -161, -160, 110, 106,
+	112, -3, 109, 148, 112, 188, -79, -134, 148, 111,
+	111, -172, -172, 112, -158, 112, 96, -115, 92, -113,
+	127, -103, -138, 197, 197, 199, 28, 197, -82, -150,
+	-150, 20, 24, 112, -161, -3, -79, 104, -3, 146,
+	107, -4, 109, -170, 108, -4, -4, -4, 111, 111,
+	149, -110, 94, -103, 197, 197, 197, -129, -172, 197,
+	-150, -146, 26, 196, 105, 112, 109, -168, 108, 149,
+	-4, -171, 110, -79, 112, 112, 112, -4, -4, -81,
+	-137, 105, -3, -3, -163, -162, 110, 106, 112, -4,
+	109, 148, 107, 107, 112, 112, 197, -160, 112, 112,
+	-163, -4, -79, 104, -4, 146, 107, 107, 26, 149,
+	105, 112, 109, -170, 108, 149, -81, 105, -4, -4,
+	-162, 112, 149,
 }
-var yyDef = [...]int{
 
-	-2, -2, 2, 30, 31, 10, 11, 12, 13, 14,
+var yyDef = [...]int{
+	-2, -2, 2, 33, 34, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	25, -2, 27, 0, 401, 46, 47, 0, 0, 0,
-	0, 0, 0, -2, 0, 0, 0, 0, 0, 139,
-	0, 0, 85, 86, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 171, 0, 0, 238, 239, 240, 241,
-	242, 243, 244, 245, 246, 247, 249, 250, 251, 252,
-	216, 254, 0, 39, 505, 222, 223, 224, 225, 226,
-	227, 0, 0, 0, 230, 0, 0, 0, 0, 322,
-	494, 0, 0, 0, 481, 489, 490, 491, 0, 228,
-	229, 235, 473, 474, 475, 476, 477, 478, 479, 480,
-	0, 0, 0, -2, 236, -2, 248, 0, 0, 0,
-	401, 0, 402, 236, -2, 188, 0, 0, 0, 0,
-	0, 492, 185, 216, 307, 0, 0, 0, 76, 492,
-	487, 485, 77, 0, 79, 0, 0, 0, 0, 0,
-	0, 84, 108, 110, 0, 140, 141, 142, 143, 0,
-	0, 0, -2, -2, 236, 236, 155, 167, -2, -2,
-	-2, -2, -2, 166, 409, -2, -2, 172, 173, 0,
-	0, 236, 0, 0, 0, 236, 247, 0, 0, 37,
-	38, 40, 217, 220, 0, 506, 0, 509, 510, 494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 301, 302, 0, 307, 307, 0, 492, 492,
-	509, 510, 0, 0, 495, 295, 305, 306, 0, 492,
-	0, 0, 3, -2, 0, 0, 307, 0, 459, 405,
-	0, 214, 0, 188, 190, 0, 0, 0, 0, 417,
-	364, 365, 354, 355, 0, -2, -2, -2, -2, 0,
-	0, 0, 415, 503, 503, 503, 0, 493, 0, 308,
-	0, 507, 0, 307, 0, 0, 0, 0, 0, 0,
-	111, 116, 124, 138, 0, 0, 0, 0, 0, 0,
-	-2, -2, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, -2, 223, 484, 237, 253, 256, 272, 188, -2,
-	0, 0, 0, 0, 0, 505, 0, 273, -2, -2,
-	0, 0, 0, 0, 0, 286, 216, 257, -2, 0,
-	0, 296, 297, 298, 299, 300, 303, 304, 231, 233,
-	0, 307, 0, 409, 313, 0, 421, 397, 399, 395,
-	396, 255, 230, 0, 0, 0, 0, 0, 0, 0,
-	307, 307, 278, 280, 0, 0, 0, 0, 494, 148,
-	307, 0, 232, 234, 443, 315, 0, 0, -2, 0,
-	0, 0, 236, 176, 198, 0, 0, 0, 190, 192,
-	0, 187, 482, 189, -2, 376, 379, 380, 381, 216,
-	366, 0, 369, 216, 0, 0, 0, 0, 190, 0,
-	0, 0, 504, 0, 0, 186, 316, 0, 0, 0,
-	216, 508, 0, 0, 0, 0, 0, 488, 486, 216,
-	0, 216, 0, 0, -2, -2, -2, -2, -2, -2,
-	-2, -2, 109, 119, -2, 0, 121, 123, 164, -2,
-	153, 154, 168, 159, 160, 410, -2, 0, 0, 41,
-	42, 0, 401, 51, 52, 53, 28, 29, 0, 483,
-	0, 0, 0, 221, 0, 0, 281, 282, 0, 0,
-	287, -2, 291, 293, 309, 0, 310, 0, 314, 0,
-	0, 307, 492, 492, 492, 492, 307, 307, 307, 0,
-	0, 0, 0, 288, 216, 275, 0, 292, 294, 0,
-	0, 0, 0, 443, -2, 0, 0, 460, 400, 406,
-	0, -2, 0, 0, -2, -2, 197, 261, 267, 265,
-	266, 192, 194, 0, 191, 0, 0, 498, 496, 0,
-	497, 500, 501, 502, 377, 0, 496, 0, 370, 0,
-	0, 0, 425, 188, 429, 0, 230, 418, 0, 236,
-	-2, 355, 0, 0, 439, 190, 416, 181, 184, 182,
-	183, 0, 0, 407, 0, 419, 89, 101, 0, 97,
-	92, 0, 0, 0, 319, 106, 107, 0, 115, 0,
-	0, 131, 132, 126, 129, 125, 0, 0, 0, 112,
-	0, 0, -2, 236, 0, -2, -2, 0, 0, 216,
-	0, 283, 0, 317, 0, 422, 398, 0, 307, 307,
-	307, 307, 307, 0, 0, 0, 318, 320, 321, 0,
-	0, 259, 0, 146, 0, 323, 0, 0, 0, 444,
-	236, 45, 403, 457, 177, 0, 204, 205, 201, 207,
-	208, 209, 210, 215, 212, 213, 0, 263, 268, 269,
-	194, 180, 0, 0, 0, 0, 0, 499, 0, 498,
-	414, -2, 0, 381, 378, 382, 236, 371, 423, 0,
-	190, 0, 0, 360, 307, 0, 0, 0, 440, 0,
-	0, 0, -2, 0, 90, 102, 103, 0, 0, 0,
-	99, 0, 0, 0, 0, 113, 0, 0, 0, 0,
-	0, 0, 0, 120, 118, 412, 32, 5, -2, 463,
-	0, 0, 0, -2, -2, 0, 0, 284, 311, 0,
-	309, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	285, 274, 0, 0, 147, 0, 258, 43, 0, -2,
-	404, 458, 0, 236, 214, 202, 0, 262, 0, 196,
-	195, 193, 383, 0, 496, 0, 0, 0, 0, 373,
-	0, 0, 216, 427, 430, 428, 0, 0, 0, 0,
-	216, 0, 408, 216, 420, 104, 105, 101, 0, 98,
-	93, 94, -2, -2, 216, -2, 0, 127, 133, 130,
-	0, -2, 0, 0, 447, 0, -2, 236, 0, 0,
-	0, 0, 218, 0, 0, 0, 317, 318, 319, 320,
-	321, 323, 0, 0, 0, 0, 0, 260, 0, 0,
-	44, 441, 201, 200, 203, 264, 270, 271, 214, 388,
-	384, 0, 0, 0, 496, 0, 386, 0, 0, 0,
-	374, 230, 236, 0, 426, 361, 362, 307, 216, 0,
-	0, 437, 0, 88, 91, 100, 114, 0, 0, 54,
-	55, 0, 401, 68, 69, 0, 61, -2, -2, 0,
-	0, 447, -2, 0, 0, 464, -2, 33, 34, 0,
-	0, 216, 312, 340, 0, 0, 0, 0, 0, 0,
-	340, 340, 0, 340, 0, 0, 196, 442, 199, 178,
-	393, 0, 389, 385, 0, 391, 387, 0, 375, 367,
-	368, 424, 0, 0, 433, 0, 435, 0, 134, -2,
-	236, 0, 236, 247, 0, 0, -2, 0, 0, 0,
-	0, 0, 448, 236, 50, 461, 35, 36, 0, 0,
-	338, 196, 0, 340, 340, 340, 340, 340, 340, 0,
-	196, 0, 0, 0, 0, 276, 0, 0, 0, 390,
-	392, 363, 431, 0, 216, 7, -2, 467, 0, -2,
-	0, 0, 0, 0, 135, 136, -2, 48, 0, -2,
-	462, 0, 219, 325, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 333, 340, 335, 340, 324, 179,
-	394, 216, 0, 438, 451, 0, -2, 236, 0, 0,
-	63, 64, 0, 401, 73, 74, 75, 0, 0, 0,
-	0, 0, 49, 445, 0, 341, 326, 327, 328, 329,
-	330, 331, 0, 0, 0, 434, 436, 0, 451, -2,
-	0, 0, 468, -2, 0, -2, 236, 0, -2, -2,
-	0, 0, 137, 446, 197, 334, 336, 432, 0, 0,
-	452, 236, 67, 465, 56, 9, -2, 471, 0, 0,
-	0, -2, -2, 339, 0, 65, 0, -2, 466, 0,
-	455, 0, -2, 236, 0, 0, 0, 0, 342, 0,
-	0, 0, 0, 66, 449, 0, 455, -2, 0, 0,
-	472, -2, 57, 58, 0, 0, 0, 0, 351, 0,
-	0, 344, 345, 346, 450, 0, 0, 456, 236, 72,
-	469, 59, 60, 0, 350, 347, 348, 349, 70, 0,
-	-2, 470, 0, 343, 0, 353, 71, 453, 352, 454,
+	25, 26, 27, 28, -2, 30, 0, 509, 49, 50,
+	0, 0, 0, 626, 0, 0, 0, 0, -2, 0,
+	0, 0, 0, 0, 155, 0, 0, 624, 92, 93,
+	0, 0, 0, 0, 0, 0, 0, 181, 0, 187,
+	0, 0, -2, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 290, 291, 292, 293, 256, 637,
+	639, 0, 303, 0, 42, 664, 262, 263, 264, 265,
+	266, 267, 0, 0, 270, 0, 0, 630, 635, 0,
+	380, 636, 0, 0, 0, 653, 0, 0, 0, 640,
+	648, 649, 650, 0, 275, 268, 269, 600, 601, 602,
+	603, 604, 605, 0, 0, 606, 607, 608, 609, 610,
+	611, 612, 613, 614, 615, 616, 617, 618, 620, 621,
+	622, 623, 625, 627, 628, 629, 631, 632, 633, 634,
+	638, -2, 276, -2, 289, 0, 0, 624, 0, 509,
+	619, 626, 0, 510, 276, -2, -2, 210, 0, 0,
+	0, 0, 0, 0, 651, 207, 256, 357, 0, 0,
+	0, 0, 83, 651, 646, 644, 84, 0, 624, 630,
+	635, 636, 637, 639, 86, 0, 0, 0, 0, 0,
+	0, 0, 91, 116, 118, 0, 156, 157, 158, 159,
+	0, 0, 0, -2, -2, 0, 357, 276, 276, 171,
+	183, -2, -2, -2, -2, -2, 182, 517, -2, -2,
+	188, 189, 192, 256, 194, 195, 196, 197, 0, 0,
+	0, 276, 0, 0, 0, 0, 297, 0, 0, 0,
+	0, 0, 668, 669, 653, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 288,
+	0, 0, 40, 41, 43, 257, 260, 0, 665, 351,
+	352, 0, 357, 357, 0, 357, 651, 651, 651, 357,
+	357, 357, 668, 669, 0, 0, 654, 345, 355, 356,
+	0, 0, 0, 3, -2, 0, 0, 357, 0, 586,
+	513, 0, 0, 254, 0, 210, 212, 0, 0, 0,
+	0, 525, 456, 457, 444, 445, 0, -2, -2, -2,
+	-2, -2, -2, 0, 0, 0, 523, 0, 662, 662,
+	662, 0, 652, 0, 358, 0, 0, 557, 666, 0,
+	357, 0, 0, 0, 0, 0, 0, 0, 119, 124,
+	132, 146, 153, 0, 0, 0, 0, 0, 0, -2,
+	-2, 0, 0, 0, 357, 0, 0, 0, 0, 0,
+	0, 0, -2, 263, 193, 210, 643, 277, 294, 305,
+	320, 295, 0, 298, 299, 300, 0, 0, 321, -2,
+	-2, 0, 0, 0, 0, 0, 0, 334, 256, 306,
+	-2, -2, 0, 0, 346, 347, 348, 349, 350, 353,
+	354, -2, 0, 0, 0, 0, 0, 664, 0, 271,
+	273, 0, 357, 0, 517, 363, 0, 529, 505, 507,
+	504, 304, 0, 357, 357, 357, 0, 0, 0, 326,
+	328, 0, 0, 0, 0, 653, 164, 0, 272, 274,
+	570, 365, 0, 0, -2, 0, 0, 0, 276, 0,
+	198, 238, 0, 0, 0, 212, 214, 0, 209, 641,
+	211, -2, 472, 475, 476, 479, 480, 256, 458, 0,
+	461, 464, 638, 256, 0, 0, 0, 0, 212, 0,
+	0, 0, 0, 663, 0, 0, 208, 366, 0, 0,
+	0, 558, 0, 0, 256, 667, 0, 0, 0, 0,
+	0, 647, 645, 256, 0, 256, 0, 0, 0, -2,
+	-2, -2, -2, -2, -2, -2, -2, 117, 127, -2,
+	0, 129, 131, 180, -2, 0, 367, 169, 170, 184,
+	175, 176, 518, -2, 296, 0, 302, 329, 330, 0,
+	0, 335, -2, -2, 341, 343, 0, 0, 44, 45,
+	0, 509, 55, 56, 57, 0, 31, 32, 0, 642,
+	0, 0, 0, 261, 0, 0, 359, 0, 360, 0,
+	364, 0, 0, 368, 0, 0, 0, 0, 0, 0,
+	0, 0, 336, 256, 323, 0, 342, 344, 0, 0,
+	0, 570, -2, 0, 0, 587, 508, 514, 0, -2,
+	0, 0, 0, -2, -2, 237, 310, 315, 314, 214,
+	227, 0, 213, 0, 0, 657, 655, 0, 0, 0,
+	656, 659, 660, 661, 473, 0, 477, 0, 0, 655,
+	0, 551, 552, 553, 554, 0, 0, 462, 0, 465,
+	0, 0, 0, 0, 549, 210, 537, 0, 270, 526,
+	0, 276, -2, 445, 0, 0, 549, 212, 524, 0,
+	203, 206, 204, 205, 0, 0, 515, 655, 559, 0,
+	527, 96, 108, 0, 104, 99, 0, 0, 0, 371,
+	113, 114, 115, 0, 123, 0, 0, 139, 140, 134,
+	137, 133, 0, 0, 0, 149, 147, 0, 0, 0,
+	120, 0, 154, 301, 331, 0, 0, -2, 276, 0,
+	-2, -2, -2, 0, 0, 256, 0, 374, 0, 0,
+	369, 0, 530, 506, 370, 372, 373, 381, 0, 0,
+	0, 0, 0, 0, 0, 308, 0, 162, 0, 0,
+	0, 0, 571, 276, 48, 511, 584, 0, 199, 0,
+	244, 245, 241, 247, 248, 249, 250, 255, 252, 253,
+	0, 312, 316, 317, 227, 229, 0, 0, 0, 0,
+	0, 658, 0, 657, 0, 0, 522, -2, 0, 480,
+	474, 478, 481, 484, 276, 463, 466, 0, 549, 0,
+	533, 0, 212, 0, 0, 452, 357, 0, 0, 0,
+	547, 549, 655, 0, 0, 0, 0, -2, 0, 97,
+	109, 110, 0, 0, 0, 106, 0, 0, 0, 0,
+	377, 0, 121, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 128, 126, 520, 332, 35, 5,
+	-2, 590, 0, 0, 0, 0, -2, -2, 0, 0,
+	0, 386, 417, 410, 0, 375, 0, 361, 0, 376,
+	0, 378, 0, 379, 0, 0, 383, 0, 402, 417,
+	408, 403, 0, 405, 0, 333, 322, 0, 0, 163,
+	307, 46, 0, -2, 512, 585, 0, -2, 276, 254,
+	242, 0, 311, 0, 236, 231, 0, 228, 215, 220,
+	216, 628, 629, 630, 485, 0, 655, 0, 0, 0,
+	0, 0, 0, 469, 0, 0, 482, 0, 0, 467,
+	531, 256, 550, 549, 538, 536, 0, 0, 0, 0,
+	548, 0, 256, 0, 516, 0, 256, 528, 111, 112,
+	108, 0, 105, 100, 101, -2, -2, 0, 389, 256,
+	-2, 0, 135, 141, 138, 0, -2, 0, 0, -2,
+	0, 150, 574, 0, -2, 276, 0, 0, 0, 0,
+	0, 258, 0, 393, 0, 413, 236, 236, 0, 0,
+	0, 387, 0, 0, 388, 0, 390, 0, 391, 0,
+	0, 392, 0, 236, 236, 0, 0, 309, 0, 47,
+	568, 0, 241, 240, 243, 313, 318, 319, 254, 202,
+	0, 230, 234, 0, 0, 0, 0, 0, 490, 486,
+	0, 0, 0, 655, 0, 488, 0, 0, 0, 0,
+	0, 470, 483, 270, 276, 0, 549, 535, 453, 454,
+	357, 256, 0, 0, 0, 549, 0, 556, 566, 0,
+	95, 98, 107, 396, 122, 0, 0, 59, 60, 0,
+	509, 73, 74, 0, 0, 66, -2, -2, 0, 0,
+	-2, 0, 574, -2, 0, 0, 591, -2, 0, 36,
+	37, 0, 0, 256, 409, 411, 0, 412, 0, 416,
+	0, 421, 422, 423, 0, 0, 394, 362, 395, 397,
+	398, 236, 399, 407, 404, 406, 0, 569, 0, 239,
+	200, 232, 0, 0, 221, 0, 0, 0, 502, 0,
+	491, 487, 0, 493, 489, 0, 0, 0, 471, 459,
+	460, 549, 534, 0, 0, 549, 0, 555, 549, 545,
+	0, 567, 560, 0, 142, -2, 276, 0, -2, 276,
+	288, 0, 0, -2, 0, 0, 0, 151, 0, 0,
+	0, 575, 276, 54, 588, 0, 38, 39, 0, 0,
+	0, 424, 0, 0, 0, 0, 0, 428, 0, 418,
+	385, 0, 324, 51, 0, 235, 417, 217, 218, 0,
+	225, 222, 256, 0, 492, 494, 0, 0, 532, 455,
+	549, 541, 0, 543, 256, 0, 0, 560, 7, -2,
+	594, 0, 0, -2, 0, 0, 0, 0, 143, 144,
+	-2, 152, 52, 0, -2, 589, 0, -2, 259, 237,
+	237, 419, 0, 0, 0, 441, 0, 0, 0, 431,
+	432, 433, 434, 0, 0, 382, 201, 0, 219, 0,
+	223, 0, 503, 0, 0, 539, 256, 0, 549, 0,
+	561, 0, 578, 0, -2, 276, 0, 0, 0, 68,
+	69, 0, 509, 79, 80, 81, 0, 0, 0, 0,
+	0, 0, 53, 572, 0, 414, 415, 0, 426, 427,
+	0, 440, 435, 436, 437, 438, 439, 429, 430, 384,
+	0, 233, 226, 0, 0, 0, 500, -2, 0, 0,
+	549, 549, 546, 0, 563, 0, 0, 578, -2, 0,
+	0, 595, -2, 0, 0, -2, 276, 0, -2, -2,
+	-2, 0, 0, 145, 573, 0, 425, 424, 0, 443,
+	0, 400, 0, 0, 0, 0, 0, 0, 549, 542,
+	544, 0, 0, 0, 0, 579, 276, 72, 592, 0,
+	61, 9, -2, 598, 0, 0, 0, 0, -2, -2,
+	58, 420, 442, 401, 224, 495, 496, 501, 499, 497,
+	540, 562, 0, 0, 70, 0, -2, 593, 0, -2,
+	582, 0, -2, 276, 0, 0, 0, 0, 0, 564,
+	0, 71, 576, 0, 0, 582, -2, 0, 0, 599,
+	-2, 0, 62, 63, 0, 0, 0, 577, 0, 0,
+	0, 583, 276, 78, 596, 0, 64, 65, 0, 75,
+	76, 0, -2, 597, 0, -2, 565, 77, 580, 0,
+	581, 0, 82,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 165, 3, 3, 3, 164, 3, 3,
-	166, 167, 162, 161, 168, 160, 169, 163, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 158,
-	3, 159,
+	3, 3, 3, 195, 3, 3, 3, 194, 3, 3,
+	196, 197, 192, 191, 199, 190, 200, 193, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 188,
+	3, 189, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 198, 3, 201,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1791,8 +2605,12 @@ var yyTok2 = [...]int{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187,
 }
+
 var yyTok3 = [...]int{
 	0,
 }
@@ -1875,9 +2693,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1887,13 +2705,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1924,30 +2742,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -2002,7 +2820,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -2013,8 +2831,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -2027,7 +2845,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -2036,18 +2854,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -2069,10 +2887,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -2108,7 +2926,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -2119,16 +2937,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -2136,1060 +2954,1197 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:248
+//line parser.y:290
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:253
+//line parser.y:295
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:258
+//line parser.y:300
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:265
+//line parser.y:307
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:269
+//line parser.y:311
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:275
+//line parser.y:317
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:279
+//line parser.y:321
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:285
+//line parser.y:327
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:289
+//line parser.y:331
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:295
+//line parser.y:337
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:299
+//line parser.y:341
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:303
+//line parser.y:345
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:307
+//line parser.y:349
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:311
+//line parser.y:353
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:315
+//line parser.y:357
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:319
+//line parser.y:361
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:323
+//line parser.y:365
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:327
+//line parser.y:369
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:331
+//line parser.y:373
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:335
+//line parser.y:377
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:339
+//line parser.y:381
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:343
+//line parser.y:385
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:347
+//line parser.y:389
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:351
+//line parser.y:393
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:355
+//line parser.y:397
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:359
+//line parser.y:401
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:363
+//line parser.y:405
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:369
+//line parser.y:409
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:373
+//line parser.y:413
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:379
+//line parser.y:417
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:383
+//line parser.y:423
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:427
+		{
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+		}
+	case 33:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:433
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:437
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 35:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:389
+//line parser.y:443
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 33:
+	case 36:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:393
+//line parser.y:447
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 34:
+	case 37:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:397
+//line parser.y:451
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 35:
+	case 38:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:401
+//line parser.y:455
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 36:
+	case 39:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:405
+//line parser.y:459
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 37:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:411
+//line parser.y:465
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 38:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:415
+//line parser.y:469
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 39:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:421
+//line parser.y:475
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 40:
+	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:425
+//line parser.y:479
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 41:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:431
+//line parser.y:485
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 42:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:435
+//line parser.y:489
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 43:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:441
+//line parser.y:495
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 44:
+	case 47:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:445
+//line parser.y:499
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 45:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:449
+//line parser.y:503
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 46:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:453
+//line parser.y:507
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 47:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:457
+//line parser.y:511
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 48:
+	case 51:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:515
+		{
+			yyVAL.statement = TryCatch{Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 52:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:463
+//line parser.y:521
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 49:
+	case 53:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:467
+//line parser.y:525
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 50:
+	case 54:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:471
+//line parser.y:529
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 51:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:475
+//line parser.y:533
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:479
+//line parser.y:537
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:483
+//line parser.y:541
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 54:
+	case 58:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:545
+		{
+			yyVAL.statement = TryCatch{Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:489
+//line parser.y:551
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 55:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:493
+//line parser.y:555
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 56:
+	case 61:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:499
+//line parser.y:561
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 57:
+	case 62:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:503
+//line parser.y:565
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 58:
+	case 63:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:507
+//line parser.y:569
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 59:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:511
+//line parser.y:573
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 60:
+	case 65:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:515
+//line parser.y:577
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 61:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:521
+//line parser.y:583
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 62:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:525
+//line parser.y:587
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 63:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:531
+//line parser.y:593
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:535
+//line parser.y:597
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:541
+//line parser.y:603
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 71:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:545
+//line parser.y:607
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 72:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:549
+//line parser.y:611
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:553
+//line parser.y:615
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:557
+//line parser.y:619
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 75:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:623
+		{
+			yyVAL.statement = TryCatch{Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:563
+//line parser.y:629
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 71:
+	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:567
+//line parser.y:633
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 72:
+	case 78:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:571
+//line parser.y:637
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 73:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:575
+//line parser.y:641
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 74:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:579
+//line parser.y:645
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 75:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:583
+//line parser.y:649
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 76:
+	case 82:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:653
+		{
+			yyVAL.statement = TryCatch{Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:589
+//line parser.y:659
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:593
+//line parser.y:663
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:597
+//line parser.y:667
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:601
+//line parser.y:671
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:607
+//line parser.y:677
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:611
+//line parser.y:681
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:615
+//line parser.y:685
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:619
+//line parser.y:689
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:623
+//line parser.y:693
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:629
+//line parser.y:699
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:633
+//line parser.y:703
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:639
+//line parser.y:709
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:643
+//line parser.y:713
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:647
+//line parser.y:717
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[3].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:651
+//line parser.y:721
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:655
+//line parser.y:725
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:659
+//line parser.y:729
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:663
+//line parser.y:733
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:667
+//line parser.y:737
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:671
+//line parser.y:741
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:675
+//line parser.y:745
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:681
+//line parser.y:751
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
 		}
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:685
+//line parser.y:755
 		{
 			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
 		}
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:691
+//line parser.y:761
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:695
+//line parser.y:765
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:701
+//line parser.y:771
 		{
 			yyVAL.expression = nil
 		}
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:705
+//line parser.y:775
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:709
+//line parser.y:779
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:713
+//line parser.y:783
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:717
+//line parser.y:787
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:723
+//line parser.y:793
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:727
+//line parser.y:797
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Statement: yyDollar[5].identifier}
 		}
-	case 108:
+	case 115:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:801
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: yyDollar[5].queryexpr.GetBaseExpr(), Fields: []QueryExpression{Field{Object: AllColumns{BaseExpr: yyDollar[5].queryexpr.GetBaseExpr()}}}},
+					FromClause:   FromClause{Tables: []QueryExpression{Table{Object: DataModifyingSubquery{BaseExpr: yyDollar[5].queryexpr.GetBaseExpr(), Query: yyDollar[5].queryexpr}}}},
+				},
+			}}
+		}
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:731
+//line parser.y:810
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 109:
+	case 117:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:735
+//line parser.y:814
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 110:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:739
+//line parser.y:818
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 111:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:743
+//line parser.y:822
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 112:
+	case 120:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:747
+//line parser.y:826
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 113:
+	case 121:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:753
+//line parser.y:832
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 114:
+	case 122:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:757
+//line parser.y:836
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 115:
+	case 123:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:761
+//line parser.y:840
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 116:
+	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:765
+//line parser.y:844
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].queryexpr}
 		}
-	case 117:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:771
+//line parser.y:850
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr}
 		}
-	case 118:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:775
+//line parser.y:854
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
 		}
-	case 119:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:781
+//line parser.y:860
 		{
 			yyVAL.replacevals = []ReplaceValue{yyDollar[1].replaceval}
 		}
-	case 120:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:785
+//line parser.y:864
 		{
 			yyVAL.replacevals = append([]ReplaceValue{yyDollar[1].replaceval}, yyDollar[3].replacevals...)
 		}
-	case 121:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:791
+//line parser.y:870
 		{
 			yyVAL.statement = StatementPreparation{Name: yyDollar[2].identifier, Statement: value.NewString(yyDollar[4].token.Literal)}
 		}
-	case 122:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:795
+//line parser.y:874
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 123:
+	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:799
+//line parser.y:878
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 124:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:803
+//line parser.y:882
 		{
 			yyVAL.statement = DisposeStatement{Name: yyDollar[3].identifier}
 		}
-	case 125:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:809
+//line parser.y:888
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 126:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:815
+//line parser.y:894
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 127:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:819
+//line parser.y:898
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 128:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:825
+//line parser.y:904
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 129:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:831
+//line parser.y:910
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 130:
+	case 138:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:835
+//line parser.y:914
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 131:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:841
+//line parser.y:920
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 132:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:845
+//line parser.y:924
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 133:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:849
+//line parser.y:928
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 134:
+	case 142:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:855
+//line parser.y:934
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 135:
+	case 143:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:859
+//line parser.y:938
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 136:
+	case 144:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:863
+//line parser.y:942
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 137:
+	case 145:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:867
+//line parser.y:946
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 138:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:871
+//line parser.y:950
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 139:
+	case 147:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:956
+		{
+			yyVAL.procparam = ProcedureParameter{BaseExpr: yyDollar[1].variable.BaseExpr, Variable: yyDollar[1].variable}
+		}
+	case 148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:960
+		{
+			yyVAL.procparam = ProcedureParameter{BaseExpr: NewBaseExpr(yyDollar[1].token), Variable: yyDollar[2].variable, Out: yyDollar[1].token}
+		}
+	case 149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:966
+		{
+			yyVAL.procparams = []ProcedureParameter{yyDollar[1].procparam}
+		}
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:970
+		{
+			yyVAL.procparams = append([]ProcedureParameter{yyDollar[1].procparam}, yyDollar[3].procparams...)
+		}
+	case 151:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:976
+		{
+			yyVAL.statement = ProcedureDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
+		}
+	case 152:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:980
+		{
+			yyVAL.statement = ProcedureDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].procparams, Statements: yyDollar[9].program}
+		}
+	case 153:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:984
+		{
+			yyVAL.statement = DisposeProcedure{Name: yyDollar[3].identifier}
+		}
+	case 154:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:988
+		{
+			yyVAL.statement = CallProcedure{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Args: yyDollar[4].queryexprs}
+		}
+	case 155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:877
+//line parser.y:994
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 140:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:881
+//line parser.y:998
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 141:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:885
+//line parser.y:1002
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 142:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:889
+//line parser.y:1006
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 143:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:893
+//line parser.y:1010
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 144:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:897
+//line parser.y:1014
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 145:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:901
+//line parser.y:1018
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 146:
+	case 162:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:907
+//line parser.y:1024
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[5].token}
 		}
-	case 147:
+	case 163:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:911
+//line parser.y:1028
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[6].token}
 		}
-	case 148:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:915
+//line parser.y:1032
 		{
 			yyVAL.queryexpr = CursorAttrebute{Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 149:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:921
+//line parser.y:1038
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 150:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:925
+//line parser.y:1042
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 151:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:929
+//line parser.y:1046
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 152:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:933
+//line parser.y:1050
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 153:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:937
+//line parser.y:1054
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 154:
+	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:941
+//line parser.y:1058
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:945
+//line parser.y:1062
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag}
 		}
-	case 156:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:949
+//line parser.y:1066
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 157:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:953
+//line parser.y:1070
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 158:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:957
+//line parser.y:1074
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 159:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:961
+//line parser.y:1078
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 160:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:965
+//line parser.y:1082
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 161:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:969
+//line parser.y:1086
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 162:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:973
+//line parser.y:1090
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 163:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:977
+//line parser.y:1094
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 180:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:981
+//line parser.y:1098
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 165:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:985
+//line parser.y:1102
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 166:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:989
+//line parser.y:1106
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 167:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:993
+//line parser.y:1110
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 168:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:997
+//line parser.y:1114
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].queryexpr}
 		}
-	case 169:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1001
+//line parser.y:1118
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 170:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1005
+//line parser.y:1122
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 171:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1009
+//line parser.y:1126
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 172:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1013
+//line parser.y:1130
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 173:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1019
+//line parser.y:1136
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 174:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1023
+//line parser.y:1140
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 175:
+	case 191:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1027
+//line parser.y:1144
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 176:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1033
+	case 192:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1150
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Statement: yyDollar[2].statement}
+		}
+	case 193:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1154
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: yyDollar[2].token, Statement: yyDollar[3].statement}
+		}
+	case 194:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1160
+		{
+			yyVAL.statement = yyDollar[1].queryexpr
+		}
+	case 195:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1164
+		{
+			yyVAL.statement = yyDollar[1].expression
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1168
+		{
+			yyVAL.statement = yyDollar[1].expression
+		}
+	case 197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1172
+		{
+			yyVAL.statement = yyDollar[1].expression
+		}
+	case 198:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1178
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3198,9 +4153,9 @@ yydefault:
 				LimitClause:   yyDollar[4].queryexpr,
 			}
 		}
-	case 177:
+	case 199:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1042
+//line parser.y:1187
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3210,9 +4165,9 @@ yydefault:
 				Context:       yyDollar[6].token,
 			}
 		}
-	case 178:
+	case 200:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1054
+//line parser.y:1199
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3228,9 +4183,9 @@ yydefault:
 				LimitClause:   yyDollar[9].queryexpr,
 			}
 		}
-	case 179:
+	case 201:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:1070
+//line parser.y:1215
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3247,9 +4202,9 @@ yydefault:
 				Context:       yyDollar[11].token,
 			}
 		}
-	case 180:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1089
+	case 202:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:1234
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -3257,11 +4212,13 @@ yydefault:
 				WhereClause:   yyDollar[3].queryexpr,
 				GroupByClause: yyDollar[4].queryexpr,
 				HavingClause:  yyDollar[5].queryexpr,
+				WindowClause:  yyDollar[6].queryexpr,
+				QualifyClause: yyDollar[7].queryexpr,
 			}
 		}
-	case 181:
+	case 203:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1099
+//line parser.y:1246
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3270,9 +4227,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 182:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1108
+//line parser.y:1255
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3281,9 +4238,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 183:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1117
+//line parser.y:1264
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3292,93 +4249,205 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 184:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1128
+//line parser.y:1275
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 185:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1132
+//line parser.y:1279
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 186:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1138
+//line parser.y:1285
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 187:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1144
+//line parser.y:1291
 		{
 			yyVAL.queryexpr = IntoClause{Variables: yyDollar[2].variables}
 		}
-	case 188:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1150
+//line parser.y:1297
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 189:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1154
+//line parser.y:1301
 		{
 			yyVAL.queryexpr = FromClause{Tables: yyDollar[2].queryexprs}
 		}
-	case 190:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1160
+//line parser.y:1307
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 191:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1164
+//line parser.y:1311
 		{
 			yyVAL.queryexpr = WhereClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 192:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1170
+//line parser.y:1317
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 193:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1174
+//line parser.y:1321
 		{
 			yyVAL.queryexpr = GroupByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 194:
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1327
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 217:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1331
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Sets: yyDollar[3].queryexprs}
+		}
+	case 218:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1335
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Sets: yyDollar[3].queryexprs}
+		}
+	case 219:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1339
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].token, Sets: yyDollar[4].queryexprs}
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1345
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1349
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 222:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1355
+		{
+			if p, ok := yyDollar[1].queryexpr.(Parentheses); ok {
+				yyVAL.queryexpr = GroupingSet{BaseExpr: p.Expr.GetBaseExpr(), Values: []QueryExpression{p.Expr}}
+			} else {
+				yyVAL.queryexpr = GroupingSet{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Values: []QueryExpression{yyDollar[1].queryexpr}}
+			}
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1363
+		{
+			yyVAL.queryexpr = GroupingSet{BaseExpr: NewBaseExpr(yyDollar[1].token)}
+		}
+	case 224:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1367
+		{
+			yyVAL.queryexpr = GroupingSet{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: append([]QueryExpression{yyDollar[2].queryexpr}, yyDollar[4].queryexprs...)}
+		}
+	case 225:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1373
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1377
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 227:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1180
+//line parser.y:1383
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 195:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1184
+//line parser.y:1387
 		{
 			yyVAL.queryexpr = HavingClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 196:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1190
+//line parser.y:1393
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 197:
+	case 230:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1397
+		{
+			yyVAL.queryexpr = WindowClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Windows: yyDollar[2].queryexprs}
+		}
+	case 231:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1403
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1407
+		{
+			yyVAL.queryexpr = QualifyClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Filter: yyDollar[2].queryexpr}
+		}
+	case 233:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1413
+		{
+			yyVAL.queryexpr = WindowDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier, Clause: yyDollar[4].queryexpr.(AnalyticClause)}
+		}
+	case 234:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1419
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1423
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 236:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:1429
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 237:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1194
+//line parser.y:1433
 		{
 			yyVAL.queryexpr = OrderByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 198:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1200
+//line parser.y:1439
 		{
 			if yyDollar[1].queryexpr == nil {
 				yyVAL.queryexpr = yyDollar[1].queryexpr
@@ -3386,9 +4455,9 @@ yydefault:
 				yyVAL.queryexpr = LimitClause{BaseExpr: yyDollar[1].queryexpr.(OffsetClause).BaseExpr, OffsetClause: yyDollar[1].queryexpr}
 			}
 		}
-	case 199:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1208
+//line parser.y:1447
 		{
 			var base *BaseExpr
 			if yyDollar[1].queryexpr == nil {
@@ -3398,333 +4467,387 @@ yydefault:
 			}
 			yyVAL.queryexpr = LimitClause{BaseExpr: base, Type: yyDollar[2].token, Position: yyDollar[3].token, Value: yyDollar[4].queryexpr, Unit: yyDollar[5].token, Restriction: yyDollar[6].token, OffsetClause: yyDollar[1].queryexpr}
 		}
-	case 200:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1218
+//line parser.y:1457
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token, Restriction: yyDollar[4].token, OffsetClause: yyDollar[5].queryexpr}
 		}
-	case 201:
+	case 241:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1224
+//line parser.y:1463
 		{
 			yyVAL.token = Token{}
 		}
-	case 202:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1228
+//line parser.y:1467
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 203:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1232
+//line parser.y:1471
 		{
 			yyVAL.token = yyDollar[2].token
 		}
-	case 204:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1238
+//line parser.y:1477
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 205:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1242
+//line parser.y:1481
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 206:
+	case 246:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1248
+//line parser.y:1487
 		{
 			yyVAL.token = Token{}
 		}
-	case 207:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1252
+//line parser.y:1491
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 208:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1258
+//line parser.y:1497
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 209:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1262
+//line parser.y:1501
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1266
+//line parser.y:1505
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1272
+//line parser.y:1511
 		{
 			yyVAL.token = Token{}
 		}
-	case 212:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1276
+//line parser.y:1515
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1280
+//line parser.y:1519
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 214:
+	case 254:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1286
+//line parser.y:1525
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 215:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1290
+//line parser.y:1529
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token}
 		}
-	case 216:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1296
+//line parser.y:1535
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 217:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1300
+//line parser.y:1539
 		{
 			yyVAL.queryexpr = WithClause{InlineTables: yyDollar[2].queryexprs}
 		}
-	case 218:
+	case 258:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1306
+//line parser.y:1545
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 219:
+	case 259:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1310
+//line parser.y:1549
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 220:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1316
+//line parser.y:1555
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 221:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1320
+//line parser.y:1559
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 222:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1326
+//line parser.y:1565
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 223:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1330
+//line parser.y:1569
 		{
 			yyVAL.queryexpr = NewIntegerValueFromString(yyDollar[1].token.Literal)
 		}
-	case 224:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1334
+//line parser.y:1573
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 225:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1338
+//line parser.y:1577
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 226:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1342
+//line parser.y:1581
 		{
 			yyVAL.queryexpr = NewDatetimeValueFromString(yyDollar[1].token.Literal, yylex.(*Lexer).GetDatetimeFormats())
 		}
-	case 227:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1346
+//line parser.y:1585
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 228:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1352
+//line parser.y:1591
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 229:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1358
+//line parser.y:1597
 		{
 			yyVAL.queryexpr = NewNullValue()
 		}
-	case 230:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1364
+//line parser.y:1603
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 231:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1368
+//line parser.y:1607
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 232:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1372
+//line parser.y:1611
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 233:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1376
+//line parser.y:1615
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 234:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1380
+//line parser.y:1619
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 235:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1386
+//line parser.y:1625
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 236:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1390
+//line parser.y:1629
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 237:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1394
+//line parser.y:1633
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 238:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1400
+//line parser.y:1639
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 239:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1404
+//line parser.y:1643
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 240:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1408
+//line parser.y:1647
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 241:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1412
+//line parser.y:1651
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 242:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1416
+//line parser.y:1655
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 243:
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1420
+//line parser.y:1659
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 244:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1424
+//line parser.y:1663
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 245:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1428
+//line parser.y:1667
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 246:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1432
+//line parser.y:1671
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 247:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1436
+//line parser.y:1675
 		{
-			yyVAL.queryexpr = yyDollar[1].variable
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 248:
+	case 288:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:1679
+		{
+			yyVAL.queryexpr = yyDollar[1].variable
+		}
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1440
+//line parser.y:1683
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 249:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1444
+//line parser.y:1687
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 250:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1448
+//line parser.y:1691
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 251:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1452
+//line parser.y:1695
 		{
 			yyVAL.queryexpr = yyDollar[1].flag
 		}
-	case 252:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1456
+//line parser.y:1699
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 253:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1460
+//line parser.y:1703
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 254:
+	case 295:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1707
+		{
+			yyVAL.queryexpr = ArrayConstructor{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: []QueryExpression{}}
+		}
+	case 296:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1711
+		{
+			yyVAL.queryexpr = ArrayConstructor{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: yyDollar[3].queryexprs}
+		}
+	case 297:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:1715
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewStringValue(yyDollar[2].token.Literal)}
+		}
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1719
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewStringValue(yyDollar[2].token.Literal), Unit: yyDollar[3].identifier}
+		}
+	case 299:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1723
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewIntegerValueFromString(yyDollar[2].token.Literal), Unit: yyDollar[3].identifier}
+		}
+	case 300:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1727
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewFloatValueFromString(yyDollar[2].token.Literal), Unit: yyDollar[3].identifier}
+		}
+	case 301:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:1731
+		{
+			yyVAL.queryexpr = AtTimeZone{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr, TimeZone: yyDollar[5].queryexpr}
+		}
+	case 302:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1735
+		{
+			yyVAL.queryexpr = ArraySubscript{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Array: yyDollar[1].queryexpr, Index: yyDollar[3].queryexpr}
+		}
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1464
+//line parser.y:1739
 		{
 			name := ""
 			if yyDollar[1].token.Literal[0] == ':' {
@@ -3732,117 +4855,111 @@ yydefault:
 			}
 			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal, Name: name}
 		}
-	case 255:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1474
+//line parser.y:1749
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 256:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1480
+//line parser.y:1755
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 257:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1484
+//line parser.y:1759
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 258:
+	case 307:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1488
+//line parser.y:1763
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 259:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1494
+//line parser.y:1769
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 260:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1498
+//line parser.y:1773
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 261:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1504
+//line parser.y:1779
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 262:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1508
+//line parser.y:1783
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 263:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1514
+//line parser.y:1789
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 264:
+	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1518
+//line parser.y:1793
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, NullsPosition: yyDollar[4].token}
 		}
-	case 265:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1524
-		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
-		}
-	case 266:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1528
+//line parser.y:1799
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 267:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1534
+//line parser.y:1805
 		{
 			yyVAL.token = Token{}
 		}
-	case 268:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1538
+//line parser.y:1809
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 269:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1542
+//line parser.y:1813
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 270:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1548
+//line parser.y:1819
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 271:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1552
+//line parser.y:1823
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 272:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1558
+//line parser.y:1829
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 273:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1564
+//line parser.y:1835
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3863,1442 +4980,2108 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 274:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1587
+//line parser.y:1858
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 275:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1591
+//line parser.y:1862
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 276:
+	case 324:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1595
+//line parser.y:1866
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 277:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1601
+//line parser.y:1872
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 278:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1605
+//line parser.y:1876
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 279:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1609
+//line parser.y:1880
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 280:
+	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1613
+//line parser.y:1884
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 281:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1617
+//line parser.y:1888
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 282:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1621
+//line parser.y:1892
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 283:
+	case 331:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1625
+//line parser.y:1896
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 284:
+	case 332:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1629
+//line parser.y:1900
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 285:
+	case 333:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1633
+//line parser.y:1904
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 286:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1637
+//line parser.y:1908
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 287:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1641
+//line parser.y:1912
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 288:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1645
+//line parser.y:1916
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 289:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1649
+//line parser.y:1920
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 290:
+	case 338:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1653
+//line parser.y:1924
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 291:
+	case 339:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:1928
+		{
+			yyVAL.queryexpr = RegExp{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
+		}
+	case 340:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:1932
+		{
+			yyVAL.queryexpr = RegExp{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
+		}
+	case 341:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1657
+//line parser.y:1936
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 292:
+	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1661
+//line parser.y:1940
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 293:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1665
+//line parser.y:1944
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 294:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1669
+//line parser.y:1948
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 295:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1673
+//line parser.y:1952
 		{
 			yyVAL.queryexpr = Exists{Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 296:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1679
+//line parser.y:1958
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 297:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1683
+//line parser.y:1962
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 298:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1687
+//line parser.y:1966
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 299:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1691
+//line parser.y:1970
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 300:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1695
+//line parser.y:1974
 		{
 			yyVAL.queryexpr = Arithmetic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 301:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1699
+//line parser.y:1978
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 302:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1703
+//line parser.y:1982
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 303:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1709
+//line parser.y:1988
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 304:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1713
+//line parser.y:1992
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 305:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1717
+//line parser.y:1996
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 306:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1721
+//line parser.y:2000
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 307:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1727
+//line parser.y:2006
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 308:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1731
+//line parser.y:2010
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 309:
+	case 359:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1737
+//line parser.y:2016
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 310:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1741
+//line parser.y:2020
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 311:
+	case 361:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1745
+//line parser.y:2024
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr}, From: yyDollar[4].token}
 		}
-	case 312:
+	case 362:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1749
+//line parser.y:2028
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr, yyDollar[7].queryexpr}, From: yyDollar[4].token, For: yyDollar[6].token}
 		}
-	case 313:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1753
+//line parser.y:2032
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 314:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1757
+//line parser.y:2036
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 315:
+	case 365:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1761
+//line parser.y:2040
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 316:
+	case 366:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1765
+//line parser.y:2044
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 317:
+	case 367:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2048
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 368:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2052
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 369:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1772
+//line parser.y:2059
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 318:
+	case 370:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1776
+//line parser.y:2063
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 319:
+	case 371:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1780
+//line parser.y:2067
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 320:
+	case 372:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1784
+//line parser.y:2071
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 321:
+	case 373:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1788
+//line parser.y:2075
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 322:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1792
-		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
-		}
-	case 323:
+	case 374:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1798
+//line parser.y:2079
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: yyDollar[5].queryexpr}
 		}
-	case 324:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1802
+	case 375:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2083
 		{
-			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 325:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1808
+	case 376:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2087
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 326:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1812
+	case 377:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2091
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 327:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1816
+	case 378:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2095
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 328:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1820
+	case 379:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2099
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr}
 		}
-	case 329:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1824
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2103
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 330:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1828
+	case 381:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2109
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 331:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:1832
+	case 382:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2113
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 332:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1836
+	case 383:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2117
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr}
 		}
-	case 333:
+	case 384:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2121
+		{
+			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr, Filter: yyDollar[11].queryexpr}
+		}
+	case 385:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2127
+		{
+			yyVAL.queryexpr = FilterClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Filter: yyDollar[4].queryexpr}
+		}
+	case 386:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2133
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 387:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2137
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 388:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2141
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 389:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2145
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 390:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2149
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 391:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2153
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 392:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2157
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 393:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2161
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: yyDollar[5].queryexpr, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+		}
+	case 394:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1840
+//line parser.y:2165
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 334:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1844
+	case 395:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2169
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 335:
+	case 396:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1848
+//line parser.y:2173
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 336:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:1852
+	case 397:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2177
 		{
-			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 337:
+	case 398:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2181
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+		}
+	case 399:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2185
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, Filter: yyDollar[6].queryexpr, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+		}
+	case 400:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2189
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr, AnalyticClause: yyDollar[12].queryexpr.(AnalyticClause)}
+		}
+	case 401:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2193
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr, Filter: yyDollar[11].queryexpr, AnalyticClause: yyDollar[13].queryexpr.(AnalyticClause)}
+		}
+	case 402:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2197
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 403:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2201
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 404:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2205
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+		}
+	case 405:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2209
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[6].queryexpr.(AnalyticClause)}
+		}
+	case 406:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2213
+		{
+			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
+		}
+	case 407:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2219
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 408:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2223
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier}
+		}
+	case 409:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2229
+		{
+			yyVAL.queryexpr = yyDollar[2].queryexpr
+		}
+	case 410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2233
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier}
+		}
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1858
+//line parser.y:2239
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 338:
+	case 412:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2243
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, OrderByClause: yyDollar[2].queryexpr}
+		}
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1864
+//line parser.y:2249
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 339:
+	case 414:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1868
+//line parser.y:2253
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: OrderByClause{Items: yyDollar[4].queryexprs}, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 340:
+	case 415:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2257
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, OrderByClause: OrderByClause{Items: yyDollar[4].queryexprs}, WindowingClause: yyDollar[5].queryexpr}
+		}
+	case 416:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2261
+		{
+			yyVAL.queryexpr = AnalyticClause{WindowName: yyDollar[1].identifier, WindowingClause: yyDollar[2].queryexpr}
+		}
+	case 417:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:1874
+//line parser.y:2267
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 341:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:1878
+//line parser.y:2271
 		{
 			yyVAL.queryexpr = PartitionClause{Values: yyDollar[3].queryexprs}
 		}
-	case 342:
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2277
+		{
+			yyVAL.queryexpr = WindowingClause{FrameType: yyDollar[1].token, FrameLow: yyDollar[2].queryexpr, Exclusion: yyDollar[3].token}
+		}
+	case 420:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2281
+		{
+			yyVAL.queryexpr = WindowingClause{FrameType: yyDollar[1].token, FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr, Exclusion: yyDollar[6].token}
+		}
+	case 421:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2287
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2291
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2295
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 424:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2301
+		{
+			yyVAL.token = Token{}
+		}
+	case 425:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2305
+		{
+			yyVAL.token = yyDollar[2].token
+		}
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1884
+//line parser.y:2309
 		{
-			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[2].queryexpr}
+			yyVAL.token = yyDollar[2].token
 		}
-	case 343:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:1888
+	case 427:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2313
+		{
+			yyVAL.token = yyDollar[2].token
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2319
 		{
-			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr}
+			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 344:
+	case 429:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2323
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewStringValue(yyDollar[2].token.Literal), Unit: yyDollar[3].identifier}
+		}
+	case 430:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2327
+		{
+			yyVAL.queryexpr = Interval{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: NewIntegerValueFromString(yyDollar[2].token.Literal), Unit: yyDollar[3].identifier}
+		}
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1894
+//line parser.y:2333
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 345:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1898
+//line parser.y:2337
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 346:
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2342
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, OffsetValue: yyDollar[1].queryexpr}
+		}
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1903
+//line parser.y:2346
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 347:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1909
+//line parser.y:2352
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 348:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1914
+//line parser.y:2357
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 349:
+	case 437:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2362
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, OffsetValue: yyDollar[1].queryexpr}
+		}
+	case 438:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2366
+		{
+			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, OffsetValue: yyDollar[1].queryexpr}
+		}
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1919
+//line parser.y:2370
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 350:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1925
+//line parser.y:2376
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 351:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1929
+//line parser.y:2380
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 352:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:1935
+//line parser.y:2386
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 353:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1939
+//line parser.y:2390
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 354:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1945
+//line parser.y:2396
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 355:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1949
+//line parser.y:2400
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 356:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1955
+//line parser.y:2406
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 357:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1959
+//line parser.y:2410
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 358:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1963
+//line parser.y:2414
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 359:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1967
+//line parser.y:2418
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 360:
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2422
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 451:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2426
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:1973
+//line parser.y:2432
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: nil}
 		}
-	case 361:
+	case 453:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1977
+//line parser.y:2436
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: yyDollar[5].queryexprs}
 		}
-	case 362:
+	case 454:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:1981
+//line parser.y:2440
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 363:
+	case 455:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:1985
+//line parser.y:2444
 		{
 			yyVAL.queryexpr = TableObject{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 364:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1991
+//line parser.y:2450
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 365:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:1995
+//line parser.y:2454
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 366:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2001
+//line parser.y:2460
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 367:
+	case 459:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2005
+//line parser.y:2464
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].identifier}
 		}
-	case 368:
+	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2009
+//line parser.y:2468
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 369:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2015
+//line parser.y:2474
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 370:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2019
+//line parser.y:2478
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 371:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2023
+//line parser.y:2482
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 372:
+	case 464:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2486
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 465:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2490
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 466:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2494
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 467:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2500
+		{
+			yyVAL.queryexpr = Unnest{BaseExpr: NewBaseExpr(yyDollar[1].token), Array: yyDollar[3].queryexpr}
+		}
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2029
+//line parser.y:2506
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 373:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2033
+//line parser.y:2510
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = []QueryExpression{yyDollar[2].table}
 		}
-	case 374:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2039
+//line parser.y:2516
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].table}, yyDollar[3].queryexprs...)
 		}
-	case 375:
+	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2043
+//line parser.y:2520
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[2].table}, yyDollar[4].queryexprs...)
 		}
-	case 376:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2051
+//line parser.y:2528
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 377:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2055
+//line parser.y:2532
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 378:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2059
+//line parser.y:2536
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 379:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2063
+//line parser.y:2540
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 380:
+	case 476:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2544
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 477:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2548
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 478:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2552
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2067
+//line parser.y:2556
 		{
 			yyVAL.queryexpr = Table{Object: Dual{}}
 		}
-	case 381:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2071
+//line parser.y:2560
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 382:
+	case 481:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2564
+		{
+			yyVAL.queryexpr = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr}}
+		}
+	case 482:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:2568
+		{
+			yyVAL.queryexpr = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr}, Alias: yyDollar[4].identifier}
+		}
+	case 483:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2572
+		{
+			yyVAL.queryexpr = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr}, As: yyDollar[4].token, Alias: yyDollar[5].identifier}
+		}
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2075
+//line parser.y:2576
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 383:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2081
+//line parser.y:2582
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 384:
+	case 486:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2085
+//line parser.y:2586
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 385:
+	case 487:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2089
+//line parser.y:2590
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 386:
+	case 488:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2093
+//line parser.y:2594
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 387:
+	case 489:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2097
+//line parser.y:2598
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 388:
+	case 490:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2101
+//line parser.y:2602
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 389:
+	case 491:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2107
+//line parser.y:2608
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 390:
+	case 492:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2113
+//line parser.y:2614
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[7].queryexpr}
 		}
-	case 391:
+	case 493:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2119
+//line parser.y:2620
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 392:
+	case 494:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2125
+//line parser.y:2626
 		{
 			yyDollar[7].table.Lateral = yyDollar[6].token
 			yyDollar[7].table.BaseExpr = NewBaseExpr(yyDollar[6].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[7].table, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 393:
+	case 495:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2634
+		{
+			yyVAL.queryexpr = Pivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Aggregate: yyDollar[4].queryexpr, For: yyDollar[6].queryexpr, In: yyDollar[9].queryexprs}
+		}
+	case 496:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2638
+		{
+			yyVAL.queryexpr = Pivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Aggregate: yyDollar[4].queryexpr, For: yyDollar[6].queryexpr, Any: yyDollar[9].token}
+		}
+	case 497:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2642
+		{
+			yyVAL.queryexpr = Unpivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Value: yyDollar[4].identifier, Name: yyDollar[6].identifier, In: yyDollar[9].queryexprs}
+		}
+	case 498:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2648
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
+		}
+	case 499:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2652
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2658
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 501:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2662
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2133
+//line parser.y:2668
 		{
 			yyVAL.queryexpr = JoinCondition{On: yyDollar[2].queryexpr}
 		}
-	case 394:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2137
+//line parser.y:2672
 		{
 			yyVAL.queryexpr = JoinCondition{Using: yyDollar[3].queryexprs}
 		}
-	case 395:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2143
-		{
-			yyVAL.queryexpr = yyDollar[1].queryexpr
-		}
-	case 396:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2147
+//line parser.y:2678
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 397:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2153
+//line parser.y:2684
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 398:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2157
+//line parser.y:2688
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 399:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2161
+//line parser.y:2692
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 400:
+	case 508:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2167
+//line parser.y:2698
 		{
 			yyVAL.queryexpr = CaseExpr{Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 401:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2173
+//line parser.y:2704
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 402:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2177
+//line parser.y:2708
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 403:
+	case 511:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2183
+//line parser.y:2714
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 404:
+	case 512:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2187
+//line parser.y:2718
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 405:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2193
+//line parser.y:2724
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 406:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2197
+//line parser.y:2728
 		{
 			yyVAL.queryexpr = CaseExprElse{Result: yyDollar[2].queryexpr}
 		}
-	case 407:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2203
+//line parser.y:2734
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 408:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2207
+//line parser.y:2738
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 409:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2213
+//line parser.y:2744
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 410:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2217
+//line parser.y:2748
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 411:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2223
+//line parser.y:2754
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 412:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2227
+//line parser.y:2758
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 413:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2233
+//line parser.y:2764
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 414:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2237
+//line parser.y:2768
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 415:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2243
+//line parser.y:2774
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 416:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2247
+//line parser.y:2778
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 417:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2253
+//line parser.y:2784
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 418:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2257
+//line parser.y:2788
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 419:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2263
+//line parser.y:2794
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 420:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2267
+//line parser.y:2798
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 421:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2273
+//line parser.y:2804
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 422:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2277
+//line parser.y:2808
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 531:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2814
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs, ReturningClause: yyDollar[7].queryexpr}
+		}
+	case 532:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2818
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs, ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 533:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2822
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery), ReturningClause: yyDollar[6].queryexpr}
+		}
+	case 534:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2826
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery), ReturningClause: yyDollar[9].queryexpr}
+		}
+	case 535:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2832
+		{
+			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr, ReturningClause: yyDollar[8].queryexpr}
+		}
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2838
+		{
+			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2844
+		{
+			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
+		}
+	case 538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2848
+		{
+			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
+		}
+	case 539:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.y:2854
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs, ReturningClause: yyDollar[11].queryexpr}
+		}
+	case 540:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser.y:2858
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs, ReturningClause: yyDollar[14].queryexpr}
+		}
+	case 541:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2862
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery), ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 542:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2866
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery), ReturningClause: yyDollar[13].queryexpr}
+		}
+	case 543:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:2870
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs, ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 544:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser.y:2874
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs, ReturningClause: yyDollar[13].queryexpr}
+		}
+	case 545:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2878
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery), ReturningClause: yyDollar[9].queryexpr}
+		}
+	case 546:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line parser.y:2882
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery), ReturningClause: yyDollar[12].queryexpr}
+		}
+	case 547:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:2888
+		{
+			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr, ReturningClause: yyDollar[6].queryexpr}
+		}
+	case 548:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2892
+		{
+			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr, ReturningClause: yyDollar[7].queryexpr}
+		}
+	case 549:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2898
 		{
-			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+			yyVAL.queryexpr = nil
 		}
-	case 423:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2283
+	case 550:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2902
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
+			yyVAL.queryexpr = ReturningClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Fields: yyDollar[2].queryexprs}
 		}
-	case 424:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2287
+	case 551:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2908
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 425:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2291
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2912
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 426:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2295
+	case 553:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2916
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 427:
-		yyDollar = yyS[yypt-7 : yypt+1]
-		//line parser.y:2301
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2920
 		{
-			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 428:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2307
+	case 555:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:2926
 		{
-			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Source: yyDollar[6].queryexpr, Condition: yyDollar[8].queryexpr, WhenClauses: yyDollar[9].mergewhens}
 		}
-	case 429:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2313
+	case 556:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:2930
 		{
-			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].table, Source: yyDollar[5].queryexpr, Condition: yyDollar[7].queryexpr, WhenClauses: yyDollar[8].mergewhens}
 		}
-	case 430:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2317
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2936
 		{
-			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 431:
-		yyDollar = yyS[yypt-10 : yypt+1]
-		//line parser.y:2323
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2940
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 432:
-		yyDollar = yyS[yypt-13 : yypt+1]
-		//line parser.y:2327
+	case 559:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:2944
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 433:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2331
+	case 560:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:2950
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = nil
 		}
-	case 434:
-		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2335
+	case 561:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2954
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = yyDollar[2].queryexpr
 		}
-	case 435:
-		yyDollar = yyS[yypt-9 : yypt+1]
-		//line parser.y:2339
+	case 562:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:2960
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token, SetList: yyDollar[7].updatesets}
 		}
-	case 436:
-		yyDollar = yyS[yypt-12 : yypt+1]
-		//line parser.y:2343
+	case 563:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:2964
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[3].queryexpr, Operation: yyDollar[5].token}
 		}
-	case 437:
+	case 564:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line parser.y:2347
+//line parser.y:2968
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Negation: yyDollar[2].token, Condition: yyDollar[4].queryexpr, Operation: yyDollar[6].token, Values: yyDollar[8].queryexpr}
 		}
-	case 438:
+	case 565:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line parser.y:2351
+//line parser.y:2972
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
+			yyVAL.mergewhen = MergeWhenClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Negation: yyDollar[2].token, Condition: yyDollar[4].queryexpr, Operation: yyDollar[6].token, Fields: yyDollar[8].queryexprs, Values: yyDollar[11].queryexpr}
 		}
-	case 439:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2357
+	case 566:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:2978
 		{
-			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr}
+			yyVAL.mergewhens = []MergeWhenClause{yyDollar[1].mergewhen}
 		}
-	case 440:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		//line parser.y:2361
+	case 567:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:2982
 		{
-			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr}
+			yyVAL.mergewhens = append([]MergeWhenClause{yyDollar[1].mergewhen}, yyDollar[2].mergewhens...)
 		}
-	case 441:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2367
+//line parser.y:2988
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 442:
+	case 569:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2371
+//line parser.y:2992
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 443:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2377
+//line parser.y:2998
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 444:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2381
+//line parser.y:3002
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 445:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2387
+//line parser.y:3008
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 446:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2391
+//line parser.y:3012
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 447:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2397
+//line parser.y:3018
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 448:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2401
+//line parser.y:3022
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 449:
+	case 576:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2407
+//line parser.y:3028
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 450:
+	case 577:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2411
+//line parser.y:3032
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 451:
+	case 578:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2417
+//line parser.y:3038
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 452:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2421
+//line parser.y:3042
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 453:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2427
+//line parser.y:3048
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 454:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2431
+//line parser.y:3052
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 455:
+	case 582:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2437
+//line parser.y:3058
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 456:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2441
+//line parser.y:3062
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 457:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2447
+//line parser.y:3068
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 458:
+	case 585:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2451
+//line parser.y:3072
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 459:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2457
+//line parser.y:3078
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 460:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2461
+//line parser.y:3082
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 461:
+	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2467
+//line parser.y:3088
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 462:
+	case 589:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2471
+//line parser.y:3092
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 463:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2477
+//line parser.y:3098
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 464:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2481
+//line parser.y:3102
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 465:
+	case 592:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2487
+//line parser.y:3108
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 466:
+	case 593:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2491
+//line parser.y:3112
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 467:
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2497
+//line parser.y:3118
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 468:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2501
+//line parser.y:3122
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 469:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line parser.y:2507
+//line parser.y:3128
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 470:
+	case 597:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line parser.y:2511
+//line parser.y:3132
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 471:
+	case 598:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2517
+//line parser.y:3138
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 472:
+	case 599:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line parser.y:2521
+//line parser.y:3142
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 473:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2527
+//line parser.y:3148
+		{
+			yyVAL.identifier = yyDollar[1].identifier
+		}
+	case 601:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3152
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 474:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2531
+//line parser.y:3156
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 475:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2535
+//line parser.y:3160
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 476:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2539
+//line parser.y:3164
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 477:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2543
+//line parser.y:3168
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 478:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2547
+//line parser.y:3174
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 479:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2551
+//line parser.y:3178
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 480:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2555
+//line parser.y:3182
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 481:
+	case 609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3186
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3190
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 611:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3194
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 612:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3198
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 613:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3202
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 614:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3206
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 615:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3210
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 616:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3214
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 617:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3218
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 618:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3222
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3226
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3230
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 621:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3234
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3238
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 623:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3242
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3246
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 625:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3250
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3254
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 627:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3258
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 628:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3262
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 629:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3266
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 630:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3270
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 631:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3274
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 632:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3278
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 633:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3282
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 634:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3286
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 635:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3290
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 636:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3294
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 637:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3298
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 638:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3302
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 639:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:3306
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2561
+//line parser.y:3312
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 482:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2567
+//line parser.y:3318
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 483:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2571
+//line parser.y:3322
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 484:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2577
+//line parser.y:3328
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 485:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2583
+//line parser.y:3334
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 486:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2587
+//line parser.y:3338
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 487:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2593
+//line parser.y:3344
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 488:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line parser.y:2597
+//line parser.y:3348
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 489:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2603
+//line parser.y:3354
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 490:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2609
+//line parser.y:3360
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 491:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2615
+//line parser.y:3366
 		{
 			yyVAL.flag = Flag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 492:
+	case 651:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2621
+//line parser.y:3372
 		{
 			yyVAL.token = Token{}
 		}
-	case 493:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2625
+//line parser.y:3376
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 494:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2631
+//line parser.y:3382
 		{
 			yyVAL.token = Token{}
 		}
-	case 495:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2635
+//line parser.y:3386
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 496:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2641
+//line parser.y:3392
 		{
 			yyVAL.token = Token{}
 		}
-	case 497:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2645
+//line parser.y:3396
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 498:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2651
+//line parser.y:3402
 		{
 			yyVAL.token = Token{}
 		}
-	case 499:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2655
+//line parser.y:3406
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 500:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2661
+//line parser.y:3412
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 501:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2665
+//line parser.y:3416
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 502:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2669
+//line parser.y:3420
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 503:
+	case 662:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2675
+//line parser.y:3426
 		{
 			yyVAL.token = Token{}
 		}
-	case 504:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2679
+//line parser.y:3430
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 505:
+	case 664:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2685
+//line parser.y:3436
 		{
 			yyVAL.token = Token{}
 		}
-	case 506:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2689
+//line parser.y:3440
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 507:
+	case 666:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line parser.y:2695
+//line parser.y:3446
 		{
 			yyVAL.token = Token{}
 		}
-	case 508:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2699
+//line parser.y:3450
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 509:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2705
+//line parser.y:3456
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 510:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line parser.y:2709
+//line parser.y:3460
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
@@ -34,6 +34,10 @@ import (
     fetchpos    FetchPosition
     replaceval  ReplaceValue
     replacevals []ReplaceValue
+    procparam   ProcedureParameter
+    procparams  []ProcedureParameter
+    mergewhen   MergeWhenClause
+    mergewhens  []MergeWhenClause
     token       Token
 }
 
@@ -74,10 +78,15 @@ import (
 %type<varassigns>  optional_parameters
 %type<varassigns>  function_parameters
 %type<statement>   user_defined_function_statement
+%type<procparam>   procedure_parameter
+%type<procparams>  procedure_parameters
+%type<statement>   user_defined_procedure_statement
 %type<fetchpos>    fetch_position
 %type<queryexpr>   cursor_status
 %type<statement>   command_statement
 %type<statement>   trigger_statement
+%type<statement>   explain_statement
+%type<statement>   explainable_statement
 %type<queryexpr>   select_query
 %type<queryexpr>   select_into_query
 %type<queryexpr>   select_entity
@@ -87,7 +96,13 @@ import (
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
+%type<queryexpr>   grouping_element
+%type<queryexprs>  grouping_elements
+%type<queryexpr>   grouping_set
+%type<queryexprs>  grouping_sets
 %type<queryexpr>   having_clause
+%type<queryexpr>   qualify_clause
+%type<queryexpr>   filter_clause
 %type<queryexpr>   order_by_clause
 %type<queryexpr>   limit_clause
 %type<token>       limit_restriction
@@ -114,6 +129,7 @@ import (
 %type<token>       order_direction
 %type<token>       order_null_position
 %type<queryexpr>   subquery
+%type<queryexpr>   unnest
 %type<queryexpr>   string_operation
 %type<queryexpr>   matrix_value
 %type<queryexpr>   comparison
@@ -127,7 +143,15 @@ import (
 %type<queryexpr>   analytic_clause
 %type<queryexpr>   analytic_clause_with_windowing
 %type<queryexpr>   partition_clause
+%type<queryexpr>   analytic_window
+%type<queryexpr>   analytic_window_with_windowing
+%type<queryexpr>   window_clause
+%type<queryexpr>   window_definition
+%type<queryexprs>  window_definitions
 %type<queryexpr>   windowing_clause
+%type<token>       window_frame_type
+%type<token>       window_exclusion
+%type<queryexpr>   window_offset_value
 %type<queryexpr>   window_position
 %type<queryexpr>   window_relative_position
 %type<queryexpr>   window_frame_low
@@ -143,6 +167,9 @@ import (
 %type<queryexprs>  joinable_tables
 %type<queryexpr>   table
 %type<queryexpr>   join
+%type<queryexpr>   pivot_table
+%type<queryexpr>   pivot_value
+%type<queryexprs>  pivot_values
 %type<queryexpr>   join_condition
 %type<queryexpr>   field_object
 %type<queryexpr>   field
@@ -162,6 +189,13 @@ import (
 %type<updatesets>  update_set_list
 %type<expression>  replace_query
 %type<expression>  delete_query
+%type<expression>  merge_query
+%type<queryexpr>   returning_clause
+%type<queryexpr>   data_modifying_query
+%type<table>       merge_target
+%type<mergewhen>   merge_when_clause
+%type<mergewhens>  merge_when_clauses
+%type<queryexpr>   merge_condition
 %type<elseif>      elseif
 %type<elseexpr>    else
 %type<elseif>      in_loop_elseif
@@ -179,6 +213,7 @@ import (
 %type<casewhen>    in_function_in_loop_case_when
 %type<caseelse>    in_function_in_loop_case_else
 %type<identifier>  identifier
+%type<identifier>  alias_identifier
 %type<variable>    variable
 %type<variables>   variables
 %type<queryexpr>   variable_substitution
@@ -200,15 +235,19 @@ import (
 %token<token> IDENTIFIER STRING INTEGER FLOAT BOOLEAN TERNARY DATETIME
 %token<token> VARIABLE FLAG ENVIRONMENT_VARIABLE RUNTIME_INFORMATION EXTERNAL_COMMAND PLACEHOLDER
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES REPLACE AS DUAL STDIN
-%token<token> RECURSIVE
+%token<token> RECURSIVE MERGE MATCHED RETURNING
 %token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
 %token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
+%token<token> ROLLUP CUBE GROUPING SETS
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL LATERAL
+%token<token> PIVOT UNPIVOT
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
-%token<token> AND OR NOT BETWEEN LIKE IS NULL
+%token<token> AND OR NOT BETWEEN LIKE REGEXP IS NULL
 %token<token> DISTINCT WITH
 %token<token> RANGE UNBOUNDED PRECEDING FOLLOWING CURRENT ROW
+%token<token> GROUPS EXCLUDE WINDOW INTERVAL QUALIFY
+%token<token> ARRAY UNNEST
 %token<token> CASE IF ELSEIF WHILE WHEN THEN ELSE DO END
 %token<token> DECLARE CURSOR FOR FETCH OPEN CLOSE DISPOSE PREPARE
 %token<token> NEXT PRIOR ABSOLUTE RELATIVE
@@ -216,11 +255,12 @@ import (
 %token<token> COMMIT ROLLBACK
 %token<token> CONTINUE BREAK EXIT
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
-%token<token> FUNCTION AGGREGATE BEGIN RETURN
-%token<token> IGNORE WITHIN
-%token<token> VAR SHOW
+%token<token> FUNCTION AGGREGATE BEGIN RETURN TRY CATCH PROCEDURE CALL OUT
+%token<token> IGNORE WITHIN FILTER
+%token<token> VAR SHOW EXPLAIN ANALYZE
 %token<token> TIES NULLS ROWS ONLY
-%token<token> CSV JSON FIXED LTSV
+%token<token> AT TIME ZONE
+%token<token> CSV JSON FIXED LTSV XLSX SQLITE
 %token<token> JSON_ROW JSON_TABLE
 %token<token> SUBSTRING COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
@@ -231,15 +271,17 @@ import (
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
-%left CROSS FULL NATURAL JOIN
+%left CROSS FULL NATURAL JOIN PIVOT UNPIVOT
 %left OR
 %left AND
 %right NOT
-%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE
+%nonassoc '=' COMPARISON_OP IS BETWEEN IN LIKE REGEXP
 %left STRING_OP
 %left '+' '-'
 %left '*' '/' '%'
+%left AT ZONE
 %right UMINUS UPLUS '!'
+%left '['
 
 %%
 
@@ -315,6 +357,10 @@ common_statement
     {
         $$ = $1
     }
+    | merge_query
+    {
+        $$ = $1
+    }
     | table_operation_statement
     {
         $$ = $1
@@ -343,6 +389,10 @@ common_statement
     {
         $$ = $1
     }
+    | user_defined_procedure_statement
+    {
+        $$ = $1
+    }
     | transaction_statement
     {
         $$ = $1
@@ -355,6 +405,10 @@ common_statement
     {
         $$ = $1
     }
+    | explain_statement
+    {
+        $$ = $1
+    }
     | substantial_value
     {
         $$ = $1
@@ -457,6 +511,10 @@ flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY program END TRY BEGIN CATCH program END CATCH
+    {
+        $$ = TryCatch{Try: $3, Catch: $8}
+    }
 
 loop_flow_control_statement
     : IF substantial_value THEN loop_program in_loop_else END IF
@@ -483,6 +541,10 @@ loop_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY loop_program END TRY BEGIN CATCH loop_program END CATCH
+    {
+        $$ = TryCatch{Try: $3, Catch: $8}
+    }
 
 function_statement
     : common_statement
@@ -557,6 +619,10 @@ function_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY function_program END TRY BEGIN CATCH function_program END CATCH
+    {
+        $$ = TryCatch{Try: $3, Catch: $8}
+    }
 
 function_loop_flow_control_statement
     : IF substantial_value THEN function_loop_program in_function_in_loop_else END IF
@@ -583,6 +649,10 @@ function_loop_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY function_loop_program END TRY BEGIN CATCH function_loop_program END CATCH
+    {
+        $$ = TryCatch{Try: $3, Catch: $8}
+    }
 
 variable_statement
     : VAR variable_assignments
@@ -727,6 +797,15 @@ cursor_statement
     {
         $$ = CursorDeclaration{Cursor:$2, Statement: $5}
     }
+    | DECLARE identifier CURSOR FOR data_modifying_query
+    {
+        $$ = CursorDeclaration{Cursor:$2, Query: SelectQuery{
+            SelectEntity: SelectEntity{
+                SelectClause: SelectClause{BaseExpr: $5.GetBaseExpr(), Fields: []QueryExpression{Field{Object: AllColumns{BaseExpr: $5.GetBaseExpr()}}}},
+                FromClause:   FromClause{Tables: []QueryExpression{Table{Object: DataModifyingSubquery{BaseExpr: $5.GetBaseExpr(), Query: $5}}}},
+            },
+        }}
+    }
     | OPEN identifier
     {
         $$ = OpenCursor{Cursor: $2}
@@ -872,6 +951,44 @@ user_defined_function_statement
         $$ = DisposeFunction{Name: $3}
     }
 
+procedure_parameter
+    : variable
+    {
+        $$ = ProcedureParameter{BaseExpr: $1.BaseExpr, Variable: $1}
+    }
+    | OUT variable
+    {
+        $$ = ProcedureParameter{BaseExpr: NewBaseExpr($1), Variable: $2, Out: $1}
+    }
+
+procedure_parameters
+    : procedure_parameter
+    {
+        $$ = []ProcedureParameter{$1}
+    }
+    | procedure_parameter ',' procedure_parameters
+    {
+        $$ = append([]ProcedureParameter{$1}, $3...)
+    }
+
+user_defined_procedure_statement
+    : DECLARE identifier PROCEDURE '(' ')' AS BEGIN function_program END
+    {
+        $$ = ProcedureDeclaration{Name: $2, Statements: $8}
+    }
+    | DECLARE identifier PROCEDURE '(' procedure_parameters ')' AS BEGIN function_program END
+    {
+        $$ = ProcedureDeclaration{Name: $2, Parameters: $5, Statements: $9}
+    }
+    | DISPOSE PROCEDURE identifier
+    {
+        $$ = DisposeProcedure{Name: $3}
+    }
+    | CALL identifier '(' arguments ')'
+    {
+        $$ = CallProcedure{BaseExpr: NewBaseExpr($1), Name: $2, Args: $4}
+    }
+
 fetch_position
     :
     {
@@ -1028,6 +1145,34 @@ trigger_statement
         $$ = Trigger{BaseExpr: NewBaseExpr($1), Event: $2, Message: $4, Code: value.NewIntegerFromString($3.Literal)}
     }
 
+explain_statement
+    : EXPLAIN explainable_statement
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Statement: $2}
+    }
+    | EXPLAIN ANALYZE explainable_statement
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: $2, Statement: $3}
+    }
+
+explainable_statement
+    : select_query
+    {
+        $$ = $1
+    }
+    | insert_query
+    {
+        $$ = $1
+    }
+    | update_query
+    {
+        $$ = $1
+    }
+    | delete_query
+    {
+        $$ = $1
+    }
+
 select_query
     : with_clause select_entity order_by_clause limit_clause
     {
@@ -1085,7 +1230,7 @@ select_into_query
     }
 
 select_entity
-    : select_clause from_clause where_clause group_by_clause having_clause
+    : select_clause from_clause where_clause group_by_clause having_clause window_clause qualify_clause
     {
         $$ = SelectEntity{
             SelectClause:  $1,
@@ -1093,6 +1238,8 @@ select_entity
             WhereClause:   $3,
             GroupByClause: $4,
             HavingClause:  $5,
+            WindowClause:  $6,
+            QualifyClause: $7,
         }
     }
     | select_set_entity UNION all select_set_entity
@@ -1170,11 +1317,67 @@ group_by_clause
     {
         $$ = nil
     }
-    | GROUP BY values
+    | GROUP BY grouping_elements
     {
         $$ = GroupByClause{Items: $3}
     }
 
+grouping_element
+    : value
+    {
+        $$ = $1
+    }
+    | ROLLUP '(' values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1, Sets: $3}
+    }
+    | CUBE '(' values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1, Sets: $3}
+    }
+    | GROUPING SETS '(' grouping_sets ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $2, Sets: $4}
+    }
+
+grouping_elements
+    : grouping_element
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_element ',' grouping_elements
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+grouping_set
+    : value
+    {
+        if p, ok := $1.(Parentheses); ok {
+            $$ = GroupingSet{BaseExpr: p.Expr.GetBaseExpr(), Values: []QueryExpression{p.Expr}}
+        } else {
+            $$ = GroupingSet{BaseExpr: $1.GetBaseExpr(), Values: []QueryExpression{$1}}
+        }
+    }
+    | '(' ')'
+    {
+        $$ = GroupingSet{BaseExpr: NewBaseExpr($1)}
+    }
+    | '(' value ',' values ')'
+    {
+        $$ = GroupingSet{BaseExpr: NewBaseExpr($1), Values: append([]QueryExpression{$2}, $4...)}
+    }
+
+grouping_sets
+    : grouping_set
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_set ',' grouping_sets
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 having_clause
     :
     {
@@ -1185,6 +1388,42 @@ having_clause
         $$ = HavingClause{Filter: $2}
     }
 
+window_clause
+    :
+    {
+        $$ = nil
+    }
+    | WINDOW window_definitions
+    {
+        $$ = WindowClause{BaseExpr: NewBaseExpr($1), Windows: $2}
+    }
+
+qualify_clause
+    :
+    {
+        $$ = nil
+    }
+    | QUALIFY value
+    {
+        $$ = QualifyClause{BaseExpr: NewBaseExpr($1), Filter: $2}
+    }
+
+window_definition
+    : identifier AS '(' analytic_clause_with_windowing ')'
+    {
+        $$ = WindowDefinition{BaseExpr: $1.BaseExpr, Name: $1, Clause: $4.(AnalyticClause)}
+    }
+
+window_definitions
+    : window_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | window_definition ',' window_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 order_by_clause
     :
     {
@@ -1420,6 +1659,10 @@ substantial_value
     {
         $$ = $1
     }
+    | analytic_function
+    {
+        $$ = $1
+    }
     | case_expr
     {
         $$ = $1
@@ -1460,6 +1703,38 @@ substantial_value
     {
         $$ = Parentheses{Expr: $2}
     }
+    | ARRAY '[' ']'
+    {
+        $$ = ArrayConstructor{BaseExpr: NewBaseExpr($1), Values: []QueryExpression{}}
+    }
+    | ARRAY '[' values ']'
+    {
+        $$ = ArrayConstructor{BaseExpr: NewBaseExpr($1), Values: $3}
+    }
+    | INTERVAL STRING
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewStringValue($2.Literal)}
+    }
+    | INTERVAL STRING identifier
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewStringValue($2.Literal), Unit: $3}
+    }
+    | INTERVAL INTEGER identifier
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewIntegerValueFromString($2.Literal), Unit: $3}
+    }
+    | INTERVAL FLOAT identifier
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewFloatValueFromString($2.Literal), Unit: $3}
+    }
+    | value AT TIME ZONE value
+    {
+        $$ = AtTimeZone{BaseExpr: $1.GetBaseExpr(), Value: $1, TimeZone: $5}
+    }
+    | value '[' value ']'
+    {
+        $$ = ArraySubscript{BaseExpr: $1.GetBaseExpr(), Array: $1, Index: $3}
+    }
     | PLACEHOLDER
     {
         name := ""
@@ -1524,10 +1799,6 @@ order_value
     {
         $$ = $1
     }
-    | analytic_function
-    {
-        $$ = $1
-    }
 
 order_direction
     :
@@ -1653,6 +1924,14 @@ comparison
     {
         $$ = Like{LHS: $1, Pattern: $4, Negation: $2}
     }
+    | value REGEXP value
+    {
+        $$ = RegExp{LHS: $1, Pattern: $3}
+    }
+    | value NOT REGEXP value
+    {
+        $$ = RegExp{LHS: $1, Pattern: $4, Negation: $2}
+    }
     | value comparison_operator ANY row_value
     {
         $$ = Any{LHS: $1, Operator: $2, Values: $4}
@@ -1765,6 +2044,14 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | CALL '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | GROUPING '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
@@ -1788,6 +2075,30 @@ aggregate_function
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}}
     }
+    | identifier '(' arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: $5}
+    }
+    | identifier '(' distinct arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | VAR '(' distinct arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | COUNT '(' distinct arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | COUNT '(' distinct wildcard ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6}
+    }
     | list_function
     {
         $$ = $1
@@ -1802,55 +2113,125 @@ list_function
     {
         $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9}
     }
+    | LIST_FUNCTION '(' distinct arguments ')' filter_clause
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' filter_clause
+    {
+        $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9, Filter: $11}
+    }
+
+filter_clause
+    : FILTER '(' WHERE value ')'
+    {
+        $$ = FilterClause{BaseExpr: NewBaseExpr($1), Filter: $4}
+    }
 
 analytic_function
-    : identifier '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    : identifier '(' arguments ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, AnalyticClause: $6.(AnalyticClause)}
     }
-    | identifier '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | identifier '(' distinct arguments ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $7.(AnalyticClause)}
     }
-    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $7.(AnalyticClause)}
     }
-    | VAR '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | VAR '(' distinct arguments ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $7.(AnalyticClause)}
     }
-    | COUNT '(' distinct arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct arguments ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $7.(AnalyticClause)}
     }
-    | COUNT '(' distinct wildcard ')' OVER '(' analytic_clause_with_windowing ')'
+    | COUNT '(' distinct wildcard ')' OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, AnalyticClause: $7.(AnalyticClause)}
     }
-    | LIST_FUNCTION '(' distinct arguments ')' OVER '(' analytic_clause ')'
+    | LIST_FUNCTION '(' distinct arguments ')' OVER analytic_window
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $8.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, AnalyticClause: $7.(AnalyticClause)}
     }
-    | ANALYTIC_FUNCTION '(' arguments ')' OVER '(' analytic_clause ')'
+    | identifier '(' arguments ')' filter_clause OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: $5, AnalyticClause: $7.(AnalyticClause)}
     }
-    | FUNCTION_NTH '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
+    | identifier '(' distinct arguments ')' filter_clause OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
     }
-    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause_with_windowing ')'
+    | AGGREGATE_FUNCTION '(' distinct arguments ')' filter_clause OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreType: $6, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' OVER '(' analytic_clause ')'
+    | VAR '(' distinct arguments ')' filter_clause OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $7.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
     }
-    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER '(' analytic_clause ')'
+    | COUNT '(' distinct arguments ')' filter_clause OVER analytic_window_with_windowing
     {
-        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreType: $6, AnalyticClause: $9.(AnalyticClause)}
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
+    }
+    | COUNT '(' distinct wildcard ')' filter_clause OVER analytic_window_with_windowing
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' filter_clause OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, Filter: $6, AnalyticClause: $8.(AnalyticClause)}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9, AnalyticClause: $12.(AnalyticClause)}
+    }
+    | LIST_FUNCTION '(' distinct arguments ')' WITHIN GROUP '(' order_by_clause ')' filter_clause OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9, Filter: $11, AnalyticClause: $13.(AnalyticClause)}
+    }
+    | ANALYTIC_FUNCTION '(' arguments ')' OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $6.(AnalyticClause)}
+    }
+    | FUNCTION_NTH '(' arguments ')' OVER analytic_window_with_windowing
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $6.(AnalyticClause)}
+    }
+    | FUNCTION_NTH '(' arguments ')' IGNORE NULLS OVER analytic_window_with_windowing
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreType: $6, AnalyticClause: $8.(AnalyticClause)}
+    }
+    | FUNCTION_WITH_INS '(' arguments ')' OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, AnalyticClause: $6.(AnalyticClause)}
+    }
+    | FUNCTION_WITH_INS '(' arguments ')' IGNORE NULLS OVER analytic_window
+    {
+        $$ = AnalyticFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3, IgnoreType: $6, AnalyticClause: $8.(AnalyticClause)}
+    }
+
+analytic_window
+    : '(' analytic_clause ')'
+    {
+        $$ = $2
+    }
+    | identifier
+    {
+        $$ = AnalyticClause{WindowName: $1}
+    }
+
+analytic_window_with_windowing
+    : '(' analytic_clause_with_windowing ')'
+    {
+        $$ = $2
+    }
+    | identifier
+    {
+        $$ = AnalyticClause{WindowName: $1}
     }
 
 analytic_clause
@@ -1858,6 +2239,10 @@ analytic_clause
     {
         $$ = AnalyticClause{PartitionClause: $1, OrderByClause: $2}
     }
+    | identifier order_by_clause
+    {
+        $$ = AnalyticClause{WindowName: $1, OrderByClause: $2}
+    }
 
 analytic_clause_with_windowing
     : analytic_clause
@@ -1868,6 +2253,14 @@ analytic_clause_with_windowing
     {
         $$ = AnalyticClause{PartitionClause: $1, OrderByClause: OrderByClause{Items: $4}, WindowingClause: $5}
     }
+    | identifier ORDER BY order_items windowing_clause
+    {
+        $$ = AnalyticClause{WindowName: $1, OrderByClause: OrderByClause{Items: $4}, WindowingClause: $5}
+    }
+    | identifier windowing_clause
+    {
+        $$ = AnalyticClause{WindowName: $1, WindowingClause: $2}
+    }
 
 partition_clause
     :
@@ -1880,13 +2273,59 @@ partition_clause
     }
 
 windowing_clause
-    : ROWS window_position
+    : window_frame_type window_position window_exclusion
+    {
+        $$ = WindowingClause{FrameType: $1, FrameLow: $2, Exclusion: $3}
+    }
+    | window_frame_type BETWEEN window_frame_low AND window_frame_high window_exclusion
+    {
+        $$ = WindowingClause{FrameType: $1, FrameLow: $3, FrameHigh: $5, Exclusion: $6}
+    }
+
+window_frame_type
+    : ROWS
+    {
+        $$ = $1
+    }
+    | RANGE
+    {
+        $$ = $1
+    }
+    | GROUPS
+    {
+        $$ = $1
+    }
+
+window_exclusion
+    :
+    {
+        $$ = Token{}
+    }
+    | EXCLUDE CURRENT ROW
+    {
+        $$ = $2
+    }
+    | EXCLUDE GROUP
     {
-        $$ = WindowingClause{FrameLow: $2}
+        $$ = $2
     }
-    | ROWS BETWEEN window_frame_low AND window_frame_high
+    | EXCLUDE TIES
     {
-        $$ = WindowingClause{FrameLow: $3, FrameHigh: $5}
+        $$ = $2
+    }
+
+window_offset_value
+    : FLOAT
+    {
+        $$ = NewFloatValueFromString($1.Literal)
+    }
+    | INTERVAL STRING identifier
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewStringValue($2.Literal), Unit: $3}
+    }
+    | INTERVAL INTEGER identifier
+    {
+        $$ = Interval{BaseExpr: NewBaseExpr($1), Value: NewIntegerValueFromString($2.Literal), Unit: $3}
     }
 
 window_position
@@ -1899,6 +2338,10 @@ window_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2, Offset: i}
     }
+    | window_offset_value PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2, OffsetValue: $1}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1}
@@ -1915,6 +2358,14 @@ window_relative_position
         i, _ := strconv.Atoi($1.Literal)
         $$ = WindowFramePosition{Direction: $2, Offset: i}
     }
+    | window_offset_value PRECEDING
+    {
+        $$ = WindowFramePosition{Direction: $2, OffsetValue: $1}
+    }
+    | window_offset_value FOLLOWING
+    {
+        $$ = WindowFramePosition{Direction: $2, OffsetValue: $1}
+    }
     | CURRENT ROW
     {
         $$ = WindowFramePosition{Direction: $1}
@@ -1967,6 +2418,14 @@ table_object_type
     {
         $$ = $1
     }
+    | XLSX
+    {
+        $$ = $1
+    }
+    | SQLITE
+    {
+        $$ = $1
+    }
 
 table_object
     : table_object_type '(' table_identifier ')'
@@ -2015,7 +2474,7 @@ laterable_query_table
     {
         $$ = Table{Object: $1}
     }
-    | subquery identifier
+    | subquery alias_identifier
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -2023,6 +2482,24 @@ laterable_query_table
     {
         $$ = Table{Object: $1, As: $2, Alias: $3}
     }
+    | unnest
+    {
+        $$ = Table{Object: $1}
+    }
+    | unnest alias_identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | unnest AS identifier
+    {
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
+
+unnest
+    : UNNEST '(' value ')'
+    {
+        $$ = Unnest{BaseExpr: NewBaseExpr($1), Array: $3}
+    }
 
 joinable_tables
     : table
@@ -2051,7 +2528,7 @@ table
     {
         $$ = Table{Object: $1}
     }
-    | virtual_table_object identifier
+    | virtual_table_object alias_identifier
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -2063,6 +2540,18 @@ table
     {
         $$ = Table{Object: $1}
     }
+    | pivot_table
+    {
+        $$ = Table{Object: $1}
+    }
+    | pivot_table alias_identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | pivot_table AS identifier
+    {
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
     | DUAL
     {
         $$ = Table{Object: Dual{}}
@@ -2071,6 +2560,18 @@ table
     {
         $$ = $1
     }
+    | '(' data_modifying_query ')'
+    {
+        $$ = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr($1), Query: $2}}
+    }
+    | '(' data_modifying_query ')' alias_identifier
+    {
+        $$ = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr($1), Query: $2}, Alias: $4}
+    }
+    | '(' data_modifying_query ')' AS identifier
+    {
+        $$ = Table{Object: DataModifyingSubquery{BaseExpr: NewBaseExpr($1), Query: $2}, As: $4, Alias: $5}
+    }
     | '(' table ')'
     {
         $$ = Parentheses{Expr: $2}
@@ -2128,6 +2629,40 @@ join
         $$ = Join{Table: $1, JoinTable: $7, JoinType: $4, Direction: $3, Natural: $2}
     }
 
+pivot_table
+    : table PIVOT '(' value FOR field_reference IN '(' pivot_values ')' ')'
+    {
+        $$ = Pivot{BaseExpr: NewBaseExpr($2), Table: $1, Aggregate: $4, For: $6, In: $9}
+    }
+    | table PIVOT '(' value FOR field_reference IN '(' ANY ')' ')'
+    {
+        $$ = Pivot{BaseExpr: NewBaseExpr($2), Table: $1, Aggregate: $4, For: $6, Any: $9}
+    }
+    | table UNPIVOT '(' identifier FOR identifier IN '(' field_references ')' ')'
+    {
+        $$ = Unpivot{BaseExpr: NewBaseExpr($2), Table: $1, Value: $4, Name: $6, In: $9}
+    }
+
+pivot_value
+    : substantial_value
+    {
+        $$ = Field{Object: $1}
+    }
+    | substantial_value AS identifier
+    {
+        $$ = Field{Object: $1, As: $2, Alias: $3}
+    }
+
+pivot_values
+    : pivot_value
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | pivot_value ',' pivot_values
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 join_condition
     : ON value
     {
@@ -2143,10 +2678,6 @@ field_object
     {
         $$ = $1
     }
-    | analytic_function
-    {
-        $$ = $1
-    }
 
 field
     : field_object
@@ -2279,27 +2810,27 @@ fields
     }
 
 insert_query
-    : with_clause INSERT INTO updatable_table_identifier VALUES row_values
+    : with_clause INSERT INTO updatable_table_identifier VALUES row_values returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6, ReturningClause: $7}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9, ReturningClause: $10}
     }
-    | with_clause INSERT INTO updatable_table_identifier select_query
+    | with_clause INSERT INTO updatable_table_identifier select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery), ReturningClause: $6}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery), ReturningClause: $9}
     }
 
 update_query
-    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause
+    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause returning_clause
     {
-        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7}
+        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7, ReturningClause: $8}
     }
 
 update_set
@@ -2319,47 +2850,137 @@ update_set_list
     }
 
 replace_query
-    : with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
+    : with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10}
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10, ReturningClause: $11}
     }
-    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
+    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13}
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13, ReturningClause: $14}
     }
-    | with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
+    | with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery)}
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery), ReturningClause: $10}
     }
-    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
+    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery)}
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery), ReturningClause: $13}
     }
-    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
+    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, ValuesList: $9}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, ValuesList: $9, ReturningClause: $10}
     }
-    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
+    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12, ReturningClause: $13}
     }
-    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
+    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery)}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery), ReturningClause: $9}
     }
-    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
+    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery)}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery), ReturningClause: $12}
     }
 
 delete_query
-    : with_clause DELETE FROM tables where_clause
+    : with_clause DELETE FROM tables where_clause returning_clause
+    {
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5, ReturningClause: $6}
+    }
+    | with_clause DELETE identified_tables FROM tables where_clause returning_clause
+    {
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6, ReturningClause: $7}
+    }
+
+returning_clause
+    :
+    {
+        $$ = nil
+    }
+    | RETURNING fields
+    {
+        $$ = ReturningClause{BaseExpr: NewBaseExpr($1), Fields: $2}
+    }
+
+data_modifying_query
+    : insert_query
+    {
+        $$ = $1.(QueryExpression)
+    }
+    | update_query
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5}
+        $$ = $1.(QueryExpression)
     }
-    | with_clause DELETE identified_tables FROM tables where_clause
+    | replace_query
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6}
+        $$ = $1.(QueryExpression)
+    }
+    | delete_query
+    {
+        $$ = $1.(QueryExpression)
+    }
+
+merge_query
+    : with_clause MERGE INTO merge_target USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: $4, Source: $6, Condition: $8, WhenClauses: $9}
+    }
+    | MERGE INTO merge_target USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($1), Table: $3, Source: $5, Condition: $7, WhenClauses: $8}
+    }
+
+merge_target
+    : updatable_table_identifier
+    {
+        $$ = Table{Object: $1}
+    }
+    | updatable_table_identifier alias_identifier
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | updatable_table_identifier AS identifier
+    {
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
+
+merge_condition
+    :
+    {
+        $$ = nil
+    }
+    | AND value
+    {
+        $$ = $2
+    }
+
+merge_when_clause
+    : WHEN MATCHED merge_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Condition: $3, Operation: $5, SetList: $7}
+    }
+    | WHEN MATCHED merge_condition THEN DELETE
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Condition: $3, Operation: $5}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT VALUES row_value
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Negation: $2, Condition: $4, Operation: $6, Values: $8}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT '(' field_references ')' VALUES row_value
+    {
+        $$ = MergeWhenClause{BaseExpr: NewBaseExpr($1), Negation: $2, Condition: $4, Operation: $6, Fields: $8, Values: $11}
+    }
+
+merge_when_clauses
+    : merge_when_clause
+    {
+        $$ = []MergeWhenClause{$1}
+    }
+    | merge_when_clause merge_when_clauses
+    {
+        $$ = append([]MergeWhenClause{$1}, $2...)
     }
 
 elseif
@@ -2523,6 +3144,32 @@ in_function_in_loop_case_else
     }
 
 identifier
+    : alias_identifier
+    {
+        $$ = $1
+    }
+    | RETURNING
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNPIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | WINDOW
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | QUALIFY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+
+alias_identifier
     : IDENTIFIER
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2555,6 +3202,110 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | XLSX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SQLITE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | AT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TIME
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ZONE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXPLAIN
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TRY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CATCH
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | OUT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CALL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PROCEDURE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MERGE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MATCHED
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ROLLUP
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CUBE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | GROUPING
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SETS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | GROUPS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXCLUDE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | FILTER
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | AGGREGATE_FUNCTION
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | LIST_FUNCTION
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ARRAY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNNEST
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INTERVAL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
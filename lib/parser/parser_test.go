@@ -358,6 +358,61 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from xlsx('sheet1', `report.xlsx`, 'A1:C10', true)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Token{Token: XLSX, Literal: "xlsx", Line: 1, Char: 16},
+								FormatElement: NewStringValue("sheet1"),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "report.xlsx", Quoted: true},
+								Args:          []QueryExpression{NewStringValue("A1:C10"), NewTernaryValueFromString("true")},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from sqlite(`app.db`, users)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: TableObject{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: SQLITE, Literal: "sqlite", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "app.db", Quoted: true},
+								Args:     []QueryExpression{FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "users"}}},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from json_table('key', `table.json`)",
 		Output: []Statement{
@@ -562,6 +617,348 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select value from unnest(array[1, 2]) as u, lateral unnest(u.value) v",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "value"}}}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Unnest{
+									BaseExpr: &BaseExpr{line: 1, char: 19},
+									Array: ArrayConstructor{
+										BaseExpr: &BaseExpr{line: 1, char: 26},
+										Values: []QueryExpression{
+											NewIntegerValueFromString("1"),
+											NewIntegerValueFromString("2"),
+										},
+									},
+								},
+								As:    Token{Token: AS, Literal: "as", Line: 1, Char: 39},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "u"},
+							},
+							Table{
+								BaseExpr: &BaseExpr{line: 1, char: 45},
+								Lateral:  Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 45},
+								Object: Unnest{
+									BaseExpr: &BaseExpr{line: 1, char: 53},
+									Array:    FieldReference{BaseExpr: &BaseExpr{line: 1, char: 60}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 60}, Literal: "u"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "value"}},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "v"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select array[], array[1, 2][-1]",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: ArrayConstructor{BaseExpr: &BaseExpr{line: 1, char: 8}, Values: []QueryExpression{}}},
+						Field{Object: ArraySubscript{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Array: ArrayConstructor{
+								BaseExpr: &BaseExpr{line: 1, char: 17},
+								Values: []QueryExpression{
+									NewIntegerValueFromString("1"),
+									NewIntegerValueFromString("2"),
+								},
+							},
+							Index: UnaryArithmetic{Operand: NewIntegerValueFromString("1"), Operator: Token{Token: '-', Literal: "-", Line: 1, Char: 29}},
+						}},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select interval 3 day, interval '1h30m', interval 1.5 hour",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: Interval{BaseExpr: &BaseExpr{line: 1, char: 8}, Value: NewIntegerValueFromString("3"), Unit: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "day"}}},
+						Field{Object: Interval{BaseExpr: &BaseExpr{line: 1, char: 24}, Value: NewStringValue("1h30m")}},
+						Field{Object: Interval{BaseExpr: &BaseExpr{line: 1, char: 42}, Value: NewFloatValueFromString("1.5"), Unit: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "hour"}}},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select dt at time zone 'Asia/Tokyo' + 1, time",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: Arithmetic{
+							LHS: AtTimeZone{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Value:    FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "dt"}},
+								TimeZone: NewStringValue("Asia/Tokyo"),
+							},
+							Operator: Token{Token: '+', Literal: "+", Line: 1, Char: 37},
+							RHS:      NewIntegerValueFromString("1"),
+						}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "time"}}},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select explain, analyze from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "explain"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "analyze"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select try, catch from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "try"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "catch"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select out, call, procedure from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "out"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "call"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "procedure"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select merge, matched from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "merge"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 15}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "matched"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select returning from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "returning"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select rollup, cube, grouping, sets from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "rollup"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "cube"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "grouping"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 32}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "sets"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select pivot, unpivot from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "pivot"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 15}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "unpivot"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select groups, exclude, window from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "groups"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "exclude"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "window"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select filter, qualify from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "filter"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "qualify"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 29}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode, median, percentile_cont from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "mode"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "median"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "percentile_cont"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select array, unnest from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "array"}}},
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 15}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "unnest"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select interval from table1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "interval"}}},
+					}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "table1"}}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 as sum, 2 as listagg from sum as array_agg",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: NewIntegerValueFromString("1"),
+								As:     Token{Token: AS, Literal: "as", Line: 1, Char: 10},
+								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "sum"},
+							},
+							Field{
+								Object: NewIntegerValueFromString("2"),
+								As:     Token{Token: AS, Literal: "as", Line: 1, Char: 20},
+								Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 23}, Literal: "listagg"},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "sum"},
+							As:     Token{Token: AS, Literal: "as", Line: 1, Char: 40},
+							Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "array_agg"},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "insert into approx_count_distinct (median) values (1)",
+		Output: []Statement{
+			InsertQuery{
+				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "approx_count_distinct"}},
+				Fields: []QueryExpression{
+					FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "median"}},
+				},
+				ValuesList: []QueryExpression{
+					RowValue{
+						BaseExpr: &BaseExpr{line: 1, char: 51},
+						Value: ValueList{
+							Values: []QueryExpression{
+								NewIntegerValueFromString("1"),
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 \r\n" +
 			" from dual \n" +
@@ -932,6 +1329,48 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select * from (delete from table1 returning column1 as c) as t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}},
+					},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: DataModifyingSubquery{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Query: DeleteQuery{
+										BaseExpr: &BaseExpr{line: 1, char: 16},
+										FromClause: FromClause{
+											Tables: []QueryExpression{
+												Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}},
+											},
+										},
+										ReturningClause: ReturningClause{
+											BaseExpr: &BaseExpr{line: 1, char: 35},
+											Fields: []QueryExpression{
+												Field{
+													Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "column1"}},
+													As:     Token{Token: AS, Literal: "as", Line: 1, Char: 53},
+													Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "c"},
+												},
+											},
+										},
+									},
+								},
+								As:    Token{Token: AS, Literal: "as", Line: 1, Char: 59},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 62}, Literal: "t"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "with ct as (select 1) select * from ct",
 		Output: []Statement{
@@ -1582,6 +2021,32 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select column1 not regexp 'p1' and column2 regexp 'p2'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Logic{
+								LHS: RegExp{
+									LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}},
+									Pattern:  NewStringValue("p1"),
+									Negation: Token{Token: NOT, Literal: "not", Line: 1, Char: 16},
+								},
+								Operator: Token{Token: AND, Literal: "and", Line: 1, Char: 32},
+								RHS: RegExp{
+									LHS:     FieldReference{BaseExpr: &BaseExpr{line: 1, char: 36}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "column2"}},
+									Pattern: NewStringValue("p2"),
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select column1 like 'pattern1' or column2 not like 'pattern2'",
 		Output: []Statement{
@@ -2290,6 +2755,77 @@ var parseTests = []struct {
 		ErrorLine: 1,
 		ErrorChar: 32,
 	},
+	{
+		Input: "select column1 from table1 group by rollup (column1, column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}}}}},
+					FromClause:   FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}}}},
+					GroupByClause: GroupByClause{
+						Items: []QueryExpression{
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 37},
+								Type:     Token{Token: ROLLUP, Literal: "rollup", Line: 1, Char: 37},
+								Sets: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column2"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 from table1 group by column1, cube (column2, column3)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}}}}},
+					FromClause:   FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}}}},
+					GroupByClause: GroupByClause{
+						Items: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 37}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "column1"}},
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 46},
+								Type:     Token{Token: CUBE, Literal: "cube", Line: 1, Char: 46},
+								Sets: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column2"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "column3"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 from table1 group by grouping sets ((column1), column2, ())",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}}}}},
+					FromClause:   FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table1"}}}},
+					GroupByClause: GroupByClause{
+						Items: []QueryExpression{
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 37},
+								Type:     Token{Token: SETS, Literal: "sets", Line: 1, Char: 46},
+								Sets: []QueryExpression{
+									GroupingSet{BaseExpr: &BaseExpr{line: 1, char: 53}, Values: []QueryExpression{FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column1"}}}},
+									GroupingSet{BaseExpr: &BaseExpr{line: 1, char: 63}, Values: []QueryExpression{FieldReference{BaseExpr: &BaseExpr{line: 1, char: 63}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "column2"}}}},
+									GroupingSet{BaseExpr: &BaseExpr{line: 1, char: 72}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select json_object()",
 		Output: []Statement{
@@ -2330,6 +2866,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select grouping(column1, column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "grouping",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 26}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select if(column1, column2, column3)",
 		Output: []Statement{
@@ -2550,7 +3108,34 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select listagg(distinct column1) within group (order by column1)",
+		Input: "select listagg(distinct column1) within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "listagg",
+								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 16},
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"}},
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select listagg(column1, ',') within group (order by column1)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
@@ -2560,13 +3145,13 @@ var parseTests = []struct {
 							Field{Object: ListFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
 								Name:     "listagg",
-								Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 16},
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+									NewStringValue(","),
 								},
 								OrderBy: OrderByClause{
 									Items: []QueryExpression{
-										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 57}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 57}, Literal: "column1"}}},
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column1"}}},
 									},
 								},
 							}},
@@ -2577,25 +3162,54 @@ var parseTests = []struct {
 		},
 	},
 	{
-		Input: "select listagg(column1, ',') within group (order by column1)",
+		Input: "select count(*) filter (where column1 > 1), f(column2) filter (where column3), listagg(column1) within group (order by column1) filter (where column2)",
 		Output: []Statement{
 			SelectQuery{
 				SelectEntity: SelectEntity{
 					SelectClause: SelectClause{
 						BaseExpr: &BaseExpr{line: 1, char: 1},
 						Fields: []QueryExpression{
-							Field{Object: ListFunction{
+							Field{Object: AggregateFunction{
 								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 17},
+									Filter: Comparison{
+										LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column1"}},
+										Operator: Token{Token: COMPARISON_OP, Literal: ">", Line: 1, Char: 39},
+										RHS:      NewIntegerValueFromString("1"),
+									},
+								},
+							}},
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 45},
+								Name:     "f",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 47}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "column2"}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 56},
+									Filter:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "column3"}},
+								},
+							}},
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 80},
 								Name:     "listagg",
 								Args: []QueryExpression{
-									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
-									NewStringValue(","),
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 88}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 88}, Literal: "column1"}},
 								},
 								OrderBy: OrderByClause{
 									Items: []QueryExpression{
-										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column1"}}},
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 120}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 120}, Literal: "column1"}}},
 									},
 								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 129},
+									Filter:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 143}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 143}, Literal: "column2"}},
+								},
 							}},
 						},
 					},
@@ -2711,6 +3325,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 47},
 										},
@@ -2743,6 +3358,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 57},
 											Unbounded: Token{Token: UNBOUNDED, Literal: "unbounded", Line: 1, Char: 47},
@@ -2776,6 +3392,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 49},
 											Offset:    1,
@@ -2809,6 +3426,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 65},
 											Unbounded: Token{Token: UNBOUNDED, Literal: "unbounded", Line: 1, Char: 55},
@@ -2846,6 +3464,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 57},
 											Offset:    1,
@@ -2883,6 +3502,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 42},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 55},
 										},
@@ -2899,6 +3519,247 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select userfunc() over (order by column2 range between interval '7' day preceding and current row exclude ties)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameType: Token{Token: RANGE, Literal: "range", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 73},
+											OffsetValue: Interval{
+												BaseExpr: &BaseExpr{line: 1, char: 56},
+												Value:    NewStringValue("7"),
+												Unit:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 69}, Literal: "day"},
+											},
+										},
+										FrameHigh: WindowFramePosition{
+											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 87},
+										},
+										Exclusion: Token{Token: TIES, Literal: "ties", Line: 1, Char: 107},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select userfunc() over (order by column2 groups between 1 preceding and 1.5 following exclude current row)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "userfunc",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 34}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameType: Token{Token: GROUPS, Literal: "groups", Line: 1, Char: 42},
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 59},
+											Offset:    1,
+										},
+										FrameHigh: WindowFramePosition{
+											Direction:   Token{Token: FOLLOWING, Literal: "following", Line: 1, Char: 77},
+											OffsetValue: NewFloatValueFromString("1.5"),
+										},
+										Exclusion: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 95},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select sum(column1) over w, count(*) over (w order by column2 range 1 preceding exclude group) from table1 window w as (partition by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "sum",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+								AnalyticClause: AnalyticClause{
+									WindowName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 26}, Literal: "w"},
+								},
+							}},
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 29},
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 35}},
+								},
+								AnalyticClause: AnalyticClause{
+									WindowName: Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "w"},
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 55}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 55}, Literal: "column2"}},
+											},
+										},
+									},
+									WindowingClause: WindowingClause{
+										FrameType: Token{Token: RANGE, Literal: "range", Line: 1, Char: 63},
+										FrameLow: WindowFramePosition{
+											Direction: Token{Token: PRECEDING, Literal: "preceding", Line: 1, Char: 71},
+											Offset:    1,
+										},
+										Exclusion: Token{Token: GROUP, Literal: "group", Line: 1, Char: 89},
+									},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 101}, Literal: "table1"}}}},
+					WindowClause: WindowClause{
+						BaseExpr: &BaseExpr{line: 1, char: 108},
+						Windows: []QueryExpression{
+							WindowDefinition{
+								BaseExpr: &BaseExpr{line: 1, char: 115},
+								Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 115}, Literal: "w"},
+								Clause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 134}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 134}, Literal: "column1"}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select sum(column1) filter (where column2) over (partition by column3) from table1 qualify row_number() over (order by column1) = 1",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "sum",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 12}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "column1"}},
+								},
+								Filter: FilterClause{
+									BaseExpr: &BaseExpr{line: 1, char: 21},
+									Filter:   FieldReference{BaseExpr: &BaseExpr{line: 1, char: 35}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 35}, Literal: "column2"}},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 63}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 63}, Literal: "column3"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "table1"}}}},
+					QualifyClause: QualifyClause{
+						BaseExpr: &BaseExpr{line: 1, char: 84},
+						Filter: Comparison{
+							LHS: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 92},
+								Name:     "row_number",
+								AnalyticClause: AnalyticClause{
+									OrderByClause: OrderByClause{
+										Items: []QueryExpression{
+											OrderItem{
+												Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 120}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 120}, Literal: "column1"}},
+											},
+										},
+									},
+								},
+							},
+							Operator: Token{Token: '=', Literal: "=", Line: 1, Char: 129},
+							RHS:      NewIntegerValueFromString("1"),
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select corr(column1, column2), percentile_disc(0.5) within group (order by column1 desc) over (partition by column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "corr",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 13}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "column1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 22}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 22}, Literal: "column2"}},
+								},
+							}},
+							Field{Object: AnalyticFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 32},
+								Name:     "percentile_disc",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.5"),
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 76}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 76}, Literal: "column1"}}, Direction: Token{Token: DESC, Literal: "desc", Line: 1, Char: 84}},
+									},
+								},
+								AnalyticClause: AnalyticClause{
+									PartitionClause: PartitionClause{
+										Values: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 109}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 109}, Literal: "column2"}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select f(column1) over (partition by column1 order by column2)",
 		Output: []Statement{
@@ -2998,6 +3859,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 65},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
 										},
@@ -3038,6 +3900,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 65},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 70},
 										},
@@ -3078,6 +3941,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 67},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 72},
 										},
@@ -3118,6 +3982,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 61},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 66},
 										},
@@ -3261,6 +4126,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 73},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 78},
 										},
@@ -3302,6 +4168,7 @@ var parseTests = []struct {
 										},
 									},
 									WindowingClause: WindowingClause{
+										FrameType: Token{Token: ROWS, Literal: "rows", Line: 1, Char: 86},
 										FrameLow: WindowFramePosition{
 											Direction: Token{Token: CURRENT, Literal: "current", Line: 1, Char: 91},
 										},
@@ -3378,7 +4245,93 @@ var parseTests = []struct {
 										},
 									},
 								},
-							}},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 pivot (sum(column1) for column2 in ('a', 'b' as b2)) as p",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Pivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Aggregate: AggregateFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 29},
+										Name:     "sum",
+										Args:     []QueryExpression{FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "column1"}}},
+									},
+									For: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 46}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "column2"}},
+									In: []QueryExpression{
+										Field{Object: NewStringValue("a")},
+										Field{Object: NewStringValue("b"), As: Token{Token: AS, Literal: "as", Line: 1, Char: 67}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "b2"}},
+									},
+								},
+								As:    Token{Token: AS, Literal: "as", Line: 1, Char: 75},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 78}, Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 pivot (count(*) for column2 in (any))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Pivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Aggregate: AggregateFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 29},
+										Name:     "count",
+										Args:     []QueryExpression{AllColumns{BaseExpr: &BaseExpr{line: 1, char: 35}}},
+									},
+									For: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 42}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 42}, Literal: "column2"}},
+									Any: Token{Token: ANY, Literal: "any", Line: 1, Char: 54},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 unpivot (val for col in (column1, column2)) u",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Unpivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "val"},
+									Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "col"},
+									In: []QueryExpression{
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 47}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "column1"}},
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 56}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 56}, Literal: "column2"}},
+									},
+								},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "u"},
+							},
 						},
 					},
 				},
@@ -4162,6 +5115,26 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "update table1 set column1 = 1 where true returning *",
+		Output: []Statement{
+			UpdateQuery{
+				Tables: []QueryExpression{
+					Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "table1"}},
+				},
+				SetList: []UpdateSet{
+					{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 19}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
+				},
+				WhereClause: WhereClause{
+					Filter: NewTernaryValueFromString("true"),
+				},
+				ReturningClause: ReturningClause{
+					BaseExpr: &BaseExpr{line: 1, char: 42},
+					Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 52}}}},
+				},
+			},
+		},
+	},
 	{
 		Input: "update csv(',', table1) set column1 = 1, column2 = 2, table1.3 = 3 where true",
 		Output: []Statement{
@@ -4534,6 +5507,108 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "merge into table1 t using table2 s on t.id = s.id when matched and s.flag then update set value = s.value when matched then delete when not matched then insert (id, value) values (s.id, s.value)",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table: Table{
+					Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"},
+					Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t"},
+				},
+				Source: Table{
+					Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table2"},
+					Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "s"},
+				},
+				Condition: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "id"}},
+					Operator: Token{Token: '=', Literal: "=", Line: 1, Char: 44},
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 46}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "id"}},
+				},
+				WhenClauses: []MergeWhenClause{
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 51},
+						Condition: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 68}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 68}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "flag"}},
+						Operation: Token{Token: UPDATE, Literal: "update", Line: 1, Char: 80},
+						SetList: []UpdateSet{
+							{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 91}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 91}, Literal: "value"}}, Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 99}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 99}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 101}, Literal: "value"}}},
+						},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 107},
+						Operation: Token{Token: DELETE, Literal: "delete", Line: 1, Char: 125},
+					},
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 132},
+						Negation:  Token{Token: NOT, Literal: "not", Line: 1, Char: 137},
+						Operation: Token{Token: INSERT, Literal: "insert", Line: 1, Char: 154},
+						Fields: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 162}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 162}, Literal: "id"}},
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 166}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 166}, Literal: "value"}},
+						},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 180},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 181}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 181}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 183}, Literal: "id"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 187}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 187}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 189}, Literal: "value"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "with ct as (select 1) merge into table1 as t using ct on true when not matched then insert values (1)",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 23},
+				WithClause: WithClause{
+					InlineTables: []QueryExpression{
+						InlineTable{
+							Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "ct"},
+							Query: SelectQuery{
+								SelectEntity: SelectEntity{
+									SelectClause: SelectClause{
+										BaseExpr: &BaseExpr{line: 1, char: 13},
+										Fields: []QueryExpression{
+											Field{Object: NewIntegerValueFromString("1")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Table: Table{
+					Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "table1"},
+					As:     Token{Token: AS, Literal: "as", Line: 1, Char: 41},
+					Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 44}, Literal: "t"},
+				},
+				Source: Table{
+					Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "ct"},
+				},
+				Condition: NewTernaryValueFromString("true"),
+				WhenClauses: []MergeWhenClause{
+					{
+						BaseExpr:  &BaseExpr{line: 1, char: 63},
+						Negation:  Token{Token: NOT, Literal: "not", Line: 1, Char: 68},
+						Operation: Token{Token: INSERT, Literal: "insert", Line: 1, Char: 85},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 99},
+							Value: ValueList{
+								Values: []QueryExpression{
+									NewIntegerValueFromString("1"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table newtable (column1, column2)",
 		Output: []Statement{
@@ -5104,6 +6179,54 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select 1 as a from dual",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Statement: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Fields: []QueryExpression{
+								Field{
+									Object: NewIntegerValueFromString("1"),
+									As:     Token{Token: AS, Literal: "as", Line: 1, Char: 18},
+									Alias:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "a"},
+								},
+							},
+						},
+						FromClause: FromClause{Tables: []QueryExpression{
+							Table{Object: Dual{}},
+						}},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze delete table1 from table1 where true",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  Token{Token: ANALYZE, Literal: "analyze", Line: 1, Char: 9},
+				Statement: DeleteQuery{
+					BaseExpr: &BaseExpr{line: 1, char: 17},
+					Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table1"}},
+					},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "table1"}},
+						},
+					},
+					WhereClause: WhereClause{
+						Filter: NewTernaryValueFromString("true"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare cur cursor for select 1",
 		Output: []Statement{
@@ -5122,6 +6245,48 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare cur cursor for insert into table1 values (1) returning column1",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							Fields: []QueryExpression{Field{Object: AllColumns{}}},
+						},
+						FromClause: FromClause{
+							Tables: []QueryExpression{
+								Table{
+									Object: DataModifyingSubquery{
+										Query: InsertQuery{
+											Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "table1"}},
+											ValuesList: []QueryExpression{
+												RowValue{
+													BaseExpr: &BaseExpr{line: 1, char: 50},
+													Value: ValueList{
+														Values: []QueryExpression{
+															NewIntegerValueFromString("1"),
+														},
+													},
+												},
+											},
+											ReturningClause: ReturningClause{
+												BaseExpr: &BaseExpr{line: 1, char: 54},
+												Fields: []QueryExpression{
+													Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 64}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 64}, Literal: "column1"}}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare cur cursor for stmt",
 		Output: []Statement{
@@ -5563,6 +6728,37 @@ var parseTests = []struct {
 			Exit{Code: value.NewIntegerFromString("1")},
 		},
 	},
+	{
+		Input: "begin try print 1; end try begin catch print 2; end catch",
+		Output: []Statement{
+			TryCatch{
+				Try: []Statement{
+					Print{Value: NewIntegerValueFromString("1")},
+				},
+				Catch: []Statement{
+					Print{Value: NewIntegerValueFromString("2")},
+				},
+			},
+		},
+	},
+	{
+		Input: "while true do begin try print 1; end try begin catch break; end catch; end while",
+		Output: []Statement{
+			While{
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					TryCatch{
+						Try: []Statement{
+							Print{Value: NewIntegerValueFromString("1")},
+						},
+						Catch: []Statement{
+							FlowControl{Token: BREAK},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "while true do print @var1; continue; end while",
 		Output: []Statement{
@@ -5729,6 +6925,24 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare func1 function () as begin begin try return 1; end try begin catch return 2; end catch; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					TryCatch{
+						Try: []Statement{
+							Return{Value: NewIntegerValueFromString("1")},
+						},
+						Catch: []Statement{
+							Return{Value: NewIntegerValueFromString("2")},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare func1 function (@arg1 default 0, @arg2 default 1) as begin end",
 		Output: []Statement{
@@ -6068,6 +7282,81 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare proc1 procedure () as begin end",
+		Output: []Statement{
+			ProcedureDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "proc1"},
+			},
+		},
+	},
+	{
+		Input: "declare proc1 procedure (@arg1, out @arg2) as begin select @arg1; @arg2 := 1; return; end",
+		Output: []Statement{
+			ProcedureDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "proc1"},
+				Parameters: []ProcedureParameter{
+					{BaseExpr: &BaseExpr{line: 1, char: 26}, Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 26}, Name: "arg1"}},
+					{BaseExpr: &BaseExpr{line: 1, char: 33}, Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 37}, Name: "arg2"}, Out: Token{Token: OUT, Literal: "out", Line: 1, Char: 33}},
+				},
+				Statements: []Statement{
+					SelectQuery{SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 53},
+							Fields: []QueryExpression{
+								Field{Object: Variable{BaseExpr: &BaseExpr{line: 1, char: 60}, Name: "arg1"}},
+							},
+						},
+					}},
+					VariableSubstitution{Variable: Variable{BaseExpr: &BaseExpr{line: 1, char: 67}, Name: "arg2"}, Value: NewIntegerValueFromString("1")},
+					Return{Value: NewNullValue()},
+				},
+			},
+		},
+	},
+	{
+		Input: "dispose procedure proc1",
+		Output: []Statement{
+			DisposeProcedure{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "proc1"},
+			},
+		},
+	},
+	{
+		Input: "call proc1()",
+		Output: []Statement{
+			CallProcedure{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "proc1"},
+			},
+		},
+	},
+	{
+		Input: "call proc1(1, @var1)",
+		Output: []Statement{
+			CallProcedure{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 6}, Literal: "proc1"},
+				Args: []QueryExpression{
+					NewIntegerValueFromString("1"),
+					Variable{BaseExpr: &BaseExpr{line: 1, char: 15}, Name: "var1"},
+				},
+			},
+		},
+	},
+	{
+		Input: "select call('cmd')",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Fields: []QueryExpression{
+						Field{Object: Function{BaseExpr: &BaseExpr{line: 1, char: 8}, Name: "call", Args: []QueryExpression{NewStringValue("cmd")}}},
+					},
+				},
+			}},
+		},
+	},
 	{
 		Input: "select @var1 := @var2 + @var3",
 		Output: []Statement{
@@ -6315,6 +7604,36 @@ var parseTests = []struct {
 			}},
 		},
 	},
+	{
+		Input: "select xlsx",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Fields: []QueryExpression{
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "xlsx"}},
+						},
+					},
+				},
+			}},
+		},
+	},
+	{
+		Input: "select sqlite",
+		Output: []Statement{
+			SelectQuery{SelectEntity: SelectEntity{
+				SelectClause: SelectClause{
+					BaseExpr: &BaseExpr{line: 1, char: 1},
+					Fields: []QueryExpression{
+						Field{
+							Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "sqlite"}},
+						},
+					},
+				},
+			}},
+		},
+	},
 	{
 		Input: "select fields",
 		Output: []Statement{
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -62,11 +63,25 @@ var aggregateFunctions = []string{
 	"STDEVP",
 	"VARP",
 	"MEDIAN",
+	"MODE",
+	"SKEWNESS",
+	"KURTOSIS",
+	"CORR",
+	"COVAR_POP",
+	"COVAR_SAMP",
+	"REGR_SLOPE",
+	"REGR_INTERCEPT",
+	"REGR_R2",
+	"APPROX_COUNT_DISTINCT",
+	"APPROX_PERCENTILE",
 }
 
 var listFunctions = []string{
 	"LISTAGG",
 	"JSON_AGG",
+	"ARRAY_AGG",
+	"PERCENTILE_CONT",
+	"PERCENTILE_DISC",
 }
 
 var analyticFunctions = []string{
@@ -93,14 +108,14 @@ func TokenLiteral(token int) string {
 	if TokenFrom <= token && token <= TokenTo {
 		return yyToknames[token-TokenFrom+3]
 	}
-	return string(token)
+	return string(rune(token))
 }
 
 func KeywordLiteral(token int) (string, error) {
 	if KeywordFrom <= token && token <= KeywordTo {
 		return yyToknames[token-TokenFrom+3], nil
 	}
-	return string(token), errTokenIsNotKeyword
+	return string(rune(token)), errTokenIsNotKeyword
 }
 
 type Scanner struct {
@@ -219,6 +234,20 @@ func (s *Scanner) Scan() (Token, error) {
 				}
 				return Token{Token: PLACEHOLDER, Literal: holderName, HolderOrdinal: s.holderOrdinal, Line: line, Char: char, SourceFile: s.sourceFile}, err
 			}
+		case ExternalCommandSign:
+			if s.isDecimal(s.peek()) {
+				s.literal.Reset()
+				s.literal.WriteRune(ch)
+				for s.isDecimal(s.peek()) {
+					s.literal.WriteRune(s.next())
+				}
+				literal = s.literal.String()
+				holderOrdinal, _ := strconv.Atoi(literal[1:])
+				if s.holderNumber < holderOrdinal {
+					s.holderNumber = holderOrdinal
+				}
+				return Token{Token: PLACEHOLDER, Literal: literal, HolderOrdinal: holderOrdinal, Line: line, Char: char, SourceFile: s.sourceFile}, err
+			}
 		}
 	}
 
@@ -550,6 +550,23 @@ var scanTests = []struct {
 			},
 		},
 	},
+	{
+		Name:        "Numbered Placeholders",
+		Input:       "$2 $1",
+		ForPrepared: true,
+		Output: []scanResult{
+			{
+				Token:         PLACEHOLDER,
+				Literal:       "$2",
+				HolderOrdinal: 2,
+			},
+			{
+				Token:         PLACEHOLDER,
+				Literal:       "$1",
+				HolderOrdinal: 1,
+			},
+		},
+	},
 	{
 		Name:        "Placeholder Disabled",
 		Input:       "?",
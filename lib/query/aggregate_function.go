@@ -8,6 +8,7 @@ import (
 	"github.com/mithrandie/csvq/lib/cmd"
 
 	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 	txjson "github.com/mithrandie/go-text/json"
 
@@ -17,16 +18,44 @@ import (
 type AggregateFunction func([]value.Primary, *cmd.Flags) value.Primary
 
 var AggregateFunctions = map[string]AggregateFunction{
-	"COUNT":  Count,
-	"MAX":    Max,
-	"MIN":    Min,
-	"SUM":    Sum,
-	"AVG":    Avg,
-	"STDEV":  StdEV,
-	"STDEVP": StdEVP,
-	"VAR":    Var,
-	"VARP":   VarP,
-	"MEDIAN": Median,
+	"COUNT":    Count,
+	"MAX":      Max,
+	"MIN":      Min,
+	"SUM":      Sum,
+	"AVG":      Avg,
+	"STDEV":    StdEV,
+	"STDEVP":   StdEVP,
+	"VAR":      Var,
+	"VARP":     VarP,
+	"MEDIAN":   Median,
+	"MODE":     Mode,
+	"SKEWNESS": Skewness,
+	"KURTOSIS": Kurtosis,
+}
+
+type BivariateAggregateFunction func([]value.Primary, []value.Primary, *cmd.Flags) value.Primary
+
+var BivariateAggregateFunctions = map[string]BivariateAggregateFunction{
+	"CORR":           Corr,
+	"COVAR_POP":      CovarPop,
+	"COVAR_SAMP":     CovarSamp,
+	"REGR_SLOPE":     RegrSlope,
+	"REGR_INTERCEPT": RegrIntercept,
+	"REGR_R2":        RegrR2,
+}
+
+// SketchAggregateFunction calculates an approximate value by using a sketch
+// instead of holding all values in memory.
+// Init validates the arguments following the first argument and returns
+// a function that creates an empty sketch.
+type SketchAggregateFunction struct {
+	ArgsLen int
+	Init    func(expr parser.QueryExpression, name string, args []value.Primary) (func() Sketch, error)
+}
+
+var SketchAggregateFunctions = map[string]SketchAggregateFunction{
+	"APPROX_COUNT_DISTINCT": {ArgsLen: 1, Init: ApproxCountDistinct},
+	"APPROX_PERCENTILE":     {ArgsLen: 2, Init: ApproxPercentile},
 }
 
 func Count(list []value.Primary, _ *cmd.Flags) value.Primary {
@@ -84,7 +113,14 @@ func Min(list []value.Primary, flags *cmd.Flags) value.Primary {
 	return result
 }
 
-func Sum(list []value.Primary, _ *cmd.Flags) value.Primary {
+func Sum(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if values, ok := decimalList(list, flags); ok {
+		if len(values) < 1 {
+			return value.NewNull()
+		}
+		return sumDecimal(values)
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -92,7 +128,15 @@ func Sum(list []value.Primary, _ *cmd.Flags) value.Primary {
 	return value.ParseFloat64(sum(values))
 }
 
-func Avg(list []value.Primary, _ *cmd.Flags) value.Primary {
+func Avg(list []value.Primary, flags *cmd.Flags) value.Primary {
+	if values, ok := decimalList(list, flags); ok {
+		if len(values) < 1 {
+			return value.NewNull()
+		}
+		avg, _ := sumDecimal(values).Quo(value.NewDecimalFromInt64(int64(len(values))))
+		return avg
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -142,6 +186,42 @@ func floatList(list []value.Primary) []float64 {
 	return values
 }
 
+// decimalList returns the list of decimals if the ExactDecimal flag is set
+// or the list contains any decimal.
+func decimalList(list []value.Primary, flags *cmd.Flags) ([]*value.Decimal, bool) {
+	if !flags.ExactDecimal && !containsDecimal(list) {
+		return nil, false
+	}
+	return toDecimalList(list), true
+}
+
+func containsDecimal(list []value.Primary) bool {
+	for _, v := range list {
+		if isDecimal(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func toDecimalList(list []value.Primary) []*value.Decimal {
+	values := make([]*value.Decimal, 0, len(list))
+	for _, v := range list {
+		if d := value.ToDecimal(v); !value.IsNull(d) {
+			values = append(values, d.(*value.Decimal))
+		}
+	}
+	return values
+}
+
+func sumDecimal(list []*value.Decimal) *value.Decimal {
+	sum := value.NewDecimalFromInt64(0)
+	for _, v := range list {
+		sum = sum.Add(v)
+	}
+	return sum
+}
+
 func sum(list []float64) float64 {
 	var sum float64
 	for _, v := range list {
@@ -215,6 +295,274 @@ func Median(list []value.Primary, flags *cmd.Flags) value.Primary {
 	return value.ParseFloat64(median)
 }
 
+func Mode(list []value.Primary, flags *cmd.Flags) value.Primary {
+	counts := make(map[string]int, 40)
+	keys := make([]string, len(list))
+
+	buf := GetComparisonKeysBuf()
+	for i, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		keys[i] = buf.String()
+		counts[keys[i]]++
+	}
+	PutComparisonkeysBuf(buf)
+
+	var result value.Primary = value.NewNull()
+	maxCount := 0
+	for i, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+		if maxCount < counts[keys[i]] {
+			maxCount = counts[keys[i]]
+			result = v
+		}
+	}
+	return result
+}
+
+func Skewness(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := floatList(list)
+	n := float64(len(values))
+	if n < 3 {
+		return value.NewNull()
+	}
+
+	m2, m3, _ := centralMoments(values)
+	if m2 == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(math.Sqrt(n*(n-1)) / (n - 2) * m3 / math.Pow(m2, 1.5))
+}
+
+func Kurtosis(list []value.Primary, _ *cmd.Flags) value.Primary {
+	values := floatList(list)
+	n := float64(len(values))
+	if n < 4 {
+		return value.NewNull()
+	}
+
+	m2, _, m4 := centralMoments(values)
+	if m2 == 0 {
+		return value.NewNull()
+	}
+	g2 := m4/(m2*m2) - 3
+	return value.ParseFloat64((n - 1) / ((n - 2) * (n - 3)) * ((n+1)*g2 + 6))
+}
+
+func centralMoments(list []float64) (float64, float64, float64) {
+	avg := average(list)
+	denom := float64(len(list))
+
+	var m2, m3, m4 float64
+	for _, v := range list {
+		d := v - avg
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+	return m2 / denom, m3 / denom, m4 / denom
+}
+
+func Corr(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	vy := variance(ys, true)
+	vx := variance(xs, true)
+	if vy == 0 || vx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(ys, xs, true) / math.Sqrt(vy*vx))
+}
+
+func CovarPop(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(ys, xs, true))
+}
+
+func CovarSamp(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 2 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(ys, xs, false))
+}
+
+func RegrSlope(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	vx := variance(xs, true)
+	if vx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(covariance(ys, xs, true) / vx)
+}
+
+func RegrIntercept(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	vx := variance(xs, true)
+	if vx == 0 {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(average(ys) - covariance(ys, xs, true)/vx*average(xs))
+}
+
+func RegrR2(ylist []value.Primary, xlist []value.Primary, _ *cmd.Flags) value.Primary {
+	ys, xs := floatPairs(ylist, xlist)
+	if len(ys) < 1 {
+		return value.NewNull()
+	}
+
+	vx := variance(xs, true)
+	if vx == 0 {
+		return value.NewNull()
+	}
+	vy := variance(ys, true)
+	if vy == 0 {
+		return value.ParseFloat64(1)
+	}
+	cov := covariance(ys, xs, true)
+	return value.ParseFloat64(cov * cov / (vx * vy))
+}
+
+func floatPairs(ylist []value.Primary, xlist []value.Primary) ([]float64, []float64) {
+	ys := make([]float64, 0, len(ylist))
+	xs := make([]float64, 0, len(xlist))
+	for i := 0; i < len(ylist) && i < len(xlist); i++ {
+		y := value.ToFloat(ylist[i])
+		if value.IsNull(y) {
+			continue
+		}
+		x := value.ToFloat(xlist[i])
+		if value.IsNull(x) {
+			continue
+		}
+		ys = append(ys, y.(*value.Float).Raw())
+		xs = append(xs, x.(*value.Float).Raw())
+	}
+	return ys, xs
+}
+
+func covariance(ys []float64, xs []float64, isP bool) float64 {
+	avgY := average(ys)
+	avgX := average(xs)
+	denom := float64(len(ys))
+	if !isP {
+		denom = denom - 1
+	}
+
+	var sum float64
+	for i := range ys {
+		sum += (ys[i] - avgY) * (xs[i] - avgX)
+	}
+
+	if denom == 0 || sum == 0 {
+		return 0
+	}
+
+	return sum / denom
+}
+
+// DistinguishPairs removes duplicate pairs of values from the two lists.
+func DistinguishPairs(ylist []value.Primary, xlist []value.Primary, flags *cmd.Flags) ([]value.Primary, []value.Primary) {
+	keys := make(map[string]bool, 40)
+	ys := make([]value.Primary, 0, len(ylist))
+	xs := make([]value.Primary, 0, len(xlist))
+
+	buf := GetComparisonKeysBuf()
+	for i := 0; i < len(ylist) && i < len(xlist); i++ {
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{ylist[i], xlist[i]}, flags)
+		key := buf.String()
+		if !keys[key] {
+			keys[key] = true
+			ys = append(ys, ylist[i])
+			xs = append(xs, xlist[i])
+		}
+	}
+	PutComparisonkeysBuf(buf)
+
+	return ys, xs
+}
+
+// PercentileCont returns the value at the fraction position of the sorted list
+// by linear interpolation.
+func PercentileCont(list []value.Primary, fraction float64, flags *cmd.Flags) value.Primary {
+	values := make([]float64, 0, len(list))
+	for _, v := range list {
+		if f, ok := floatOrDatetime(v, flags); ok {
+			values = append(values, f)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	low := math.Floor(pos)
+	high := math.Ceil(pos)
+	result := values[int(low)] + (pos-low)*(values[int(high)]-values[int(low)])
+	return value.ParseFloat64(result)
+}
+
+// PercentileDisc returns the first value of the sorted list whose cumulative
+// distribution is greater than or equal to the fraction.
+func PercentileDisc(list []value.Primary, fraction float64) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
+func ApproxCountDistinct(_ parser.QueryExpression, _ string, _ []value.Primary) (func() Sketch, error) {
+	return func() Sketch { return NewHyperLogLog() }, nil
+}
+
+func ApproxPercentile(expr parser.QueryExpression, name string, args []value.Primary) (func() Sketch, error) {
+	f := value.ToFloat(args[0])
+	if value.IsNull(f) {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a number between 0 and 1")
+	}
+	fraction := f.(*value.Float).Raw()
+	value.Discard(f)
+	if fraction < 0 || 1 < fraction {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "the second argument must be a number between 0 and 1")
+	}
+
+	return func() Sketch { return NewTDigest(fraction) }, nil
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
@@ -232,6 +580,16 @@ func ListAgg(list []value.Primary, separator string) value.Primary {
 	return value.NewString(strings.Join(strlist, separator))
 }
 
+func ArrayAgg(list []value.Primary) value.Primary {
+	if len(list) < 1 {
+		return value.NewNull()
+	}
+
+	values := make([]value.Primary, len(list))
+	copy(values, list)
+	return value.NewArray(values)
+}
+
 func JsonAgg(list []value.Primary) value.Primary {
 	if len(list) < 1 {
 		return value.NewNull()
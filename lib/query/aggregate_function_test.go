@@ -1,10 +1,12 @@
 package query
 
 import (
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
@@ -112,6 +114,15 @@ var sumTests = []aggregateTests{
 		},
 		Result: value.NewInteger(8),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimal(big.NewInt(10), 2),
+			value.NewString("0.2"),
+			value.NewNull(),
+			value.NewInteger(1),
+		},
+		Result: value.NewDecimal(big.NewInt(130), 2),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -127,6 +138,14 @@ func TestSum(t *testing.T) {
 			t.Errorf("sum list = %s: result = %s, want %s", v.List, r, v.Result)
 		}
 	}
+
+	flags := cmd.NewFlags(nil)
+	flags.SetExactDecimal(true)
+	list := []value.Primary{value.NewFloat(0.1), value.NewString("0.2")}
+	expect := value.NewDecimal(big.NewInt(3), 1)
+	if r := Sum(list, flags); !reflect.DeepEqual(r, expect) {
+		t.Errorf("sum list = %s: result = %s, want %s with exact decimal", list, r, expect)
+	}
 }
 
 var avgTests = []aggregateTests{
@@ -141,6 +160,15 @@ var avgTests = []aggregateTests{
 		},
 		Result: value.NewInteger(2),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimal(big.NewInt(100), 2),
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimal(big.NewInt(13333333333333333), 16),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -352,6 +380,228 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewString("1"),
+			value.NewInteger(2),
+			value.NewInteger(2),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("str2"),
+			value.NewString("str1"),
+			value.NewString("str1"),
+			value.NewString("str2"),
+		},
+		Result: value.NewString("str2"),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var skewnessTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewNull(),
+			value.NewInteger(3),
+			value.NewInteger(10),
+		},
+		Result: value.NewFloat(1.763632614803888),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(2),
+			value.NewInteger(2),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestSkewness(t *testing.T) {
+	for _, v := range skewnessTests {
+		r := Skewness(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("skewness list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var kurtosisTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewNull(),
+			value.NewInteger(3),
+			value.NewInteger(10),
+		},
+		Result: value.NewFloat(3.2279999999999998),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(1),
+			value.NewInteger(2),
+			value.NewInteger(3),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestKurtosis(t *testing.T) {
+	for _, v := range kurtosisTests {
+		r := Kurtosis(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("kurtosis list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var bivariateAggregateTests = []struct {
+	Name   string
+	Func   BivariateAggregateFunction
+	YList  []value.Primary
+	XList  []value.Primary
+	Result value.Primary
+}{
+	{
+		Name:   "CovarPop",
+		Func:   CovarPop,
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(3), value.NewNull(), value.NewInteger(5)},
+		XList:  []value.Primary{value.NewInteger(2), value.NewInteger(4), value.NewInteger(5), value.NewInteger(9)},
+		Result: value.NewFloat(14.0 / 3),
+	},
+	{
+		Name:   "CovarPop Empty",
+		Func:   CovarPop,
+		YList:  []value.Primary{value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "CovarSamp",
+		Func:   CovarSamp,
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(3), value.NewNull(), value.NewInteger(5)},
+		XList:  []value.Primary{value.NewInteger(2), value.NewInteger(4), value.NewInteger(5), value.NewInteger(9)},
+		Result: value.NewInteger(7),
+	},
+	{
+		Name:   "CovarSamp One Pair",
+		Func:   CovarSamp,
+		YList:  []value.Primary{value.NewInteger(1)},
+		XList:  []value.Primary{value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "Corr",
+		Func:   Corr,
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)},
+		XList:  []value.Primary{value.NewInteger(6), value.NewInteger(4), value.NewInteger(2)},
+		Result: value.NewInteger(-1),
+	},
+	{
+		Name:   "Corr Constant Values",
+		Func:   Corr,
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(2)},
+		XList:  []value.Primary{value.NewInteger(3), value.NewInteger(3)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrSlope",
+		Func:   RegrSlope,
+		YList:  []value.Primary{value.NewInteger(3), value.NewInteger(5), value.NewInteger(7), value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3), value.NewInteger(4)},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name:   "RegrIntercept",
+		Func:   RegrIntercept,
+		YList:  []value.Primary{value.NewInteger(3), value.NewInteger(5), value.NewInteger(7), value.NewNull()},
+		XList:  []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3), value.NewInteger(4)},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name:   "RegrIntercept Constant X",
+		Func:   RegrIntercept,
+		YList:  []value.Primary{value.NewInteger(3), value.NewInteger(5)},
+		XList:  []value.Primary{value.NewInteger(1), value.NewInteger(1)},
+		Result: value.NewNull(),
+	},
+	{
+		Name:   "RegrR2",
+		Func:   RegrR2,
+		YList:  []value.Primary{value.NewInteger(1), value.NewInteger(3), value.NewInteger(2)},
+		XList:  []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)},
+		Result: value.NewFloat(0.25),
+	},
+	{
+		Name:   "RegrR2 Constant Y",
+		Func:   RegrR2,
+		YList:  []value.Primary{value.NewInteger(2), value.NewInteger(2)},
+		XList:  []value.Primary{value.NewInteger(1), value.NewInteger(3)},
+		Result: value.NewInteger(1),
+	},
+}
+
+func TestBivariateAggregateFunctions(t *testing.T) {
+	for _, v := range bivariateAggregateTests {
+		r := v.Func(v.YList, v.XList, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Result)
+		}
+	}
+}
+
+func TestDistinguishPairs(t *testing.T) {
+	ylist := []value.Primary{value.NewInteger(1), value.NewInteger(1), value.NewInteger(1), value.NewNull(), value.NewNull()}
+	xlist := []value.Primary{value.NewInteger(2), value.NewString("2"), value.NewInteger(3), value.NewInteger(2), value.NewInteger(2)}
+	expectY := []value.Primary{value.NewInteger(1), value.NewInteger(1), value.NewNull()}
+	expectX := []value.Primary{value.NewInteger(2), value.NewInteger(3), value.NewInteger(2)}
+
+	ys, xs := DistinguishPairs(ylist, xlist, TestTx.Flags)
+	if !reflect.DeepEqual(ys, expectY) || !reflect.DeepEqual(xs, expectX) {
+		t.Errorf("result = %s, %s, want %s, %s", ys, xs, expectY, expectX)
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -413,3 +663,96 @@ func TestJsonAgg(t *testing.T) {
 		}
 	}
 }
+
+var arrayAggTests = []struct {
+	List   []value.Primary
+	Result value.Primary
+}{
+	{
+		List:   []value.Primary{},
+		Result: value.NewNull(),
+	},
+	{
+		List: []value.Primary{
+			value.NewString("str3"),
+			value.NewNull(),
+			value.NewString("str2"),
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewString("str3"),
+			value.NewNull(),
+			value.NewString("str2"),
+		}),
+	},
+}
+
+func TestArrayAgg(t *testing.T) {
+	for _, v := range arrayAggTests {
+		r := ArrayAgg(v.List)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("ArrayAgg list = %s, result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var percentileTests = []struct {
+	Name     string
+	List     []value.Primary
+	Fraction float64
+	Cont     value.Primary
+	Disc     value.Primary
+}{
+	{
+		Name:     "Median",
+		List:     []value.Primary{value.NewNull(), value.NewInteger(1), value.NewInteger(2), value.NewInteger(4), value.NewInteger(8)},
+		Fraction: 0.5,
+		Cont:     value.NewInteger(3),
+		Disc:     value.NewInteger(2),
+	},
+	{
+		Name:     "Descending Order",
+		List:     []value.Primary{value.NewInteger(8), value.NewInteger(4), value.NewInteger(2), value.NewInteger(1), value.NewNull()},
+		Fraction: 0.25,
+		Cont:     value.NewInteger(5),
+		Disc:     value.NewInteger(8),
+	},
+	{
+		Name:     "Zero Fraction",
+		List:     []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(4)},
+		Fraction: 0,
+		Cont:     value.NewInteger(1),
+		Disc:     value.NewInteger(1),
+	},
+	{
+		Name:     "Datetime Values",
+		List:     []value.Primary{value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 17, 0, GetTestLocation()))},
+		Fraction: 0.5,
+		Cont:     value.NewInteger(time.Date(2012, 2, 3, 9, 18, 16, 0, GetTestLocation()).Unix()),
+		Disc:     value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		Name:     "Empty",
+		List:     []value.Primary{value.NewNull()},
+		Fraction: 0.5,
+		Cont:     value.NewNull(),
+		Disc:     value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileCont(v.List, v.Fraction, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Cont) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Cont)
+		}
+	}
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileDisc(v.List, v.Fraction)
+		if !reflect.DeepEqual(r, v.Disc) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Disc)
+		}
+	}
+}
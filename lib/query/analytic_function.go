@@ -3,27 +3,37 @@ package query
 import (
 	"context"
 	"math"
+	"reflect"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 var AnalyticFunctions = map[string]AnalyticFunction{
-	"ROW_NUMBER":   RowNumber{},
-	"RANK":         Rank{},
-	"DENSE_RANK":   DenseRank{},
-	"CUME_DIST":    CumeDist{},
-	"PERCENT_RANK": PercentRank{},
-	"NTILE":        NTile{},
-	"FIRST_VALUE":  FirstValue{},
-	"LAST_VALUE":   LastValue{},
-	"NTH_VALUE":    NthValue{},
-	"LAG":          Lag{},
-	"LEAD":         Lead{},
-	"LISTAGG":      AnalyticListAgg{},
-	"JSON_AGG":     AnalyticJsonAgg{},
+	"ROW_NUMBER":      RowNumber{},
+	"RANK":            Rank{},
+	"DENSE_RANK":      DenseRank{},
+	"CUME_DIST":       CumeDist{},
+	"PERCENT_RANK":    PercentRank{},
+	"NTILE":           NTile{},
+	"FIRST_VALUE":     FirstValue{},
+	"LAST_VALUE":      LastValue{},
+	"NTH_VALUE":       NthValue{},
+	"LAG":             Lag{},
+	"LEAD":            Lead{},
+	"LOCF":            Locf{},
+	"INTERPOLATE":     Interpolate{},
+	"LISTAGG":         AnalyticListAgg{},
+	"JSON_AGG":        AnalyticJsonAgg{},
+	"ARRAY_AGG":       AnalyticArrayAgg{},
+	"PERCENTILE_CONT": AnalyticPercentileCont{},
+	"PERCENTILE_DISC": AnalyticPercentileDisc{},
 }
 
 type AnalyticFunction interface {
@@ -39,9 +49,73 @@ func (p Partition) Reverse() {
 
 type Partitions map[string]Partition
 
+type WindowDefinitions map[string]parser.AnalyticClause
+
+func NewWindowDefinitions(clause parser.WindowClause) (WindowDefinitions, error) {
+	windows := make(WindowDefinitions, len(clause.Windows))
+	for _, v := range clause.Windows {
+		def := v.(parser.WindowDefinition)
+		name := strings.ToUpper(def.Name.Literal)
+		if _, ok := windows[name]; ok {
+			return nil, NewWindowRedeclaredError(def.Name)
+		}
+
+		analyticClause, err := windows.Resolve(def.Clause)
+		if err != nil {
+			return nil, err
+		}
+		windows[name] = analyticClause
+	}
+	return windows, nil
+}
+
+// Resolve returns the analytic clause in which the referred window definition
+// is merged with the clauses specified after the window name.
+func (windows WindowDefinitions) Resolve(clause parser.AnalyticClause) (parser.AnalyticClause, error) {
+	if clause.WindowName == nil {
+		return clause, nil
+	}
+
+	name := clause.WindowName.(parser.Identifier)
+	def, ok := windows[strings.ToUpper(name.Literal)]
+	if !ok {
+		return clause, NewUndefinedWindowError(name)
+	}
+
+	if def.WindowingClause != nil && (clause.OrderByClause != nil || clause.WindowingClause != nil) {
+		return clause, NewWindowClauseOverriddenError(name, "a windowing clause")
+	}
+	if clause.OrderByClause != nil {
+		if def.OrderByClause != nil {
+			return clause, NewWindowClauseOverriddenError(name, "an ORDER BY clause")
+		}
+		def.OrderByClause = clause.OrderByClause
+	}
+	if clause.WindowingClause != nil {
+		def.WindowingClause = clause.WindowingClause
+	}
+	return def, nil
+}
+
+func isBuiltInAnalyticFunction(name string) bool {
+	if _, ok := AnalyticFunctions[name]; ok {
+		return true
+	}
+	if _, ok := AggregateFunctions[name]; ok {
+		return true
+	}
+	if _, ok := BivariateAggregateFunctions[name]; ok {
+		return true
+	}
+	_, ok := SketchAggregateFunctions[name]
+	return ok
+}
+
 func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.AnalyticFunction, partitionIndices []int) error {
 	var anfn AnalyticFunction
 	var aggfn AggregateFunction
+	var bifn BivariateAggregateFunction
+	var newSketch func() Sketch
 	var udfn *UserDefinedFunction
 	var err error
 
@@ -50,6 +124,12 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		anfn = f
 	} else if f, ok := AggregateFunctions[uname]; ok {
 		aggfn = f
+	} else if f, ok := BivariateAggregateFunctions[uname]; ok {
+		bifn = f
+	} else if f, ok := SketchAggregateFunctions[uname]; ok {
+		if newSketch, err = initSketch(ctx, scope, fn, fn.Name, fn.Args, fn.IsDistinct(), f); err != nil {
+			return err
+		}
 	} else {
 		if udfn, err = scope.GetFunction(fn, uname); err != nil || !udfn.IsAggregate {
 			return NewFunctionNotExistError(fn, fn.Name)
@@ -68,7 +148,11 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 		if _, ok := fn.Args[0].(parser.AllColumns); ok {
 			fn.Args[0] = parser.NewIntegerValue(1)
 		}
-	} else {
+	} else if bifn != nil {
+		if len(fn.Args) != 2 {
+			return NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+		}
+	} else if udfn != nil {
 		if err := udfn.CheckArgsLen(fn, fn.Name, len(fn.Args)-1); err != nil {
 			return err
 		}
@@ -150,20 +234,63 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 				}
 			} else {
 				partition := partitions[partitionMapKeys[i]]
-				frameSet := WindowFrameSet(partition, fn.AnalyticClause)
+				frameSet, e := WindowFrameSet(seqScope, partition, fn)
+				if e != nil {
+					gm.SetError(e)
+					break AnalyzeLoop
+				}
 				valueCache := make(map[int]value.Primary, len(partition))
 
+				var yfn, xfn parser.AnalyticFunction
+				var xvalueCache map[int]value.Primary
+				if bifn != nil {
+					yfn, xfn = splitBivariateArgs(fn)
+					xvalueCache = make(map[int]value.Primary, len(partition))
+				}
+
 				udfnArgsExprs := fn.Args[1:]
 				udfnArgs := make([]value.Primary, len(udfnArgsExprs))
 
 				for _, frame := range frameSet {
+					if bifn != nil {
+						ys, e := windowValues(ctx, seqScope, frame, partition, yfn, valueCache)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
+						xs, e := windowValues(ctx, seqScope, frame, partition, xfn, xvalueCache)
+						if e != nil {
+							gm.SetError(e)
+							break AnalyzeLoop
+						}
+						if fn.IsDistinct() {
+							ys, xs = DistinguishPairs(ys, xs, scope.Tx.Flags)
+						}
+
+						val := bifn(ys, xs, scope.Tx.Flags)
+						for _, idx := range frame.Records {
+							view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
+						}
+						continue
+					}
+
 					values, e := windowValues(ctx, seqScope, frame, partition, fn, valueCache)
 					if e != nil {
 						gm.SetError(e)
 						break AnalyzeLoop
 					}
 
-					if aggfn != nil {
+					if newSketch != nil {
+						sketch := newSketch()
+						for _, v := range values {
+							sketch.Add(v, scope.Tx.Flags)
+						}
+						val := sketch.Result()
+
+						for _, idx := range frame.Records {
+							view.RecordSet[idx] = append(view.RecordSet[idx], NewCell(val))
+						}
+					} else if aggfn != nil {
 						val := aggfn(values, scope.Tx.Flags)
 
 						for _, idx := range frame.Records {
@@ -219,13 +346,73 @@ func Analyze(ctx context.Context, scope *ReferenceScope, view *View, fn parser.A
 	return nil
 }
 
+// splitBivariateArgs returns the expressions to evaluate the first and the second
+// arguments of the function separately. The values are distinguished as pairs later.
+func splitBivariateArgs(fn parser.AnalyticFunction) (parser.AnalyticFunction, parser.AnalyticFunction) {
+	yfn, xfn := fn, fn
+	yfn.Distinct, xfn.Distinct = parser.Token{}, parser.Token{}
+	yfn.Args = fn.Args[0:1]
+	xfn.Args = fn.Args[1:2]
+	return yfn, xfn
+}
+
+// searchAnalyticFunctions returns the analytic functions used in the expression.
+// Subqueries are not searched because they are evaluated in their own views.
+func searchAnalyticFunctions(expr parser.QueryExpression) []parser.AnalyticFunction {
+	var list []parser.AnalyticFunction
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+			return
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+			return
+		case reflect.Struct:
+		default:
+			return
+		}
+
+		switch e := v.Interface().(type) {
+		case parser.AnalyticFunction:
+			list = append(list, e)
+			return
+		case parser.Subquery, parser.Exists:
+			return
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				walk(v.Field(i))
+			}
+		}
+	}
+
+	walk(reflect.ValueOf(expr))
+	return list
+}
+
 type WindowFrame struct {
-	Low     int
-	High    int
-	Records []int
+	Low      int
+	High     int
+	Records  []int
+	Excluded []int
+}
+
+func (frame WindowFrame) IsExcluded(pos int) bool {
+	if frame.Excluded == nil {
+		return false
+	}
+	i := sort.SearchInts(frame.Excluded, pos)
+	return i < len(frame.Excluded) && frame.Excluded[i] == pos
 }
 
-func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFrame {
+func WindowFrameSet(scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) ([]WindowFrame, error) {
 	var singleFrameSet = func(partition Partition) []WindowFrame {
 		indices := make([]int, len(partition))
 		for i, idx := range partition {
@@ -234,90 +421,357 @@ func WindowFrameSet(partition Partition, expr parser.AnalyticClause) []WindowFra
 		return []WindowFrame{{Low: 0, High: len(partition) - 1, Records: indices}}
 	}
 
-	var frameIndex = func(current int, length int, framePosition parser.WindowFramePosition) int {
-		var idx int
+	length := len(partition)
+	clause := expr.AnalyticClause
 
+	var windowClause parser.WindowingClause
+	if clause.WindowingClause == nil {
+		windowClause = parser.WindowingClause{
+			FrameLow: parser.WindowFramePosition{
+				Direction: parser.Token{Token: parser.PRECEDING},
+				Unbounded: parser.Token{Token: parser.UNBOUNDED},
+			},
+		}
+	} else {
+		windowClause = clause.WindowingClause.(parser.WindowingClause)
+	}
+
+	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
+	frameHigh := parser.WindowFramePosition{Direction: parser.Token{Token: parser.CURRENT}}
+	if windowClause.FrameHigh != nil {
+		frameHigh = windowClause.FrameHigh.(parser.WindowFramePosition)
+	}
+
+	if clause.OrderByClause == nil || (!frameLow.Unbounded.IsEmpty() && !frameHigh.Unbounded.IsEmpty()) {
+		if windowClause.Exclusion.IsEmpty() {
+			return singleFrameSet(partition), nil
+		}
+		frameLow = parser.WindowFramePosition{Direction: parser.Token{Token: parser.PRECEDING}, Unbounded: parser.Token{Token: parser.UNBOUNDED}}
+		frameHigh = parser.WindowFramePosition{Direction: parser.Token{Token: parser.FOLLOWING}, Unbounded: parser.Token{Token: parser.UNBOUNDED}}
+	}
+
+	peers := newPeerGroups(scope, partition, clause.OrderByClause != nil)
+
+	var keys *rangeKeys
+	if windowClause.Type() == parser.RANGE && (isOffsetPosition(frameLow) || isOffsetPosition(frameHigh)) {
+		if len(clause.OrderByClause.(parser.OrderByClause).Items) != 1 {
+			return nil, NewInvalidWindowFrameError(expr, "RANGE with an offset requires exactly one ORDER BY item")
+		}
+		var err error
+		if keys, err = newRangeKeys(scope, partition, expr, frameLow, frameHigh); err != nil {
+			return nil, err
+		}
+	}
+
+	var frameIndex = func(current int, framePosition parser.WindowFramePosition, isLow bool) (int, error) {
 		switch framePosition.Direction.Token {
 		case parser.CURRENT:
-			idx = current
+			if windowClause.Type() == parser.ROWS {
+				return current, nil
+			}
+			if isLow {
+				return peers.start[peers.index[current]], nil
+			}
+			return peers.end[peers.index[current]], nil
 		case parser.PRECEDING:
 			if !framePosition.Unbounded.IsEmpty() {
-				idx = 0
-			} else {
-				idx = current - framePosition.Offset
+				return 0, nil
 			}
 		case parser.FOLLOWING:
 			if !framePosition.Unbounded.IsEmpty() {
-				idx = length - 1
-			} else {
-				idx = current + framePosition.Offset
+				return length - 1, nil
+			}
+		}
+
+		switch windowClause.Type() {
+		case parser.RANGE:
+			return keys.bound(current, framePosition, isLow, peers)
+		case parser.GROUPS:
+			if framePosition.OffsetValue != nil {
+				return 0, NewInvalidWindowFrameError(expr, "the offset of GROUPS frame must be an integer")
+			}
+			return peers.bound(current, framePosition, isLow, length), nil
+		default:
+			if framePosition.OffsetValue != nil {
+				return 0, NewInvalidWindowFrameError(expr, "the offset of ROWS frame must be an integer")
+			}
+			if framePosition.Direction.Token == parser.PRECEDING {
+				return current - framePosition.Offset, nil
+			}
+			return current + framePosition.Offset, nil
+		}
+	}
+
+	frameSet := make([]WindowFrame, 0, length)
+	for current := 0; current < length; current++ {
+		low, err := frameIndex(current, frameLow, true)
+		if err != nil {
+			return nil, err
+		}
+		high, err := frameIndex(current, frameHigh, false)
+		if err != nil {
+			return nil, err
+		}
+
+		frame := WindowFrame{
+			Low:     low,
+			High:    high,
+			Records: []int{partition[current]},
+		}
+
+		switch windowClause.Exclusion.Token {
+		case parser.CURRENT:
+			frame.Excluded = []int{current}
+		case parser.GROUP, parser.TIES:
+			g := peers.index[current]
+			frame.Excluded = make([]int, 0, peers.end[g]-peers.start[g]+1)
+			for i := peers.start[g]; i <= peers.end[g]; i++ {
+				if i == current && windowClause.Exclusion.Token == parser.TIES {
+					continue
+				}
+				frame.Excluded = append(frame.Excluded, i)
 			}
 		}
 
-		return idx
+		frameSet = append(frameSet, frame)
 	}
 
-	length := len(partition)
+	return frameSet, nil
+}
 
-	if expr.OrderByClause == nil {
-		return singleFrameSet(partition)
+func isOffsetPosition(position parser.WindowFramePosition) bool {
+	return position.Direction.Token != parser.CURRENT && position.Unbounded.IsEmpty()
+}
+
+type peerGroups struct {
+	index []int
+	start []int
+	end   []int
+}
+
+func newPeerGroups(scope *ReferenceScope, partition Partition, isOrdered bool) *peerGroups {
+	peers := &peerGroups{
+		index: make([]int, len(partition)),
+		start: make([]int, 0, len(partition)),
+		end:   make([]int, 0, len(partition)),
 	}
 
-	frameSet := make([]WindowFrame, 0, length)
+	var sortValues []SortValues
+	if isOrdered && 0 < len(scope.Records) && scope.Records[0].view != nil {
+		sortValues = scope.Records[0].view.sortValuesInEachRecord
+	}
 
-	var windowClause parser.WindowingClause
-	if expr.WindowingClause == nil {
-		windowClause = parser.WindowingClause{
-			FrameLow: parser.WindowFramePosition{
-				Direction: parser.Token{Token: parser.PRECEDING},
-				Unbounded: parser.Token{Token: parser.UNBOUNDED},
-			},
+	for i, idx := range partition {
+		isPeer := 0 < i && (!isOrdered || (sortValues != nil && sortValues[idx].EquivalentTo(sortValues[partition[i-1]])))
+		if isPeer {
+			peers.end[len(peers.end)-1] = i
+		} else {
+			peers.start = append(peers.start, i)
+			peers.end = append(peers.end, i)
 		}
+		peers.index[i] = len(peers.start) - 1
+	}
+	return peers
+}
+
+func (peers *peerGroups) bound(current int, position parser.WindowFramePosition, isLow bool, length int) int {
+	g := peers.index[current]
+	if position.Direction.Token == parser.PRECEDING {
+		g = g - position.Offset
 	} else {
-		windowClause = expr.WindowingClause.(parser.WindowingClause)
+		g = g + position.Offset
+	}
+
+	switch {
+	case g < 0:
+		if isLow {
+			return 0
+		}
+		return -1
+	case len(peers.start) <= g:
+		if isLow {
+			return length
+		}
+		return length - 1
+	}
+
+	if isLow {
+		return peers.start[g]
+	}
+	return peers.end[g]
+}
+
+type rangeKeys struct {
+	isDatetime bool
+	floats     []float64
+	times      []int64
+	isNull     []bool
+
+	nonNullStart int
+	nonNullEnd   int
+	direction    int
+
+	expr parser.AnalyticFunction
+}
+
+func newRangeKeys(scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction, positions ...parser.WindowFramePosition) (*rangeKeys, error) {
+	if len(scope.Records) < 1 || scope.Records[0].view == nil || scope.Records[0].view.sortValuesInEachRecord == nil {
+		return nil, NewInvalidWindowFrameError(expr, "RANGE with an offset requires sorted records")
+	}
+	sortValues := scope.Records[0].view.sortValuesInEachRecord
+
+	keys := &rangeKeys{
+		isNull:       make([]bool, len(partition)),
+		nonNullStart: -1,
+		nonNullEnd:   -1,
+		direction:    1,
+		expr:         expr,
 	}
-	frameLow := windowClause.FrameLow.(parser.WindowFramePosition)
 
-	if windowClause.FrameHigh == nil {
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    current,
-				Records: []int{partition[current]},
-			})
+	for _, position := range positions {
+		if _, ok := position.OffsetValue.(parser.Interval); ok {
+			keys.isDatetime = true
 		}
+	}
+	if keys.isDatetime {
+		keys.times = make([]int64, len(partition))
 	} else {
-		frameHigh := windowClause.FrameHigh.(parser.WindowFramePosition)
-		if frameLow.Direction.Token == parser.PRECEDING && !frameLow.Unbounded.IsEmpty() && frameHigh.Direction.Token == parser.FOLLOWING && !frameHigh.Unbounded.IsEmpty() {
-			return singleFrameSet(partition)
+		keys.floats = make([]float64, len(partition))
+	}
+
+	for i, idx := range partition {
+		sv := sortValues[idx][0]
+		switch sv.Type {
+		case NullType:
+			keys.isNull[i] = true
+			continue
+		case IntegerType, FloatType:
+			if keys.isDatetime {
+				return nil, NewInvalidWindowFrameError(expr, "the interval offset of RANGE frame requires datetime values to be sorted")
+			}
+			keys.floats[i] = sv.Float
+		case DatetimeType:
+			if !keys.isDatetime {
+				return nil, NewInvalidWindowFrameError(expr, "the offset of RANGE frame for datetime values must be an interval")
+			}
+			keys.times[i] = sv.Datetime
+		default:
+			return nil, NewInvalidWindowFrameError(expr, "the offset of RANGE frame requires numeric or datetime values to be sorted")
 		}
 
-		for current := 0; current < length; current++ {
-			frameSet = append(frameSet, WindowFrame{
-				Low:     frameIndex(current, length, frameLow),
-				High:    frameIndex(current, length, frameHigh),
-				Records: []int{partition[current]},
-			})
+		if keys.nonNullStart < 0 {
+			keys.nonNullStart = i
 		}
+		keys.nonNullEnd = i
 	}
 
-	return frameSet
+	if 0 <= keys.nonNullStart && keys.compareKeys(keys.nonNullEnd, keys.nonNullStart) < 0 {
+		keys.direction = -1
+	}
+	return keys, nil
+}
+
+func (keys *rangeKeys) compareKeys(i int, j int) int {
+	if keys.isDatetime {
+		return compareInt64(keys.times[i], keys.times[j])
+	}
+	return compareFloat64(keys.floats[i], keys.floats[j])
+}
+
+func (keys *rangeKeys) bound(current int, position parser.WindowFramePosition, isLow bool, peers *peerGroups) (int, error) {
+	if keys.isNull[current] {
+		if isLow {
+			return peers.start[peers.index[current]], nil
+		}
+		return peers.end[peers.index[current]], nil
+	}
+
+	sign := keys.direction
+	if position.Direction.Token == parser.PRECEDING {
+		sign = -sign
+	}
+
+	var compare func(int) int
+	if keys.isDatetime {
+		target, err := shiftDatetime(keys.expr, keys.times[current], position.OffsetValue.(parser.Interval), sign)
+		if err != nil {
+			return 0, err
+		}
+		compare = func(i int) int { return keys.direction * compareInt64(keys.times[i], target) }
+	} else {
+		offset := float64(position.Offset)
+		if position.OffsetValue != nil {
+			f := value.ToFloat(position.OffsetValue.(parser.PrimitiveType).Value)
+			if value.IsNull(f) {
+				return 0, NewInvalidWindowFrameError(keys.expr, "the offset of RANGE frame must be a number")
+			}
+			offset = f.(*value.Float).Raw()
+			value.Discard(f)
+		}
+		target := keys.floats[current] + float64(sign)*offset
+		compare = func(i int) int { return keys.direction * compareFloat64(keys.floats[i], target) }
+	}
+
+	n := keys.nonNullEnd - keys.nonNullStart + 1
+	if isLow {
+		return keys.nonNullStart + sort.Search(n, func(i int) bool {
+			return 0 <= compare(keys.nonNullStart+i)
+		}), nil
+	}
+	return keys.nonNullStart + sort.Search(n, func(i int) bool {
+		return 0 < compare(keys.nonNullStart+i)
+	}) - 1, nil
+}
+
+func shiftDatetime(expr parser.AnalyticFunction, t int64, interval parser.Interval, sign int) (int64, error) {
+	amount := value.ToFloat(interval.Value.(parser.PrimitiveType).Value)
+	if value.IsNull(amount) {
+		return 0, NewInvalidWindowFrameError(expr, "the amount of interval must be a number")
+	}
+	f := amount.(*value.Float).Raw() * float64(sign)
+	value.Discard(amount)
+
+	iv, err := value.NewIntervalFromUnit(f, interval.Unit.Literal)
+	if err != nil {
+		return 0, NewInvalidWindowFrameError(expr, err.Error())
+	}
+	return iv.AddTo(time.Unix(0, t).In(cmd.GetLocation())).UnixNano(), nil
+}
+
+func compareFloat64(f1 float64, f2 float64) int {
+	if f1 < f2 {
+		return -1
+	} else if f1 > f2 {
+		return 1
+	}
+	return 0
 }
 
 func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame, partition Partition, expr parser.AnalyticFunction, valueCache map[int]value.Primary) ([]value.Primary, error) {
-	values := make([]value.Primary, 0, frame.High-frame.Low+1)
+	values := make([]value.Primary, 0, frameCapacity(frame))
 
 	anScope := scope.CreateScopeForAnalytics()
 	for i := frame.Low; i <= frame.High; i++ {
-		if i < 0 || len(partition) <= i {
+		if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 			continue
 		}
 
 		recordIdx := partition[i]
 		if v, ok := valueCache[recordIdx]; ok {
-			values = append(values, v)
+			if v != nil {
+				values = append(values, v)
+			}
 		} else {
 			anScope.Records[0].recordIndex = recordIdx
+			passed, e := passesAnalyticFilter(ctx, anScope, expr)
+			if e != nil {
+				return nil, e
+			}
+			if !passed {
+				valueCache[recordIdx] = nil
+				continue
+			}
+
 			p, e := Evaluate(ctx, anScope, expr.Args[0])
 			if e != nil {
 				return nil, e
@@ -333,6 +787,83 @@ func windowValues(ctx context.Context, scope *ReferenceScope, frame WindowFrame,
 	return values, nil
 }
 
+func passesAnalyticFilter(ctx context.Context, anScope *ReferenceScope, expr parser.AnalyticFunction) (bool, error) {
+	if expr.Filter == nil {
+		return true, nil
+	}
+
+	p, err := Evaluate(ctx, anScope, expr.Filter.(parser.FilterClause).Filter)
+	if err != nil {
+		return false, err
+	}
+	return p.Ternary() == ternary.TRUE, nil
+}
+
+func partitionValues(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) ([]value.Primary, error) {
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, 0, len(partition))
+	for _, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		passed, e := passesAnalyticFilter(ctx, anScope, expr)
+		if e != nil {
+			return nil, e
+		}
+		if !passed {
+			continue
+		}
+
+		val, e := Evaluate(ctx, anScope, expr.Args[0])
+		if e != nil {
+			return nil, e
+		}
+		values = append(values, val)
+	}
+	if expr.IsDistinct() {
+		values = Distinguish(values, scope.Tx.Flags)
+	}
+	return values, nil
+}
+
+// sortPartition returns the record indices of the partition sorted by the order
+// specified in the WITHIN GROUP clause.
+func sortPartition(ctx context.Context, scope *ReferenceScope, partition Partition, orderBy parser.QueryExpression) (Partition, error) {
+	if orderBy == nil {
+		return partition, nil
+	}
+
+	items := orderBy.(parser.OrderByClause).Items
+	directions, nullPositions := sortDirections(items)
+
+	anScope := scope.CreateScopeForAnalytics()
+	sortValues := make(map[int]SortValues, len(partition))
+	for _, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		values := make(SortValues, len(items))
+		for i, item := range items {
+			p, e := Evaluate(ctx, anScope, item.(parser.OrderItem).Value)
+			if e != nil {
+				return nil, e
+			}
+			values[i] = NewSortValue(p, scope.Tx.Flags)
+		}
+		sortValues[idx] = values
+	}
+
+	sorted := make(Partition, len(partition))
+	copy(sorted, partition)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sortValues[sorted[i]].Less(sortValues[sorted[j]], directions, nullPositions)
+	})
+	return sorted, nil
+}
+
+func frameCapacity(frame WindowFrame) int {
+	if frame.High < frame.Low {
+		return 0
+	}
+	return frame.High - frame.Low + 1
+}
+
 func CheckArgsLen(expr parser.AnalyticFunction, length []int) error {
 	if len(length) == 1 {
 		if len(expr.Args) != length[0] {
@@ -584,7 +1115,10 @@ func (fn NthValue) Execute(ctx context.Context, scope *ReferenceScope, partition
 }
 
 func setNthValue(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction, n int) (map[int]value.Primary, error) {
-	frameSet := WindowFrameSet(partition, expr.AnalyticClause)
+	frameSet, err := WindowFrameSet(scope, partition, expr)
+	if err != nil {
+		return nil, err
+	}
 	list := make(map[int]value.Primary, len(partition))
 
 	valueCache := make(map[int]value.Primary, len(partition))
@@ -595,7 +1129,7 @@ func setNthValue(ctx context.Context, scope *ReferenceScope, partition Partition
 		count := 0
 
 		for i := frame.Low; i <= frame.High; i++ {
-			if i < 0 || len(partition) <= i {
+			if i < 0 || len(partition) <= i || frame.IsExcluded(i) {
 				continue
 			}
 
@@ -703,6 +1237,115 @@ func setLag(ctx context.Context, scope *ReferenceScope, partition Partition, exp
 	return list, nil
 }
 
+type Locf struct{}
+
+func (fn Locf) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+// Execute returns the value of each record, or the last non-null value
+// preceding the record if the value is null.
+func (fn Locf) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	anScope := scope.CreateScopeForAnalytics()
+	list := make(map[int]value.Primary, len(partition))
+	var last value.Primary = value.NewNull()
+	for _, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		p, err := Evaluate(ctx, anScope, expr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if !value.IsNull(p) {
+			last = p
+		}
+		list[idx] = last
+	}
+
+	return list, nil
+}
+
+type Interpolate struct{}
+
+func (fn Interpolate) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+// Execute returns the value of each record, or the value linearly interpolated
+// from the nearest non-null values before and after the record if the value is null.
+// The distances between records are measured by the first value of the ORDER BY clause
+// if the values are numbers or datetimes, otherwise by the positions of the records.
+func (fn Interpolate) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	var orderExpr parser.QueryExpression
+	if expr.AnalyticClause.OrderByClause != nil {
+		orderExpr = expr.AnalyticClause.OrderByClause.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+	}
+
+	anScope := scope.CreateScopeForAnalytics()
+	values := make([]value.Primary, len(partition))
+	positions := make([]float64, len(partition))
+	isMeasurable := orderExpr != nil
+	for i, idx := range partition {
+		anScope.Records[0].recordIndex = idx
+		p, err := Evaluate(ctx, anScope, expr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		values[i] = p
+
+		positions[i] = float64(i)
+		if isMeasurable {
+			pos, err := Evaluate(ctx, anScope, orderExpr)
+			if err != nil {
+				return nil, err
+			}
+			if dt, ok := pos.(*value.Datetime); ok {
+				positions[i] = float64(dt.Raw().UnixNano())
+			} else if f := value.ToFloat(pos); !value.IsNull(f) {
+				positions[i] = f.(*value.Float).Raw()
+				value.Discard(f)
+			} else {
+				isMeasurable = false
+			}
+		}
+	}
+	if !isMeasurable {
+		for i := range positions {
+			positions[i] = float64(i)
+		}
+	}
+
+	list := make(map[int]value.Primary, len(partition))
+	prev := -1
+	for i, idx := range partition {
+		list[idx] = values[i]
+		if value.IsNull(values[i]) {
+			continue
+		}
+
+		if -1 < prev && prev < i-1 {
+			y0 := value.ToFloat(values[prev])
+			y1 := value.ToFloat(values[i])
+			if !value.IsNull(y0) && !value.IsNull(y1) {
+				f0 := y0.(*value.Float).Raw()
+				f1 := y1.(*value.Float).Raw()
+				for j := prev + 1; j < i; j++ {
+					ratio := 0.0
+					if positions[i] != positions[prev] {
+						ratio = (positions[j] - positions[prev]) / (positions[i] - positions[prev])
+					}
+					list[partition[j]] = value.NewFloat(f0 + (f1-f0)*ratio)
+				}
+			}
+			value.Discard(y0)
+			value.Discard(y1)
+		}
+		prev = i
+	}
+
+	return list, nil
+}
+
 type AnalyticListAgg struct{}
 
 func (fn AnalyticListAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
@@ -724,18 +1367,13 @@ func (fn AnalyticListAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 		value.Discard(s)
 	}
 
-	anScope := scope.CreateScopeForAnalytics()
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
-		anScope.Records[0].recordIndex = idx
-		val, e := Evaluate(ctx, anScope, expr.Args[0])
-		if e != nil {
-			return nil, e
-		}
-		values[i] = val
+	sorted, err := sortPartition(ctx, scope, partition, expr.OrderBy)
+	if err != nil {
+		return nil, err
 	}
-	if expr.IsDistinct() {
-		values = Distinguish(values, scope.Tx.Flags)
+	values, err := partitionValues(ctx, scope, sorted, expr)
+	if err != nil {
+		return nil, err
 	}
 
 	val := ListAgg(values, separator)
@@ -755,18 +1393,13 @@ func (fn AnalyticJsonAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
 }
 
 func (fn AnalyticJsonAgg) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
-	anScope := scope.CreateScopeForAnalytics()
-	values := make([]value.Primary, len(partition))
-	for i, idx := range partition {
-		anScope.Records[0].recordIndex = idx
-		val, e := Evaluate(ctx, anScope, expr.Args[0])
-		if e != nil {
-			return nil, e
-		}
-		values[i] = val
+	sorted, err := sortPartition(ctx, scope, partition, expr.OrderBy)
+	if err != nil {
+		return nil, err
 	}
-	if expr.IsDistinct() {
-		values = Distinguish(values, scope.Tx.Flags)
+	values, err := partitionValues(ctx, scope, sorted, expr)
+	if err != nil {
+		return nil, err
 	}
 
 	val := JsonAgg(values)
@@ -778,3 +1411,93 @@ func (fn AnalyticJsonAgg) Execute(ctx context.Context, scope *ReferenceScope, pa
 
 	return list, nil
 }
+
+type AnalyticArrayAgg struct{}
+
+func (fn AnalyticArrayAgg) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticArrayAgg) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	sorted, err := sortPartition(ctx, scope, partition, expr.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	values, err := partitionValues(ctx, scope, sorted, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	val := ArrayAgg(values)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticPercentileCont struct{}
+
+func (fn AnalyticPercentileCont) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileCont) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	fraction, values, err := percentileValues(ctx, scope, partition, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	val := PercentileCont(values, fraction, scope.Tx.Flags)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+type AnalyticPercentileDisc struct{}
+
+func (fn AnalyticPercentileDisc) CheckArgsLen(expr parser.AnalyticFunction) error {
+	return CheckArgsLen(expr, []int{1})
+}
+
+func (fn AnalyticPercentileDisc) Execute(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (map[int]value.Primary, error) {
+	fraction, values, err := percentileValues(ctx, scope, partition, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	val := PercentileDisc(values, fraction)
+
+	list := make(map[int]value.Primary, len(partition))
+	for _, idx := range partition {
+		list[idx] = val
+	}
+
+	return list, nil
+}
+
+func percentileValues(ctx context.Context, scope *ReferenceScope, partition Partition, expr parser.AnalyticFunction) (float64, []value.Primary, error) {
+	fraction, err := checkArgsForPercentile(ctx, scope, expr, expr.Name, expr.Args, expr.OrderBy)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	sorted, err := sortPartition(ctx, scope, partition, expr.OrderBy)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	valueExpr := expr
+	valueExpr.Args = []parser.QueryExpression{expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value}
+	values, err := partitionValues(ctx, scope, sorted, valueExpr)
+	if err != nil {
+		return 0, nil, err
+	}
+	return fraction, values, nil
+}
@@ -314,6 +314,92 @@ var analyzeTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Analyze AggregateFunction With Filter",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.FilterClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(2),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
 	{
 		Name: "Analyze AggregateFunction with Windowing Clause",
 		View: &View{
@@ -548,6 +634,220 @@ var analyzeTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Analyze BivariateAggregateFunction",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "covar_pop",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewFloat(0.25),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewFloat(0.25),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(0),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(0),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(0),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze BivariateAggregateFunction Argument Length Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "corr",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function corr takes exactly 2 arguments",
+	},
+	{
+		Name: "Analyze SketchAggregateFunction",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "approx_percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewFloatValue(0.5),
+			},
+			AnalyticClause: parser.AnalyticClause{
+				PartitionClause: parser.PartitionClause{
+					Values: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		PartitionIndices: []int{0},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+					value.NewFloat(1.5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(2),
+					value.NewFloat(1.5),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewNull(),
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("b"),
+					value.NewInteger(1),
+					value.NewInteger(1),
+				}),
+			},
+			sortValuesInEachCell: [][]*SortValue{
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("a"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+				{NewSortValue(value.NewString("b"), TestTx.Flags), nil},
+			},
+		},
+	},
+	{
+		Name: "Analyze SketchAggregateFunction Argument Length Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "approx_percentile",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function approx_percentile takes exactly 2 arguments",
+	},
 	{
 		Name: "Analyze UserDefinedFunction",
 		View: &View{
@@ -1578,16 +1878,232 @@ var firstValueExecuteTests = []analyticFunctionExecuteTests{
 			Args: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
 			},
-			IgnoreType: parser.Token{Token: parser.NULLS, Literal: "nulls"},
-		},
-		Result: map[int]value.Primary{
-			2: value.NewInteger(200),
-			3: value.NewInteger(200),
-			4: value.NewInteger(200),
-			5: value.NewInteger(200),
-			6: value.NewInteger(200),
-			7: value.NewInteger(200),
+			IgnoreType: parser.Token{Token: parser.NULLS, Literal: "nulls"},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewInteger(200),
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+			5: value.NewInteger(200),
+			6: value.NewInteger(200),
+			7: value.NewInteger(200),
+		},
+	},
+	{
+		Name:  "FirstValue with Range Frame Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewInteger(200), TestTx.Flags)},
+			4: {NewSortValue(value.NewInteger(300), TestTx.Flags)},
+			5: {NewSortValue(value.NewInteger(500), TestTx.Flags)},
+			6: {NewSortValue(value.NewInteger(800), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameType: parser.Token{Token: parser.RANGE},
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.Token{Token: parser.PRECEDING},
+						OffsetValue: parser.NewFloatValueFromString("150.5"),
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(200),
+			4: value.NewInteger(200),
+			5: value.NewInteger(500),
+			6: value.NewInteger(800),
+		},
+	},
+	{
+		Name:  "FirstValue with Range Frame of Interval Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC)), TestTx.Flags)},
+			4: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, time.UTC)), TestTx.Flags)},
+			5: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 9, 0, 0, 0, 0, time.UTC)), TestTx.Flags)},
+			6: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 10, 12, 0, 0, 0, time.UTC)), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameType: parser.Token{Token: parser.RANGE},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.PRECEDING},
+						OffsetValue: parser.Interval{
+							Value: parser.NewStringValue("2"),
+							Unit:  parser.Identifier{Literal: "day"},
+						},
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.FOLLOWING},
+						Unbounded: parser.Token{Token: parser.UNBOUNDED},
+					},
+					Exclusion: parser.Token{Token: parser.CURRENT},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(300),
+			4: value.NewInteger(200),
+			5: value.NewInteger(800),
+			6: value.NewInteger(500),
+		},
+	},
+	{
+		Name:  "FirstValue with Groups Frame Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewInteger(1), TestTx.Flags)},
+			4: {NewSortValue(value.NewInteger(2), TestTx.Flags)},
+			5: {NewSortValue(value.NewInteger(2), TestTx.Flags)},
+			6: {NewSortValue(value.NewInteger(3), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameType: parser.Token{Token: parser.GROUPS},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.FOLLOWING},
+						Offset:    1,
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.FOLLOWING},
+						Unbounded: parser.Token{Token: parser.UNBOUNDED},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(300),
+			4: value.NewInteger(800),
+			5: value.NewInteger(800),
+			6: value.NewNull(),
+		},
+	},
+	{
+		Name:  "FirstValue with Exclude Ties Execute",
+		Items: Partition{3, 4, 5, 6},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewInteger(1), TestTx.Flags)},
+			4: {NewSortValue(value.NewInteger(1), TestTx.Flags)},
+			5: {NewSortValue(value.NewInteger(1), TestTx.Flags)},
+			6: {NewSortValue(value.NewInteger(3), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameType: parser.Token{Token: parser.RANGE},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.CURRENT},
+					},
+					FrameHigh: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.FOLLOWING},
+						Unbounded: parser.Token{Token: parser.UNBOUNDED},
+					},
+					Exclusion: parser.Token{Token: parser.TIES},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			3: value.NewInteger(200),
+			4: value.NewInteger(300),
+			5: value.NewInteger(500),
+			6: value.NewInteger(800),
+		},
+	},
+	{
+		Name:  "FirstValue with Rows Frame Offset Error",
+		Items: Partition{3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameLow: parser.WindowFramePosition{
+						Direction:   parser.Token{Token: parser.PRECEDING},
+						OffsetValue: parser.NewFloatValueFromString("1.5"),
+					},
+				},
+			},
+		},
+		Error: "invalid window frame for function first_value: the offset of ROWS frame must be an integer",
+	},
+	{
+		Name:  "FirstValue with Range Frame Interval Unit Error",
+		Items: Partition{3, 4},
+		SortValues: map[int]SortValues{
+			3: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, time.UTC)), TestTx.Flags)},
+			4: {NewSortValue(value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, time.UTC)), TestTx.Flags)},
+		},
+		Function: parser.AnalyticFunction{
+			Name: "first_value",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				WindowingClause: parser.WindowingClause{
+					FrameType: parser.Token{Token: parser.RANGE},
+					FrameLow: parser.WindowFramePosition{
+						Direction: parser.Token{Token: parser.PRECEDING},
+						OffsetValue: parser.Interval{
+							Value: parser.NewStringValue("2"),
+							Unit:  parser.Identifier{Literal: "fortnight"},
+						},
+					},
+				},
+			},
 		},
+		Error: "invalid window frame for function first_value: fortnight is an unknown interval unit",
 	},
 	{
 		Name:  "FirstValue Execute Argument Value Error",
@@ -2089,6 +2605,149 @@ func TestLead_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, Lead{}, leadExecuteTests)
 }
 
+var locfCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "Locf CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "locf",
+		},
+		Error: "function locf takes exactly 1 argument",
+	},
+}
+
+func TestLocf_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, Locf{}, locfCheckArgsLenTests)
+}
+
+var locfExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "Locf Execute",
+		Items: Partition{2, 3, 4, 7, 5},
+		Function: parser.AnalyticFunction{
+			Name: "locf",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewNull(),
+			3: value.NewInteger(200),
+			4: value.NewInteger(300),
+			7: value.NewInteger(300),
+			5: value.NewInteger(500),
+		},
+	},
+	{
+		Name:  "Locf Execute Argument Evaluation Error",
+		Items: Partition{2, 3, 4, 7, 5},
+		Function: parser.AnalyticFunction{
+			Name: "locf",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestLocf_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, Locf{}, locfExecuteTests)
+}
+
+var interpolateCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "Interpolate CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "interpolate",
+		},
+		Error: "function interpolate takes exactly 1 argument",
+	},
+}
+
+func TestInterpolate_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, Interpolate{}, interpolateCheckArgsLenTests)
+}
+
+var interpolateExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "Interpolate Execute Leading And Trailing Nulls",
+		Items: Partition{2, 0, 4, 7},
+		Function: parser.AnalyticFunction{
+			Name: "interpolate",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			2: value.NewNull(),
+			0: value.NewInteger(100),
+			7: value.NewNull(),
+			4: value.NewInteger(300),
+		},
+	},
+	{
+		Name:  "Interpolate Execute By Positions",
+		Items: Partition{0, 2, 4},
+		Function: parser.AnalyticFunction{
+			Name: "interpolate",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			2: value.NewFloat(200),
+			4: value.NewInteger(300),
+		},
+	},
+	{
+		Name:  "Interpolate Execute By Order Values",
+		Items: Partition{0, 1, 4, 6},
+		Function: parser.AnalyticFunction{
+			Name: "interpolate",
+			Args: []parser.QueryExpression{
+				parser.Function{
+					Name: "nullif",
+					Args: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						parser.NewIntegerValue(300),
+					},
+				},
+			},
+			AnalyticClause: parser.AnalyticClause{
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{
+							Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						},
+					},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			1: value.NewInteger(200),
+			4: value.NewFloat(300),
+			6: value.NewInteger(800),
+		},
+	},
+	{
+		Name:  "Interpolate Execute Argument Evaluation Error",
+		Items: Partition{0, 2, 4},
+		Function: parser.AnalyticFunction{
+			Name: "interpolate",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestInterpolate_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, Interpolate{}, interpolateExecuteTests)
+}
+
 var analyticListAggCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
 	{
 		Name: "ListAgg CheckArgsLen Too Little Error",
@@ -2158,6 +2817,68 @@ var analyticListAggExecuteTests = []analyticFunctionExecuteTests{
 			4: value.NewString("100,200,300"),
 		},
 	},
+	{
+		Name:  "AnalyticListAgg Execute With Within Group",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("300,200,200,100"),
+			1: value.NewString("300,200,200,100"),
+			2: value.NewString("300,200,200,100"),
+			3: value.NewString("300,200,200,100"),
+			4: value.NewString("300,200,200,100"),
+		},
+	},
+	{
+		Name:  "AnalyticListAgg Execute With Filter",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+			Filter: parser.FilterClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewIntegerValue(100),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewString("200,200,300"),
+			1: value.NewString("200,200,300"),
+			2: value.NewString("200,200,300"),
+			3: value.NewString("200,200,300"),
+			4: value.NewString("200,200,300"),
+		},
+	},
+	{
+		Name:  "AnalyticListAgg Execute Filter Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			Filter: parser.FilterClause{
+				Filter: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
 	{
 		Name:  "AnalyticListAgg Execute First Argument Evaluation Error",
 		Items: Partition{0, 1, 2, 3, 4},
@@ -2266,3 +2987,182 @@ var analyticJsonAggExecuteTests = []analyticFunctionExecuteTests{
 func TestAnalyticJsonAgg_Execute(t *testing.T) {
 	testAnalyticFunctionExecute(t, AnalyticJsonAgg{}, analyticJsonAggExecuteTests)
 }
+
+var analyticArrayAggCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "ArrayAgg CheckArgsLen Too Little Error",
+		Function: parser.AnalyticFunction{
+			Name: "array_agg",
+		},
+		Error: "function array_agg takes exactly 1 argument",
+	},
+}
+
+func TestAnalyticArrayAgg_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticArrayAgg{}, analyticArrayAggCheckArgsLenTests)
+}
+
+var analyticArrayAggExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticArrayAgg Execute With Distinct",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name:     "array_agg",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			1: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			2: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			3: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+			4: value.NewArray([]value.Primary{value.NewInteger(100), value.NewInteger(200), value.NewNull(), value.NewInteger(300)}),
+		},
+	},
+	{
+		Name:  "AnalyticArrayAgg Execute First Argument Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "array_agg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticArrayAgg_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticArrayAgg{}, analyticArrayAggExecuteTests)
+}
+
+var analyticPercentileContCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "PercentileCont CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+		},
+		Error: "function percentile_cont takes exactly 1 argument",
+	},
+}
+
+func TestAnalyticPercentileCont_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticPercentileCont{}, analyticPercentileContCheckArgsLenTests)
+}
+
+var analyticPercentileContExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileCont Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(175),
+			1: value.NewInteger(175),
+			2: value.NewInteger(175),
+			3: value.NewInteger(175),
+			4: value.NewInteger(175),
+		},
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Within Group Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+		},
+		Error: "WITHIN GROUP clause with one sort key is required for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Fraction Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("a"),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
+	{
+		Name:  "AnalyticPercentileCont Execute Sort Key Evaluation Error",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestAnalyticPercentileCont_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileCont{}, analyticPercentileContExecuteTests)
+}
+
+var analyticPercentileDiscCheckArgsLenTests = []analyticFunctionCheckArgsLenTests{
+	{
+		Name: "PercentileDisc CheckArgsLen Error",
+		Function: parser.AnalyticFunction{
+			Name: "percentile_disc",
+		},
+		Error: "function percentile_disc takes exactly 1 argument",
+	},
+}
+
+func TestAnalyticPercentileDisc_CheckArgsLen(t *testing.T) {
+	testAnalyticFunctionCheckArgsLenTests(t, AnalyticPercentileDisc{}, analyticPercentileDiscCheckArgsLenTests)
+}
+
+var analyticPercentileDiscExecuteTests = []analyticFunctionExecuteTests{
+	{
+		Name:  "AnalyticPercentileDisc Execute",
+		Items: Partition{0, 1, 2, 3, 4},
+		Function: parser.AnalyticFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: map[int]value.Primary{
+			0: value.NewInteger(100),
+			1: value.NewInteger(100),
+			2: value.NewInteger(100),
+			3: value.NewInteger(100),
+			4: value.NewInteger(100),
+		},
+	},
+}
+
+func TestAnalyticPercentileDisc_Execute(t *testing.T) {
+	testAnalyticFunctionExecute(t, AnalyticPercentileDisc{}, analyticPercentileDiscExecuteTests)
+}
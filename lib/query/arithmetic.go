@@ -3,10 +3,19 @@ package query
 import (
 	"math"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
+func Calculate(p1 value.Primary, p2 value.Primary, operator int, flags *cmd.Flags) value.Primary {
+	if isInterval(p1) || isInterval(p2) || isDatetime(p1) || isDatetime(p2) {
+		return calculateInterval(p1, p2, operator, flags)
+	}
+
+	if isDecimal(p1) || isDecimal(p2) {
+		return calculateDecimal(p1, p2, operator)
+	}
+
 	if operator != '/' {
 		if pi1 := value.ToInteger(p1); !value.IsNull(pi1) {
 			if pi2 := value.ToInteger(p2); !value.IsNull(pi2) {
@@ -19,6 +28,10 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 		}
 	}
 
+	if flags.ExactDecimal {
+		return calculateDecimal(p1, p2, operator)
+	}
+
 	pf1 := value.ToFloat(p1)
 	if value.IsNull(pf1) {
 		return value.NewNull()
@@ -50,18 +63,142 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
 	return value.ParseFloat64(result)
 }
 
+// calculateInteger calculates integers.
+// If the result overflows int64, then the result is calculated as a decimal.
+// Modulo by zero results in null.
 func calculateInteger(i1 int64, i2 int64, operator int) value.Primary {
 	var result int64 = 0
 	switch operator {
 	case '+':
 		result = i1 + i2
+		if (0 < i2 && result < i1) || (i2 < 0 && i1 < result) {
+			return value.NewDecimalFromInt64(i1).Add(value.NewDecimalFromInt64(i2))
+		}
 	case '-':
 		result = i1 - i2
+		if (0 < i2 && i1 < result) || (i2 < 0 && result < i1) {
+			return value.NewDecimalFromInt64(i1).Sub(value.NewDecimalFromInt64(i2))
+		}
 	case '*':
 		result = i1 * i2
+		if i1 != 0 && (result/i1 != i2 || (i1 == -1 && i2 == math.MinInt64)) {
+			return value.NewDecimalFromInt64(i1).Mul(value.NewDecimalFromInt64(i2))
+		}
 	case '%':
+		if i2 == 0 {
+			return value.NewNull()
+		}
 		result = i1 % i2
 	}
 
 	return value.NewInteger(result)
 }
+
+func calculateDecimal(p1 value.Primary, p2 value.Primary, operator int) value.Primary {
+	pd1 := value.ToDecimal(p1)
+	if value.IsNull(pd1) {
+		return value.NewNull()
+	}
+	pd2 := value.ToDecimal(p2)
+	if value.IsNull(pd2) {
+		return value.NewNull()
+	}
+	d1 := pd1.(*value.Decimal)
+	d2 := pd2.(*value.Decimal)
+
+	var result *value.Decimal
+	ok := true
+	switch operator {
+	case '+':
+		result = d1.Add(d2)
+	case '-':
+		result = d1.Sub(d2)
+	case '*':
+		result = d1.Mul(d2)
+	case '/':
+		result, ok = d1.Quo(d2)
+	case '%':
+		result, ok = d1.Rem(d2)
+	}
+
+	if !ok {
+		return value.NewNull()
+	}
+	return result
+}
+
+// calculateInterval calculates datetimes and intervals.
+// A datetime can be shifted by an interval, the difference between two datetimes is an interval,
+// and intervals can be added, subtracted and multiplied by integers.
+// Any other combination results in null.
+func calculateInterval(p1 value.Primary, p2 value.Primary, operator int, flags *cmd.Flags) value.Primary {
+	switch operator {
+	case '+', '-':
+		if piv2 := value.ToInterval(p2); !value.IsNull(piv2) {
+			iv2 := piv2.(*value.Interval)
+			if operator == '-' {
+				iv2 = iv2.Neg()
+			}
+
+			if piv1 := value.ToInterval(p1); !value.IsNull(piv1) {
+				return piv1.(*value.Interval).Add(iv2)
+			}
+			if dt1 := value.ToDatetime(p1, flags.DatetimeFormat); !value.IsNull(dt1) {
+				t := dt1.(*value.Datetime).Raw()
+				value.Discard(dt1)
+				return value.NewDatetime(iv2.AddTo(t))
+			}
+			return value.NewNull()
+		}
+
+		if operator == '+' {
+			if piv1 := value.ToInterval(p1); !value.IsNull(piv1) {
+				if dt2 := value.ToDatetime(p2, flags.DatetimeFormat); !value.IsNull(dt2) {
+					t := dt2.(*value.Datetime).Raw()
+					value.Discard(dt2)
+					return value.NewDatetime(piv1.(*value.Interval).AddTo(t))
+				}
+			}
+			return value.NewNull()
+		}
+
+		if dt1 := value.ToDatetime(p1, flags.DatetimeFormat); !value.IsNull(dt1) {
+			if dt2 := value.ToDatetime(p2, flags.DatetimeFormat); !value.IsNull(dt2) {
+				d := dt1.(*value.Datetime).Raw().Sub(dt2.(*value.Datetime).Raw())
+				value.Discard(dt1)
+				value.Discard(dt2)
+				return value.NewIntervalFromDuration(d)
+			}
+			value.Discard(dt1)
+		}
+	case '*':
+		iv, n := p1, p2
+		if !isInterval(iv) {
+			iv, n = p2, p1
+		}
+		if piv := value.ToInterval(iv); !value.IsNull(piv) {
+			if pi := value.ToInteger(n); !value.IsNull(pi) {
+				i := pi.(*value.Integer).Raw()
+				value.Discard(pi)
+				return piv.(*value.Interval).Mul(i)
+			}
+		}
+	}
+
+	return value.NewNull()
+}
+
+func isInterval(p value.Primary) bool {
+	_, ok := p.(*value.Interval)
+	return ok
+}
+
+func isDatetime(p value.Primary) bool {
+	_, ok := p.(*value.Datetime)
+	return ok
+}
+
+func isDecimal(p value.Primary) bool {
+	_, ok := p.(*value.Decimal)
+	return ok
+}
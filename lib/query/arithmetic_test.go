@@ -1,17 +1,22 @@
 package query
 
 import (
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 var calculateTests = []struct {
-	LHS      value.Primary
-	RHS      value.Primary
-	Operator int
-	Result   value.Primary
+	LHS          value.Primary
+	RHS          value.Primary
+	Operator     int
+	ExactDecimal bool
+	Result       value.Primary
 }{
 	{
 		LHS:      value.NewString("9"),
@@ -79,13 +84,145 @@ var calculateTests = []struct {
 		Operator: '%',
 		Result:   value.NewFloat(0.5),
 	},
+	{
+		LHS:      value.NewInteger(5),
+		RHS:      value.NewInteger(0),
+		Operator: '%',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewInteger(math.MaxInt64),
+		RHS:      value.NewInteger(1),
+		Operator: '+',
+		Result:   value.NewDecimal(new(big.Int).Add(big.NewInt(math.MaxInt64), big.NewInt(1)), 0),
+	},
+	{
+		LHS:      value.NewInteger(math.MinInt64),
+		RHS:      value.NewInteger(1),
+		Operator: '-',
+		Result:   value.NewDecimal(new(big.Int).Sub(big.NewInt(math.MinInt64), big.NewInt(1)), 0),
+	},
+	{
+		LHS:      value.NewInteger(math.MaxInt64),
+		RHS:      value.NewInteger(2),
+		Operator: '*',
+		Result:   value.NewDecimal(new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2)), 0),
+	},
+	{
+		LHS:      value.NewInteger(-1),
+		RHS:      value.NewInteger(math.MinInt64),
+		Operator: '*',
+		Result:   value.NewDecimal(new(big.Int).Neg(big.NewInt(math.MinInt64)), 0),
+	},
+	{
+		LHS:      value.NewDecimal(big.NewInt(1), 1),
+		RHS:      value.NewFloat(0.2),
+		Operator: '+',
+		Result:   value.NewDecimal(big.NewInt(3), 1),
+	},
+	{
+		LHS:      value.NewDecimal(big.NewInt(1), 0),
+		RHS:      value.NewInteger(0),
+		Operator: '/',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDecimal(big.NewInt(1), 0),
+		RHS:      value.NewString("abc"),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:          value.NewString("0.1"),
+		RHS:          value.NewString("0.2"),
+		Operator:     '+',
+		ExactDecimal: true,
+		Result:       value.NewDecimal(big.NewInt(3), 1),
+	},
+	{
+		LHS:          value.NewInteger(1),
+		RHS:          value.NewInteger(4),
+		Operator:     '/',
+		ExactDecimal: true,
+		Result:       value.NewDecimal(big.NewInt(25), 2),
+	},
+	{
+		LHS:          value.NewInteger(1),
+		RHS:          value.NewInteger(4),
+		Operator:     '+',
+		ExactDecimal: true,
+		Result:       value.NewInteger(5),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2024, 1, 31, 9, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInterval(1, 0, int64(90*time.Minute)),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2024, 3, 2, 10, 30, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewInterval(0, 1, 0),
+		RHS:      value.NewString("2024-02-28 00:00:00"),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2024, 2, 29, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2024, 3, 1, 0, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewString("P1D"),
+		Operator: '-',
+		Result:   value.NewDatetime(time.Date(2024, 2, 29, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2024, 1, 2, 12, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewDatetime(time.Date(2024, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+		Operator: '-',
+		Result:   value.NewInterval(0, 0, int64(36*time.Hour)),
+	},
+	{
+		LHS:      value.NewInterval(1, 2, 3),
+		RHS:      value.NewInterval(0, 1, 1),
+		Operator: '-',
+		Result:   value.NewInterval(1, 1, 2),
+	},
+	{
+		LHS:      value.NewInteger(3),
+		RHS:      value.NewInterval(0, 1, int64(time.Hour)),
+		Operator: '*',
+		Result:   value.NewInterval(0, 3, int64(3*time.Hour)),
+	},
+	{
+		LHS:      value.NewInterval(0, 1, 0),
+		RHS:      value.NewInteger(2),
+		Operator: '/',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2024, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInteger(1),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
 }
 
 func TestCalculate(t *testing.T) {
+	flags := cmd.NewFlags(nil)
+
 	for _, v := range calculateTests {
-		r := Calculate(v.LHS, v.RHS, v.Operator)
+		flags.ExactDecimal = v.ExactDecimal
+		r := Calculate(v.LHS, v.RHS, v.Operator, flags)
+		if d, ok := v.Result.(*value.Decimal); ok {
+			if rd, ok := r.(*value.Decimal); !ok || rd.String() != d.String() {
+				t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(rune(v.Operator)), v.RHS)
+			}
+			continue
+		}
+		if dt, ok := v.Result.(*value.Datetime); ok {
+			if rdt, ok := r.(*value.Datetime); !ok || !rdt.Raw().Equal(dt.Raw()) {
+				t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(rune(v.Operator)), v.RHS)
+			}
+			continue
+		}
 		if !reflect.DeepEqual(r, v.Result) {
-			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(v.Operator), v.RHS)
+			t.Errorf("result = %s, want %s for (%s %s %s)", r, v.Result, v.LHS, string(rune(v.Operator)), v.RHS)
 		}
 	}
 }
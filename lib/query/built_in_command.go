@@ -217,16 +217,16 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.DatetimeFormatFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
-		cmd.LineBreakFlag, cmd.JsonEscapeFlag:
+		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.ArraySeparatorFlag:
 		p = value.ToString(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.String).Raw()
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
+	case cmd.AnsiQuotesFlag, cmd.ExactDecimalFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag, cmd.EncloseAllFlag,
 		cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
-		cmd.QuietFlag, cmd.StatsFlag:
+		cmd.QuietFlag, cmd.StatsFlag, cmd.StreamingFlag:
 		p = value.ToBoolean(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
@@ -265,13 +265,13 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 			Value:    expr.Value,
 		}
 		return SetFlag(ctx, scope, e)
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.ExactDecimalFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
 		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.EncloseAllFlag, cmd.ArraySeparatorFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
-		cmd.QuietFlag, cmd.StatsFlag,
+		cmd.QuietFlag, cmd.StatsFlag, cmd.StreamingFlag,
 		cmd.WaitTimeoutFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
@@ -313,13 +313,13 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 		} else {
 			return NewInvalidFlagValueToBeRemovedError(expr)
 		}
-	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag,
+	case cmd.RepositoryFlag, cmd.TimezoneFlag, cmd.AnsiQuotesFlag, cmd.ExactDecimalFlag,
 		cmd.ImportFormatFlag, cmd.DelimiterFlag, cmd.DelimiterPositionsFlag, cmd.JsonQueryFlag, cmd.EncodingFlag,
 		cmd.ExportEncodingFlag, cmd.FormatFlag, cmd.ExportDelimiterFlag, cmd.ExportDelimiterPositionsFlag,
 		cmd.LineBreakFlag, cmd.JsonEscapeFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.WithoutHeaderFlag,
-		cmd.EncloseAllFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
+		cmd.EncloseAllFlag, cmd.ArraySeparatorFlag, cmd.PrettyPrintFlag, cmd.StripEndingLineBreakFlag,
 		cmd.EastAsianEncodingFlag, cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag, cmd.ColorFlag,
-		cmd.QuietFlag, cmd.StatsFlag,
+		cmd.QuietFlag, cmd.StatsFlag, cmd.StreamingFlag,
 		cmd.WaitTimeoutFlag,
 		cmd.LimitRecursion, cmd.CPUFlag:
 
@@ -416,6 +416,17 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
+	case cmd.ArraySeparatorFlag:
+		switch tx.Flags.ExportOptions.Format {
+		case cmd.CSV, cmd.TSV, cmd.FIXED, cmd.LTSV:
+			if len(val.(*value.String).Raw()) < 1 {
+				s = tx.Palette.Render(cmd.NullEffect, "(JSON array)")
+			} else {
+				s = tx.Palette.Render(cmd.StringEffect, val.(*value.String).String())
+			}
+		default:
+			s = tx.Palette.Render(cmd.NullEffect, IgnoredFlagPrefix+val.(*value.String).String())
+		}
 	case cmd.JsonEscapeFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case cmd.JSON:
@@ -452,8 +463,8 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Integer).String())
 	case cmd.WaitTimeoutFlag:
 		s = tx.Palette.Render(cmd.NumberEffect, val.(*value.Float).String())
-	case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StripEndingLineBreakFlag,
-		cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
+	case cmd.AnsiQuotesFlag, cmd.ExactDecimalFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag, cmd.StripEndingLineBreakFlag,
+		cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamingFlag:
 		s = tx.Palette.Render(cmd.BooleanEffect, val.(*value.Boolean).String())
 	}
 
@@ -688,19 +699,21 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 	case ShowRuninfo:
 		for _, ri := range RuntimeInformatinList {
 			label := string(parser.VariableSign) + string(parser.RuntimeInformationSign) + ri
-			p, _ := GetRuntimeInformation(scope.Tx, parser.RuntimeInformation{Name: ri})
+			p, _ := GetRuntimeInformation(scope, parser.RuntimeInformation{Name: ri})
 
 			w.WriteSpaces(19 - len(label))
 			w.WriteColorWithoutLineBreak(label, cmd.LableEffect)
 			w.WriteColorWithoutLineBreak(":", cmd.LableEffect)
 			w.WriteSpaces(1)
-			switch ri {
-			case WorkingDirectory, VersionInformation:
+			switch p.(type) {
+			case *value.String:
 				w.WriteColorWithoutLineBreak(p.(*value.String).Raw(), cmd.StringEffect)
-			case UncommittedInformation:
+			case *value.Boolean:
 				w.WriteColorWithoutLineBreak(p.(*value.Boolean).String(), cmd.BooleanEffect)
-			default:
+			case *value.Integer:
 				w.WriteColorWithoutLineBreak(p.(*value.Integer).String(), cmd.NumberEffect)
+			default:
+				w.WriteColorWithoutLineBreak(p.String(), cmd.NullEffect)
 			}
 			w.NewLine()
 		}
@@ -714,6 +727,20 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 }
 
 func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
+	if info.IsDatabaseTable() {
+		w.WriteColor("Database: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.DatabasePath)
+		w.NewLine()
+		if 0 < len(info.DatabaseTable) {
+			w.WriteColor("Table: ", cmd.LableEffect)
+			w.WriteWithoutLineBreak(info.DatabaseTable)
+		} else {
+			w.WriteColor("Query: ", cmd.LableEffect)
+			w.WriteColorWithoutLineBreak(info.DatabaseQuery, cmd.NullEffect)
+		}
+		return
+	}
+
 	encWidth := cmd.TextWidth(info.Encoding.String(), flags)
 
 	w.WriteColor("Format: ", cmd.LableEffect)
@@ -752,6 +779,16 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 		} else {
 			w.WriteColorWithoutLineBreak(info.JsonQuery, cmd.NullEffect)
 		}
+	case cmd.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(info.SheetName)
+		w.WriteSpaces(2)
+		w.WriteColorWithoutLineBreak("Range: ", cmd.LableEffect)
+		if len(info.CellRange) < 1 {
+			w.WriteColorWithoutLineBreak("(all)", cmd.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.CellRange)
+		}
 	}
 
 	switch info.Format {
@@ -765,13 +802,13 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", cmd.LableEffect)
 	switch info.Format {
-	case cmd.JSON:
+	case cmd.JSON, cmd.PARQUET, cmd.XLSX:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), cmd.NullEffect)
 	default:
 		w.WriteWithoutLineBreak(info.Encoding.String())
 	}
 
-	if !(info.Format == cmd.FIXED && info.SingleLine) {
+	if !isBinaryFormat(info.Format) && !(info.Format == cmd.FIXED && info.SingleLine) {
 		w.WriteSpaces(encWidth + 2 - (cmd.TextWidth(info.Encoding.String(), flags)))
 		w.WriteColorWithoutLineBreak("LineBreak: ", cmd.LableEffect)
 		w.WriteWithoutLineBreak(info.LineBreak.String())
@@ -788,6 +825,10 @@ func writeTableAttribute(w *ObjectWriter, flags *cmd.Flags, info *FileInfo) {
 			w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
 			w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 		}
+	case cmd.XLSX:
+		w.WriteSpaces(2)
+		w.WriteColorWithoutLineBreak("Header: ", cmd.LableEffect)
+		w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 	}
 }
 
@@ -409,6 +409,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set ExactDecimal",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "exact_decimal"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set WaitTimeout",
 		Expr: parser.SetFlag{
@@ -570,6 +577,13 @@ var setFlagTests = []struct {
 			Value: parser.NewTernaryValueFromString("true"),
 		},
 	},
+	{
+		Name: "Set Streaming",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "streaming"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set Encoding with Identifier",
 		Expr: parser.SetFlag{
@@ -924,6 +938,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@ANSI_QUOTES:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show ExactDecimal",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "exact_decimal"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "exact_decimal"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@EXACT_DECIMAL:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Show WaitTimeout",
 		Expr: parser.ShowFlag{
@@ -1300,6 +1327,57 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@ENCLOSE_ALL:\033[0m \033[90m(ignored) true\033[0m",
 	},
+	{
+		Name: "Show ArraySeparator",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "array_separator"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "array_separator"},
+				Value: parser.NewStringValue("|"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@ARRAY_SEPARATOR:\033[0m \033[32m'|'\033[0m",
+	},
+	{
+		Name: "Show ArraySeparator Empty",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "array_separator"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "array_separator"},
+				Value: parser.NewStringValue(""),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("CSV"),
+			},
+		},
+		Result: "\033[34;1m@@ARRAY_SEPARATOR:\033[0m \033[90m(JSON array)\033[0m",
+	},
+	{
+		Name: "Show ArraySeparator Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "array_separator"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "array_separator"},
+				Value: parser.NewStringValue("|"),
+			},
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("JSON"),
+			},
+		},
+		Result: "\033[34;1m@@ARRAY_SEPARATOR:\033[0m \033[90m(ignored) '|'\033[0m",
+	},
 	{
 		Name: "Show JsonEscape",
 		Expr: parser.ShowFlag{
@@ -1557,6 +1635,19 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@STATS:\033[0m \033[33;1mtrue\033[0m",
 	},
+	{
+		Name: "Show Streaming",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "streaming"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "streaming"},
+				Value: parser.NewTernaryValueFromString("true"),
+			},
+		},
+		Result: "\033[34;1m@@STREAMING:\033[0m \033[33;1mtrue\033[0m",
+	},
 	{
 		Name: "Invalid Flag Name Error",
 		Expr: parser.ShowFlag{
@@ -2162,6 +2253,7 @@ var showObjectsTests = []struct {
 			"                  @@TIMEZONE: UTC\n" +
 			"           @@DATETIME_FORMAT: (not set)\n" +
 			"               @@ANSI_QUOTES: false\n" +
+			"             @@EXACT_DECIMAL: false\n" +
 			"              @@WAIT_TIMEOUT: 15\n" +
 			"             @@IMPORT_FORMAT: CSV\n" +
 			"                 @@DELIMITER: ','\n" +
@@ -2178,6 +2270,7 @@ var showObjectsTests = []struct {
 			"            @@WITHOUT_HEADER: false\n" +
 			"                @@LINE_BREAK: LF\n" +
 			"               @@ENCLOSE_ALL: false\n" +
+			"           @@ARRAY_SEPARATOR: (JSON array)\n" +
 			"               @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
 			"              @@PRETTY_PRINT: (ignored) false\n" +
 			"       @@EAST_ASIAN_ENCODING: (ignored) false\n" +
@@ -2188,6 +2281,7 @@ var showObjectsTests = []struct {
 			"           @@LIMIT_RECURSION: 5\n" +
 			"                       @@CPU: " + strconv.Itoa(TestTx.Flags.CPU) + "\n" +
 			"                     @@STATS: false\n" +
+			"                 @@STREAMING: false\n" +
 			"\n",
 	},
 	{
@@ -2202,8 +2296,13 @@ var showObjectsTests = []struct {
 			"           @#UPDATED: 0\n" +
 			"     @#UPDATED_VIEWS: 0\n" +
 			"     @#LOADED_TABLES: 0\n" +
+			"     @#JOIN_STRATEGY: NULL\n" +
 			" @#WORKING_DIRECTORY: " + GetWD() + "\n" +
 			"           @#VERSION: v1.0.0\n" +
+			"        @#ERROR_CODE: NULL\n" +
+			"     @#ERROR_MESSAGE: NULL\n" +
+			"        @#ERROR_LINE: NULL\n" +
+			"      @#ERROR_SOURCE: NULL\n" +
 			"\n",
 	},
 	{
@@ -2253,6 +2352,7 @@ func TestShowObjects(t *testing.T) {
 		if v.PreparedStatements.SyncMap != nil {
 			TestTx.PreparedStatements = v.PreparedStatements
 		}
+		TestTx.setJoinStrategy(0)
 
 		if v.Scope == nil {
 			v.Scope = NewReferenceScope(TestTx)
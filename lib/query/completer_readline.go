@@ -31,6 +31,7 @@ var statementPrefix = []string{
 	"UPDATE",
 	"REPLACE",
 	"DELETE",
+	"MERGE",
 	"CREATE",
 	"ALTER",
 	"DECLARE",
@@ -84,6 +85,8 @@ var tableObjectCandidates = []string{
 	"FIXED()",
 	"JSON()",
 	"LTSV()",
+	"SQLITE()",
+	"XLSX()",
 }
 
 var exportEncodingsCandidates = []string{
@@ -150,6 +153,7 @@ type Completer struct {
 	userFuncs        []string
 	userAggFuncs     []string
 	userFuncList     []string
+	procedureList    []string
 	viewList         []string
 	cursorList       []string
 	funcList         []string
@@ -185,22 +189,34 @@ func NewCompleter(scope *ReferenceScope) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(Functions)+3)
+	completer.funcs = make([]string, 0, len(Functions)+4)
 	for k := range Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
 	completer.funcs = append(completer.funcs, "CALL")
 	completer.funcs = append(completer.funcs, "NOW")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
+	completer.funcs = append(completer.funcs, "GROUPING")
 
-	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+2)
-	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions))
+	completer.aggFuncs = make([]string, 0, len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions)+5)
+	completer.analyticFuncs = make([]string, 0, len(AnalyticFunctions)+len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions))
 	for k := range AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
+	for k := range BivariateAggregateFunctions {
+		completer.aggFuncs = append(completer.aggFuncs, k)
+		completer.analyticFuncs = append(completer.analyticFuncs, k)
+	}
+	for k := range SketchAggregateFunctions {
+		completer.aggFuncs = append(completer.aggFuncs, k)
+		completer.analyticFuncs = append(completer.analyticFuncs, k)
+	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "ARRAY_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_CONT")
+	completer.aggFuncs = append(completer.aggFuncs, "PERCENTILE_DISC")
 	for k := range AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -219,6 +235,7 @@ func (c *Completer) Update() {
 	c.updateViews()
 	c.updateCursors()
 	c.updateFunctions()
+	c.updateProcedures()
 	c.updateVariables()
 	c.updateEnvironmentVariables()
 	c.updateAllColumns()
@@ -308,6 +325,16 @@ func (c *Completer) updateFunctions() {
 	c.analyticFuncList = analyticFuncKeys
 }
 
+func (c *Completer) updateProcedures() {
+	procedures := c.scope.AllProcedures()
+	c.procedureList = make([]string, 0, procedures.Len())
+	procedures.Range(func(key, value interface{}) bool {
+		c.procedureList = append(c.procedureList, value.(*UserDefinedProcedure).Name.String())
+		return true
+	})
+	sort.Strings(c.procedureList)
+}
+
 func (c *Completer) updateVariables() {
 	vars := c.scope.AllVariables()
 	varKeys := vars.SortedKeys()
@@ -337,9 +364,12 @@ func (c *Completer) updateAllColumns() {
 
 func (c *Completer) GetStatementPrefix(line string, origLine string, index int) readline.CandidateList {
 	prefix := statementPrefix
-	if 0 < len(c.cursorList) || 0 < len(c.userFuncList) || 0 < len(c.viewList) || 0 < len(c.varList) || 0 < len(c.statementList) {
+	if 0 < len(c.cursorList) || 0 < len(c.userFuncList) || 0 < len(c.procedureList) || 0 < len(c.viewList) || 0 < len(c.varList) || 0 < len(c.statementList) {
 		prefix = append(prefix, "DISPOSE")
 	}
+	if 0 < len(c.procedureList) {
+		prefix = append(prefix, "CALL")
+	}
 	if 0 < len(c.cursorList) {
 		prefix = append(prefix,
 			"OPEN",
@@ -383,6 +413,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return c.ReplaceArgs(line, origLine, index)
 	case parser.DELETE:
 		return c.DeleteArgs(line, origLine, index)
+	case parser.MERGE:
+		return c.MergeArgs(line, origLine, index)
 	case parser.CREATE:
 		return c.CreateArgs(line, origLine, index)
 	case parser.ALTER:
@@ -421,6 +453,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		}
 	case parser.DISPOSE:
 		return c.DisposeArgs(line, origLine, index)
+	case parser.CALL:
+		return c.CallArgs(line, origLine, index)
 	case parser.OPEN:
 		return c.UsingArgs(line, origLine, index)
 	case parser.CLOSE:
@@ -481,6 +515,11 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
 			}
 		}
+	case "SQLITE":
+		if commaCnt == 0 && c.tokens[c.lastIdx].Token == '(' {
+			files := c.ListFiles(line, sqliteExtensions, c.scope.Tx.Flags.Repository)
+			cands = c.identifierList(files, false)
+		}
 	default:
 		switch commaCnt {
 		case 0:
@@ -506,7 +545,7 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 		case 3, 4:
 			if c.tokens[c.lastIdx].Token == ',' {
 				switch strings.ToUpper(c.tokens[0].Literal) {
-				case cmd.CSV.String(), cmd.FIXED.String():
+				case cmd.CSV.String(), cmd.FIXED.String(), cmd.XLSX.String():
 					cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
 				}
 			}
@@ -656,7 +695,7 @@ func (c *Completer) functionArgs(line string, origLine string, index int) readli
 							if funcName == "FIRST_VALUE" ||
 								funcName == "LAST_VALUE" ||
 								funcName == "NTH_VALUE" ||
-								(funcName != "LISTAGG" && funcName != "JSON_AGG" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
+								(funcName != "LISTAGG" && funcName != "JSON_AGG" && funcName != "ARRAY_AGG" && funcName != "PERCENTILE_CONT" && funcName != "PERCENTILE_DISC" && InStrSliceWithCaseInsensitive(funcName, c.aggFuncs)) ||
 								InStrSliceWithCaseInsensitive(funcName, c.userAggFuncs) {
 
 								customList = append(customList, c.candidate("ROWS", true))
@@ -717,7 +756,7 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 				blockLevel++
 			case '(':
 				blockLevel--
-			case parser.SELECT, parser.INSERT, parser.UPDATE, parser.REPLACE, parser.DELETE:
+			case parser.SELECT, parser.INSERT, parser.UPDATE, parser.REPLACE, parser.DELETE, parser.MERGE:
 				if blockLevel == 0 {
 					return i
 				}
@@ -741,6 +780,8 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 			return c.ReplaceArgs(line, origLine, index)
 		case parser.DELETE:
 			return c.DeleteArgs(line, origLine, index)
+		case parser.MERGE:
+			return c.MergeArgs(line, origLine, index)
 		}
 	}
 
@@ -775,6 +816,7 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 								"UPDATE",
 								"REPLACE",
 								"DELETE",
+								"MERGE",
 							)
 						} else {
 							keywords = append(keywords, "AS")
@@ -842,7 +884,7 @@ func (c *Completer) combineTableAlias(fromIdx int) {
 }
 
 func (c *Completer) allTableCandidates(line string, origLine string, index int) readline.CandidateList {
-	list := c.candidateList(append(tableObjectCandidates, "JSON_TABLE()"), false)
+	list := c.candidateList(append(tableObjectCandidates, "JSON_TABLE()", "UNNEST()"), false)
 	list.Sort()
 	list = append(list, c.SearchAllTables(line, origLine, index)...)
 	return list
@@ -1575,6 +1617,110 @@ func (c *Completer) DeleteArgs(line string, origLine string, index int) readline
 	)
 }
 
+func (c *Completer) MergeArgs(line string, origLine string, index int) readline.CandidateList {
+	var isNotMatched = func(i int) bool {
+		for j := i; j >= 0; j-- {
+			if c.tokens[j].Token == parser.MATCHED {
+				return 0 < j && c.tokens[j-1].Token == parser.NOT
+			}
+		}
+		return false
+	}
+
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.VALUES:
+				customList = c.SearchValues(line, origLine, index)
+				if 0 < len(line) {
+					customList = append(customList, c.candidate("JSON_ROW()", false))
+				}
+				if i < c.lastIdx && c.tokens[c.lastIdx].Token == ')' && c.BracketIsEnclosed() {
+					customList = append(customList, c.candidate("WHEN", true))
+				}
+				customList.Sort()
+				return nil, customList, true
+			case parser.INSERT:
+				if i == c.lastIdx || c.tokens[c.lastIdx].Token == ')' {
+					keywords = append(keywords, "VALUES")
+				}
+				return keywords, nil, true
+			case parser.DELETE:
+				if i == c.lastIdx {
+					keywords = append(keywords, "WHEN")
+				}
+				return keywords, nil, true
+			case parser.SET:
+				if c.tokens[c.lastIdx].Token != parser.SET && c.tokens[c.lastIdx].Token != ',' &&
+					c.tokens[c.lastIdx-1].Token != parser.SET && c.tokens[c.lastIdx-1].Token != ',' {
+
+					customList = append(customList, c.SearchValues(line, origLine, index)...)
+					customList.Sort()
+					if c.tokens[c.lastIdx].Token != '=' {
+						keywords = append(keywords, "WHEN")
+					}
+				}
+				return keywords, customList, true
+			case parser.THEN:
+				if i == c.lastIdx {
+					if isNotMatched(i) {
+						keywords = append(keywords, "INSERT")
+					} else {
+						keywords = append(keywords, "UPDATE SET", "DELETE")
+					}
+				}
+				return keywords, nil, true
+			case parser.MATCHED:
+				if i == c.lastIdx {
+					keywords = append(keywords, "AND", "THEN")
+				} else {
+					customList = c.SearchValues(line, origLine, index)
+					customList.Sort()
+					keywords = append(keywords, "THEN")
+				}
+				return keywords, customList, true
+			case parser.WHEN:
+				if i == c.lastIdx {
+					keywords = append(keywords, "MATCHED", "NOT MATCHED")
+				} else if c.tokens[c.lastIdx].Token == parser.NOT {
+					keywords = append(keywords, "MATCHED")
+				}
+				return keywords, nil, true
+			case parser.ON:
+				customList = c.SearchValues(line, origLine, index)
+				customList.Sort()
+				if i < c.lastIdx {
+					keywords = append(keywords, "WHEN")
+				}
+				return keywords, customList, true
+			case parser.USING:
+				if i == c.lastIdx {
+					customList = c.allTableCandidates(line, origLine, index)
+				} else {
+					keywords = append(keywords, "ON")
+				}
+				return keywords, customList, true
+			case parser.INTO:
+				if i == c.lastIdx {
+					customList = c.allTableCandidatesWithSpaceForUpdate(line, origLine, index)
+				} else {
+					keywords = append(keywords, "USING")
+				}
+				return keywords, customList, true
+			case parser.MERGE:
+				if i == c.lastIdx {
+					keywords = append(keywords, "INTO")
+				}
+				return keywords, nil, true
+			}
+			return nil, nil, false
+		},
+	)
+}
+
 func (c *Completer) CreateArgs(line string, origLine string, index int) readline.CandidateList {
 	var isPrepositionOfSelect = func(i int) bool {
 		for j := i; j >= 0; j-- {
@@ -1765,7 +1911,7 @@ func (c *Completer) DeclareArgs(line string, origLine string, index int) readlin
 				case parser.VIEW, ')':
 					return []string{"AS"}, nil, true
 				}
-			case parser.AGGREGATE, parser.FUNCTION:
+			case parser.AGGREGATE, parser.FUNCTION, parser.PROCEDURE:
 			case parser.VAR:
 				switch c.tokens[c.lastIdx].Token {
 				case parser.VARIABLE, ',':
@@ -1779,6 +1925,7 @@ func (c *Completer) DeclareArgs(line string, origLine string, index int) readlin
 						"VIEW",
 						"FUNCTION",
 						"AGGREGATE",
+						"PROCEDURE",
 					}
 					return obj, nil, true
 				} else if i < c.lastIdx-1 && c.tokens[i+1].Token == parser.VARIABLE {
@@ -1896,11 +2043,11 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.encodingList(), false), true
 					case cmd.ExportEncodingFlag:
 						return nil, c.candidateList(exportEncodingsCandidates, false), true
-					case cmd.AnsiQuotesFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
+					case cmd.AnsiQuotesFlag, cmd.ExactDecimalFlag, cmd.NoHeaderFlag, cmd.WithoutNullFlag,
 						cmd.WithoutHeaderFlag, cmd.EncloseAllFlag, cmd.PrettyPrintFlag,
 						cmd.StripEndingLineBreakFlag, cmd.EastAsianEncodingFlag,
 						cmd.CountDiacriticalSignFlag, cmd.CountFormatCodeFlag,
-						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag:
+						cmd.ColorFlag, cmd.QuietFlag, cmd.StatsFlag, cmd.StreamingFlag:
 						return nil, c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false), true
 					case cmd.FormatFlag:
 						return nil, c.candidateList(c.tableFormatList(), false), true
@@ -1996,6 +2143,26 @@ func (c *Completer) RemoveFlagArgs(line string, origLine string, index int) read
 
 }
 
+func (c *Completer) CallArgs(line string, origLine string, index int) readline.CandidateList {
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.CALL:
+				switch i {
+				case c.lastIdx:
+					return nil, c.candidateList(c.procedureList, false), true
+				}
+				return nil, c.SearchValues(line, origLine, index), true
+			default:
+				return nil, nil, false
+			}
+		},
+	)
+}
+
 func (c *Completer) DisposeArgs(line string, origLine string, index int) readline.CandidateList {
 	return c.completeArgs(
 		line,
@@ -2013,6 +2180,11 @@ func (c *Completer) DisposeArgs(line string, origLine string, index int) readlin
 				case c.lastIdx:
 					return nil, c.candidateList(c.userFuncList, false), true
 				}
+			case parser.PROCEDURE:
+				switch i {
+				case c.lastIdx:
+					return nil, c.candidateList(c.procedureList, false), true
+				}
 			case parser.VIEW:
 				switch i {
 				case c.lastIdx:
@@ -2033,6 +2205,9 @@ func (c *Completer) DisposeArgs(line string, origLine string, index int) readlin
 					if 0 < len(c.userFuncList) {
 						items = append(items, "FUNCTION")
 					}
+					if 0 < len(c.procedureList) {
+						items = append(items, "PROCEDURE")
+					}
 					if 0 < len(c.viewList) {
 						items = append(items, "VIEW")
 					}
@@ -2208,6 +2383,7 @@ func (c *Completer) SearchValues(line string, origLine string, index int) readli
 		"IS",
 		"BETWEEN",
 		"LIKE",
+		"REGEXP",
 		"IN",
 		"ANY",
 		"ALL",
@@ -2694,7 +2870,7 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	switch token.Token {
-	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.JSON_TABLE:
+	case parser.CSV, parser.JSON, parser.FIXED, parser.LTSV, parser.XLSX, parser.SQLITE, parser.JSON_TABLE, parser.UNNEST:
 		return true
 	}
 	return false
@@ -2710,7 +2886,9 @@ func (c *Completer) isFunction(token parser.Token) bool {
 
 	return token.Token == parser.SUBSTRING ||
 		token.Token == parser.JSON_OBJECT ||
+		token.Token == parser.GROUPING ||
 		token.Token == parser.IF ||
+		token.Token == parser.CALL ||
 		token.Token == parser.AGGREGATE_FUNCTION ||
 		token.Token == parser.COUNT ||
 		token.Token == parser.LIST_FUNCTION ||
@@ -121,6 +121,7 @@ func TestCompleter_Update(t *testing.T) {
 	_ = scope.DeclareCursor(parser.CursorDeclaration{Cursor: parser.Identifier{Literal: "cur1"}})
 	_ = scope.DeclareFunction(parser.FunctionDeclaration{Name: parser.Identifier{Literal: "scalarfunc"}})
 	_ = scope.DeclareAggregateFunction(parser.AggregateDeclaration{Name: parser.Identifier{Literal: "aggfunc"}})
+	_ = scope.DeclareProcedure(parser.ProcedureDeclaration{Name: parser.Identifier{Literal: "userproc"}})
 	_ = scope.DeclareVariable(ctx, parser.VariableDeclaration{Assignments: []parser.VariableAssignment{{Variable: parser.Variable{Name: "var"}}}})
 
 	_ = TestTx.PreparedStatements.Prepare(scope.Tx.Flags, parser.StatementPreparation{
@@ -135,13 +136,13 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], cmd.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(Functions)+3 {
+	if len(c.funcs) != len(Functions)+4 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions)+5 {
 		t.Error("aggregate functions are not set correctly")
 	}
-	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions) {
+	if len(c.analyticFuncs) != len(AnalyticFunctions)+len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions) {
 		t.Error("analytic functions are not set correctly")
 	}
 
@@ -155,16 +156,19 @@ func TestCompleter_Update(t *testing.T) {
 	if !reflect.DeepEqual(c.userFuncList, []string{"aggfunc", "scalarfunc"}) {
 		t.Error("user defined functions are not set correctly")
 	}
+	if !reflect.DeepEqual(c.procedureList, []string{"userproc"}) {
+		t.Error("stored procedures are not set correctly")
+	}
 	if len(c.statementList) != 1 {
 		t.Error("statement list is not set correctly")
 	}
-	if len(c.funcList) != len(Functions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(Functions)+4+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list is not set correctly")
 	}
-	if len(c.aggFuncList) != len(AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions)+5+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list is not set correctly")
 	}
-	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
+	if len(c.analyticFuncList) != len(AnalyticFunctions)+len(AggregateFunctions)+len(BivariateAggregateFunctions)+len(SketchAggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
 		t.Error("analytic function list is not set correctly")
 	}
 	if !reflect.DeepEqual(c.varList, []string{"@var"}) {
@@ -210,6 +214,7 @@ func testCompleter(t *testing.T, f func(line string, origLine string, index int)
 	completer.userFuncs = []string{"userfunc"}
 	completer.userAggFuncs = []string{"aggfunc"}
 	completer.userFuncList = []string{"userfunc", "aggfunc"}
+	completer.procedureList = []string{"userproc"}
 	completer.funcList = []string{"NOW()", "userfunc()"}
 	completer.aggFuncList = []string{"COUNT()", "aggfunc()"}
 	completer.analyticFuncList = []string{"RANK() OVER ()", "FIRST_VALUE() OVER ()", "aggfunc"}
@@ -255,6 +260,7 @@ var completerStatementsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("ADD"), AppendSpace: true},
 			{Name: []rune("ALTER"), AppendSpace: true},
+			{Name: []rune("CALL"), AppendSpace: true},
 			{Name: []rune("CHDIR"), AppendSpace: true},
 			{Name: []rune("CLOSE"), AppendSpace: true},
 			{Name: []rune("COMMIT")},
@@ -267,6 +273,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("EXIT")},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
 			{Name: []rune("PREPARE"), AppendSpace: true},
 			{Name: []rune("PRINT"), AppendSpace: true},
@@ -381,6 +388,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -531,6 +539,7 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
 			{Name: []rune("PREPARE"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 			{Name: []rune("@var1")},
 			{Name: []rune("@var2")},
@@ -1111,6 +1120,7 @@ var completerWithArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("REPLACE"), AppendSpace: true},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("UPDATE"), AppendSpace: true},
@@ -1248,6 +1258,7 @@ var completerSelectArgsTests = []completerTest{
 		Index:    14,
 		Expect: readline.CandidateList{
 			{Name: []rune("RANK() OVER ()")},
+			{Name: []rune("REGEXP"), AppendSpace: true},
 		},
 	},
 	{
@@ -1312,6 +1323,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1386,6 +1400,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1570,6 +1587,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1591,6 +1611,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1612,6 +1635,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1632,6 +1658,9 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2070,6 +2099,8 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2155,6 +2186,8 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2239,6 +2272,8 @@ var completerReplaceArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2349,6 +2384,9 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2378,6 +2416,9 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("UNNEST()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2421,6 +2462,129 @@ func TestCompleter_DeleteArgs(t *testing.T) {
 	testCompleter(t, completer.DeleteArgs, completerDeleteArgsTests)
 }
 
+var completerMergeArgsTests = []completerTest{
+	{
+		Name:     "MergeArgs",
+		Line:     "",
+		OrigLine: "merge ",
+		Index:    6,
+		Expect: readline.CandidateList{
+			{Name: []rune("INTO"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After INTO",
+		Line:     "",
+		OrigLine: "merge into ",
+		Index:    11,
+		Expect: readline.CandidateList{
+			{Name: []rune("CSV()"), AppendSpace: true},
+			{Name: []rune("FIXED()"), AppendSpace: true},
+			{Name: []rune("JSON()"), AppendSpace: true},
+			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("."), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune(".."), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("sub/"), FormatAsIdentifier: true, AppendSpace: true},
+			{Name: []rune("table1.csv"), FormatAsIdentifier: true, AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After Table Name",
+		Line:     "",
+		OrigLine: "merge into tbl t ",
+		Index:    17,
+		Expect: readline.CandidateList{
+			{Name: []rune("USING"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After Source Table",
+		Line:     "",
+		OrigLine: "merge into tbl t using src ",
+		Index:    27,
+		Expect: readline.CandidateList{
+			{Name: []rune("ON"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After WHEN",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when ",
+		Index:    49,
+		Expect: readline.CandidateList{
+			{Name: []rune("MATCHED"), AppendSpace: true},
+			{Name: []rune("NOT MATCHED"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After MATCHED",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when matched ",
+		Index:    57,
+		Expect: readline.CandidateList{
+			{Name: []rune("AND"), AppendSpace: true},
+			{Name: []rune("THEN"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After THEN for Matched Records",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when matched then ",
+		Index:    62,
+		Expect: readline.CandidateList{
+			{Name: []rune("DELETE"), AppendSpace: true},
+			{Name: []rune("UPDATE SET"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After THEN for Not Matched Records",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when not matched then ",
+		Index:    66,
+		Expect: readline.CandidateList{
+			{Name: []rune("INSERT"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After DELETE",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when matched then delete ",
+		Index:    69,
+		Expect: readline.CandidateList{
+			{Name: []rune("WHEN"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After INSERT",
+		Line:     "",
+		OrigLine: "merge into tbl t using src on t.id = src.id when not matched then insert ",
+		Index:    73,
+		Expect: readline.CandidateList{
+			{Name: []rune("VALUES"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "MergeArgs After VALUES",
+		Line:     "@",
+		OrigLine: "merge into tbl t using src on t.id = src.id when not matched then insert values (@",
+		Index:    82,
+		Expect: readline.CandidateList{
+			{Name: []rune("@var1")},
+			{Name: []rune("@var2")},
+			{Name: []rune("JSON_ROW()")},
+		},
+	},
+}
+
+func TestCompleter_MergeArgs(t *testing.T) {
+	testCompleter(t, completer.MergeArgs, completerMergeArgsTests)
+}
+
 var completerCreateArgsTests = []completerTest{
 	{
 		Name:     "CreateArgs",
@@ -2528,6 +2692,8 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("FIXED()"), AppendSpace: true},
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("SQLITE()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2722,8 +2888,10 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -2834,6 +3002,7 @@ var completerDeclareArgsTests = []completerTest{
 			{Name: []rune("AGGREGATE"), AppendSpace: true},
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 		},
 	},
@@ -3079,7 +3248,9 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("FIXED")},
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -3158,8 +3329,10 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("JSON")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
 		},
 	},
 	{
@@ -3364,6 +3537,7 @@ var completerDisposeArgsTests = []completerTest{
 			{Name: []rune("CURSOR"), AppendSpace: true},
 			{Name: []rune("FUNCTION"), AppendSpace: true},
 			{Name: []rune("PREPARE"), AppendSpace: true},
+			{Name: []rune("PROCEDURE"), AppendSpace: true},
 			{Name: []rune("VIEW"), AppendSpace: true},
 			{Name: []rune("@var1")},
 			{Name: []rune("@var2")},
@@ -3389,6 +3563,15 @@ var completerDisposeArgsTests = []completerTest{
 			{Name: []rune("aggfunc")},
 		},
 	},
+	{
+		Name:     "DisposeArgs After PROCEDURE",
+		Line:     "",
+		OrigLine: "dispose procedure ",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune("userproc")},
+		},
+	},
 	{
 		Name:     "DisposeArgs After VIEW",
 		Line:     "",
@@ -3420,6 +3603,32 @@ func TestCompleter_DisposeArgs(t *testing.T) {
 	testCompleter(t, completer.DisposeArgs, completerDisposeArgsTests)
 }
 
+var completerCallArgsTests = []completerTest{
+	{
+		Name:     "CallArgs",
+		Line:     "",
+		OrigLine: "call ",
+		Index:    5,
+		Expect: readline.CandidateList{
+			{Name: []rune("userproc")},
+		},
+	},
+	{
+		Name:     "CallArgs Arguments",
+		Line:     "@",
+		OrigLine: "call userproc(1, @",
+		Index:    18,
+		Expect: readline.CandidateList{
+			{Name: []rune("@var1")},
+			{Name: []rune("@var2")},
+		},
+	},
+}
+
+func TestCompleter_CallArgs(t *testing.T) {
+	testCompleter(t, completer.CallArgs, completerCallArgsTests)
+}
+
 var completerShowArgsTests = []completerTest{
 	{
 		Name:     "ShowArgs",
@@ -3491,6 +3700,8 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("FIXED()")},
 			{Name: []rune("JSON()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("SQLITE()")},
+			{Name: []rune("XLSX()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -34,6 +35,10 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		return "", encodeJson(ctx, fp, view, options, palette)
 	case cmd.LTSV:
 		return "", encodeLTSV(ctx, fp, view, options)
+	case cmd.PARQUET:
+		return "", encodeParquet(ctx, fp, view)
+	case cmd.XLSX:
+		return "", encodeXlsx(ctx, fp, view, options)
 	case cmd.GFM, cmd.ORG, cmd.TEXT:
 		return encodeText(ctx, fp, view, options, palette)
 	case cmd.TSV:
@@ -44,6 +49,12 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 	}
 }
 
+// isBinaryFormat reports whether the encoded data of the format is not a text,
+// so that no line break can be appended to the end of the data.
+func isBinaryFormat(format cmd.Format) bool {
+	return format == cmd.PARQUET || format == cmd.XLSX
+}
+
 func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
 	w, err := csv.NewWriter(fp, options.LineBreak, options.Encoding)
 	if err != nil {
@@ -64,14 +75,21 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 		return DataEmpty
 	}
 
-	for i := range view.RecordSet {
+	err = writeCSVRecordSet(ctx, w, fields, view.RecordSet, options)
+	if e := w.Flush(); e != nil && err == nil {
+		err = NewSystemError(e.Error())
+	}
+	return err
+}
+
+func writeCSVRecordSet(ctx context.Context, w *csv.Writer, fields []csv.Field, recordSet RecordSet, options cmd.ExportOptions) error {
+	for i := range recordSet {
 		if i&15 == 0 && ctx.Err() != nil {
-			err = ConvertContextError(ctx.Err())
-			break
+			return ConvertContextError(ctx.Err())
 		}
 
-		for j := range view.RecordSet[i] {
-			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], false)
+		for j := range recordSet[i] {
+			str, effect, _ := convertExportFieldContents(recordSet[i][j][0], options)
 			quote := false
 			if options.EncloseAll && (effect == cmd.StringEffect || effect == cmd.DatetimeEffect) {
 				quote = true
@@ -82,9 +100,6 @@ func encodeCSV(ctx context.Context, fp io.Writer, view *View, options cmd.Export
 			return NewSystemError(err.Error())
 		}
 	}
-	if err = w.Flush(); err != nil {
-		return NewSystemError(err.Error())
-	}
 	return nil
 }
 
@@ -121,7 +136,7 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 
 			fields := make([]fixedlen.Field, fieldLen)
 			for j := range view.RecordSet[i] {
-				str, _, a := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				str, _, a := convertExportFieldContents(view.RecordSet[i][j][0], options)
 				fields[j] = fixedlen.NewField(str, a)
 			}
 			fieldList[i+recordStartPos] = fields
@@ -175,7 +190,7 @@ func encodeFixedLengthFormat(ctx context.Context, fp io.Writer, view *View, opti
 			}
 
 			for j := range view.RecordSet[i] {
-				str, _, a := ConvertFieldContents(view.RecordSet[i][j][0], false)
+				str, _, a := convertExportFieldContents(view.RecordSet[i][j][0], options)
 				fields[j] = fixedlen.NewField(str, a)
 			}
 			if err := w.Write(fields); err != nil {
@@ -367,25 +382,51 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options cmd.Expor
 		return NewDataEncodingError(err.Error())
 	}
 
-	fields := make([]string, view.FieldLen())
-	for i := range view.RecordSet {
+	if err = writeLTSVRecordSet(ctx, w, make([]string, view.FieldLen()), view.RecordSet, options); err != nil {
+		return err
+	}
+	if err = w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func writeLTSVRecordSet(ctx context.Context, w *ltsv.Writer, fields []string, recordSet RecordSet, options cmd.ExportOptions) error {
+	for i := range recordSet {
 		if i&15 == 0 && ctx.Err() != nil {
 			return ConvertContextError(ctx.Err())
 		}
 
-		for j := range view.RecordSet[i] {
-			fields[j], _, _ = ConvertFieldContents(view.RecordSet[i][j][0], false)
+		for j := range recordSet[i] {
+			fields[j], _, _ = convertExportFieldContents(recordSet[i][j][0], options)
 		}
 		if err := w.Write(fields); err != nil {
 			return NewDataEncodingError(err.Error())
 		}
 	}
-	if err = w.Flush(); err != nil {
-		return NewSystemError(err.Error())
-	}
 	return nil
 }
 
+// convertExportFieldContents converts a field value for delimited formats.
+// Elements of an array are joined with the array separator if it is specified.
+// Elements containing the separator or double quotes are enclosed in double quotes,
+// and double quotes in the elements are escaped by doubling them.
+func convertExportFieldContents(val value.Primary, options cmd.ExportOptions) (string, string, text.FieldAlignment) {
+	arr, ok := val.(*value.Array)
+	if !ok || len(options.ArraySeparator) < 1 {
+		return ConvertFieldContents(val, false)
+	}
+
+	elems := make([]string, arr.Len())
+	for i, v := range arr.Raw() {
+		elems[i], _, _ = ConvertFieldContents(v, false)
+		if strings.Contains(elems[i], options.ArraySeparator) || strings.Contains(elems[i], "\"") {
+			elems[i] = "\"" + strings.ReplaceAll(elems[i], "\"", "\"\"") + "\""
+		}
+	}
+	return strings.Join(elems, options.ArraySeparator), cmd.StringEffect, text.NotAligned
+}
+
 func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string, text.FieldAlignment) {
 	var s string
 	var effect = cmd.NoEffect
@@ -403,6 +444,10 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 		s = val.(*value.Float).String()
 		effect = cmd.NumberEffect
 		align = text.RightAligned
+	case *value.Decimal:
+		s = val.(*value.Decimal).String()
+		effect = cmd.NumberEffect
+		align = text.RightAligned
 	case *value.Boolean:
 		s = val.(*value.Boolean).String()
 		effect = cmd.BooleanEffect
@@ -421,6 +466,12 @@ func ConvertFieldContents(val value.Primary, forTextTable bool) (string, string,
 	case *value.Datetime:
 		s = val.(*value.Datetime).Format(time.RFC3339Nano)
 		effect = cmd.DatetimeEffect
+	case *value.Interval:
+		s = val.(*value.Interval).String()
+		effect = cmd.DatetimeEffect
+	case *value.Array:
+		s = json.ParseValueToStructure(val).Encode()
+		effect = cmd.StringEffect
 	case *value.Null:
 		if forTextTable {
 			s = "NULL"
@@ -25,6 +25,7 @@ var encodeViewTests = []struct {
 	WriteAsSingleLine       bool
 	WithoutHeader           bool
 	EncloseAll              bool
+	ArraySeparator          string
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
 	UseColor                bool
@@ -263,6 +264,45 @@ var encodeViewTests = []struct {
 			"2.0123,\"2016-02-01T16:00:00.123456-07:00\",\"abcdef\"\n" +
 			"34567890,\" abcdefghijklmnopqrstuvwxyzabcdefg\nhi\"\"jk\n\",",
 	},
+	{
+		Name: "CSV Array",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewNull()})}),
+			},
+		},
+		Format: cmd.CSV,
+		Result: "c1,c2\n" +
+			"1,\"[1,\"\"a\"\",null]\"",
+	},
+	{
+		Name: "CSV Array with Separator",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewNull()})}),
+			},
+		},
+		Format:         cmd.CSV,
+		ArraySeparator: "|",
+		EncloseAll:     true,
+		Result: "\"c1\",\"c2\"\n" +
+			"1,\"1|a|\"",
+	},
+	{
+		Name: "TSV Array with Separator Contained in Elements",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewArray([]value.Primary{value.NewString("1"), value.NewString("a|b"), value.NewString("c\"d")})}),
+			},
+		},
+		Format:         cmd.TSV,
+		ArraySeparator: "|",
+		Result: "c1\tc2\n" +
+			"1\t1|\"a|b\"|\"c\"\"d\"",
+	},
 	{
 		Name: "CSV Line Break CRLF",
 		View: &View{
@@ -316,6 +356,18 @@ var encodeViewTests = []struct {
 			"}" +
 			"]",
 	},
+	{
+		Name: "JSON Array",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewNull()})}),
+			},
+		},
+		Format:         cmd.JSON,
+		ArraySeparator: "|",
+		Result:         "[{\"c1\":[1,\"a\",null]}]",
+	},
 	{
 		Name: "JSONH",
 		View: &View{
@@ -510,6 +562,7 @@ func TestEncodeView(t *testing.T) {
 		options.LineBreak = v.LineBreak
 		options.WithoutHeader = v.WithoutHeader
 		options.EncloseAll = v.EncloseAll
+		options.ArraySeparator = v.ArraySeparator
 		options.JsonEscape = v.JsonEscape
 		options.PrettyPrint = v.PrettyPrint
 		options.SingleLine = v.WriteAsSingleLine
@@ -127,6 +127,21 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgInvalidRegularExpression             = "invalid regular expression: %s"
+	ErrMsgProcedureNotExist                    = "procedure %s does not exist"
+	ErrMsgProcedureArgumentsLength             = "procedure %s takes %s"
+	ErrMsgProcedureOutArgument                 = "argument %s for OUT parameter %s is not a variable"
+	ErrMsgProcedureRedeclared                  = "procedure %s is redeclared"
+	ErrMsgMergeRecordAmbiguous                 = "record in %s is matched by more than one source record"
+	ErrMsgReturningClauseNotSpecified          = "data-modifying query used as a table requires a RETURNING clause"
+	ErrMsgNestedDataModifyingQuery             = "data-modifying query cannot be used as a table within another data-modifying statement"
+	ErrMsgWindowRedeclared                     = "window %s is redeclared"
+	ErrMsgUndefinedWindow                      = "window %s is undefined"
+	ErrMsgWindowClauseOverridden               = "window %s already has %s"
+	ErrMsgInvalidWindowFrame                   = "invalid window frame for function %s: %s"
+	ErrMsgInvalidInterval                      = "invalid interval %s: %s"
+	ErrMsgInvalidTimeZone                      = "invalid time zone for %s: %s"
+	ErrMsgStatementNotAllowed                  = "%s is not allowed in server mode"
 )
 
 type Error interface {
@@ -1451,6 +1466,160 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type InvalidRegularExpressionError struct {
+	*BaseError
+}
+
+func NewInvalidRegularExpressionError(expr parser.QueryExpression, message string) error {
+	return &InvalidRegularExpressionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidRegularExpression, message), ReturnCodeApplicationError, ErrorInvalidRegularExpression),
+	}
+}
+
+type ProcedureNotExistError struct {
+	*BaseError
+}
+
+func NewProcedureNotExistError(name parser.Identifier) error {
+	return &ProcedureNotExistError{
+		NewBaseError(name, fmt.Sprintf(ErrMsgProcedureNotExist, name.Literal), ReturnCodeApplicationError, ErrorProcedureNotExist),
+	}
+}
+
+type ProcedureArgumentLengthError struct {
+	*BaseError
+}
+
+func NewProcedureArgumentLengthError(expr parser.CallProcedure, paramslen int) error {
+	argstr := FormatCount(paramslen, "argument")
+	if 0 < paramslen {
+		argstr = "exactly " + argstr
+	}
+	return &ProcedureArgumentLengthError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgProcedureArgumentsLength, expr.Name.Literal, argstr), ReturnCodeApplicationError, ErrorProcedureArgumentsLength),
+	}
+}
+
+type ProcedureOutArgumentError struct {
+	*BaseError
+}
+
+func NewProcedureOutArgumentError(arg parser.QueryExpression, param parser.Variable) error {
+	return &ProcedureOutArgumentError{
+		NewBaseError(arg, fmt.Sprintf(ErrMsgProcedureOutArgument, arg, param), ReturnCodeApplicationError, ErrorProcedureOutArgument),
+	}
+}
+
+type ProcedureRedeclaredError struct {
+	*BaseError
+}
+
+func NewProcedureRedeclaredError(expr parser.Identifier) error {
+	return &ProcedureRedeclaredError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgProcedureRedeclared, expr.Literal), ReturnCodeApplicationError, ErrorProcedureRedeclared),
+	}
+}
+
+type MergeRecordAmbiguousError struct {
+	*BaseError
+}
+
+func NewMergeRecordAmbiguousError(expr parser.MergeWhenClause, table parser.Identifier) error {
+	return &MergeRecordAmbiguousError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgMergeRecordAmbiguous, table), ReturnCodeApplicationError, ErrorMergeRecordAmbiguous),
+	}
+}
+
+type ReturningClauseNotSpecifiedError struct {
+	*BaseError
+}
+
+func NewReturningClauseNotSpecifiedError(expr parser.DataModifyingSubquery) error {
+	return &ReturningClauseNotSpecifiedError{
+		NewBaseError(expr, ErrMsgReturningClauseNotSpecified, ReturnCodeApplicationError, ErrorReturningClauseNotSpecified),
+	}
+}
+
+type NestedDataModifyingQueryError struct {
+	*BaseError
+}
+
+func NewNestedDataModifyingQueryError(expr parser.DataModifyingSubquery) error {
+	return &NestedDataModifyingQueryError{
+		NewBaseError(expr, ErrMsgNestedDataModifyingQuery, ReturnCodeApplicationError, ErrorNestedDataModifyingQuery),
+	}
+}
+
+type WindowRedeclaredError struct {
+	*BaseError
+}
+
+func NewWindowRedeclaredError(expr parser.Identifier) error {
+	return &WindowRedeclaredError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgWindowRedeclared, expr.Literal), ReturnCodeApplicationError, ErrorWindowRedeclared),
+	}
+}
+
+type UndefinedWindowError struct {
+	*BaseError
+}
+
+func NewUndefinedWindowError(expr parser.Identifier) error {
+	return &UndefinedWindowError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgUndefinedWindow, expr.Literal), ReturnCodeApplicationError, ErrorUndefinedWindow),
+	}
+}
+
+type WindowClauseOverriddenError struct {
+	*BaseError
+}
+
+func NewWindowClauseOverriddenError(expr parser.Identifier, clause string) error {
+	return &WindowClauseOverriddenError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgWindowClauseOverridden, expr.Literal, clause), ReturnCodeApplicationError, ErrorWindowClauseOverridden),
+	}
+}
+
+type InvalidWindowFrameError struct {
+	*BaseError
+}
+
+func NewInvalidWindowFrameError(expr parser.AnalyticFunction, message string) error {
+	return &InvalidWindowFrameError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidWindowFrame, expr.Name, message), ReturnCodeApplicationError, ErrorInvalidWindowFrame),
+	}
+}
+
+type InvalidIntervalError struct {
+	*BaseError
+}
+
+func NewInvalidIntervalError(expr parser.Interval, message string) error {
+	return &InvalidIntervalError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidInterval, expr, message), ReturnCodeApplicationError, ErrorInvalidInterval),
+	}
+}
+
+type InvalidTimeZoneError struct {
+	*BaseError
+}
+
+func NewInvalidTimeZoneError(expr parser.AtTimeZone, message string) error {
+	return &InvalidTimeZoneError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidTimeZone, expr, message), ReturnCodeApplicationError, ErrorInvalidTimeZone),
+	}
+}
+
+type StatementNotAllowedError struct {
+	*BaseError
+}
+
+func NewStatementNotAllowedError(expr parser.Expression, statement string) error {
+	return &StatementNotAllowedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgStatementNotAllowed, statement), ReturnCodeApplicationError, ErrorStatementNotAllowed),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
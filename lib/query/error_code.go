@@ -107,6 +107,21 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorInvalidRegularExpression             = 14101
+	ErrorProcedureNotExist                    = 14201
+	ErrorProcedureArgumentsLength             = 14202
+	ErrorProcedureOutArgument                 = 14203
+	ErrorProcedureRedeclared                  = 14204
+	ErrorMergeRecordAmbiguous                 = 14301
+	ErrorReturningClauseNotSpecified          = 14401
+	ErrorNestedDataModifyingQuery             = 14402
+	ErrorWindowRedeclared                     = 14501
+	ErrorUndefinedWindow                      = 14502
+	ErrorWindowClauseOverridden               = 14503
+	ErrorInvalidWindowFrame                   = 14504
+	ErrorInvalidInterval                      = 14601
+	ErrorInvalidTimeZone                      = 14602
+	ErrorStatementNotAllowed                  = 14701
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	"context"
+	"math"
 	"os"
 	"strings"
 
@@ -25,7 +26,7 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 
 	switch expr.(type) {
 	case parser.PrimitiveType:
-		val = expr.(parser.PrimitiveType).Value
+		val = evalPrimitiveType(scope, expr.(parser.PrimitiveType))
 	case parser.FieldReference, parser.ColumnNumber:
 		val, err = evalFieldReference(expr, scope)
 	case parser.Parentheses:
@@ -36,6 +37,14 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalUnaryArithmetic(ctx, scope, expr.(parser.UnaryArithmetic))
 	case parser.Concat:
 		val, err = evalConcat(ctx, scope, expr.(parser.Concat))
+	case parser.ArrayConstructor:
+		val, err = evalArrayConstructor(ctx, scope, expr.(parser.ArrayConstructor))
+	case parser.Interval:
+		val, err = evalInterval(ctx, scope, expr.(parser.Interval))
+	case parser.AtTimeZone:
+		val, err = evalAtTimeZone(ctx, scope, expr.(parser.AtTimeZone))
+	case parser.ArraySubscript:
+		val, err = evalArraySubscript(ctx, scope, expr.(parser.ArraySubscript))
 	case parser.Comparison:
 		val, err = evalComparison(ctx, scope, expr.(parser.Comparison))
 	case parser.Is:
@@ -44,6 +53,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalBetween(ctx, scope, expr.(parser.Between))
 	case parser.Like:
 		val, err = evalLike(ctx, scope, expr.(parser.Like))
+	case parser.RegExp:
+		val, err = evalRegExp(ctx, scope, expr.(parser.RegExp))
 	case parser.In:
 		val, err = evalIn(ctx, scope, expr.(parser.In))
 	case parser.Any:
@@ -60,6 +71,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalAggregateFunction(ctx, scope, expr.(parser.AggregateFunction))
 	case parser.ListFunction:
 		val, err = evalListFunction(ctx, scope, expr.(parser.ListFunction))
+	case parser.AnalyticFunction:
+		val, err = evalAnalyticFunctionResult(scope, expr.(parser.AnalyticFunction))
 	case parser.CaseExpr:
 		val, err = evalCaseExpr(ctx, scope, expr.(parser.CaseExpr))
 	case parser.Logic:
@@ -71,7 +84,7 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 	case parser.EnvironmentVariable:
 		val = value.NewString(os.Getenv(expr.(parser.EnvironmentVariable).Name))
 	case parser.RuntimeInformation:
-		val, err = GetRuntimeInformation(scope.Tx, expr.(parser.RuntimeInformation))
+		val, err = GetRuntimeInformation(scope, expr.(parser.RuntimeInformation))
 	case parser.Flag:
 		if v, ok := scope.Tx.GetFlag(expr.(parser.Flag).Name); ok {
 			val = v
@@ -180,6 +193,29 @@ func evalFieldReference(expr parser.QueryExpression, scope *ReferenceScope) (val
 	return p, nil
 }
 
+func evalAnalyticFunctionResult(scope *ReferenceScope, expr parser.AnalyticFunction) (value.Primary, error) {
+	if 0 < len(scope.Records) {
+		if idx, ok := scope.Records[0].view.Header.ContainsObject(expr); ok {
+			if scope.Records[0].IsInRange() {
+				return scope.Records[0].view.RecordSet[scope.Records[0].recordIndex][idx][0], nil
+			}
+			return value.NewNull(), nil
+		}
+	}
+	return nil, NewInvalidValueExpressionError(expr)
+}
+
+// evalPrimitiveType returns the value of the literal.
+// Float literals are evaluated as decimals if the ExactDecimal flag is set.
+func evalPrimitiveType(scope *ReferenceScope, expr parser.PrimitiveType) value.Primary {
+	if _, ok := expr.Value.(*value.Float); ok && scope.Tx.Flags.ExactDecimal && 0 < len(expr.Literal) {
+		if d, ok := value.ParseDecimal(expr.Literal); ok {
+			return d
+		}
+	}
+	return expr.Value
+}
+
 func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arithmetic) (value.Primary, error) {
 	lhs, err := Evaluate(ctx, scope, expr.LHS)
 	if err != nil {
@@ -194,7 +230,7 @@ func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arit
 		return nil, err
 	}
 
-	return Calculate(lhs, rhs, expr.Operator.Token), nil
+	return Calculate(lhs, rhs, expr.Operator.Token, scope.Tx.Flags), nil
 }
 
 func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.UnaryArithmetic) (value.Primary, error) {
@@ -203,16 +239,44 @@ func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser
 		return nil, err
 	}
 
+	if d, ok := ope.(*value.Decimal); ok {
+		if expr.Operator.Token == '-' {
+			return d.Neg(), nil
+		}
+		return d, nil
+	}
+
+	if iv, ok := ope.(*value.Interval); ok {
+		if expr.Operator.Token == '-' {
+			return iv.Neg(), nil
+		}
+		return iv, nil
+	}
+
 	if pi := value.ToInteger(ope); !value.IsNull(pi) {
 		val := pi.(*value.Integer).Raw()
 		value.Discard(pi)
 		switch expr.Operator.Token {
 		case '-':
+			if val == math.MinInt64 {
+				return value.NewDecimalFromInt64(val).Neg(), nil
+			}
 			val = val * -1
 		}
 		return value.NewInteger(val), nil
 	}
 
+	if scope.Tx.Flags.ExactDecimal {
+		pd := value.ToDecimal(ope)
+		if value.IsNull(pd) {
+			return value.NewNull(), nil
+		}
+		if expr.Operator.Token == '-' {
+			return pd.(*value.Decimal).Neg(), nil
+		}
+		return pd, nil
+	}
+
 	pf := value.ToFloat(ope)
 	if value.IsNull(pf) {
 		return value.NewNull(), nil
@@ -246,6 +310,99 @@ func evalConcat(ctx context.Context, scope *ReferenceScope, expr parser.Concat)
 	return value.NewString(strings.Join(items, "")), nil
 }
 
+func evalArrayConstructor(ctx context.Context, scope *ReferenceScope, expr parser.ArrayConstructor) (value.Primary, error) {
+	values := make([]value.Primary, len(expr.Values))
+	for i, v := range expr.Values {
+		p, err := Evaluate(ctx, scope, v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = p
+	}
+	return value.NewArray(values), nil
+}
+
+func evalInterval(ctx context.Context, scope *ReferenceScope, expr parser.Interval) (value.Primary, error) {
+	p, err := Evaluate(ctx, scope, expr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expr.Unit.Literal) < 1 {
+		iv := value.ToInterval(p)
+		if value.IsNull(iv) {
+			return nil, NewInvalidIntervalError(expr, "the value must be an ISO 8601 duration or a duration string")
+		}
+		return iv, nil
+	}
+
+	amount := value.ToFloat(p)
+	if value.IsNull(amount) {
+		return nil, NewInvalidIntervalError(expr, "the amount of interval must be a number")
+	}
+	f := amount.(*value.Float).Raw()
+	value.Discard(amount)
+
+	iv, err := value.NewIntervalFromUnit(f, expr.Unit.Literal)
+	if err != nil {
+		return nil, NewInvalidIntervalError(expr, err.Error())
+	}
+	return iv, nil
+}
+
+func evalAtTimeZone(ctx context.Context, scope *ReferenceScope, expr parser.AtTimeZone) (value.Primary, error) {
+	p, err := Evaluate(ctx, scope, expr.Value)
+	if err != nil {
+		return nil, err
+	}
+	tz, err := Evaluate(ctx, scope, expr.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := value.ToDatetime(p, scope.Tx.Flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	t := dt.(*value.Datetime).Raw()
+	value.Discard(dt)
+
+	s := value.ToString(tz)
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	name := s.(*value.String).Raw()
+	value.Discard(s)
+
+	loc, err := cmd.LoadLocation(name)
+	if err != nil {
+		return nil, NewInvalidTimeZoneError(expr, err.Error())
+	}
+	return value.NewDatetime(t.In(loc)), nil
+}
+
+func evalArraySubscript(ctx context.Context, scope *ReferenceScope, expr parser.ArraySubscript) (value.Primary, error) {
+	p, err := Evaluate(ctx, scope, expr.Array)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := Evaluate(ctx, scope, expr.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := p.(*value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	i := value.ToInteger(idx)
+	if value.IsNull(i) {
+		return value.NewNull(), nil
+	}
+	defer value.Discard(i)
+	return array.Index(i.(*value.Integer).Raw()), nil
+}
+
 func evalRowOrSingleValue(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) (value.RowValue, value.Primary, error) {
 	var rv value.RowValue
 	var sv value.Primary
@@ -494,6 +651,26 @@ func evalLike(ctx context.Context, scope *ReferenceScope, expr parser.Like) (val
 	return value.NewTernary(t), nil
 }
 
+func evalRegExp(ctx context.Context, scope *ReferenceScope, expr parser.RegExp) (value.Primary, error) {
+	lhs, err := Evaluate(ctx, scope, expr.LHS)
+	if err != nil {
+		return nil, err
+	}
+	pattern, err := Evaluate(ctx, scope, expr.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := RegExp(lhs, pattern)
+	if err != nil {
+		return nil, NewInvalidRegularExpressionError(expr.Pattern, err.Error())
+	}
+	if expr.IsNegated() {
+		t = ternary.Not(t)
+	}
+	return value.NewTernary(t), nil
+}
+
 func evalExists(ctx context.Context, scope *ReferenceScope, expr parser.Exists) (value.Primary, error) {
 	view, err := Select(ctx, scope, expr.Query.Query)
 	if err != nil {
@@ -534,7 +711,7 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	var ok bool
 	var err error
 
-	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" {
+	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" && name != "GROUPING" {
 		udfn, err = scope.GetFunction(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -555,6 +732,8 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 
 	if name == "JSON_OBJECT" {
 		return JsonObject(ctx, scope, expr)
+	} else if name == "GROUPING" {
+		return Grouping(scope, expr)
 	}
 
 	args := make([]value.Primary, len(expr.Args))
@@ -580,29 +759,43 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 
 func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr parser.AggregateFunction) (value.Primary, error) {
 	var aggfn func([]value.Primary, *cmd.Flags) value.Primary
+	var bifn BivariateAggregateFunction
+	var newSketch func() Sketch
 	var udfn *UserDefinedFunction
 	var err error
 
 	uname := strings.ToUpper(expr.Name)
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
+	} else if fn, ok := BivariateAggregateFunctions[uname]; ok {
+		bifn = fn
+	} else if fn, ok := SketchAggregateFunctions[uname]; ok {
+		if newSketch, err = initSketch(ctx, scope, expr, expr.Name, expr.Args, expr.IsDistinct(), fn); err != nil {
+			return nil, err
+		}
 	} else {
 		if udfn, err = scope.GetFunction(expr, uname); err != nil || !udfn.IsAggregate {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
 		}
 	}
 
-	if aggfn == nil {
+	if udfn != nil {
 		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
 			return nil, err
 		}
-	} else {
+	} else if bifn != nil {
+		if len(expr.Args) != 2 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{2})
+		}
+	} else if aggfn != nil {
 		if len(expr.Args) != 1 {
 			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
 		}
 	}
 
 	var list []value.Primary
+	var xlist []value.Primary
+	var sketch Sketch
 	if 0 < len(scope.Records) {
 		if !scope.Records[0].view.isGrouped {
 			return nil, NewNotGroupingRecordsError(expr, expr.Name)
@@ -613,7 +806,7 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			listExpr = parser.NewIntegerValue(1)
 		}
 
-		if uname == "COUNT" {
+		if uname == "COUNT" && expr.Filter == nil {
 			if pt, ok := listExpr.(parser.PrimitiveType); ok {
 				v := pt.Value
 				if !value.IsNull(v) && !value.IsUnknown(v) && scope.Records[0].IsInRange() {
@@ -629,14 +822,35 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			if err != nil {
 				return nil, err
 			}
-			list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
+			if expr.Filter != nil {
+				if err = view.filterForAggregateFunctions(ctx, scope, expr, expr.Filter.(parser.FilterClause)); err != nil {
+					return nil, err
+				}
+			}
+			if newSketch != nil {
+				sketch, err = view.SketchForAggregateFunctions(ctx, scope, expr, expr.Args[0], newSketch)
+			} else if bifn != nil {
+				list, xlist, err = view.ListPairsForAggregateFunctions(ctx, scope, expr, expr.Args[0], expr.Args[1], expr.IsDistinct())
+			} else {
+				list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
+			}
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
-	if aggfn == nil {
+	if newSketch != nil {
+		if sketch == nil {
+			sketch = newSketch()
+		}
+		return sketch.Result(), nil
+	}
+	if bifn != nil {
+		return bifn(list, xlist, scope.Tx.Flags), nil
+	}
+
+	if udfn != nil {
 		argsExprs := expr.Args[1:]
 		args := make([]value.Primary, len(argsExprs))
 		for i, v := range argsExprs {
@@ -654,11 +868,20 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 
 func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (value.Primary, error) {
 	var separator string
+	var fraction float64
 	var err error
 
-	switch strings.ToUpper(expr.Name) {
-	case "JSON_AGG":
+	uname := strings.ToUpper(expr.Name)
+	var listExpr parser.QueryExpression
+
+	switch uname {
+	case "JSON_AGG", "ARRAY_AGG":
 		err = checkArgsForJsonAgg(expr)
+	case "PERCENTILE_CONT", "PERCENTILE_DISC":
+		fraction, err = checkArgsForPercentile(ctx, scope, expr, expr.Name, expr.Args, expr.OrderBy)
+		if err == nil {
+			listExpr = expr.OrderBy.(parser.OrderByClause).Items[0].(parser.OrderItem).Value
+		}
 	default: // LISTAGG
 		separator, err = checkArgsForListFunction(ctx, scope, expr)
 	}
@@ -666,6 +889,9 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 	if err != nil {
 		return nil, err
 	}
+	if listExpr == nil {
+		listExpr = expr.Args[0]
+	}
 
 	var list []value.Primary
 	if 0 < len(scope.Records) {
@@ -673,26 +899,39 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 			return nil, NewNotGroupingRecordsError(expr, expr.Name)
 		}
 
-		view, err := NewViewFromGroupedRecord(ctx, scope.Tx.Flags, scope.Records[0])
-		if err != nil {
-			return nil, err
-		}
-		if expr.OrderBy != nil {
-			err := view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause))
+		if scope.Records[0].IsInRange() {
+			view, err := NewViewFromGroupedRecord(ctx, scope.Tx.Flags, scope.Records[0])
 			if err != nil {
 				return nil, err
 			}
-		}
+			if expr.Filter != nil {
+				if err = view.filterForAggregateFunctions(ctx, scope, expr, expr.Filter.(parser.FilterClause)); err != nil {
+					return nil, err
+				}
+			}
+			if expr.OrderBy != nil {
+				err := view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause))
+				if err != nil {
+					return nil, err
+				}
+			}
 
-		list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, expr.Args[0], expr.IsDistinct())
-		if err != nil {
-			return nil, err
+			list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	switch strings.ToUpper(expr.Name) {
+	switch uname {
 	case "JSON_AGG":
 		return JsonAgg(list), nil
+	case "ARRAY_AGG":
+		return ArrayAgg(list), nil
+	case "PERCENTILE_CONT":
+		return PercentileCont(list, fraction, scope.Tx.Flags), nil
+	case "PERCENTILE_DISC":
+		return PercentileDisc(list, fraction), nil
 	}
 	return ListAgg(list, separator), nil
 }
@@ -719,6 +958,49 @@ func checkArgsForListFunction(ctx context.Context, scope *ReferenceScope, expr p
 	return separator, nil
 }
 
+func initSketch(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, name string, args []parser.QueryExpression, distinct bool, fn SketchAggregateFunction) (func() Sketch, error) {
+	if len(args) != fn.ArgsLen {
+		return nil, NewFunctionArgumentLengthError(expr, name, []int{fn.ArgsLen})
+	}
+	if distinct {
+		return nil, NewFunctionInvalidArgumentError(expr, name, "DISTINCT cannot be specified")
+	}
+
+	values := make([]value.Primary, len(args)-1)
+	for i, v := range args[1:] {
+		p, err := Evaluate(ctx, scope, v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = p
+	}
+	return fn.Init(expr, name, values)
+}
+
+func checkArgsForPercentile(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, name string, args []parser.QueryExpression, orderBy parser.QueryExpression) (float64, error) {
+	if 1 != len(args) {
+		return 0, NewFunctionArgumentLengthError(expr, name, []int{1})
+	}
+	if orderBy == nil || len(orderBy.(parser.OrderByClause).Items) != 1 {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "WITHIN GROUP clause with one sort key is required")
+	}
+
+	p, err := Evaluate(ctx, scope, args[0])
+	if err != nil {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	f := value.ToFloat(p)
+	if value.IsNull(f) {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	fraction := f.(*value.Float).Raw()
+	value.Discard(f)
+	if fraction < 0 || 1 < fraction {
+		return 0, NewFunctionInvalidArgumentError(expr, name, "the first argument must be a number between 0 and 1")
+	}
+	return fraction, nil
+}
+
 func checkArgsForJsonAgg(expr parser.ListFunction) error {
 	if 1 != len(expr.Args) {
 		return NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
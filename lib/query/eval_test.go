@@ -2,6 +2,8 @@ package query
 
 import (
 	"context"
+	"math"
+	"math/big"
 	"os"
 	"reflect"
 	"sync"
@@ -43,6 +45,158 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewString("str"),
 	},
+	{
+		Name: "ArrayConstructor",
+		Expr: parser.ArrayConstructor{
+			Values: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.NewStringValue("str"),
+				parser.NewNullValue(),
+			},
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewInteger(1),
+			value.NewString("str"),
+			value.NewNull(),
+		}),
+	},
+	{
+		Name: "ArrayConstructor Evaluation Error",
+		Expr: parser.ArrayConstructor{
+			Values: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "ArraySubscript",
+		Expr: parser.ArraySubscript{
+			Array: parser.ArrayConstructor{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValue(1),
+					parser.NewIntegerValue(2),
+					parser.NewIntegerValue(3),
+				},
+			},
+			Index: parser.NewIntegerValue(2),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "ArraySubscript Negative Index",
+		Expr: parser.ArraySubscript{
+			Array: parser.ArrayConstructor{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValue(1),
+					parser.NewIntegerValue(2),
+					parser.NewIntegerValue(3),
+				},
+			},
+			Index: parser.NewIntegerValue(-1),
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "ArraySubscript Index Out of Range",
+		Expr: parser.ArraySubscript{
+			Array: parser.ArrayConstructor{
+				Values: []parser.QueryExpression{
+					parser.NewIntegerValue(1),
+				},
+			},
+			Index: parser.NewIntegerValue(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArraySubscript Not Array",
+		Expr: parser.ArraySubscript{
+			Array: parser.NewStringValue("str"),
+			Index: parser.NewIntegerValue(1),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Interval",
+		Expr: parser.Interval{
+			Value: parser.NewIntegerValue(3),
+			Unit:  parser.Identifier{Literal: "day"},
+		},
+		Result: value.NewInterval(0, 3, 0),
+	},
+	{
+		Name: "Interval with Duration String",
+		Expr: parser.Interval{
+			Value: parser.NewStringValue("1h30m"),
+		},
+		Result: value.NewInterval(0, 0, int64(90*time.Minute)),
+	},
+	{
+		Name: "Interval with ISO 8601 Duration",
+		Expr: parser.Interval{
+			Value: parser.NewStringValue("P1Y2D"),
+		},
+		Result: value.NewInterval(12, 2, 0),
+	},
+	{
+		Name: "Interval Invalid Duration Error",
+		Expr: parser.Interval{
+			Value: parser.NewStringValue("3 days"),
+		},
+		Error: "invalid interval INTERVAL '3 days': the value must be an ISO 8601 duration or a duration string",
+	},
+	{
+		Name: "Interval Amount Not Integer Error",
+		Expr: parser.Interval{
+			Value: parser.NewFloatValueFromString("1.5"),
+			Unit:  parser.Identifier{Literal: "month"},
+		},
+		Error: "invalid interval INTERVAL 1.5 MONTH: the amount of interval for MONTH must be an integer",
+	},
+	{
+		Name: "Interval Unknown Unit Error",
+		Expr: parser.Interval{
+			Value: parser.NewIntegerValue(1),
+			Unit:  parser.Identifier{Literal: "fortnight"},
+		},
+		Error: "invalid interval INTERVAL 1 FORTNIGHT: fortnight is an unknown interval unit",
+	},
+	{
+		Name: "AtTimeZone",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewStringValue("2012-02-03T09:18:15+09:00"),
+			TimeZone: parser.NewStringValue("utc"),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 0, 18, 15, 0, time.UTC)),
+	},
+	{
+		Name: "AtTimeZone Null Time Zone",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewStringValue("2012-02-03T09:18:15+09:00"),
+			TimeZone: parser.NewNullValue(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "AtTimeZone Invalid Time Zone Error",
+		Expr: parser.AtTimeZone{
+			Value:    parser.NewStringValue("2012-02-03T09:18:15+09:00"),
+			TimeZone: parser.NewStringValue("Asia/NotExist"),
+		},
+		Error: "invalid time zone for '2012-02-03T09:18:15+09:00' AT TIME ZONE 'Asia/NotExist': timezone \"Asia/NotExist\" does not exist",
+	},
+	{
+		Name: "UnaryArithmetic Interval",
+		Expr: parser.UnaryArithmetic{
+			Operand: parser.Interval{
+				Value: parser.NewIntegerValue(2),
+				Unit:  parser.Identifier{Literal: "hour"},
+			},
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		Result: value.NewInterval(0, 0, int64(-2*time.Hour)),
+	},
 	{
 		Name: "FieldReference",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -297,6 +451,22 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewFloat(-1.234),
 	},
+	{
+		Name: "UnaryArithmetic Decimal",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.PrimitiveType{Value: value.NewDecimal(big.NewInt(1234), 3)},
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		Result: value.NewDecimal(big.NewInt(-1234), 3),
+	},
+	{
+		Name: "UnaryArithmetic Integer Overflow",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewIntegerValue(math.MinInt64),
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		Result: value.NewDecimal(new(big.Int).Neg(big.NewInt(math.MinInt64)), 0),
+	},
 	{
 		Name: "UnaryArithmetic Operand Error",
 		Expr: parser.UnaryArithmetic{
@@ -2273,6 +2443,47 @@ var evaluateTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "RegExp",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abc123"),
+			Pattern: parser.NewStringValue("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "Not RegExp",
+		Expr: parser.RegExp{
+			LHS:      parser.NewStringValue("abc123"),
+			Pattern:  parser.NewStringValue("^[a-z]+\\d+$"),
+			Negation: parser.Token{Token: parser.NOT, Literal: "not"},
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExp LHS Error",
+		Expr: parser.RegExp{
+			LHS:     parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Pattern: parser.NewStringValue("^[a-z]+$"),
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Pattern Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abc123"),
+			Pattern: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "RegExp Invalid Regular Expression Error",
+		Expr: parser.RegExp{
+			LHS:     parser.NewStringValue("abc123"),
+			Pattern: parser.NewStringValue("[a-z"),
+		},
+		Error: "invalid regular expression: error parsing regexp: missing closing ]: `[a-z`",
+	},
 	{
 		Name: "Exists",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -2735,7 +2946,7 @@ var evaluateTests = []struct {
 		Error: "aggregate functions are nested at AVG(AVG(column1))",
 	},
 	{
-		Name: "Aggregate Function Count With AllColumns",
+		Name: "Aggregate Function With Filter",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -2761,16 +2972,22 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "count",
-			Distinct: parser.Token{},
+			Name: "sum",
 			Args: []parser.QueryExpression{
-				parser.AllColumns{},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Filter: parser.FilterClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("str1"),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<>"},
+				},
 			},
 		},
 		Result: value.NewInteger(3),
 	},
 	{
-		Name: "Aggregate Function Count With Null",
+		Name: "Aggregate Function Count With Filter",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
@@ -2796,109 +3013,23 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "count",
-			Distinct: parser.Token{},
+			Name: "count",
 			Args: []parser.QueryExpression{
-				parser.PrimitiveType{Value: value.NewNull()},
+				parser.AllColumns{},
 			},
-		},
-		Result: value.NewInteger(0),
-	},
-	{
-		Name: "Aggregate Function As a Statement Error",
-		Expr: parser.AggregateFunction{
-			Name:     "avg",
-			Distinct: parser.Token{},
-			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			Filter: parser.FilterClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("str2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
 			},
 		},
-		Result: value.NewNull(),
+		Result: value.NewInteger(1),
 	},
 	{
-		Name: "Aggregate Function User Defined",
-		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameFunctions: {
-					"USERAGGFUNC": &UserDefinedFunction{
-						Name:        parser.Identifier{Literal: "useraggfunc"},
-						IsAggregate: true,
-						Cursor:      parser.Identifier{Literal: "column1"},
-						Parameters: []parser.Variable{
-							{Name: "default"},
-						},
-						RequiredArgs: 1,
-						Statements: []parser.Statement{
-							parser.VariableDeclaration{
-								Assignments: []parser.VariableAssignment{
-									{
-										Variable: parser.Variable{Name: "value"},
-									},
-									{
-										Variable: parser.Variable{Name: "fetch"},
-									},
-								},
-							},
-							parser.WhileInCursor{
-								Variables: []parser.Variable{
-									{Name: "fetch"},
-								},
-								Cursor: parser.Identifier{Literal: "column1"},
-								Statements: []parser.Statement{
-									parser.If{
-										Condition: parser.Is{
-											LHS: parser.Variable{Name: "fetch"},
-											RHS: parser.NewNullValue(),
-										},
-										Statements: []parser.Statement{
-											parser.FlowControl{Token: parser.CONTINUE},
-										},
-									},
-									parser.If{
-										Condition: parser.Is{
-											LHS: parser.Variable{Name: "value"},
-											RHS: parser.NewNullValue(),
-										},
-										Statements: []parser.Statement{
-											parser.VariableSubstitution{
-												Variable: parser.Variable{Name: "value"},
-												Value:    parser.Variable{Name: "fetch"},
-											},
-											parser.FlowControl{Token: parser.CONTINUE},
-										},
-									},
-									parser.VariableSubstitution{
-										Variable: parser.Variable{Name: "value"},
-										Value: parser.Arithmetic{
-											LHS:      parser.Variable{Name: "value"},
-											RHS:      parser.Variable{Name: "fetch"},
-											Operator: parser.Token{Token: '*', Literal: "*"},
-										},
-									},
-								},
-							},
-
-							parser.If{
-								Condition: parser.Is{
-									LHS: parser.Variable{Name: "value"},
-									RHS: parser.NewNullValue(),
-								},
-								Statements: []parser.Statement{
-									parser.VariableSubstitution{
-										Variable: parser.Variable{Name: "value"},
-										Value:    parser.Variable{Name: "default"},
-									},
-								},
-							},
-
-							parser.Return{
-								Value: parser.Variable{Name: "value"},
-							},
-						},
-					},
-				},
-			},
-		}, nil, time.Time{}, []ReferenceRecord{
+		Name: "Aggregate Function Filter Nested Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
 					Header: NewHeader("table1", []string{"column1", "column2"}),
@@ -2908,13 +3039,11 @@ var evaluateTests = []struct {
 								value.NewInteger(1),
 								value.NewNull(),
 								value.NewInteger(3),
-								value.NewInteger(3),
 							}),
 							NewGroupCell([]value.Primary{
 								value.NewString("str1"),
 								value.NewString("str2"),
 								value.NewString("str3"),
-								value.NewString("str4"),
 							}),
 						},
 					},
@@ -2925,36 +3054,35 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "useraggfunc",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name: "count",
 			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-				parser.NewIntegerValue(0),
+				parser.AllColumns{},
 			},
-		},
-		Result: value.NewInteger(3),
-	},
-	{
-		Name: "Aggregate Function User Defined Argument Length Error",
-		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameFunctions: {
-					"USERAGGFUNC": &UserDefinedFunction{
-						Name:        parser.Identifier{Literal: "useraggfunc"},
-						IsAggregate: true,
-						Cursor:      parser.Identifier{Literal: "column1"},
-						Parameters: []parser.Variable{
-							{Name: "default"},
-						},
-						Statements: []parser.Statement{
-							parser.Return{
-								Value: parser.Variable{Name: "value"},
-							},
+			Filter: parser.FilterClause{
+				Filter: parser.Comparison{
+					LHS: parser.AggregateFunction{
+						Name: "sum",
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 						},
 					},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
 				},
 			},
-		}, nil, time.Time{}, []ReferenceRecord{
+		},
+		Error: "aggregate functions are nested at COUNT(*) FILTER (WHERE SUM(column1) > 1)",
+	},
+	{
+		Name: "Analytic Function Not Evaluated Error",
+		Expr: parser.AnalyticFunction{
+			Name: "row_number",
+		},
+		Error: "ROW_NUMBER() OVER (): cannot evaluate as a value",
+	},
+	{
+		Name: "Aggregate Function Count With AllColumns",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
 			{
 				view: &View{
 					Header: NewHeader("table1", []string{"column1", "column2"}),
@@ -2964,13 +3092,11 @@ var evaluateTests = []struct {
 								value.NewInteger(1),
 								value.NewNull(),
 								value.NewInteger(3),
-								value.NewInteger(3),
 							}),
 							NewGroupCell([]value.Primary{
 								value.NewString("str1"),
 								value.NewString("str2"),
 								value.NewString("str3"),
-								value.NewString("str4"),
 							}),
 						},
 					},
@@ -2981,17 +3107,541 @@ var evaluateTests = []struct {
 			},
 		}),
 		Expr: parser.AggregateFunction{
-			Name:     "useraggfunc",
-			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Name:     "count",
+			Distinct: parser.Token{},
 			Args: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.AllColumns{},
 			},
 		},
-		Error: "function useraggfunc takes exactly 2 arguments",
+		Result: value.NewInteger(3),
 	},
 	{
-		Name: "Aggregate Function User Defined Argument Evaluation Error",
-		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+		Name: "Aggregate Function Count With Null",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "count",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.PrimitiveType{Value: value.NewNull()},
+			},
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Aggregate Function Bivariate",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewNull(),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "covar_samp",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "Aggregate Function Bivariate Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewNull(),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "corr",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "function corr takes exactly 2 arguments",
+	},
+	{
+		Name: "Aggregate Function Approximate Count Distinct",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewInteger(8),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "approx_count_distinct",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Aggregate Function Approximate Percentile",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewInteger(8),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "approx_percentile",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewFloatValue(0.75),
+			},
+		},
+		Result: value.NewInteger(5),
+	},
+	{
+		Name: "Aggregate Function Approximate Percentile Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewInteger(8),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "approx_percentile",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Error: "function approx_percentile takes exactly 2 arguments",
+	},
+	{
+		Name: "Aggregate Function Approximate Percentile Fraction Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewInteger(8),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "approx_percentile",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewFloatValue(1.5),
+			},
+		},
+		Error: "the second argument must be a number between 0 and 1 for function approx_percentile",
+	},
+	{
+		Name: "Aggregate Function Approximate Count Distinct Distinct Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewNull(),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(2),
+								value.NewInteger(4),
+								value.NewInteger(4),
+								value.NewInteger(8),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "approx_count_distinct",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "DISTINCT cannot be specified for function approx_count_distinct",
+	},
+	{
+		Name: "Aggregate Function As a Statement Error",
+		Expr: parser.AggregateFunction{
+			Name:     "avg",
+			Distinct: parser.Token{},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Aggregate Function User Defined",
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameFunctions: {
+					"USERAGGFUNC": &UserDefinedFunction{
+						Name:        parser.Identifier{Literal: "useraggfunc"},
+						IsAggregate: true,
+						Cursor:      parser.Identifier{Literal: "column1"},
+						Parameters: []parser.Variable{
+							{Name: "default"},
+						},
+						RequiredArgs: 1,
+						Statements: []parser.Statement{
+							parser.VariableDeclaration{
+								Assignments: []parser.VariableAssignment{
+									{
+										Variable: parser.Variable{Name: "value"},
+									},
+									{
+										Variable: parser.Variable{Name: "fetch"},
+									},
+								},
+							},
+							parser.WhileInCursor{
+								Variables: []parser.Variable{
+									{Name: "fetch"},
+								},
+								Cursor: parser.Identifier{Literal: "column1"},
+								Statements: []parser.Statement{
+									parser.If{
+										Condition: parser.Is{
+											LHS: parser.Variable{Name: "fetch"},
+											RHS: parser.NewNullValue(),
+										},
+										Statements: []parser.Statement{
+											parser.FlowControl{Token: parser.CONTINUE},
+										},
+									},
+									parser.If{
+										Condition: parser.Is{
+											LHS: parser.Variable{Name: "value"},
+											RHS: parser.NewNullValue(),
+										},
+										Statements: []parser.Statement{
+											parser.VariableSubstitution{
+												Variable: parser.Variable{Name: "value"},
+												Value:    parser.Variable{Name: "fetch"},
+											},
+											parser.FlowControl{Token: parser.CONTINUE},
+										},
+									},
+									parser.VariableSubstitution{
+										Variable: parser.Variable{Name: "value"},
+										Value: parser.Arithmetic{
+											LHS:      parser.Variable{Name: "value"},
+											RHS:      parser.Variable{Name: "fetch"},
+											Operator: parser.Token{Token: '*', Literal: "*"},
+										},
+									},
+								},
+							},
+
+							parser.If{
+								Condition: parser.Is{
+									LHS: parser.Variable{Name: "value"},
+									RHS: parser.NewNullValue(),
+								},
+								Statements: []parser.Statement{
+									parser.VariableSubstitution{
+										Variable: parser.Variable{Name: "value"},
+										Value:    parser.Variable{Name: "default"},
+									},
+								},
+							},
+
+							parser.Return{
+								Value: parser.Variable{Name: "value"},
+							},
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+								value.NewString("str4"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "useraggfunc",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewIntegerValue(0),
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Aggregate Function User Defined Argument Length Error",
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameFunctions: {
+					"USERAGGFUNC": &UserDefinedFunction{
+						Name:        parser.Identifier{Literal: "useraggfunc"},
+						IsAggregate: true,
+						Cursor:      parser.Identifier{Literal: "column1"},
+						Parameters: []parser.Variable{
+							{Name: "default"},
+						},
+						Statements: []parser.Statement{
+							parser.Return{
+								Value: parser.Variable{Name: "value"},
+							},
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeader("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(3),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("str1"),
+								value.NewString("str2"),
+								value.NewString("str3"),
+								value.NewString("str4"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name:     "useraggfunc",
+			Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Error: "function useraggfunc takes exactly 2 arguments",
+	},
+	{
+		Name: "Aggregate Function User Defined Argument Evaluation Error",
+		Scope: GenerateReferenceScope([]map[string]map[string]interface{}{
 			{
 				scopeNameFunctions: {
 					"USERAGGFUNC": &UserDefinedFunction{
@@ -3649,6 +4299,237 @@ var evaluateTests = []struct {
 		},
 		Error: "function json_agg takes exactly 1 argument",
 	},
+	{
+		Name: "PercentileCont Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(2),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.25),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Direction: parser.Token{Token: parser.DESC, Literal: "desc"}},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "PercentileDisc Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(2),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "PercentileCont Function Empty Group",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header:    NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: RecordSet{},
+					isGrouped: true,
+				},
+				recordIndex: -1,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ListAgg Function Empty Group",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header:    NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: RecordSet{},
+					isGrouped: true,
+				},
+				recordIndex: -1,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.NewStringValue(","),
+			},
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "PercentileCont Function Within Group Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(2),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+		},
+		Error: "WITHIN GROUP clause with one sort key is required for function percentile_cont",
+	},
+	{
+		Name: "PercentileCont Function Fraction Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(2),
+								value.NewInteger(3),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(4),
+								value.NewInteger(1),
+								value.NewNull(),
+								value.NewInteger(2),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(2),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
 	{
 		Name: "CaseExpr Comparison",
 		Expr: parser.CaseExpr{
@@ -4187,6 +5068,47 @@ var evaluateEmbeddedStringTests = []struct {
 	},
 }
 
+func TestEvaluate_ExactDecimal(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
+	TestTx.Flags.SetExactDecimal(true)
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range []struct {
+		Expr   parser.QueryExpression
+		Result string
+	}{
+		{
+			Expr:   parser.NewFloatValueFromString("0.10"),
+			Result: "0.10",
+		},
+		{
+			Expr: parser.Arithmetic{
+				LHS:      parser.NewFloatValueFromString("0.1"),
+				RHS:      parser.NewFloatValueFromString("0.2"),
+				Operator: parser.Token{Token: '+', Literal: "+"},
+			},
+			Result: "0.3",
+		},
+		{
+			Expr: parser.UnaryArithmetic{
+				Operand:  parser.NewStringValue("1.5"),
+				Operator: parser.Token{Token: '-', Literal: "-"},
+			},
+			Result: "-1.5",
+		},
+	} {
+		result, err := Evaluate(context.Background(), scope, v.Expr)
+		if err != nil {
+			t.Errorf("unexpected error %q for %s", err, v.Expr)
+			continue
+		}
+		if _, ok := result.(*value.Decimal); !ok || result.String() != v.Result {
+			t.Errorf("result = %#v, want decimal %s for %s", result, v.Result, v.Expr)
+		}
+	}
+}
+
 func TestEvaluateEmbeddedString(t *testing.T) {
 	scope := NewReferenceScope(TestTx)
 	_ = scope.DeclareVariableDirectly(parser.Variable{Name: "var"}, value.NewInteger(1))
@@ -0,0 +1,903 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const ExecutionPlanContextKey = "ep"
+
+const (
+	PlanSelectQuery    = "SELECT QUERY"
+	PlanWith           = "WITH"
+	PlanLoadFile       = "LOAD FILE"
+	PlanCachedView     = "CACHED VIEW"
+	PlanTemporaryTable = "TEMPORARY TABLE"
+	PlanInlineTable    = "INLINE TABLE"
+	PlanStdin          = "STDIN"
+	PlanSubquery       = "SUBQUERY"
+	PlanJsonTable      = "JSON_TABLE"
+	PlanUnnest         = "UNNEST"
+	PlanPivot          = "PIVOT"
+	PlanUnpivot        = "UNPIVOT"
+	PlanJoin           = "JOIN"
+	PlanCrossJoin      = "CROSS JOIN"
+	PlanLateralJoin    = "LATERAL JOIN"
+	PlanWhere          = "WHERE"
+	PlanGroupBy        = "GROUP BY"
+	PlanHaving         = "HAVING"
+	PlanSelect         = "SELECT"
+	PlanQualify        = "QUALIFY"
+	PlanOrderBy        = "ORDER BY"
+	PlanOffset         = "OFFSET"
+	PlanLimit          = "LIMIT"
+	PlanInsert         = "INSERT"
+	PlanValues         = "VALUES"
+	PlanUpdate         = "UPDATE"
+	PlanSet            = "SET"
+	PlanDelete         = "DELETE"
+)
+
+var explainHeader = []string{"OPERATION", "DETAIL"}
+var explainAnalyzeHeader = []string{"OPERATION", "DETAIL", "LOOPS", "ROWS", "TIME"}
+
+type PlanNode struct {
+	Operation string
+	Detail    string
+	Loops     int
+	Rows      int
+	Time      time.Duration
+	Children  []*PlanNode
+
+	key planNodeKey
+}
+
+type planNodeKey struct {
+	id        interface{}
+	operation string
+	detail    string
+}
+
+func NewPlanNode(operation string, detail string) *PlanNode {
+	return &PlanNode{
+		Operation: operation,
+		Detail:    detail,
+	}
+}
+
+func (node *PlanNode) AddChild(child *PlanNode) {
+	node.Children = append(node.Children, child)
+}
+
+type ExecutionPlan struct {
+	Nodes []*PlanNode
+
+	mtx *sync.Mutex
+}
+
+func NewExecutionPlan() *ExecutionPlan {
+	return &ExecutionPlan{
+		mtx: &sync.Mutex{},
+	}
+}
+
+func (p *ExecutionPlan) child(parent *PlanNode, key planNodeKey) *PlanNode {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	children := p.Nodes
+	if parent != nil {
+		children = parent.Children
+	}
+
+	for _, node := range children {
+		if node.key == key {
+			return node
+		}
+	}
+
+	node := NewPlanNode(key.operation, key.detail)
+	node.key = key
+	if parent != nil {
+		parent.AddChild(node)
+	} else {
+		p.Nodes = append(p.Nodes, node)
+	}
+	return node
+}
+
+func (p *ExecutionPlan) View(analyze bool) *View {
+	fields := explainHeader
+	if analyze {
+		fields = explainAnalyzeHeader
+	}
+
+	records := make(RecordSet, 0, 20)
+	var appendNode func(node *PlanNode, depth int)
+	appendNode = func(node *PlanNode, depth int) {
+		operation := node.Operation
+		if 0 < depth {
+			operation = strings.Repeat("   ", depth-1) + "-> " + operation
+		}
+
+		values := []value.Primary{value.NewString(operation), value.NewString(node.Detail)}
+		if analyze {
+			values = append(
+				values,
+				value.NewInteger(int64(node.Loops)),
+				value.NewInteger(int64(node.Rows)),
+				value.NewFloat(node.Time.Seconds()),
+			)
+		}
+		records = append(records, NewRecord(values))
+
+		for _, child := range node.Children {
+			appendNode(child, depth+1)
+		}
+	}
+
+	for _, node := range p.Nodes {
+		appendNode(node, 0)
+	}
+
+	return &View{
+		Header:    NewHeader("", fields),
+		RecordSet: records,
+	}
+}
+
+type planContext struct {
+	plan *ExecutionPlan
+	node *PlanNode
+}
+
+func ContextForExecutionPlan(ctx context.Context, plan *ExecutionPlan) context.Context {
+	return context.WithValue(ctx, ExecutionPlanContextKey, &planContext{plan: plan})
+}
+
+func contextWithoutExecutionPlan(ctx context.Context) context.Context {
+	if !IsPlanTraced(ctx) {
+		return ctx
+	}
+	return context.WithValue(ctx, ExecutionPlanContextKey, nil)
+}
+
+func IsPlanTraced(ctx context.Context) bool {
+	_, ok := ctx.Value(ExecutionPlanContextKey).(*planContext)
+	return ok
+}
+
+// PlanStep records the number of loops, the number of rows and the elapsed time of a step
+// into the execution plan set to the context by ContextForExecutionPlan.
+//
+// The same steps executed repeatedly, such as correlated subqueries, are aggregated into one node.
+type PlanStep struct {
+	plan  *ExecutionPlan
+	node  *PlanNode
+	start time.Time
+}
+
+func StartPlanStep(ctx context.Context, id interface{}, operation string, detail string) (context.Context, *PlanStep) {
+	pc, ok := ctx.Value(ExecutionPlanContextKey).(*planContext)
+	if !ok {
+		return ctx, nil
+	}
+
+	node := pc.plan.child(pc.node, planNodeKey{id: id, operation: operation, detail: detail})
+	ctx = context.WithValue(ctx, ExecutionPlanContextKey, &planContext{plan: pc.plan, node: node})
+	return ctx, &PlanStep{
+		plan:  pc.plan,
+		node:  node,
+		start: time.Now(),
+	}
+}
+
+func (s *PlanStep) Finish(rows int) {
+	if s == nil {
+		return
+	}
+
+	elapsed := time.Since(s.start)
+
+	s.plan.mtx.Lock()
+	s.node.Loops++
+	s.node.Rows += rows
+	s.node.Time += elapsed
+	s.plan.mtx.Unlock()
+}
+
+func setPlanJoinOperation(ctx context.Context, operation string) {
+	pc, ok := ctx.Value(ExecutionPlanContextKey).(*planContext)
+	if !ok || pc.node == nil || pc.node.key.operation != PlanJoin {
+		return
+	}
+
+	pc.plan.mtx.Lock()
+	pc.node.Operation = operation
+	pc.plan.mtx.Unlock()
+}
+
+func selectQueryPlanId(query parser.SelectQuery) interface{} {
+	expr := query.SelectEntity
+	for {
+		switch expr.(type) {
+		case parser.SelectEntity:
+			return expr.(parser.SelectEntity).SelectClause.GetBaseExpr()
+		case parser.SelectSet:
+			expr = expr.(parser.SelectSet).LHS
+		case parser.Subquery:
+			expr = expr.(parser.Subquery).Query.SelectEntity
+		default:
+			return nil
+		}
+	}
+}
+
+func selectSetPlanOperation(set parser.SelectSet) string {
+	operation := parser.TokenLiteral(set.Operator.Token)
+	if !set.All.IsEmpty() {
+		operation = operation + " " + parser.TokenLiteral(parser.ALL)
+	}
+	return operation
+}
+
+func selectPlanDetail(clause parser.SelectClause) string {
+	detail := listPlanExpressions(clause.Fields)
+	if clause.IsDistinct() {
+		detail = parser.TokenLiteral(parser.DISTINCT) + " " + detail
+	}
+	return detail
+}
+
+func limitPlanDetail(clause parser.LimitClause) string {
+	s := []string{clause.Value.String()}
+	if clause.Percentage() {
+		s = append(s, parser.TokenLiteral(parser.PERCENT))
+	}
+	if clause.WithTies() {
+		s = append(s, parser.TokenLiteral(parser.WITH), parser.TokenLiteral(parser.TIES))
+	}
+	return strings.Join(s, " ")
+}
+
+func updateSetPlanDetail(setList []parser.UpdateSet) string {
+	s := make([]string, len(setList))
+	for i, v := range setList {
+		s[i] = v.Field.String() + " = " + v.Value.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func deletePlanDetail(query parser.DeleteQuery) string {
+	tables := query.Tables
+	if tables == nil {
+		tables = query.FromClause.Tables
+	}
+	return listPlanExpressions(tables)
+}
+
+func sumRecords(counts []int) int {
+	sum := 0
+	for _, c := range counts {
+		sum += c
+	}
+	return sum
+}
+
+func joinPlanDetail(join parser.Join) string {
+	s := make([]string, 0, 6)
+	if !join.Natural.IsEmpty() {
+		s = append(s, parser.TokenLiteral(parser.NATURAL))
+	}
+	if !join.Direction.IsEmpty() {
+		s = append(s, parser.TokenLiteral(join.Direction.Token))
+	}
+	s = append(s, parser.TokenLiteral(joinType(join)), parser.TokenLiteral(parser.JOIN))
+	if join.Condition != nil {
+		s = append(s, join.Condition.String())
+	}
+	return strings.Join(s, " ")
+}
+
+func pivotPlanDetail(pivot parser.Pivot) string {
+	in := pivot.Any.String()
+	if pivot.Any.IsEmpty() {
+		in = listPlanExpressions(pivot.In)
+	}
+	return pivot.Aggregate.String() + " " + parser.TokenLiteral(parser.FOR) + " " + pivot.For.String() + " " + parser.TokenLiteral(parser.IN) + " (" + in + ")"
+}
+
+func unpivotPlanDetail(unpivot parser.Unpivot) string {
+	return unpivot.Value.String() + " " + parser.TokenLiteral(parser.FOR) + " " + unpivot.Name.String() + " " + parser.TokenLiteral(parser.IN) + " (" + listPlanExpressions(unpivot.In) + ")"
+}
+
+func joinType(join parser.Join) int {
+	if !join.JoinType.IsEmpty() {
+		return join.JoinType.Token
+	}
+	if join.Direction.IsEmpty() {
+		return parser.INNER
+	}
+	return parser.OUTER
+}
+
+func joinPlanOperation(join parser.Join) string {
+	if t, ok := join.JoinTable.(parser.Table); ok && !t.Lateral.IsEmpty() {
+		return PlanLateralJoin
+	}
+	if joinType(join) == parser.CROSS || (join.Natural.IsEmpty() && join.Condition == nil) {
+		return PlanCrossJoin
+	}
+	return PlanJoin
+}
+
+func listPlanExpressions(exprs []parser.QueryExpression) string {
+	s := make([]string, len(exprs))
+	for i, v := range exprs {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func aliasPlanDetail(detail string, name string, tableName parser.Identifier) string {
+	if 0 < len(tableName.Literal) && !strings.EqualFold(name, tableName.Literal) {
+		return detail + " AS " + tableName.Literal
+	}
+	return detail
+}
+
+func tableSourcePlan(
+	scope *ReferenceScope,
+	tableExpr parser.QueryExpression,
+	tableName parser.Identifier,
+	forUpdate bool,
+	options cmd.ImportOptions,
+	loadedFiles map[string]bool,
+) (string, string, error) {
+	if _, ok := tableExpr.(parser.Stdin); ok {
+		return PlanStdin, aliasPlanDetail("", parser.TokenLiteral(parser.STDIN), tableName), nil
+	}
+
+	tableIdentifier := tableExpr.(parser.Identifier)
+
+	if (scope.RecursiveTable != nil && strings.EqualFold(tableIdentifier.Literal, scope.RecursiveTable.Name.Literal)) || scope.InlineTableExists(tableIdentifier) {
+		return PlanInlineTable, aliasPlanDetail(tableIdentifier.Literal, tableIdentifier.Literal, tableName), nil
+	}
+
+	if scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return PlanTemporaryTable, aliasPlanDetail(tableIdentifier.Literal, parser.FormatTableName(tableIdentifier.Literal), tableName), nil
+	}
+
+	filePath, ok := scope.LoadFilePath(tableIdentifier.Literal)
+	if !ok {
+		if p, err := CreateFilePath(tableIdentifier, scope.Tx.Flags.Repository); err == nil && scope.Tx.cachedViews.Exists(p) {
+			filePath = p
+		} else {
+			fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
+			if err != nil {
+				return "", "", err
+			}
+			filePath = fileInfo.Path
+		}
+	}
+
+	operation := PlanLoadFile
+	if view, ok := scope.Tx.cachedViews.Load(filePath); (ok && (!forUpdate || view.FileInfo.ForUpdate)) || loadedFiles[strings.ToUpper(filePath)] {
+		operation = PlanCachedView
+	}
+	if loadedFiles != nil {
+		loadedFiles[strings.ToUpper(filePath)] = true
+	}
+	return operation, aliasPlanDetail(filePath, parser.FormatTableName(filePath), tableName), nil
+}
+
+func sqliteSourcePlan(
+	scope *ReferenceScope,
+	tableObject parser.TableObject,
+	tableName parser.Identifier,
+	forUpdate bool,
+	loadedFiles map[string]bool,
+) (string, string, error) {
+	source, err := sqliteObjectSource(context.Background(), scope, tableObject)
+	if err != nil {
+		return "", "", err
+	}
+	dbPath, err := SearchFilePathWithExtType(tableObject.Path.(parser.Identifier), scope.Tx.Flags.Repository, sqliteExtensions)
+	if err != nil {
+		return "", "", err
+	}
+	filePath := sqliteViewPath(dbPath, source)
+
+	name := source
+	if isSqliteQuery(source) {
+		name = parser.FormatTableName(dbPath)
+	}
+
+	operation := PlanLoadFile
+	if view, ok := scope.Tx.cachedViews.Load(filePath); (ok && (!forUpdate || view.FileInfo.ForUpdate)) || loadedFiles[strings.ToUpper(filePath)] {
+		operation = PlanCachedView
+	}
+	if loadedFiles != nil {
+		loadedFiles[strings.ToUpper(filePath)] = true
+	}
+	return operation, aliasPlanDetail(filePath, name, tableName), nil
+}
+
+func tableObjectImportOptions(scope *ReferenceScope, tableObject parser.TableObject) cmd.ImportOptions {
+	options := scope.Tx.Flags.ImportOptions.Copy()
+	switch tableObject.Type.Token {
+	case parser.CSV:
+		options.Format = cmd.CSV
+	case parser.FIXED:
+		options.Format = cmd.FIXED
+	case parser.JSON:
+		options.Format = cmd.JSON
+	case parser.LTSV:
+		options.Format = cmd.LTSV
+	case parser.XLSX:
+		options.Format = cmd.XLSX
+	}
+	return options
+}
+
+func startTablePlanStep(ctx context.Context, scope *ReferenceScope, table parser.Table, forUpdate bool) (context.Context, *PlanStep) {
+	if !IsPlanTraced(ctx) {
+		return ctx, nil
+	}
+
+	var operation, detail string
+
+	switch table.Object.(type) {
+	case parser.TableObject, parser.Identifier, parser.Stdin:
+		if tableObject, ok := table.Object.(parser.TableObject); ok && tableObject.Type.Token == parser.SQLITE {
+			var err error
+			if operation, detail, err = sqliteSourcePlan(scope, tableObject, table.Name(), forUpdate, nil); err != nil {
+				operation, detail = PlanLoadFile, tableObject.Path.String()
+			}
+			break
+		}
+
+		var path parser.QueryExpression
+		var options cmd.ImportOptions
+		if tableObject, ok := table.Object.(parser.TableObject); ok {
+			path = tableObject.Path
+			options = tableObjectImportOptions(scope, tableObject)
+		} else {
+			path = table.Object
+			options = scope.Tx.Flags.ImportOptions.Copy()
+			options.Format = cmd.AutoSelect
+		}
+
+		var err error
+		if operation, detail, err = tableSourcePlan(scope, path, table.Name(), forUpdate, options, nil); err != nil {
+			operation, detail = PlanLoadFile, path.String()
+		}
+	case parser.Join:
+		join := table.Object.(parser.Join)
+		operation, detail = joinPlanOperation(join), joinPlanDetail(join)
+	case parser.Pivot:
+		operation, detail = PlanPivot, pivotPlanDetail(table.Object.(parser.Pivot))
+	case parser.Unpivot:
+		operation, detail = PlanUnpivot, unpivotPlanDetail(table.Object.(parser.Unpivot))
+	case parser.JsonQuery:
+		operation, detail = PlanJsonTable, aliasPlanDetail(table.Object.String(), "", table.Name())
+	case parser.Unnest:
+		operation, detail = PlanUnnest, aliasPlanDetail(table.Object.String(), "", table.Name())
+	case parser.Subquery, parser.DataModifyingSubquery:
+		operation, detail = PlanSubquery, table.Name().Literal
+	default:
+		return ctx, nil
+	}
+
+	return StartPlanStep(ctx, table.BaseExpr, operation, detail)
+}
+
+// ExplainStatement builds the execution plan of a statement without executing it.
+//
+// Join strategies are estimated from the join conditions. Equi-joins are shown as HASH JOIN,
+// though they are executed as SORT MERGE JOIN if both tables are already ordered.
+func ExplainStatement(scope *ReferenceScope, stmt parser.Statement) (*ExecutionPlan, error) {
+	builder := &planBuilder{
+		scope:       scope.CreateNode(),
+		loadedFiles: make(map[string]bool),
+	}
+	defer builder.scope.CloseCurrentNode()
+
+	var node *PlanNode
+	var err error
+
+	switch stmt.(type) {
+	case parser.SelectQuery:
+		node, err = builder.selectQuery(stmt.(parser.SelectQuery))
+	case parser.InsertQuery:
+		node, err = builder.insertQuery(stmt.(parser.InsertQuery))
+	case parser.UpdateQuery:
+		node, err = builder.updateQuery(stmt.(parser.UpdateQuery))
+	case parser.DeleteQuery:
+		node, err = builder.deleteQuery(stmt.(parser.DeleteQuery))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plan := NewExecutionPlan()
+	plan.Nodes = []*PlanNode{node}
+	return plan, nil
+}
+
+type planBuilder struct {
+	scope       *ReferenceScope
+	loadedFiles map[string]bool
+}
+
+func (b *planBuilder) withClause(node *PlanNode, clause parser.QueryExpression) error {
+	if clause == nil {
+		return nil
+	}
+
+	for _, v := range clause.(parser.WithClause).InlineTables {
+		inlineTable := v.(parser.InlineTable)
+		withNode := NewPlanNode(PlanWith, inlineTable.Name.Literal)
+
+		if inlineTable.IsRecursive() {
+			if err := b.scope.StoreInlineTable(inlineTable.Name, NewView()); err != nil {
+				return err
+			}
+		}
+		queryNode, err := b.selectQuery(inlineTable.Query)
+		if err != nil {
+			return err
+		}
+		if !inlineTable.IsRecursive() {
+			if err := b.scope.StoreInlineTable(inlineTable.Name, NewView()); err != nil {
+				return err
+			}
+		}
+
+		withNode.AddChild(queryNode)
+		node.AddChild(withNode)
+	}
+	return nil
+}
+
+func (b *planBuilder) selectQuery(query parser.SelectQuery) (*PlanNode, error) {
+	parent := b.scope
+	b.scope = parent.CreateNode()
+	defer func() {
+		b.scope.CloseCurrentNode()
+		b.scope = parent
+	}()
+
+	node := NewPlanNode(PlanSelectQuery, "")
+
+	if err := b.withClause(node, query.WithClause); err != nil {
+		return nil, err
+	}
+
+	if err := b.selectEntity(node, query.SelectEntity, query.IsForUpdate()); err != nil {
+		return nil, err
+	}
+
+	if query.OrderByClause != nil {
+		node.AddChild(NewPlanNode(PlanOrderBy, listPlanExpressions(query.OrderByClause.(parser.OrderByClause).Items)))
+	}
+
+	if query.LimitClause != nil {
+		limitClause := query.LimitClause.(parser.LimitClause)
+		if limitClause.OffsetClause != nil {
+			node.AddChild(NewPlanNode(PlanOffset, limitClause.OffsetClause.(parser.OffsetClause).Value.String()))
+		}
+		if !limitClause.Type.IsEmpty() {
+			node.AddChild(NewPlanNode(PlanLimit, limitPlanDetail(limitClause)))
+		}
+	}
+
+	return node, nil
+}
+
+func (b *planBuilder) selectEntity(node *PlanNode, expr parser.QueryExpression, forUpdate bool) error {
+	switch expr.(type) {
+	case parser.Subquery:
+		queryNode, err := b.selectQuery(expr.(parser.Subquery).Query)
+		if err != nil {
+			return err
+		}
+		node.AddChild(queryNode)
+		return nil
+	case parser.SelectSet:
+		set := expr.(parser.SelectSet)
+		setNode := NewPlanNode(selectSetPlanOperation(set), "")
+		for _, operand := range []parser.QueryExpression{set.LHS, set.RHS} {
+			operandNode := setNode
+			if _, ok := operand.(parser.SelectEntity); ok {
+				operandNode = NewPlanNode(PlanSelectQuery, "")
+				setNode.AddChild(operandNode)
+			}
+			if err := b.selectEntity(operandNode, operand, forUpdate); err != nil {
+				return err
+			}
+		}
+		node.AddChild(setNode)
+		return nil
+	}
+
+	entity := expr.(parser.SelectEntity)
+
+	var tables []parser.QueryExpression
+	if entity.FromClause != nil {
+		tables = entity.FromClause.(parser.FromClause).Tables
+	}
+	if err := b.tables(node, tables, forUpdate); err != nil {
+		return err
+	}
+
+	if entity.WhereClause != nil {
+		node.AddChild(NewPlanNode(PlanWhere, entity.WhereClause.(parser.WhereClause).Filter.String()))
+	}
+	if entity.GroupByClause != nil {
+		node.AddChild(NewPlanNode(PlanGroupBy, listPlanExpressions(entity.GroupByClause.(parser.GroupByClause).Items)))
+	}
+	if entity.HavingClause != nil {
+		node.AddChild(NewPlanNode(PlanHaving, entity.HavingClause.(parser.HavingClause).Filter.String()))
+	}
+	node.AddChild(NewPlanNode(PlanSelect, selectPlanDetail(entity.SelectClause.(parser.SelectClause))))
+	if entity.QualifyClause != nil {
+		node.AddChild(NewPlanNode(PlanQualify, entity.QualifyClause.(parser.QualifyClause).Filter.String()))
+	}
+	return nil
+}
+
+func (b *planBuilder) tables(node *PlanNode, tables []parser.QueryExpression, forUpdate bool) error {
+	if tables == nil {
+		if b.scope.Tx.Session.CanReadStdin {
+			tables = []parser.QueryExpression{parser.Table{Object: parser.Stdin{}}}
+		} else {
+			return nil
+		}
+	}
+
+	table := tables[0]
+	for i := 1; i < len(tables); i++ {
+		table = parser.Table{
+			Object: parser.Join{
+				Table:     table,
+				JoinTable: tables[i],
+				JoinType:  parser.Token{Token: parser.CROSS},
+			},
+		}
+	}
+	return b.table(node, table, forUpdate)
+}
+
+func (b *planBuilder) table(node *PlanNode, tableExpr parser.QueryExpression, forUpdate bool) error {
+	if parentheses, ok := tableExpr.(parser.Parentheses); ok {
+		return b.table(node, parentheses.Expr, forUpdate)
+	}
+
+	table := tableExpr.(parser.Table)
+	tableName := table.Name()
+
+	switch table.Object.(type) {
+	case parser.Dual:
+		return nil
+	case parser.TableObject:
+		tableObject := table.Object.(parser.TableObject)
+		var operation, detail string
+		var err error
+		if tableObject.Type.Token == parser.SQLITE {
+			operation, detail, err = sqliteSourcePlan(b.scope, tableObject, tableName, forUpdate, b.loadedFiles)
+		} else {
+			operation, detail, err = tableSourcePlan(b.scope, tableObject.Path, tableName, forUpdate, tableObjectImportOptions(b.scope, tableObject), b.loadedFiles)
+		}
+		if err != nil {
+			return err
+		}
+		node.AddChild(NewPlanNode(operation, detail))
+	case parser.Identifier, parser.Stdin:
+		options := b.scope.Tx.Flags.ImportOptions.Copy()
+		options.Format = cmd.AutoSelect
+
+		operation, detail, err := tableSourcePlan(b.scope, table.Object, tableName, forUpdate, options, b.loadedFiles)
+		if err != nil {
+			return err
+		}
+		node.AddChild(NewPlanNode(operation, detail))
+	case parser.Join:
+		join := table.Object.(parser.Join)
+
+		operation := joinPlanOperation(join)
+		if operation == PlanJoin {
+			operation = estimateJoinStrategy(join).String()
+		}
+		joinNode := NewPlanNode(operation, joinPlanDetail(join))
+
+		if err := b.table(joinNode, join.Table, forUpdate); err != nil {
+			return err
+		}
+		if err := b.table(joinNode, join.JoinTable, forUpdate); err != nil {
+			return err
+		}
+		node.AddChild(joinNode)
+	case parser.Pivot:
+		pivot := table.Object.(parser.Pivot)
+		pivotNode := NewPlanNode(PlanPivot, pivotPlanDetail(pivot))
+		if err := b.table(pivotNode, pivot.Table, forUpdate); err != nil {
+			return err
+		}
+		node.AddChild(pivotNode)
+	case parser.Unpivot:
+		unpivot := table.Object.(parser.Unpivot)
+		unpivotNode := NewPlanNode(PlanUnpivot, unpivotPlanDetail(unpivot))
+		if err := b.table(unpivotNode, unpivot.Table, forUpdate); err != nil {
+			return err
+		}
+		node.AddChild(unpivotNode)
+	case parser.JsonQuery:
+		node.AddChild(NewPlanNode(PlanJsonTable, aliasPlanDetail(table.Object.String(), "", tableName)))
+	case parser.Unnest:
+		node.AddChild(NewPlanNode(PlanUnnest, aliasPlanDetail(table.Object.String(), "", tableName)))
+	case parser.Subquery:
+		subqueryNode := NewPlanNode(PlanSubquery, tableName.Literal)
+		queryNode, err := b.selectQuery(table.Object.(parser.Subquery).Query)
+		if err != nil {
+			return err
+		}
+		subqueryNode.AddChild(queryNode)
+		node.AddChild(subqueryNode)
+	case parser.DataModifyingSubquery:
+		subqueryNode := NewPlanNode(PlanSubquery, tableName.Literal)
+
+		var queryNode *PlanNode
+		var err error
+		switch query := table.Object.(parser.DataModifyingSubquery).Query.(type) {
+		case parser.InsertQuery:
+			queryNode, err = b.insertQuery(query)
+		case parser.UpdateQuery:
+			queryNode, err = b.updateQuery(query)
+		case parser.DeleteQuery:
+			queryNode, err = b.deleteQuery(query)
+		}
+		if err != nil {
+			return err
+		}
+		if queryNode != nil {
+			subqueryNode.AddChild(queryNode)
+		}
+		node.AddChild(subqueryNode)
+	}
+	return nil
+}
+
+func (b *planBuilder) insertQuery(query parser.InsertQuery) (*PlanNode, error) {
+	parent := b.scope
+	b.scope = parent.CreateNode()
+	defer func() {
+		b.scope.CloseCurrentNode()
+		b.scope = parent
+	}()
+
+	node := NewPlanNode(PlanInsert, query.Table.String())
+
+	if err := b.withClause(node, query.WithClause); err != nil {
+		return nil, err
+	}
+	if err := b.tables(node, []parser.QueryExpression{query.Table}, true); err != nil {
+		return nil, err
+	}
+
+	if query.ValuesList != nil {
+		node.AddChild(NewPlanNode(PlanValues, ""))
+	} else {
+		queryNode, err := b.selectQuery(query.Query.(parser.SelectQuery))
+		if err != nil {
+			return nil, err
+		}
+		node.AddChild(queryNode)
+	}
+	return node, nil
+}
+
+func (b *planBuilder) updateQuery(query parser.UpdateQuery) (*PlanNode, error) {
+	parent := b.scope
+	b.scope = parent.CreateNode()
+	defer func() {
+		b.scope.CloseCurrentNode()
+		b.scope = parent
+	}()
+
+	node := NewPlanNode(PlanUpdate, listPlanExpressions(query.Tables))
+
+	if err := b.withClause(node, query.WithClause); err != nil {
+		return nil, err
+	}
+
+	tables := query.Tables
+	if query.FromClause != nil {
+		tables = query.FromClause.(parser.FromClause).Tables
+	}
+	if err := b.tables(node, tables, true); err != nil {
+		return nil, err
+	}
+
+	if query.WhereClause != nil {
+		node.AddChild(NewPlanNode(PlanWhere, query.WhereClause.(parser.WhereClause).Filter.String()))
+	}
+	node.AddChild(NewPlanNode(PlanSet, updateSetPlanDetail(query.SetList)))
+	return node, nil
+}
+
+func (b *planBuilder) deleteQuery(query parser.DeleteQuery) (*PlanNode, error) {
+	parent := b.scope
+	b.scope = parent.CreateNode()
+	defer func() {
+		b.scope.CloseCurrentNode()
+		b.scope = parent
+	}()
+
+	node := NewPlanNode(PlanDelete, deletePlanDetail(query))
+
+	if err := b.withClause(node, query.WithClause); err != nil {
+		return nil, err
+	}
+	if err := b.tables(node, query.FromClause.Tables, true); err != nil {
+		return nil, err
+	}
+
+	if query.WhereClause != nil {
+		node.AddChild(NewPlanNode(PlanWhere, query.WhereClause.(parser.WhereClause).Filter.String()))
+	}
+	return node, nil
+}
+
+func estimateJoinStrategy(join parser.Join) JoinStrategy {
+	if !join.Natural.IsEmpty() {
+		return HashJoin
+	}
+
+	cond := join.Condition.(parser.JoinCondition)
+	if cond.On == nil || hasEquiJoinCondition(cond.On) {
+		return HashJoin
+	}
+	return NestedLoopJoin
+}
+
+func hasEquiJoinCondition(expr parser.QueryExpression) bool {
+	switch expr.(type) {
+	case parser.Parentheses:
+		return hasEquiJoinCondition(expr.(parser.Parentheses).Expr)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		return logic.Operator.Token == parser.AND && (hasEquiJoinCondition(logic.LHS) || hasEquiJoinCondition(logic.RHS))
+	case parser.Comparison:
+		comp := expr.(parser.Comparison)
+		if comp.Operator.Literal != "=" {
+			return false
+		}
+		lhsView, ok := fieldReferenceViewName(comp.LHS)
+		if !ok {
+			return false
+		}
+		rhsView, ok := fieldReferenceViewName(comp.RHS)
+		if !ok {
+			return false
+		}
+		return len(lhsView) < 1 || len(rhsView) < 1 || !strings.EqualFold(lhsView, rhsView)
+	}
+	return false
+}
+
+func fieldReferenceViewName(expr parser.QueryExpression) (string, bool) {
+	switch expr.(type) {
+	case parser.FieldReference:
+		return expr.(parser.FieldReference).View.Literal, true
+	case parser.ColumnNumber:
+		return expr.(parser.ColumnNumber).View.Literal, true
+	}
+	return "", false
+}
@@ -0,0 +1,484 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var explainStatementTests = []struct {
+	Name   string
+	Stmt   parser.Statement
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Explain Select Query",
+		Stmt: parser.SelectQuery{
+			WithClause: parser.WithClause{
+				InlineTables: []parser.QueryExpression{
+					parser.InlineTable{
+						Name: parser.Identifier{Literal: "it"},
+						Query: parser.SelectQuery{
+							SelectEntity: parser.SelectEntity{
+								SelectClause: parser.SelectClause{
+									Fields: []parser.QueryExpression{
+										parser.Field{Object: parser.NewIntegerValueFromString("2"), As: parser.Token{Token: parser.AS, Literal: "as"}, Alias: parser.Identifier{Literal: "c1"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Join{
+								Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								JoinTable: parser.Table{
+									Object: parser.Identifier{Literal: "table2"},
+									As:     parser.Token{Token: parser.AS, Literal: "as"},
+									Alias:  parser.Identifier{Literal: "t2"},
+								},
+								Condition: parser.JoinCondition{
+									On: parser.Comparison{
+										LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+										RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t2"}, Column: parser.Identifier{Literal: "column3"}},
+										Operator: parser.Token{Token: '=', Literal: "="},
+									},
+								},
+							},
+						},
+						parser.Table{Object: parser.Identifier{Literal: "it"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("10"),
+				Unit:  parser.Token{Token: parser.PERCENT, Literal: "percent"},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"OPERATION", "DETAIL"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("-> WITH"), value.NewString("it")}),
+				NewRecord([]value.Primary{value.NewString("   -> SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("      -> SELECT"), value.NewString("2 AS c1")}),
+				NewRecord([]value.Primary{value.NewString("-> CROSS JOIN"), value.NewString("CROSS JOIN")}),
+				NewRecord([]value.Primary{value.NewString("   -> HASH JOIN"), value.NewString("INNER JOIN ON table1.column1 = t2.column3")}),
+				NewRecord([]value.Primary{value.NewString("      -> LOAD FILE"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("      -> LOAD FILE"), value.NewString(GetTestFilePath("table2.csv") + " AS t2")}),
+				NewRecord([]value.Primary{value.NewString("   -> INLINE TABLE"), value.NewString("it")}),
+				NewRecord([]value.Primary{value.NewString("-> WHERE"), value.NewString("column1 = c1")}),
+				NewRecord([]value.Primary{value.NewString("-> SELECT"), value.NewString("DISTINCT column1")}),
+				NewRecord([]value.Primary{value.NewString("-> ORDER BY"), value.NewString("column1")}),
+				NewRecord([]value.Primary{value.NewString("-> LIMIT"), value.NewString("10 PERCENT")}),
+			},
+		},
+	},
+	{
+		Name: "Explain Select Query with Set Operation",
+		Stmt: parser.SelectQuery{
+			SelectEntity: parser.SelectSet{
+				LHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+				Operator: parser.Token{Token: parser.UNION, Literal: "union"},
+				All:      parser.Token{Token: parser.ALL, Literal: "all"},
+				RHS: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"OPERATION", "DETAIL"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("-> UNION ALL"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("   -> SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("      -> LOAD FILE"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("      -> SELECT"), value.NewString("*")}),
+				NewRecord([]value.Primary{value.NewString("   -> SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("      -> CACHED VIEW"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("      -> SELECT"), value.NewString("*")}),
+			},
+		},
+	},
+	{
+		Name: "Explain Select Query with Pivot",
+		Stmt: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Pivot{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								Aggregate: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}},
+								For:       parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+								Any:       parser.Token{Token: parser.ANY, Literal: "any"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"OPERATION", "DETAIL"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("-> PIVOT"), value.NewString("COUNT(*) FOR column2 IN (ANY)")}),
+				NewRecord([]value.Primary{value.NewString("   -> LOAD FILE"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("-> SELECT"), value.NewString("*")}),
+			},
+		},
+	},
+	{
+		Name: "Explain Update Query",
+		Stmt: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"OPERATION", "DETAIL"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("UPDATE"), value.NewString("table1")}),
+				NewRecord([]value.Primary{value.NewString("-> LOAD FILE"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("-> WHERE"), value.NewString("column1 = 2")}),
+				NewRecord([]value.Primary{value.NewString("-> SET"), value.NewString("column2 = 'update'")}),
+			},
+		},
+	},
+	{
+		Name: "Explain Select Query with Data-Modifying Subquery",
+		Stmt: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.DataModifyingSubquery{
+								Query: parser.DeleteQuery{
+									FromClause: parser.FromClause{
+										Tables: []parser.QueryExpression{
+											parser.Table{Object: parser.Identifier{Literal: "table1"}},
+										},
+									},
+									WhereClause: parser.WhereClause{
+										Filter: parser.Comparison{
+											LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+											RHS:      parser.NewIntegerValueFromString("2"),
+											Operator: parser.Token{Token: '=', Literal: "="},
+										},
+									},
+									ReturningClause: parser.ReturningClause{
+										Fields: []parser.QueryExpression{
+											parser.Field{Object: parser.AllColumns{}},
+										},
+									},
+								},
+							},
+							Alias: parser.Identifier{Literal: "d"},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"OPERATION", "DETAIL"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("SELECT QUERY"), value.NewString("")}),
+				NewRecord([]value.Primary{value.NewString("-> SUBQUERY"), value.NewString("d")}),
+				NewRecord([]value.Primary{value.NewString("   -> DELETE"), value.NewString("table1")}),
+				NewRecord([]value.Primary{value.NewString("      -> LOAD FILE"), value.NewString(GetTestFilePath("table1.csv"))}),
+				NewRecord([]value.Primary{value.NewString("      -> WHERE"), value.NewString("column1 = 2")}),
+				NewRecord([]value.Primary{value.NewString("-> SELECT"), value.NewString("*")}),
+			},
+		},
+	},
+	{
+		Name: "Explain Select Query File Does Not Exist Error",
+		Stmt: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+					},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+}
+
+func TestExplainStatement(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	for _, v := range explainStatementTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		plan, err := ExplainStatement(NewReferenceScope(TestTx), v.Stmt)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		result := plan.View(false)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+var executionPlanAnalyzeTests = []struct {
+	Name   string
+	Query  parser.SelectQuery
+	Result []*PlanNode
+}{
+	{
+		Name: "Analyze Select Query",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValueFromString("3"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+					},
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValueFromString("1"),
+			},
+		},
+		Result: []*PlanNode{
+			{
+				Operation: "SELECT QUERY",
+				Loops:     1,
+				Rows:      1,
+				Children: []*PlanNode{
+					{Operation: "LOAD FILE", Detail: GetTestFilePath("group_table.csv"), Loops: 1, Rows: 5},
+					{Operation: "WHERE", Detail: "column1 < 3", Loops: 1, Rows: 4},
+					{Operation: "GROUP BY", Detail: "column1", Loops: 1, Rows: 2},
+					{Operation: "SELECT", Detail: "column1, COUNT(*)", Loops: 1, Rows: 2},
+					{Operation: "LIMIT", Detail: "1", Loops: 1, Rows: 1},
+				},
+			},
+		},
+	},
+	{
+		Name: "Analyze Select Query with Correlated Subquery",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{
+							Object: parser.Subquery{
+								Query: parser.SelectQuery{
+									SelectEntity: parser.SelectEntity{
+										SelectClause: parser.SelectClause{
+											Fields: []parser.QueryExpression{
+												parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: []*PlanNode{
+			{
+				Operation: "SELECT QUERY",
+				Loops:     1,
+				Rows:      3,
+				Children: []*PlanNode{
+					{Operation: "LOAD FILE", Detail: GetTestFilePath("table1.csv"), Loops: 1, Rows: 3},
+					{
+						Operation: "SELECT",
+						Detail:    "(SELECT column1)",
+						Loops:     1,
+						Rows:      3,
+						Children: []*PlanNode{
+							{
+								Operation: "SELECT QUERY",
+								Loops:     3,
+								Rows:      3,
+								Children: []*PlanNode{
+									{Operation: "SELECT", Detail: "column1", Loops: 3, Rows: 3},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func clearPlanNodeMeasurements(nodes []*PlanNode) {
+	for _, node := range nodes {
+		node.Time = 0
+		node.key = planNodeKey{}
+		clearPlanNodeMeasurements(node.Children)
+	}
+}
+
+func TestExecutionPlan_Analyze(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	for _, v := range executionPlanAnalyzeTests {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+		plan := NewExecutionPlan()
+		_, err := Select(ContextForExecutionPlan(context.Background(), plan), NewReferenceScope(TestTx), v.Query)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		clearPlanNodeMeasurements(plan.Nodes)
+		if !reflect.DeepEqual(plan.Nodes, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, plan.Nodes, v.Result)
+		}
+	}
+}
+
+func TestExecutionPlan_View(t *testing.T) {
+	plan := NewExecutionPlan()
+	plan.Nodes = []*PlanNode{
+		{
+			Operation: "SELECT QUERY",
+			Loops:     1,
+			Rows:      2,
+			Time:      2 * time.Second,
+			Children: []*PlanNode{
+				{Operation: "LOAD FILE", Detail: "table1.csv", Loops: 1, Rows: 3, Time: 500 * time.Millisecond},
+			},
+		},
+	}
+
+	expect := &View{
+		Header: NewHeader("", []string{"OPERATION", "DETAIL", "LOOPS", "ROWS", "TIME"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("SELECT QUERY"), value.NewString(""), value.NewInteger(1), value.NewInteger(2), value.NewFloat(2)}),
+			NewRecord([]value.Primary{value.NewString("-> LOAD FILE"), value.NewString("table1.csv"), value.NewInteger(1), value.NewInteger(3), value.NewFloat(0.5)}),
+		},
+	}
+
+	result := plan.View(true)
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+}
@@ -72,6 +72,8 @@ type FileInfo struct {
 	Delimiter          rune
 	DelimiterPositions fixedlen.DelimiterPositions
 	JsonQuery          string
+	SheetName          string
+	CellRange          string
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
 	NoHeader           bool
@@ -81,6 +83,16 @@ type FileInfo struct {
 
 	SingleLine bool
 
+	// DatabasePath is the path of the SQLite database file that the view is loaded from.
+	// Path of the view is composed of DatabasePath and DatabaseTable or DatabaseQuery.
+	DatabasePath  string
+	DatabaseTable string
+	DatabaseQuery string
+
+	// Projection is the list of the loaded columns.
+	// The nil slice means that all columns are loaded.
+	Projection []string
+
 	Handler *file.Handler
 
 	ForUpdate bool
@@ -88,6 +100,8 @@ type FileInfo struct {
 
 	restorePointHeader    Header
 	restorePointRecordSet RecordSet
+
+	databaseRows *sqliteRows
 }
 
 func NewFileInfo(
@@ -106,7 +120,7 @@ func NewFileInfo(
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.PARQUET, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
@@ -178,7 +192,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case cmd.TSV:
 		delimiter = '\t'
-	case cmd.JSON:
+	case cmd.JSON, cmd.PARQUET, cmd.XLSX:
 		encoding = text.UTF8
 	}
 
@@ -200,6 +214,14 @@ func (f *FileInfo) SetEncoding(s string) error {
 		if encoding != text.UTF8 {
 			return errors.New("json format is supported only UTF8")
 		}
+	case cmd.PARQUET:
+		if encoding != text.UTF8 {
+			return errors.New("parquet format is supported only UTF8")
+		}
+	case cmd.XLSX:
+		if encoding != text.UTF8 {
+			return errors.New("xlsx format is supported only UTF8")
+		}
 	}
 
 	if f.Encoding == encoding {
@@ -262,6 +284,22 @@ func (f *FileInfo) SetPrettyPrint(b bool) error {
 	return nil
 }
 
+// HasColumns returns true if all the columns are loaded.
+func (f *FileInfo) HasColumns(columns []string) bool {
+	if f.Projection == nil {
+		return true
+	}
+	if columns == nil {
+		return false
+	}
+	for _, c := range columns {
+		if !containsColumn(f.Projection, c) {
+			return false
+		}
+	}
+	return true
+}
+
 func (f *FileInfo) IsFile() bool {
 	return f.ViewType == ViewTypeFile
 }
@@ -274,6 +312,10 @@ func (f *FileInfo) IsStdin() bool {
 	return f.ViewType == ViewTypeStdin
 }
 
+func (f *FileInfo) IsDatabaseTable() bool {
+	return f.ViewType == ViewTypeFile && 0 < len(f.DatabasePath)
+}
+
 func (f *FileInfo) ExportOptions(tx *Transaction) cmd.ExportOptions {
 	ops := tx.Flags.ExportOptions.Copy()
 	ops.Format = f.Format
@@ -304,6 +346,10 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case cmd.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case cmd.PARQUET:
+		fpath, err = SearchParquetFilePath(filename, repository)
+	case cmd.XLSX:
+		fpath, err = SearchXlsxFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
 			switch strings.ToLower(filepath.Ext(fpath)) {
@@ -315,6 +361,10 @@ func SearchFilePath(filename parser.Identifier, repository string, options cmd.I
 				format = cmd.JSON
 			case cmd.LtsvExt:
 				format = cmd.LTSV
+			case cmd.ParquetExt:
+				format = cmd.PARQUET
+			case cmd.XlsxExt:
+				format = cmd.XLSX
 			default:
 				format = defaultFormat
 			}
@@ -340,8 +390,16 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{cmd.LtsvExt, cmd.TextExt})
 }
 
+func SearchParquetFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.ParquetExt})
+}
+
+func SearchXlsxFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.XlsxExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{cmd.CsvExt, cmd.TsvExt, cmd.JsonExt, cmd.LtsvExt, cmd.ParquetExt, cmd.XlsxExt, cmd.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -394,6 +452,7 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	var format cmd.Format
+	var sheetName string
 	switch strings.ToLower(filepath.Ext(fpath)) {
 	case cmd.TsvExt:
 		delimiter = '\t'
@@ -403,6 +462,13 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		format = cmd.JSON
 	case cmd.LtsvExt:
 		format = cmd.LTSV
+	case cmd.ParquetExt:
+		encoding = text.UTF8
+		format = cmd.PARQUET
+	case cmd.XlsxExt:
+		encoding = text.UTF8
+		format = cmd.XLSX
+		sheetName = xlsxDefaultSheetName
 	case cmd.GfmExt:
 		format = cmd.GFM
 	case cmd.OrgExt:
@@ -416,6 +482,7 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		Delimiter: delimiter,
 		Format:    format,
 		Encoding:  encoding,
+		SheetName: sheetName,
 	}, nil
 }
 
@@ -134,6 +134,62 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "Parquet",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.PARQUET,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table7.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "Parquet with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table7"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "table7.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "XLSX",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.XLSX,
+		Delimiter:  ',',
+		Encoding:   text.SJIS,
+		Result: &FileInfo{
+			Path:      "table8.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "XLSX with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     cmd.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF8,
+		Result: &FileInfo{
+			Path:      "table8.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:       "Fixed-Length",
 		FilePath:   parser.Identifier{Literal: "fixed_length.txt"},
@@ -282,6 +338,31 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "Parquet",
+		FilePath:  parser.Identifier{Literal: "table1.parquet"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.parquet",
+			Delimiter: ',',
+			Format:    cmd.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "XLSX",
+		FilePath:  parser.Identifier{Literal: "table1.xlsx"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.xlsx",
+			Delimiter: ',',
+			Format:    cmd.XLSX,
+			Encoding:  text.UTF8,
+			SheetName: "Sheet1",
+		},
+	},
 	{
 		Name:      "GFM",
 		FilePath:  parser.Identifier{Literal: "table1.md"},
@@ -11,7 +11,9 @@ import (
 	"encoding/hex"
 	"hash"
 	"math"
+	"math/big"
 	"os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -24,119 +26,133 @@ import (
 	"github.com/mithrandie/csvq/lib/value"
 
 	"github.com/mithrandie/go-text"
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
 type BuiltInFunction func(parser.Function, []value.Primary, *cmd.Flags) (value.Primary, error)
 
 var Functions = map[string]BuiltInFunction{
-	"COALESCE":         Coalesce,
-	"IF":               If,
-	"IFNULL":           Ifnull,
-	"NULLIF":           Nullif,
-	"CEIL":             Ceil,
-	"FLOOR":            Floor,
-	"ROUND":            Round,
-	"ABS":              Abs,
-	"ACOS":             Acos,
-	"ASIN":             Asin,
-	"ATAN":             Atan,
-	"ATAN2":            Atan2,
-	"COS":              Cos,
-	"SIN":              Sin,
-	"TAN":              Tan,
-	"EXP":              Exp,
-	"EXP2":             Exp2,
-	"EXPM1":            Expm1,
-	"LOG":              MathLog,
-	"LOG10":            Log10,
-	"LOG2":             Log2,
-	"LOG1P":            Log1p,
-	"SQRT":             Sqrt,
-	"POW":              Pow,
-	"BIN_TO_DEC":       BinToDec,
-	"OCT_TO_DEC":       OctToDec,
-	"HEX_TO_DEC":       HexToDec,
-	"ENOTATION_TO_DEC": EnotationToDec,
-	"BIN":              Bin,
-	"OCT":              Oct,
-	"HEX":              Hex,
-	"ENOTATION":        Enotation,
-	"NUMBER_FORMAT":    NumberFormat,
-	"RAND":             Rand,
-	"TRIM":             Trim,
-	"LTRIM":            Ltrim,
-	"RTRIM":            Rtrim,
-	"UPPER":            Upper,
-	"LOWER":            Lower,
-	"BASE64_ENCODE":    Base64Encode,
-	"BASE64_DECODE":    Base64Decode,
-	"HEX_ENCODE":       HexEncode,
-	"HEX_DECODE":       HexDecode,
-	"LEN":              Len,
-	"BYTE_LEN":         ByteLen,
-	"WIDTH":            Width,
-	"LPAD":             Lpad,
-	"RPAD":             Rpad,
-	"SUBSTRING":        Substring,
-	"SUBSTR":           Substr,
-	"INSTR":            Instr,
-	"LIST_ELEM":        ListElem,
-	"REPLACE":          ReplaceFn,
-	"FORMAT":           Format,
-	"JSON_VALUE":       JsonValue,
-	"MD5":              Md5,
-	"SHA1":             Sha1,
-	"SHA256":           Sha256,
-	"SHA512":           Sha512,
-	"MD5_HMAC":         Md5Hmac,
-	"SHA1_HMAC":        Sha1Hmac,
-	"SHA256_HMAC":      Sha256Hmac,
-	"SHA512_HMAC":      Sha512Hmac,
-	"DATETIME_FORMAT":  DatetimeFormat,
-	"YEAR":             Year,
-	"MONTH":            Month,
-	"DAY":              Day,
-	"HOUR":             Hour,
-	"MINUTE":           Minute,
-	"SECOND":           Second,
-	"MILLISECOND":      Millisecond,
-	"MICROSECOND":      Microsecond,
-	"NANOSECOND":       Nanosecond,
-	"WEEKDAY":          Weekday,
-	"UNIX_TIME":        UnixTime,
-	"UNIX_NANO_TIME":   UnixNanoTime,
-	"DAY_OF_YEAR":      DayOfYear,
-	"WEEK_OF_YEAR":     WeekOfYear,
-	"ADD_YEAR":         AddYear,
-	"ADD_MONTH":        AddMonth,
-	"ADD_DAY":          AddDay,
-	"ADD_HOUR":         AddHour,
-	"ADD_MINUTE":       AddMinute,
-	"ADD_SECOND":       AddSecond,
-	"ADD_MILLI":        AddMilli,
-	"ADD_MICRO":        AddMicro,
-	"ADD_NANO":         AddNano,
-	"TRUNC_MONTH":      TruncMonth,
-	"TRUNC_DAY":        TruncDay,
-	"TRUNC_TIME":       TruncTime,
-	"TRUNC_HOUR":       TruncTime,
-	"TRUNC_MINUTE":     TruncMinute,
-	"TRUNC_SECOND":     TruncSecond,
-	"TRUNC_MILLI":      TruncMilli,
-	"TRUNC_MICRO":      TruncMicro,
-	"TRUNC_NANO":       TruncNano,
-	"DATE_DIFF":        DateDiff,
-	"TIME_DIFF":        TimeDiff,
-	"TIME_NANO_DIFF":   TimeNanoDiff,
-	"UTC":              UTC,
-	"NANO_TO_DATETIME": NanoToDatetime,
-	"STRING":           String,
-	"INTEGER":          Integer,
-	"FLOAT":            Float,
-	"BOOLEAN":          Boolean,
-	"TERNARY":          Ternary,
-	"DATETIME":         Datetime,
+	"COALESCE":            Coalesce,
+	"IF":                  If,
+	"IFNULL":              Ifnull,
+	"NULLIF":              Nullif,
+	"CEIL":                Ceil,
+	"FLOOR":               Floor,
+	"ROUND":               Round,
+	"ABS":                 Abs,
+	"ACOS":                Acos,
+	"ASIN":                Asin,
+	"ATAN":                Atan,
+	"ATAN2":               Atan2,
+	"COS":                 Cos,
+	"SIN":                 Sin,
+	"TAN":                 Tan,
+	"EXP":                 Exp,
+	"EXP2":                Exp2,
+	"EXPM1":               Expm1,
+	"LOG":                 MathLog,
+	"LOG10":               Log10,
+	"LOG2":                Log2,
+	"LOG1P":               Log1p,
+	"SQRT":                Sqrt,
+	"POW":                 Pow,
+	"BIN_TO_DEC":          BinToDec,
+	"OCT_TO_DEC":          OctToDec,
+	"HEX_TO_DEC":          HexToDec,
+	"ENOTATION_TO_DEC":    EnotationToDec,
+	"BIN":                 Bin,
+	"OCT":                 Oct,
+	"HEX":                 Hex,
+	"ENOTATION":           Enotation,
+	"NUMBER_FORMAT":       NumberFormat,
+	"RAND":                Rand,
+	"TRIM":                Trim,
+	"LTRIM":               Ltrim,
+	"RTRIM":               Rtrim,
+	"UPPER":               Upper,
+	"LOWER":               Lower,
+	"BASE64_ENCODE":       Base64Encode,
+	"BASE64_DECODE":       Base64Decode,
+	"HEX_ENCODE":          HexEncode,
+	"HEX_DECODE":          HexDecode,
+	"LEN":                 Len,
+	"BYTE_LEN":            ByteLen,
+	"WIDTH":               Width,
+	"LPAD":                Lpad,
+	"RPAD":                Rpad,
+	"SUBSTRING":           Substring,
+	"SUBSTR":              Substr,
+	"INSTR":               Instr,
+	"LIST_ELEM":           ListElem,
+	"REPLACE":             ReplaceFn,
+	"REGEXP_MATCH":        RegExpMatch,
+	"REGEXP_FIND":         RegExpFind,
+	"REGEXP_FIND_ALL":     RegExpFindAll,
+	"REGEXP_REPLACE":      RegExpReplace,
+	"FORMAT":              Format,
+	"JSON_VALUE":          JsonValue,
+	"SPLIT":               Split,
+	"ARRAY_LENGTH":        ArrayLength,
+	"ARRAY_CONTAINS":      ArrayContains,
+	"MD5":                 Md5,
+	"SHA1":                Sha1,
+	"SHA256":              Sha256,
+	"SHA512":              Sha512,
+	"MD5_HMAC":            Md5Hmac,
+	"SHA1_HMAC":           Sha1Hmac,
+	"SHA256_HMAC":         Sha256Hmac,
+	"SHA512_HMAC":         Sha512Hmac,
+	"DATETIME_FORMAT":     DatetimeFormat,
+	"YEAR":                Year,
+	"MONTH":               Month,
+	"DAY":                 Day,
+	"HOUR":                Hour,
+	"MINUTE":              Minute,
+	"SECOND":              Second,
+	"MILLISECOND":         Millisecond,
+	"MICROSECOND":         Microsecond,
+	"NANOSECOND":          Nanosecond,
+	"WEEKDAY":             Weekday,
+	"UNIX_TIME":           UnixTime,
+	"UNIX_NANO_TIME":      UnixNanoTime,
+	"DAY_OF_YEAR":         DayOfYear,
+	"WEEK_OF_YEAR":        WeekOfYear,
+	"ADD_YEAR":            AddYear,
+	"ADD_MONTH":           AddMonth,
+	"ADD_DAY":             AddDay,
+	"ADD_HOUR":            AddHour,
+	"ADD_MINUTE":          AddMinute,
+	"ADD_SECOND":          AddSecond,
+	"ADD_MILLI":           AddMilli,
+	"ADD_MICRO":           AddMicro,
+	"ADD_NANO":            AddNano,
+	"TRUNC_MONTH":         TruncMonth,
+	"TRUNC_DAY":           TruncDay,
+	"TRUNC_TIME":          TruncTime,
+	"TRUNC_HOUR":          TruncTime,
+	"TRUNC_MINUTE":        TruncMinute,
+	"TRUNC_SECOND":        TruncSecond,
+	"TRUNC_MILLI":         TruncMilli,
+	"TRUNC_MICRO":         TruncMicro,
+	"TRUNC_NANO":          TruncNano,
+	"DATE_DIFF":           DateDiff,
+	"TIME_DIFF":           TimeDiff,
+	"TIME_NANO_DIFF":      TimeNanoDiff,
+	"UTC":                 UTC,
+	"CONVERT_TZ":          ConvertTZ,
+	"TZ_OFFSET":           TZOffset,
+	"TZ_NAME":             TZName,
+	"TIME_BUCKET":         TimeBucket,
+	"TIME_BUCKET_GAPFILL": TimeBucketGapfill,
+	"NANO_TO_DATETIME":    NanoToDatetime,
+	"STRING":              String,
+	"INTEGER":             Integer,
+	"FLOAT":               Float,
+	"DECIMAL":             Decimal,
+	"BOOLEAN":             Boolean,
+	"TERNARY":             Ternary,
+	"DATETIME":            Datetime,
 }
 
 type Direction string
@@ -265,7 +281,11 @@ func round(f float64, place float64) float64 {
 	return r
 }
 
-func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+func Round(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if 0 < len(args) && len(args) < 3 && (isDecimal(args[0]) || flags.ExactDecimal) {
+		return roundDecimal(args)
+	}
+
 	number, place, isnull, argsErr := roundParams(args)
 	if argsErr {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
@@ -277,6 +297,25 @@ func Round(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	return value.ParseFloat64(round(number, place)), nil
 }
 
+func roundDecimal(args []value.Primary) (value.Primary, error) {
+	d := value.ToDecimal(args[0])
+	if value.IsNull(d) {
+		return value.NewNull(), nil
+	}
+
+	var place int64
+	if len(args) == 2 {
+		i := value.ToInteger(args[1])
+		if value.IsNull(i) {
+			return value.NewNull(), nil
+		}
+		place = i.(*value.Integer).Raw()
+		value.Discard(i)
+	}
+
+	return d.(*value.Decimal).Round(int(place)), nil
+}
+
 func execMath1Arg(fn parser.Function, args []value.Primary, mathf func(float64) float64) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1070,6 +1109,129 @@ func ReplaceFn(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return value.NewString(r), nil
 }
 
+func compileRegExp(fn parser.Function, pattern value.Primary, flags value.Primary) (*regexp.Regexp, error) {
+	p := value.ToString(pattern)
+	if value.IsNull(p) {
+		return nil, nil
+	}
+	patternStr := p.(*value.String).Raw()
+	value.Discard(p)
+
+	flagsStr := ""
+	if flags != nil {
+		f := value.ToString(flags)
+		if value.IsNull(f) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the flags must be a string")
+		}
+		flagsStr = f.(*value.String).Raw()
+		value.Discard(f)
+	}
+
+	re, err := RegExps.Compile(patternStr, flagsStr)
+	if err != nil {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+	}
+	return re, nil
+}
+
+func regExpFunctionArgs(fn parser.Function, args []value.Primary) (string, *regexp.Regexp, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return "", nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return "", nil, nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	var flags value.Primary
+	if 2 < len(args) {
+		flags = args[2]
+	}
+	re, err := compileRegExp(fn, args[1], flags)
+	return str, re, err
+}
+
+func RegExpMatch(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, err := regExpFunctionArgs(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+	return value.NewTernary(ternary.ConvertFromBool(re.MatchString(str))), nil
+}
+
+func RegExpFind(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, err := regExpFunctionArgs(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return value.NewNull(), nil
+	}
+
+	loc := re.FindStringIndex(str)
+	if loc == nil {
+		return value.NewNull(), nil
+	}
+	return value.NewString(str[loc[0]:loc[1]]), nil
+}
+
+func RegExpFindAll(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	str, re, err := regExpFunctionArgs(fn, args)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return value.NewNull(), nil
+	}
+
+	matches := re.FindAllString(str, -1)
+	array := make(txjson.Array, 0, len(matches))
+	for _, m := range matches {
+		array = append(array, txjson.String(m))
+	}
+	return value.NewString(array.Encode()), nil
+}
+
+func RegExpReplace(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 3 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3, 4})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	var flags value.Primary
+	if 3 < len(args) {
+		flags = args[3]
+	}
+	re, err := compileRegExp(fn, args[1], flags)
+	if err != nil {
+		return nil, err
+	}
+	if re == nil {
+		return value.NewNull(), nil
+	}
+
+	r := value.ToString(args[2])
+	if value.IsNull(r) {
+		return value.NewNull(), nil
+	}
+	repl := r.(*value.String).Raw()
+	value.Discard(r)
+
+	return value.NewString(re.ReplaceAllString(str, repl)), nil
+}
+
 func Format(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) < 1 {
 		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
@@ -1114,6 +1276,67 @@ func JsonValue(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Pr
 	return v, nil
 }
 
+func Split(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+	sepStr := sep.(*value.String).Raw()
+	value.Discard(sep)
+
+	list := strings.Split(str, sepStr)
+	values := make([]value.Primary, len(list))
+	for i := range list {
+		values[i] = value.NewString(list[i])
+	}
+	return value.NewArray(values), nil
+}
+
+func ArrayLength(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	array, ok := args[0].(*value.Array)
+	if !ok {
+		return value.NewNull(), nil
+	}
+	return value.NewInteger(int64(array.Len())), nil
+}
+
+func ArrayContains(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	array, ok := args[0].(*value.Array)
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	result := ternary.FALSE
+	for _, v := range array.Raw() {
+		switch value.Equal(v, args[1], flags.DatetimeFormat) {
+		case ternary.TRUE:
+			return value.NewTernary(ternary.TRUE), nil
+		case ternary.UNKNOWN:
+			result = ternary.UNKNOWN
+		}
+	}
+	return value.NewTernary(result), nil
+}
+
 func Md5(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	return execCrypto(fn, args, md5.New)
 }
@@ -1552,6 +1775,143 @@ func UTC(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Prim
 	return value.NewDatetime(t), nil
 }
 
+// ConvertTZ interprets the date and time of the datetime as the time in the first time zone,
+// and returns the datetime converted to the second time zone.
+func ConvertTZ(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 3 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
+	}
+
+	dt := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	t := dt.(*value.Datetime).Raw()
+	value.Discard(dt)
+
+	var locs [2]*time.Location
+	for i := 0; i < 2; i++ {
+		s := value.ToString(args[i+1])
+		if value.IsNull(s) {
+			return value.NewNull(), nil
+		}
+		loc, err := cmd.LoadLocation(s.(*value.String).Raw())
+		value.Discard(s)
+		if err != nil {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, err.Error())
+		}
+		locs[i] = loc
+	}
+
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), locs[0])
+	return value.NewDatetime(t.In(locs[1])), nil
+}
+
+func TZOffset(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	dt := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	s := dt.(*value.Datetime).Format("-07:00")
+	value.Discard(dt)
+
+	return value.NewString(s), nil
+}
+
+// TZName returns the IANA time zone name of the datetime.
+// If the datetime does not have the name, then returns the abbreviation or the offset.
+func TZName(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	dt := value.ToDatetime(args[0], flags.DatetimeFormat)
+	if value.IsNull(dt) {
+		return value.NewNull(), nil
+	}
+	t := dt.(*value.Datetime).Raw()
+	value.Discard(dt)
+
+	name := t.Location().String()
+	if len(name) < 1 || name == "Local" {
+		name, _ = t.Zone()
+		if len(name) < 1 {
+			name = t.Format("-07:00")
+		}
+	}
+	return value.NewString(name), nil
+}
+
+// timeBucketOrigin returns the default origin of time buckets.
+// Buckets of months are aligned to the first day of a year, and other buckets
+// are aligned to a Monday.
+func timeBucketOrigin(iv *value.Interval, loc *time.Location) time.Time {
+	if iv.Months() != 0 {
+		return time.Date(2000, 1, 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(2000, 1, 3, 0, 0, 0, 0, loc)
+}
+
+func bucketWidth(fn parser.Function, p value.Primary) (*value.Interval, error) {
+	iv := value.ToInterval(p)
+	if value.IsNull(iv) || !iv.(*value.Interval).IsValidBucketWidth() {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a positive interval of either months or days and time")
+	}
+	return iv.(*value.Interval), nil
+}
+
+func timeBucket(fn parser.Function, width value.Primary, dt value.Primary, origin value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	iv, err := bucketWidth(fn, width)
+	if err != nil {
+		return nil, err
+	}
+
+	p := value.ToDatetime(dt, flags.DatetimeFormat)
+	if value.IsNull(p) {
+		return value.NewNull(), nil
+	}
+	t := p.(*value.Datetime).Raw()
+	value.Discard(p)
+
+	o := timeBucketOrigin(iv, t.Location())
+	if origin != nil {
+		op := value.ToDatetime(origin, flags.DatetimeFormat)
+		if value.IsNull(op) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the third argument must be a datetime")
+		}
+		o = op.(*value.Datetime).Raw()
+		value.Discard(op)
+	}
+
+	return value.NewDatetime(iv.Bucket(t, o)), nil
+}
+
+// TimeBucket returns the start of the bucket of the interval width that contains the datetime.
+func TimeBucket(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 || 3 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3})
+	}
+
+	var origin value.Primary
+	if 2 < len(args) {
+		origin = args[2]
+	}
+	return timeBucket(fn, args[0], args[1], origin, flags)
+}
+
+// TimeBucketGapfill returns the same value as TimeBucket.
+// In a GROUP BY clause, the groups of missing buckets are generated as well.
+func TimeBucketGapfill(fn parser.Function, args []value.Primary, flags *cmd.Flags) (value.Primary, error) {
+	if len(args) < 2 || 4 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3, 4})
+	}
+	return timeBucket(fn, args[0], args[1], nil, flags)
+}
+
 func NanoToDatetime(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1579,6 +1939,8 @@ func String(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prima
 		return value.NewString(args[0].(*value.Ternary).Ternary().String()), nil
 	case *value.Datetime:
 		return value.NewString(args[0].(*value.Datetime).Format(time.RFC3339Nano)), nil
+	case *value.Array:
+		return value.NewString(json.ParseValueToStructure(args[0]).Encode()), nil
 	default:
 		return value.ToString(args[0]), nil
 	}
@@ -1592,6 +1954,11 @@ func Integer(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Prim
 	switch args[0].(type) {
 	case *value.Float:
 		return value.NewInteger(int64(round(args[0].(*value.Float).Raw(), 0))), nil
+	case *value.Decimal:
+		if i, ok := args[0].(*value.Decimal).Round(0).Int64(); ok {
+			return value.NewInteger(i), nil
+		}
+		return value.NewNull(), nil
 	case *value.Datetime:
 		return value.NewInteger(args[0].(*value.Datetime).Raw().Unix()), nil
 	default:
@@ -1617,6 +1984,43 @@ func Float(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primar
 	}
 }
 
+func Decimal(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	var d value.Primary
+	if dt, ok := args[0].(*value.Datetime); ok {
+		t := dt.Raw()
+		nano := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(1e9))
+		nano.Add(nano, big.NewInt(int64(t.Nanosecond())))
+		d = value.NewDecimal(nano, 9).Normalize()
+	} else {
+		d = value.ToDecimal(args[0])
+	}
+
+	if len(args) == 2 {
+		i := value.ToInteger(args[1])
+		if value.IsNull(i) {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be an integer")
+		}
+		scale := i.(*value.Integer).Raw()
+		value.Discard(i)
+		if scale < 0 {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be greater than or equal to 0")
+		}
+		if value.DecimalMaxScale < scale {
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the second argument must be less than or equal to "+strconv.Itoa(value.DecimalMaxScale))
+		}
+
+		if !value.IsNull(d) {
+			d = d.(*value.Decimal).SetScale(int(scale))
+		}
+	}
+
+	return d, nil
+}
+
 func Boolean(fn parser.Function, args []value.Primary, _ *cmd.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1691,6 +2095,46 @@ func Now(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value
 	return value.NewDatetime(scope.Now()), nil
 }
 
+func Grouping(scope *ReferenceScope, fn parser.Function) (value.Primary, error) {
+	if len(fn.Args) < 1 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(fn, fn.Name, "at least 1 argument")
+	}
+	if len(scope.Records) < 1 || !scope.Records[0].view.isGrouped {
+		return nil, NewNotGroupingRecordsError(fn, fn.Name)
+	}
+
+	view := scope.Records[0].view
+	record := view.RecordSet[scope.Records[0].recordIndex]
+	gIdx, hasGroupingId := view.Header.ContainsGroupingId()
+
+	var result int64 = 0
+	for _, arg := range fn.Args {
+		switch arg.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+		default:
+			return nil, NewFieldNotGroupKeyError(arg)
+		}
+
+		idx, err := view.Header.SearchIndex(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !view.Header[idx].IsGroupKey {
+			return nil, NewFieldNotGroupKeyError(arg)
+		}
+
+		var bit int64 = 0
+		if hasGroupingId {
+			mask := record[gIdx][0].(*value.String).Raw()
+			if mask[view.Header.GroupKeyPosition(idx)] == '1' {
+				bit = 1
+			}
+		}
+		result = result<<1 | bit
+	}
+	return value.NewInteger(result), nil
+}
+
 func JsonObject(ctx context.Context, scope *ReferenceScope, fn parser.Function) (value.Primary, error) {
 	if len(scope.Records) < 1 {
 		return value.NewNull(), nil
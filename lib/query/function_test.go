@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -304,6 +305,28 @@ var roundTests = []functionTest{
 		},
 		Result: value.NewFloat(-2.46),
 	},
+	{
+		Name: "Round Decimal",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimal(big.NewInt(2345), 3),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimal(big.NewInt(235), 2),
+	},
+	{
+		Name: "Round Decimal Negative Place",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimal(big.NewInt(-1250), 0),
+			value.NewInteger(-2),
+		},
+		Result: value.NewDecimal(big.NewInt(-1300), 0),
+	},
 	{
 		Name: "Round Null",
 		Function: parser.Function{
@@ -1995,6 +2018,240 @@ func TestReplaceFn(t *testing.T) {
 	testFunction(t, ReplaceFn, replaceFnTests)
 }
 
+var regExpMatchTests = []functionTest{
+	{
+		Name: "RegExpMatch",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch Not Matched",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "RegExpMatch with Flags",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("ABC123"),
+			value.NewString("^[a-z]+\\d+$"),
+			value.NewString("i"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "RegExpMatch String is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("^[a-z]+\\d+$"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewNull(),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "RegExpMatch Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args:  []value.Primary{},
+		Error: "function regexp_match takes 2 or 3 arguments",
+	},
+	{
+		Name: "RegExpMatch Invalid Pattern Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("[a-z"),
+		},
+		Error: "error parsing regexp: missing closing ]: `[a-z` for function regexp_match",
+	},
+	{
+		Name: "RegExpMatch Invalid Flags Error",
+		Function: parser.Function{
+			Name: "regexp_match",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123"),
+			value.NewString("[a-z]"),
+			value.NewNull(),
+		},
+		Error: "the flags must be a string for function regexp_match",
+	},
+}
+
+func TestRegExpMatch(t *testing.T) {
+	testFunction(t, RegExpMatch, regExpMatchTests)
+}
+
+var regExpFindTests = []functionTest{
+	{
+		Name: "RegExpFind",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123def456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("123"),
+	},
+	{
+		Name: "RegExpFind Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdef"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind String is Null",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpFind Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_find",
+		},
+		Args:  []value.Primary{},
+		Error: "function regexp_find takes 2 or 3 arguments",
+	},
+}
+
+func TestRegExpFind(t *testing.T) {
+	testFunction(t, RegExpFind, regExpFindTests)
+}
+
+var regExpFindAllTests = []functionTest{
+	{
+		Name: "RegExpFindAll",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abc123def456"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("[\"123\",\"456\"]"),
+	},
+	{
+		Name: "RegExpFindAll Not Matched",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdef"),
+			value.NewString("\\d+"),
+		},
+		Result: value.NewString("[]"),
+	},
+	{
+		Name: "RegExpFindAll Pattern is Null",
+		Function: parser.Function{
+			Name: "regexp_find_all",
+		},
+		Args: []value.Primary{
+			value.NewString("abcdef"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestRegExpFindAll(t *testing.T) {
+	testFunction(t, RegExpFindAll, regExpFindAllTests)
+}
+
+var regExpReplaceTests = []functionTest{
+	{
+		Name: "RegExpReplace",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("2012-02-03"),
+			value.NewString("(\\d+)-(\\d+)-(\\d+)"),
+			value.NewString("$3/$2/$1"),
+		},
+		Result: value.NewString("03/02/2012"),
+	},
+	{
+		Name: "RegExpReplace with Flags",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abcABC"),
+			value.NewString("b"),
+			value.NewString("x"),
+			value.NewString("i"),
+		},
+		Result: value.NewString("axcAxC"),
+	},
+	{
+		Name: "RegExpReplace Replacement is Null",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args: []value.Primary{
+			value.NewString("abcABC"),
+			value.NewString("b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "RegExpReplace Arguments Error",
+		Function: parser.Function{
+			Name: "regexp_replace",
+		},
+		Args:  []value.Primary{},
+		Error: "function regexp_replace takes 3 or 4 arguments",
+	},
+}
+
+func TestRegExpReplace(t *testing.T) {
+	testFunction(t, RegExpReplace, regExpReplaceTests)
+}
+
 var formatTests = []functionTest{
 	{
 		Name: "Format",
@@ -2106,6 +2363,157 @@ func TestJsonValue(t *testing.T) {
 	testFunction(t, JsonValue, jsonValueTests)
 }
 
+var splitTests = []functionTest{
+	{
+		Name: "Split",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b,,c"),
+			value.NewString(","),
+		},
+		Result: value.NewArray([]value.Primary{
+			value.NewString("a"),
+			value.NewString("b"),
+			value.NewString(""),
+			value.NewString("c"),
+		}),
+	},
+	{
+		Name: "Split Null",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(","),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Split Separator is Null",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Split Arguments Error",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString("a,b"),
+		},
+		Error: "function split takes exactly 2 arguments",
+	},
+}
+
+func TestSplit(t *testing.T) {
+	testFunction(t, Split, splitTests)
+}
+
+var arrayLengthTests = []functionTest{
+	{
+		Name: "ArrayLength",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewNull()}),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "ArrayLength Not Array",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewString("a"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayLength Arguments Error",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args:  []value.Primary{},
+		Error: "function array_length takes exactly 1 argument",
+	},
+}
+
+func TestArrayLength(t *testing.T) {
+	testFunction(t, ArrayLength, arrayLengthTests)
+}
+
+var arrayContainsTests = []functionTest{
+	{
+		Name: "ArrayContains",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("2")}),
+			value.NewInteger(2),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "ArrayContains Not Found",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "ArrayContains Unknown",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewNull()}),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Not Array",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewInteger(3),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Arguments Error",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Error: "function array_contains takes exactly 2 arguments",
+	},
+}
+
+func TestArrayContains(t *testing.T) {
+	testFunction(t, ArrayContains, arrayContainsTests)
+}
+
 var md5Tests = []functionTest{
 	{
 		Name: "Md5",
@@ -3113,6 +3521,299 @@ func TestUTC(t *testing.T) {
 	testFunction(t, UTC, utcTests)
 }
 
+func TestConvertTZ(t *testing.T) {
+	for _, v := range []struct {
+		Name   string
+		Args   []value.Primary
+		Result string
+		Error  string
+	}{
+		{
+			Name:   "ConvertTZ Standard Time",
+			Args:   []value.Primary{value.NewString("2024-03-10 01:30:00"), value.NewString("America/New_York"), value.NewString("UTC")},
+			Result: "2024-03-10T06:30:00Z",
+		},
+		{
+			Name:   "ConvertTZ Daylight Saving Time",
+			Args:   []value.Primary{value.NewString("2024-03-10 03:30:00"), value.NewString("America/New_York"), value.NewString("Asia/Tokyo")},
+			Result: "2024-03-10T16:30:00+09:00",
+		},
+		{
+			Name:   "ConvertTZ Fixed Offset",
+			Args:   []value.Primary{value.NewDatetime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), value.NewString("+09:00"), value.NewString("-05:30")},
+			Result: "2023-12-31T09:30:00-05:30",
+		},
+		{
+			Name:   "ConvertTZ Null",
+			Args:   []value.Primary{value.NewNull(), value.NewString("UTC"), value.NewString("UTC")},
+			Result: "NULL",
+		},
+		{
+			Name:   "ConvertTZ Time Zone Null",
+			Args:   []value.Primary{value.NewString("2024-01-01 00:00:00"), value.NewNull(), value.NewString("UTC")},
+			Result: "NULL",
+		},
+		{
+			Name:  "ConvertTZ Invalid Time Zone",
+			Args:  []value.Primary{value.NewString("2024-01-01 00:00:00"), value.NewString("UTC"), value.NewString("Asia/NotExist")},
+			Error: "timezone \"Asia/NotExist\" does not exist for function convert_tz",
+		},
+		{
+			Name:  "ConvertTZ Arguments Error",
+			Args:  []value.Primary{value.NewString("2024-01-01 00:00:00")},
+			Error: "function convert_tz takes exactly 3 arguments",
+		},
+	} {
+		result, err := ConvertTZ(parser.Function{Name: "convert_tz"}, v.Args, TestTx.Flags)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		s := "NULL"
+		if dt, ok := result.(*value.Datetime); ok {
+			s = dt.Format(time.RFC3339Nano)
+		}
+		if s != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, s, v.Result)
+		}
+	}
+}
+
+var tzOffsetTests = []functionTest{
+	{
+		Name: "TZOffset",
+		Function: parser.Function{
+			Name: "tz_offset",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.FixedZone("", -4*3600))),
+		},
+		Result: value.NewString("-04:00"),
+	},
+	{
+		Name: "TZOffset UTC",
+		Function: parser.Function{
+			Name: "tz_offset",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Result: value.NewString("+00:00"),
+	},
+	{
+		Name: "TZOffset Null",
+		Function: parser.Function{
+			Name: "tz_offset",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "TZOffset Arguments Error",
+		Function: parser.Function{
+			Name: "tz_offset",
+		},
+		Args:  []value.Primary{},
+		Error: "function tz_offset takes exactly 1 argument",
+	},
+}
+
+func TestTZOffset(t *testing.T) {
+	testFunction(t, TZOffset, tzOffsetTests)
+}
+
+var tzNameTests = []functionTest{
+	{
+		Name: "TZName",
+		Function: parser.Function{
+			Name: "tz_name",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Result: value.NewString("UTC"),
+	},
+	{
+		Name: "TZName Abbreviation",
+		Function: parser.Function{
+			Name: "tz_name",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.FixedZone("JST", 9*3600))),
+		},
+		Result: value.NewString("JST"),
+	},
+	{
+		Name: "TZName Offset",
+		Function: parser.Function{
+			Name: "tz_name",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2024, 7, 1, 0, 0, 0, 0, time.FixedZone("", 9*3600))),
+		},
+		Result: value.NewString("+09:00"),
+	},
+	{
+		Name: "TZName Null",
+		Function: parser.Function{
+			Name: "tz_name",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "TZName Arguments Error",
+		Function: parser.Function{
+			Name: "tz_name",
+		},
+		Args:  []value.Primary{},
+		Error: "function tz_name takes exactly 1 argument",
+	},
+}
+
+func TestTZName(t *testing.T) {
+	testFunction(t, TZName, tzNameTests)
+}
+
+var timeBucketTests = []functionTest{
+	{
+		Name: "TimeBucket",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 0, int64(15*time.Minute)),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 15, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TimeBucket Weeks",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewString("P1W"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 1, 30, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TimeBucket Months",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(3, 0, 0),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TimeBucket With Origin",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 0, int64(time.Hour)),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 1, 1, 0, 30, 0, 0, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 8, 30, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TimeBucket Datetime Is Null",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 1, 0),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "TimeBucket Arguments Error",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 1, 0),
+		},
+		Error: "function time_bucket takes 2 or 3 arguments",
+	},
+	{
+		Name: "TimeBucket Interval Error",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(1, 1, 0),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Error: "the first argument must be a positive interval of either months or days and time for function time_bucket",
+	},
+	{
+		Name: "TimeBucket Origin Error",
+		Function: parser.Function{
+			Name: "time_bucket",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 1, 0),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewString("abc"),
+		},
+		Error: "the third argument must be a datetime for function time_bucket",
+	},
+}
+
+func TestTimeBucket(t *testing.T) {
+	testFunction(t, TimeBucket, timeBucketTests)
+}
+
+var timeBucketGapfillTests = []functionTest{
+	{
+		Name: "TimeBucketGapfill",
+		Function: parser.Function{
+			Name: "time_bucket_gapfill",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 1, 0),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 1, 0, 0, 0, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 3, 1, 0, 0, 0, 0, GetTestLocation())),
+		},
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		Name: "TimeBucketGapfill Arguments Error",
+		Function: parser.Function{
+			Name: "time_bucket_gapfill",
+		},
+		Args: []value.Primary{
+			value.NewInterval(0, 1, 0),
+		},
+		Error: "function time_bucket_gapfill takes 2 to 4 arguments",
+	},
+}
+
+func TestTimeBucketGapfill(t *testing.T) {
+	testFunction(t, TimeBucketGapfill, timeBucketGapfillTests)
+}
+
 var nanoToDatetimeTests = []functionTest{
 	{
 		Name: "NanoToDatetime",
@@ -3189,6 +3890,16 @@ var stringTests = []functionTest{
 		},
 		Result: value.NewString("2012-02-03T09:18:15Z"),
 	},
+	{
+		Name: "String from Array",
+		Function: parser.Function{
+			Name: "string",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a"), value.NewNull()}),
+		},
+		Result: value.NewString("[1,\"a\",null]"),
+	},
 	{
 		Name: "String Arguments Error",
 		Function: parser.Function{
@@ -3313,6 +4024,117 @@ func TestFloat(t *testing.T) {
 	testFunction(t, Float, floatTests)
 }
 
+var decimalTests = []functionTest{
+	{
+		Name: "Decimal from String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("12.30"),
+		},
+		Result: value.NewDecimal(big.NewInt(1230), 2),
+	},
+	{
+		Name: "Decimal from Float",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewFloat(0.1),
+		},
+		Result: value.NewDecimal(big.NewInt(1), 1),
+	},
+	{
+		Name: "Decimal with Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+			value.NewInteger(3),
+		},
+		Result: value.NewDecimal(big.NewInt(1500), 3),
+	},
+	{
+		Name: "Decimal with Smaller Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.005"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimal(big.NewInt(101), 2),
+	},
+	{
+		Name: "Decimal from Datetime",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+		},
+		Result: value.NewDecimal(big.NewInt(132826069512345), 5),
+	},
+	{
+		Name: "Decimal from Invalid String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Decimal Arguments Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args:  []value.Primary{},
+		Error: "function decimal takes 1 or 2 arguments",
+	},
+	{
+		Name: "Decimal Scale Not Integer Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+			value.NewString("abc"),
+		},
+		Error: "the second argument must be an integer for function decimal",
+	},
+	{
+		Name: "Decimal Negative Scale Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+			value.NewInteger(-1),
+		},
+		Error: "the second argument must be greater than or equal to 0 for function decimal",
+	},
+	{
+		Name: "Decimal Too Large Scale Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1.5"),
+			value.NewInteger(4097),
+		},
+		Error: "the second argument must be less than or equal to 4096 for function decimal",
+	},
+}
+
+func TestDecimal(t *testing.T) {
+	testFunction(t, Decimal, decimalTests)
+}
+
 var booleanTests = []functionTest{
 	{
 		Name: "Boolean from String",
@@ -3550,6 +4372,222 @@ func TestNow(t *testing.T) {
 	}
 }
 
+var groupingTests = []struct {
+	Name     string
+	Function parser.Function
+	Scope    *ReferenceScope
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "Grouping",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+		},
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: []HeaderField{
+						{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column3", Number: 3, IsFromTable: true},
+						{Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+					},
+					RecordSet: []Record{
+						{
+							NewCell(value.NewInteger(1)),
+							NewCell(value.NewNull()),
+							NewGroupCell([]value.Primary{value.NewInteger(10), value.NewInteger(20)}),
+							NewCell(value.NewString("01")),
+							NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+							NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "Grouping Multiple Arguments",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: []HeaderField{
+						{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column3", Number: 3, IsFromTable: true},
+						{Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+					},
+					RecordSet: []Record{
+						{
+							NewCell(value.NewInteger(1)),
+							NewCell(value.NewNull()),
+							NewGroupCell([]value.Primary{value.NewInteger(10), value.NewInteger(20)}),
+							NewCell(value.NewString("01")),
+							NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+							NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Grouping Without Grouping Sets",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: []HeaderField{
+						{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+					},
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+							NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "Grouping Arguments Length Error",
+		Function: parser.Function{
+			Name: "grouping",
+		},
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: []HeaderField{
+						{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column3", Number: 3, IsFromTable: true},
+						{Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+					},
+					RecordSet: []Record{
+						{
+							NewCell(value.NewInteger(1)),
+							NewCell(value.NewNull()),
+							NewGroupCell([]value.Primary{value.NewInteger(10), value.NewInteger(20)}),
+							NewCell(value.NewString("01")),
+							NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+							NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Error: "function grouping takes at least 1 argument",
+	},
+	{
+		Name: "Grouping Not Grouping Records Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "function grouping cannot aggregate not grouping records",
+	},
+	{
+		Name: "Grouping Field Not Group Key Error",
+		Function: parser.Function{
+			Name: "grouping",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			},
+		},
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: []HeaderField{
+						{View: "table1", Column: "column1", Number: 1, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column2", Number: 2, IsFromTable: true, IsGroupKey: true},
+						{View: "table1", Column: "column3", Number: 3, IsFromTable: true},
+						{Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+						{View: "table1", Column: GroupingIdColumn},
+					},
+					RecordSet: []Record{
+						{
+							NewCell(value.NewInteger(1)),
+							NewCell(value.NewNull()),
+							NewGroupCell([]value.Primary{value.NewInteger(10), value.NewInteger(20)}),
+							NewCell(value.NewString("01")),
+							NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(1)}),
+							NewGroupCell([]value.Primary{value.NewInteger(2), value.NewInteger(3)}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Error: "field column3 is not a group key",
+	},
+}
+
+func TestGrouping(t *testing.T) {
+	for _, v := range groupingTests {
+		result, err := Grouping(v.Scope, v.Function)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
 var jsonObjectTests = []struct {
 	Name     string
 	Function parser.Function
@@ -9,6 +9,7 @@ import (
 )
 
 const InternalIdColumn = "@__internal_id"
+const GroupingIdColumn = "@__grouping_id"
 
 type HeaderField struct {
 	View         string
@@ -222,6 +223,25 @@ func (h Header) ContainsInternalId(viewName string) (int, error) {
 	return h.SearchIndex(fieldRef)
 }
 
+func (h Header) ContainsGroupingId() (int, bool) {
+	for i := range h {
+		if !h[i].IsFromTable && h[i].Column == GroupingIdColumn {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (h Header) GroupKeyPosition(idx int) int {
+	pos := 0
+	for i := 0; i < idx; i++ {
+		if h[i].IsGroupKey {
+			pos++
+		}
+	}
+	return pos
+}
+
 func (h Header) Update(reference string, fields []parser.QueryExpression) error {
 	if fields != nil {
 		if len(fields) != h.Len() {
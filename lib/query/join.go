@@ -106,6 +106,7 @@ func ParseJoinCondition(join parser.Join, view *View, joinView *View) (parser.Qu
 }
 
 func CrossJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView *View) error {
+	setPlanJoinOperation(ctx, PlanCrossJoin)
 	mergedHeader := view.Header.Merge(joinView.Header)
 	records := make(RecordSet, view.RecordLen()*joinView.RecordLen())
 
@@ -137,6 +138,9 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	}
 
 	mergedHeader := view.Header.Merge(joinView.Header)
+	plan := NewJoinPlan(scope, view, joinView, ParseEquiJoinKeys(condition, mergedHeader, view.FieldLen()))
+	scope.Tx.setJoinStrategy(plan.Strategy)
+	setPlanJoinOperation(ctx, plan.Strategy.String())
 
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
 	recordsList := make([]RecordSet, gm.Number)
@@ -155,7 +159,8 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	InnerJoinLoop:
 		for i := start; i < end; i++ {
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for n := 0; n < plan.CandidateLen(i); n++ {
+				j := plan.CandidateIndex(i, n)
 				if gm.HasError() {
 					break InnerJoinLoop
 				}
@@ -224,11 +229,17 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	}
 
 	mergedHeader := view.Header.Merge(joinView.Header)
+	keys := ParseEquiJoinKeys(condition, mergedHeader, view.FieldLen())
 
 	if direction == parser.RIGHT {
 		view, joinView = joinView, view
+		keys = keys.Swap()
 	}
 
+	plan := NewJoinPlan(scope, view, joinView, keys)
+	scope.Tx.setJoinStrategy(plan.Strategy)
+	setPlanJoinOperation(ctx, plan.Strategy.String())
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
 
 	recordsList := make([]RecordSet, gm.Number+1)
@@ -257,7 +268,8 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	OuterJoinLoop:
 		for i := start; i < end; i++ {
 			match := false
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for n := 0; n < plan.CandidateLen(i); n++ {
+				j := plan.CandidateIndex(i, n)
 				if gm.HasError() {
 					break OuterJoinLoop
 				}
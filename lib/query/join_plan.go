@@ -0,0 +1,279 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+type JoinStrategy int32
+
+const (
+	NestedLoopJoin JoinStrategy = iota + 1
+	HashJoin
+	SortMergeJoin
+)
+
+var joinStrategyLiterals = map[JoinStrategy]string{
+	NestedLoopJoin: "NESTED LOOP JOIN",
+	HashJoin:       "HASH JOIN",
+	SortMergeJoin:  "SORT MERGE JOIN",
+}
+
+func (s JoinStrategy) String() string {
+	return joinStrategyLiterals[s]
+}
+
+type EquiJoinKeys struct {
+	ViewIndices     []int
+	JoinViewIndices []int
+}
+
+func ParseEquiJoinKeys(condition parser.QueryExpression, mergedHeader Header, viewFieldLen int) EquiJoinKeys {
+	keys := EquiJoinKeys{}
+	keys.parse(condition, mergedHeader, viewFieldLen)
+	return keys
+}
+
+func (k *EquiJoinKeys) parse(expr parser.QueryExpression, mergedHeader Header, viewFieldLen int) {
+	switch expr.(type) {
+	case parser.Parentheses:
+		k.parse(expr.(parser.Parentheses).Expr, mergedHeader, viewFieldLen)
+	case parser.Logic:
+		logic := expr.(parser.Logic)
+		if logic.Operator.Token == parser.AND {
+			k.parse(logic.LHS, mergedHeader, viewFieldLen)
+			k.parse(logic.RHS, mergedHeader, viewFieldLen)
+		}
+	case parser.Comparison:
+		comp := expr.(parser.Comparison)
+		if comp.Operator.Literal != "=" {
+			return
+		}
+
+		lhsIdx, ok := equiJoinKeyIndex(comp.LHS, mergedHeader)
+		if !ok {
+			return
+		}
+		rhsIdx, ok := equiJoinKeyIndex(comp.RHS, mergedHeader)
+		if !ok {
+			return
+		}
+
+		if lhsIdx < viewFieldLen && viewFieldLen <= rhsIdx {
+			k.ViewIndices = append(k.ViewIndices, lhsIdx)
+			k.JoinViewIndices = append(k.JoinViewIndices, rhsIdx-viewFieldLen)
+		} else if rhsIdx < viewFieldLen && viewFieldLen <= lhsIdx {
+			k.ViewIndices = append(k.ViewIndices, rhsIdx)
+			k.JoinViewIndices = append(k.JoinViewIndices, lhsIdx-viewFieldLen)
+		}
+	}
+}
+
+func equiJoinKeyIndex(expr parser.QueryExpression, mergedHeader Header) (int, bool) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		idx, err := mergedHeader.SearchIndex(expr)
+		return idx, err == nil
+	}
+	return -1, false
+}
+
+func (k EquiJoinKeys) Len() int {
+	return len(k.ViewIndices)
+}
+
+func (k EquiJoinKeys) Swap() EquiJoinKeys {
+	return EquiJoinKeys{
+		ViewIndices:     k.JoinViewIndices,
+		JoinViewIndices: k.ViewIndices,
+	}
+}
+
+// JoinPlan holds the records of the joined view to be compared with each record of the view.
+//
+// Hash keys and sort orders follow the same comparison rules as GROUP BY and ORDER BY,
+// and the join condition is evaluated for every candidate, so the results and their order
+// are the same as those of the nested loop join.
+type JoinPlan struct {
+	Strategy JoinStrategy
+
+	joinViewLen int
+	candidates  [][]int
+}
+
+func NewJoinPlan(scope *ReferenceScope, view *View, joinView *View, keys EquiJoinKeys) *JoinPlan {
+	plan := &JoinPlan{
+		Strategy:    NestedLoopJoin,
+		joinViewLen: joinView.RecordLen(),
+	}
+	if keys.Len() < 1 {
+		return plan
+	}
+
+	viewKeys := joinKeyValues(scope, view.RecordSet, keys.ViewIndices)
+	joinViewKeys := joinKeyValues(scope, joinView.RecordSet, keys.JoinViewIndices)
+
+	if isMergeable(viewKeys, joinViewKeys, keys.Len()) {
+		plan.Strategy = SortMergeJoin
+		plan.candidates = mergeJoinCandidates(viewKeys, joinViewKeys)
+	} else {
+		plan.Strategy = HashJoin
+		plan.candidates = hashJoinCandidates(viewKeys, joinViewKeys)
+	}
+	return plan
+}
+
+func (p *JoinPlan) CandidateLen(viewIndex int) int {
+	if p.Strategy == NestedLoopJoin {
+		return p.joinViewLen
+	}
+	return len(p.candidates[viewIndex])
+}
+
+func (p *JoinPlan) CandidateIndex(viewIndex int, n int) int {
+	if p.Strategy == NestedLoopJoin {
+		return n
+	}
+	return p.candidates[viewIndex][n]
+}
+
+func joinKeyValues(scope *ReferenceScope, records RecordSet, indices []int) []SortValues {
+	keys := make([]SortValues, len(records))
+
+	for i := range records {
+		values := make(SortValues, len(indices))
+		for j, idx := range indices {
+			values[j] = NewSortValue(records[i][idx][0], scope.Tx.Flags)
+			if values[j].Type == NullType {
+				values = nil
+				break
+			}
+		}
+		keys[i] = values
+	}
+	return keys
+}
+
+func hashJoinCandidates(viewKeys []SortValues, joinViewKeys []SortValues) [][]int {
+	buf := GetComparisonKeysBuf()
+	defer PutComparisonkeysBuf(buf)
+
+	table := make(map[string][]int, len(joinViewKeys))
+	for i, key := range joinViewKeys {
+		if key == nil {
+			continue
+		}
+		buf.Reset()
+		key.Serialize(buf)
+		table[buf.String()] = append(table[buf.String()], i)
+	}
+
+	candidates := make([][]int, len(viewKeys))
+	for i, key := range viewKeys {
+		if key == nil {
+			continue
+		}
+		buf.Reset()
+		key.Serialize(buf)
+		candidates[i] = table[buf.String()]
+	}
+	return candidates
+}
+
+func mergeJoinCandidates(viewKeys []SortValues, joinViewKeys []SortValues) [][]int {
+	indices := make([]int, len(joinViewKeys))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	candidates := make([][]int, len(viewKeys))
+	start := 0
+	for i, key := range viewKeys {
+		for start < len(joinViewKeys) && compareJoinKeys(joinViewKeys[start], key) < 0 {
+			start++
+		}
+		end := start
+		for end < len(joinViewKeys) && compareJoinKeys(joinViewKeys[end], key) == 0 {
+			end++
+		}
+		candidates[i] = indices[start:end]
+	}
+	return candidates
+}
+
+func isMergeable(viewKeys []SortValues, joinViewKeys []SortValues, keyLen int) bool {
+	if len(viewKeys) < 1 || len(joinViewKeys) < 1 {
+		return false
+	}
+
+	types := make([]SortValueType, keyLen)
+	for _, keys := range [][]SortValues{viewKeys, joinViewKeys} {
+		for i, key := range keys {
+			if key == nil {
+				return false
+			}
+			for j := range key {
+				t := mergeableType(key[j].Type)
+				if t == NullType {
+					return false
+				}
+				if types[j] == NullType {
+					types[j] = t
+				} else if types[j] != t {
+					return false
+				}
+			}
+			if 0 < i && 0 < compareJoinKeys(keys[i-1], key) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func mergeableType(t SortValueType) SortValueType {
+	switch t {
+	case IntegerType, FloatType:
+		return FloatType
+	case DatetimeType, StringType:
+		return t
+	}
+	return NullType
+}
+
+func compareJoinKeys(values1 SortValues, values2 SortValues) int {
+	for i := range values1 {
+		if c := compareJoinKeyValue(values1[i], values2[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareJoinKeyValue(v1 *SortValue, v2 *SortValue) int {
+	switch v1.Type {
+	case IntegerType, FloatType:
+		if v1.Type == IntegerType && v2.Type == IntegerType {
+			return compareInt64(v1.Integer, v2.Integer)
+		}
+		if v1.Float < v2.Float {
+			return -1
+		} else if v1.Float > v2.Float {
+			return 1
+		}
+		return 0
+	case DatetimeType:
+		return compareInt64(v1.Datetime, v2.Datetime)
+	}
+	return strings.Compare(v1.String, v2.String)
+}
+
+func compareInt64(i1 int64, i2 int64) int {
+	if i1 < i2 {
+		return -1
+	} else if i1 > i2 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,213 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var joinPlanTestHeader = NewHeader("table1", []string{"column1", "column2"}).Merge(NewHeader("table2", []string{"column3", "column4"}))
+
+var parseEquiJoinKeysTests = []struct {
+	Name      string
+	Condition parser.QueryExpression
+	Result    EquiJoinKeys
+}{
+	{
+		Name: "ParseEquiJoinKeys",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: EquiJoinKeys{
+			ViewIndices:     []int{0},
+			JoinViewIndices: []int{0},
+		},
+	},
+	{
+		Name: "ParseEquiJoinKeys Multiple Keys",
+		Condition: parser.Logic{
+			LHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+					RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			RHS: parser.Logic{
+				LHS: parser.Comparison{
+					LHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(1)},
+					RHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)},
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+				},
+				RHS: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+				},
+				Operator: parser.Token{Token: parser.AND, Literal: "and"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Result: EquiJoinKeys{
+			ViewIndices:     []int{1, 0},
+			JoinViewIndices: []int{1, 0},
+		},
+	},
+	{
+		Name: "ParseEquiJoinKeys Or Operator",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			Operator: parser.Token{Token: parser.OR, Literal: "or"},
+		},
+		Result: EquiJoinKeys{},
+	},
+	{
+		Name: "ParseEquiJoinKeys Fields in the Same View",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: EquiJoinKeys{},
+	},
+	{
+		Name: "ParseEquiJoinKeys Not Field Reference",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.NewIntegerValue(1),
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: EquiJoinKeys{},
+	},
+	{
+		Name: "ParseEquiJoinKeys Field Does Not Exist",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Operator: parser.Token{Token: '=', Literal: "="},
+		},
+		Result: EquiJoinKeys{},
+	},
+}
+
+func TestParseEquiJoinKeys(t *testing.T) {
+	for _, v := range parseEquiJoinKeysTests {
+		result := ParseEquiJoinKeys(v.Condition, joinPlanTestHeader, 2)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func joinPlanTestView(view string, values ...value.Primary) *View {
+	records := make(RecordSet, len(values))
+	for i, v := range values {
+		records[i] = NewRecord([]value.Primary{v, value.NewString("str")})
+	}
+	return &View{
+		Header:    NewHeader(view, []string{"key", "value"}),
+		RecordSet: records,
+	}
+}
+
+var newJoinPlanTests = []struct {
+	Name       string
+	View       *View
+	JoinView   *View
+	Keys       EquiJoinKeys
+	Strategy   JoinStrategy
+	Candidates [][]int
+}{
+	{
+		Name:       "NewJoinPlan Nested Loop Join",
+		View:       joinPlanTestView("table1", value.NewInteger(1), value.NewInteger(2)),
+		JoinView:   joinPlanTestView("table2", value.NewInteger(2), value.NewInteger(1)),
+		Keys:       EquiJoinKeys{},
+		Strategy:   NestedLoopJoin,
+		Candidates: [][]int{{0, 1}, {0, 1}},
+	},
+	{
+		Name:       "NewJoinPlan Hash Join",
+		View:       joinPlanTestView("table1", value.NewInteger(3), value.NewString("1"), value.NewNull(), value.NewFloat(2)),
+		JoinView:   joinPlanTestView("table2", value.NewInteger(2), value.NewString("1"), value.NewInteger(2), value.NewNull(), value.NewFloat(1)),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0}, JoinViewIndices: []int{0}},
+		Strategy:   HashJoin,
+		Candidates: [][]int{{}, {1, 4}, {}, {0, 2}},
+	},
+	{
+		Name:       "NewJoinPlan Hash Join with Strings",
+		View:       joinPlanTestView("table1", value.NewString("abc"), value.NewString(" Def")),
+		JoinView:   joinPlanTestView("table2", value.NewString("DEF"), value.NewString("ABC")),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0}, JoinViewIndices: []int{0}},
+		Strategy:   HashJoin,
+		Candidates: [][]int{{1}, {0}},
+	},
+	{
+		Name:       "NewJoinPlan Sort Merge Join",
+		View:       joinPlanTestView("table1", value.NewInteger(1), value.NewInteger(2), value.NewInteger(2), value.NewInteger(5)),
+		JoinView:   joinPlanTestView("table2", value.NewFloat(0.5), value.NewInteger(2), value.NewString("2"), value.NewInteger(3), value.NewInteger(5)),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0}, JoinViewIndices: []int{0}},
+		Strategy:   SortMergeJoin,
+		Candidates: [][]int{{}, {1, 2}, {1, 2}, {4}},
+	},
+	{
+		Name:       "NewJoinPlan Sort Merge Join with Multiple Keys",
+		View:       joinPlanTestView("table1", value.NewString("a"), value.NewString("b")),
+		JoinView:   joinPlanTestView("table2", value.NewString("A"), value.NewString("b")),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0, 1}, JoinViewIndices: []int{0, 1}},
+		Strategy:   SortMergeJoin,
+		Candidates: [][]int{{0}, {1}},
+	},
+	{
+		Name:       "NewJoinPlan Mixed Types",
+		View:       joinPlanTestView("table1", value.NewInteger(1), value.NewString("a")),
+		JoinView:   joinPlanTestView("table2", value.NewInteger(1), value.NewString("a")),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0}, JoinViewIndices: []int{0}},
+		Strategy:   HashJoin,
+		Candidates: [][]int{{0}, {1}},
+	},
+	{
+		Name:       "NewJoinPlan Booleans",
+		View:       joinPlanTestView("table1", value.NewBoolean(false), value.NewBoolean(true)),
+		JoinView:   joinPlanTestView("table2", value.NewInteger(0), value.NewInteger(1)),
+		Keys:       EquiJoinKeys{ViewIndices: []int{0}, JoinViewIndices: []int{0}},
+		Strategy:   HashJoin,
+		Candidates: [][]int{{0}, {1}},
+	},
+}
+
+func TestNewJoinPlan(t *testing.T) {
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range newJoinPlanTests {
+		plan := NewJoinPlan(scope, v.View, v.JoinView, v.Keys)
+		if plan.Strategy != v.Strategy {
+			t.Errorf("%s: strategy = %s, want %s", v.Name, plan.Strategy, v.Strategy)
+			continue
+		}
+
+		candidates := make([][]int, v.View.RecordLen())
+		for i := range candidates {
+			candidates[i] = make([]int, plan.CandidateLen(i))
+			for n := range candidates[i] {
+				candidates[i][n] = plan.CandidateIndex(i, n)
+			}
+		}
+		if !reflect.DeepEqual(candidates, v.Candidates) {
+			t.Errorf("%s: candidates = %v, want %v", v.Name, candidates, v.Candidates)
+		}
+	}
+}
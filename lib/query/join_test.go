@@ -382,6 +382,84 @@ var innerJoinTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Inner Join with Unordered Records",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str2"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewNull(),
+					value.NewString("str"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+				}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeaderWithId("table2", []string{"column1", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewInteger(2),
+					value.NewString("str22"),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+			},
+		},
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				RHS:      parser.NewStringValue("str22"),
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<>"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "and"},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: InternalIdColumn},
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "table2", Column: InternalIdColumn},
+				{View: "table2", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table2", Column: "column3", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewInteger(3),
+					value.NewInteger(1),
+					value.NewString("str11"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Inner Join in Multi Threading",
 		CPU:  2,
@@ -156,6 +156,9 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "table6.ltsv"), filepath.Join(TestDataDir, "table6.ltsv"))
 	_ = copyfile(filepath.Join(TestDir, "table6_bom.ltsv"), filepath.Join(TestDataDir, "table6_bom.ltsv"))
 
+	_ = copyfile(filepath.Join(TestDir, "table7.parquet"), filepath.Join(TestDataDir, "table7.parquet"))
+	_ = copyfile(filepath.Join(TestDir, "table8.xlsx"), filepath.Join(TestDataDir, "table8.xlsx"))
+
 	_ = copyfile(filepath.Join(TestDir, "fixed_length.txt"), filepath.Join(TestDataDir, "fixed_length.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_bom.txt"), filepath.Join(TestDataDir, "fixed_length_bom.txt"))
 	_ = copyfile(filepath.Join(TestDir, "fixed_length_sl.txt"), filepath.Join(TestDataDir, "fixed_length_sl.txt"))
@@ -200,6 +203,7 @@ func initFlag(flags *cmd.Flags) {
 	flags.Location = TestLocation
 	flags.DatetimeFormat = []string{}
 	flags.AnsiQuotes = false
+	flags.ExactDecimal = false
 	flags.WaitTimeout = 15
 	flags.ImportOptions = cmd.NewImportOptions()
 	flags.ExportOptions = cmd.NewExportOptions()
@@ -207,6 +211,7 @@ func initFlag(flags *cmd.Flags) {
 	flags.LimitRecursion = 5
 	flags.CPU = cpu
 	flags.Stats = false
+	flags.Streaming = false
 	flags.SetColor(false)
 }
 
@@ -316,6 +321,14 @@ func GenerateUserDefinedFunctionMap(values []*UserDefinedFunction) UserDefinedFu
 	return m
 }
 
+func GenerateUserDefinedProcedureMap(values []*UserDefinedProcedure) UserDefinedProcedureMap {
+	m := NewUserDefinedProcedureMap()
+	for _, v := range values {
+		m.Store(v.Name.Literal, v)
+	}
+	return m
+}
+
 func GenerateStatementMap(values []*PreparedStatement) PreparedStatementMap {
 	m := NewPreparedStatementMap()
 	for _, v := range values {
@@ -0,0 +1,453 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
+)
+
+const parquetReadBufferSize = 1024
+
+const julianDayOfUnixEpoch = 2440588
+
+func loadViewFromParquetFile(ctx context.Context, fp io.ReadSeeker, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	var r io.ReaderAt
+	size := fileSize(fp)
+	if ra, ok := fp.(io.ReaderAt); ok && 0 < size {
+		r = ra
+	} else {
+		data, err := ioutil.ReadAll(fp)
+		if err != nil {
+			return nil, NewIOError(expr, err.Error())
+		}
+		r = bytes.NewReader(data)
+		size = int64(len(data))
+	}
+
+	pf, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := pf.Root().Columns()
+	loadColumns := make([]*parquet.Column, 0, len(columns))
+	for _, c := range columns {
+		if fileInfo.Projection == nil || containsColumn(fileInfo.Projection, c.Name()) {
+			loadColumns = append(loadColumns, c)
+		}
+	}
+	switch {
+	case len(loadColumns) == len(columns):
+		fileInfo.Projection = nil
+	case len(loadColumns) < 1:
+		// At least one column is needed to know the number of records.
+		loadColumns = append(loadColumns, columns[0])
+		fileInfo.Projection = append(fileInfo.Projection, columns[0].Name())
+	}
+
+	records := make(RecordSet, pf.NumRows())
+	for i := range records {
+		records[i] = make(Record, len(loadColumns))
+	}
+
+	header := make([]string, len(loadColumns))
+	for i, c := range loadColumns {
+		header[i] = c.Name()
+
+		if c.Leaf() && !c.Repeated() {
+			err = readParquetLeafColumn(ctx, c, records, i)
+		} else {
+			err = readParquetNestedColumn(ctx, c, records, i)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func readParquetLeafColumn(ctx context.Context, column *parquet.Column, records RecordSet, fieldIndex int) error {
+	typ := column.Type()
+	recordIndex := 0
+
+	err := readParquetColumnValues(ctx, column, func(v parquet.Value) error {
+		if len(records) <= recordIndex {
+			return errors.New(fmt.Sprintf("column %s has more values than the number of rows", column.Name()))
+		}
+		records[recordIndex][fieldIndex] = NewCell(parquetValueToPrimary(v, typ))
+		recordIndex++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if recordIndex < len(records) {
+		return errors.New(fmt.Sprintf("column %s has fewer values than the number of rows", column.Name()))
+	}
+	return nil
+}
+
+// readParquetNestedColumn reads a group or repeated column and converts the value of each row to a json string.
+func readParquetNestedColumn(ctx context.Context, column *parquet.Column, records RecordSet, fieldIndex int) error {
+	leaves := parquetLeafColumns(column, nil)
+
+	rows := make([]parquet.Row, len(records))
+	for leafIndex, leaf := range leaves {
+		recordIndex := -1
+
+		err := readParquetColumnValues(ctx, leaf, func(v parquet.Value) error {
+			if v.RepetitionLevel() == 0 {
+				recordIndex++
+			}
+			if recordIndex < 0 || len(rows) <= recordIndex {
+				return errors.New(fmt.Sprintf("column %s has more values than the number of rows", column.Name()))
+			}
+			rows[recordIndex] = append(rows[recordIndex], v.Clone().Level(v.RepetitionLevel(), v.DefinitionLevel(), leafIndex))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	schema := parquet.NewSchema("", parquet.Group{column.Name(): column})
+	for i := range records {
+		m := make(map[string]interface{})
+		if err := schema.Reconstruct(&m, rows[i]); err != nil {
+			return err
+		}
+
+		v, ok := m[column.Name()]
+		if !ok || v == nil {
+			records[i][fieldIndex] = NewCell(value.NewNull())
+			continue
+		}
+
+		b, err := gojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		records[i][fieldIndex] = NewCell(value.NewString(string(b)))
+	}
+	return nil
+}
+
+func parquetLeafColumns(column *parquet.Column, leaves []*parquet.Column) []*parquet.Column {
+	if column.Leaf() {
+		return append(leaves, column)
+	}
+	for _, c := range column.Columns() {
+		leaves = parquetLeafColumns(c, leaves)
+	}
+	return leaves
+}
+
+func readParquetColumnValues(ctx context.Context, column *parquet.Column, fn func(parquet.Value) error) error {
+	pages := column.Pages()
+	defer func() {
+		_ = pages.Close()
+	}()
+
+	buf := make([]parquet.Value, parquetReadBufferSize)
+	for {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		page, err := pages.ReadPage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		err = readParquetPageValues(page, buf, fn)
+		parquet.Release(page)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func readParquetPageValues(page parquet.Page, buf []parquet.Value, fn func(parquet.Value) error) error {
+	reader := page.Values()
+	for {
+		n, err := reader.ReadValues(buf)
+		for i := 0; i < n; i++ {
+			if e := fn(buf[i]); e != nil {
+				return e
+			}
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func parquetValueToPrimary(v parquet.Value, typ parquet.Type) value.Primary {
+	if v.IsNull() {
+		return value.NewNull()
+	}
+
+	lt := typ.LogicalType()
+
+	switch v.Kind() {
+	case parquet.Boolean:
+		return value.NewBoolean(v.Boolean())
+	case parquet.Int32, parquet.Int64:
+		var i int64
+		if v.Kind() == parquet.Int32 {
+			i = int64(v.Int32())
+		} else {
+			i = v.Int64()
+		}
+
+		if lt != nil {
+			switch {
+			case lt.Date != nil:
+				t := time.Unix(i*86400, 0).UTC()
+				return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, cmd.GetLocation()))
+			case lt.Timestamp != nil:
+				return value.NewDatetime(parquetTimestamp(i, lt.Timestamp))
+			case lt.Decimal != nil:
+				return value.NewFloat(float64(i) / math.Pow10(int(lt.Decimal.Scale)))
+			}
+		}
+		return value.NewInteger(i)
+	case parquet.Int96:
+		i96 := v.Int96()
+		nanos := int64(i96[1])<<32 | int64(i96[0])
+		days := int64(i96[2]) - julianDayOfUnixEpoch
+		return value.NewDatetime(time.Unix(days*86400, nanos).In(cmd.GetLocation()))
+	case parquet.Float:
+		return value.NewFloat(float64(v.Float()))
+	case parquet.Double:
+		return value.NewFloat(v.Double())
+	default: // parquet.ByteArray, parquet.FixedLenByteArray
+		b := v.ByteArray()
+		if lt != nil {
+			switch {
+			case lt.Decimal != nil:
+				i := new(big.Int).SetBytes(b)
+				if 0 < len(b) && b[0]&0x80 != 0 {
+					i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+				}
+				f, _ := new(big.Float).Quo(new(big.Float).SetInt(i), new(big.Float).SetFloat64(math.Pow10(int(lt.Decimal.Scale)))).Float64()
+				return value.NewFloat(f)
+			case lt.UUID != nil && len(b) == 16:
+				return value.NewString(fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]))
+			}
+		}
+		return value.NewString(string(b))
+	}
+}
+
+func parquetTimestamp(i int64, ts *format.TimestampType) time.Time {
+	var t time.Time
+	switch {
+	case ts.Unit.Millis != nil:
+		t = time.UnixMilli(i)
+	case ts.Unit.Micros != nil:
+		t = time.UnixMicro(i)
+	default:
+		t = time.Unix(0, i)
+	}
+
+	if ts.IsAdjustedToUTC {
+		return t.In(cmd.GetLocation())
+	}
+
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), cmd.GetLocation())
+}
+
+type parquetColumnType int
+
+const (
+	parquetNullColumn parquetColumnType = iota
+	parquetBooleanColumn
+	parquetIntegerColumn
+	parquetFloatColumn
+	parquetDatetimeColumn
+	parquetStringColumn
+)
+
+// parquetGroup is a group node that keeps the order of the fields.
+type parquetGroup struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+func (g parquetGroup) Fields() []parquet.Field {
+	return g.fields
+}
+
+type parquetField struct {
+	parquet.Node
+	name string
+}
+
+func (f parquetField) Name() string {
+	return f.name
+}
+
+func (f parquetField) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(&f.name).Elem())
+}
+
+func encodeParquet(ctx context.Context, fp io.Writer, view *View) error {
+	columnTypes := make([]parquetColumnType, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			columnTypes[j] = mergeParquetColumnType(columnTypes[j], view.RecordSet[i][j][0])
+		}
+	}
+
+	group := parquetGroup{
+		Group:  make(parquet.Group, view.FieldLen()),
+		fields: make([]parquet.Field, view.FieldLen()),
+	}
+	for i := range view.Header {
+		name := view.Header[i].Column
+		if _, ok := group.Group[name]; ok {
+			return NewDataEncodingError(fmt.Sprintf("field name %s is a duplicate", name))
+		}
+
+		var node parquet.Node
+		switch columnTypes[i] {
+		case parquetBooleanColumn:
+			node = parquet.Leaf(parquet.BooleanType)
+		case parquetIntegerColumn:
+			node = parquet.Int(64)
+		case parquetFloatColumn:
+			node = parquet.Leaf(parquet.DoubleType)
+		case parquetDatetimeColumn:
+			node = parquet.Timestamp(parquet.Nanosecond)
+		default:
+			node = parquet.String()
+		}
+		node = parquet.Optional(node)
+
+		group.Group[name] = node
+		group.fields[i] = parquetField{Node: node, name: name}
+	}
+
+	w := parquet.NewWriter(fp, parquet.NewSchema("", group), parquet.Compression(&parquet.Snappy))
+
+	row := make(parquet.Row, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			row[j] = primaryToParquetValue(view.RecordSet[i][j][0], columnTypes[j]).Level(0, 1, j)
+			if row[j].IsNull() {
+				row[j] = row[j].Level(0, 0, j)
+			}
+		}
+		if _, err := w.WriteRows([]parquet.Row{row}); err != nil {
+			return NewDataEncodingError(err.Error())
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	return nil
+}
+
+func mergeParquetColumnType(columnType parquetColumnType, p value.Primary) parquetColumnType {
+	var t parquetColumnType
+	switch p.(type) {
+	case *value.Null:
+		return columnType
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+			return columnType
+		}
+		t = parquetBooleanColumn
+	case *value.Boolean:
+		t = parquetBooleanColumn
+	case *value.Integer:
+		t = parquetIntegerColumn
+	case *value.Float:
+		t = parquetFloatColumn
+	case *value.Datetime:
+		t = parquetDatetimeColumn
+	default:
+		t = parquetStringColumn
+	}
+
+	switch {
+	case columnType == parquetNullColumn || columnType == t:
+		return t
+	case (columnType == parquetIntegerColumn && t == parquetFloatColumn) || (columnType == parquetFloatColumn && t == parquetIntegerColumn):
+		return parquetFloatColumn
+	}
+	return parquetStringColumn
+}
+
+func primaryToParquetValue(p value.Primary, columnType parquetColumnType) parquet.Value {
+	switch p.(type) {
+	case *value.Null:
+		return parquet.NullValue()
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+			return parquet.NullValue()
+		}
+	}
+
+	switch columnType {
+	case parquetBooleanColumn:
+		if t, ok := p.(*value.Ternary); ok {
+			return parquet.BooleanValue(t.Ternary().ParseBool())
+		}
+		return parquet.BooleanValue(p.(*value.Boolean).Raw())
+	case parquetIntegerColumn:
+		return parquet.Int64Value(p.(*value.Integer).Raw())
+	case parquetFloatColumn:
+		if i, ok := p.(*value.Integer); ok {
+			return parquet.DoubleValue(float64(i.Raw()))
+		}
+		return parquet.DoubleValue(p.(*value.Float).Raw())
+	case parquetDatetimeColumn:
+		return parquet.Int64Value(p.(*value.Datetime).Raw().UnixNano())
+	}
+
+	s, _, _ := ConvertFieldContents(p, false)
+	return parquet.ByteArrayValue([]byte(s))
+}
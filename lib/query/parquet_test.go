@@ -0,0 +1,282 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var loadViewFromParquetFileTests = []struct {
+	Name       string
+	Projection []string
+	Result     *View
+	Error      string
+}{
+	{
+		Name: "Load Parquet File",
+		Result: &View{
+			Header: NewHeader("table7", []string{"id", "name", "day", "created", "price", "ratio", "active", "tags", "attr"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+					value.NewFloat(12.34),
+					value.NewFloat(0.5),
+					value.NewBoolean(true),
+					value.NewString("[\"a\",\"b\"]"),
+					value.NewString("{\"key\":\"k1\",\"value\":10}"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewDatetime(time.Date(2012, 2, 4, 0, 0, 0, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 4, 9, 18, 15, 0, GetTestLocation())),
+					value.NewFloat(-0.5),
+					value.NewFloat(1.25),
+					value.NewBoolean(false),
+					value.NewString("[]"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("str3"),
+					value.NewDatetime(time.Date(2012, 2, 5, 0, 0, 0, 0, GetTestLocation())),
+					value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+					value.NewFloat(0),
+					value.NewFloat(2),
+					value.NewBoolean(true),
+					value.NewString("[\"c\"]"),
+					value.NewString("{\"key\":\"k3\",\"value\":30}"),
+				}),
+			},
+		},
+	},
+	{
+		Name:       "Load Parquet File with Projection",
+		Projection: []string{"NAME", "attr", "notexist"},
+		Result: &View{
+			Header: NewHeader("table7", []string{"name", "attr"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+					value.NewString("{\"key\":\"k1\",\"value\":10}"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str3"),
+					value.NewString("{\"key\":\"k3\",\"value\":30}"),
+				}),
+			},
+		},
+	},
+	{
+		Name:       "Load Parquet File with No Columns",
+		Projection: []string{},
+		Result: &View{
+			Header: NewHeader("table7", []string{"id"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewInteger(3)}),
+			},
+		},
+	},
+}
+
+func TestLoadViewFromParquetFile(t *testing.T) {
+	defer func() {
+		_ = TestTx.Flags.SetLocation("Local")
+	}()
+	_ = TestTx.Flags.SetLocation(TestLocation)
+
+	for _, v := range loadViewFromParquetFileTests {
+		fp, err := os.Open(GetTestFilePath("table7.parquet"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fileInfo := &FileInfo{
+			Path:       GetTestFilePath("table7.parquet"),
+			Format:     cmd.PARQUET,
+			Projection: v.Projection,
+		}
+		view, err := loadViewFromParquetFile(context.Background(), fp, fileInfo, parser.Identifier{Literal: "table7"})
+		_ = fp.Close()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, view.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %v, want %v", v.Name, view.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
+
+func TestEncodeParquet(t *testing.T) {
+	defer func() {
+		_ = TestTx.Flags.SetLocation("Local")
+	}()
+	_ = TestTx.Flags.SetLocation(TestLocation)
+
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2", "c3", "c4", "c5", "c6"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewInteger(1),
+				value.NewInteger(1),
+				value.NewString("str1"),
+				value.NewBoolean(true),
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+				value.NewNull(),
+			}),
+			NewRecord([]value.Primary{
+				value.NewNull(),
+				value.NewFloat(1.5),
+				value.NewInteger(2),
+				value.NewTernary(ternary.FALSE),
+				value.NewNull(),
+				value.NewNull(),
+			}),
+		},
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewInteger(1),
+			value.NewFloat(1),
+			value.NewString("str1"),
+			value.NewBoolean(true),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewNull(),
+		}),
+		NewRecord([]value.Primary{
+			value.NewNull(),
+			value.NewFloat(1.5),
+			value.NewString("2"),
+			value.NewBoolean(false),
+			value.NewNull(),
+			value.NewNull(),
+		}),
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := EncodeView(context.Background(), buf, view, cmd.ExportOptions{Format: cmd.PARQUET}, nil); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	loaded, err := loadViewFromParquetFile(context.Background(), bytes.NewReader(buf.Bytes()), &FileInfo{Path: "test.parquet", Format: cmd.PARQUET}, parser.Identifier{Literal: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(loaded.Header, view.Header) {
+		t.Errorf("header = %v, want %v", loaded.Header, view.Header)
+	}
+	if !reflect.DeepEqual(loaded.RecordSet, expect) {
+		t.Errorf("records = %v, want %v", loaded.RecordSet, expect)
+	}
+
+	view.Header = NewHeader("test", []string{"c1", "c1", "c3", "c4", "c5", "c6"})
+	expectErr := "data encode error: field name c1 is a duplicate"
+	_, err = EncodeView(context.Background(), &bytes.Buffer{}, view, cmd.ExportOptions{Format: cmd.PARQUET}, nil)
+	if err == nil {
+		t.Errorf("no error, want error %q", expectErr)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q", err.Error(), expectErr)
+	}
+}
+
+func TestLoadView_ParquetProjection(t *testing.T) {
+	defer func() {
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+	TestTx.Flags.Repository = TestDir
+	_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+
+	selectQuery := func(columns ...string) parser.SelectQuery {
+		fields := make([]parser.QueryExpression, len(columns))
+		for i, c := range columns {
+			fields[i] = parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: c}}}
+		}
+		return parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{Fields: fields},
+			},
+		}
+	}
+	from := []parser.QueryExpression{parser.Table{Object: parser.Identifier{Literal: "table7"}}}
+
+	tests := []struct {
+		Query      parser.SelectQuery
+		ForUpdate  bool
+		Header     []string
+		Projection []string
+	}{
+		{
+			Query:      selectQuery("id", "name"),
+			Header:     []string{"id", "name"},
+			Projection: []string{"id", "name"},
+		},
+		{
+			Query:      selectQuery("name"),
+			Header:     []string{"id", "name"},
+			Projection: []string{"id", "name"},
+		},
+		{
+			Query:      selectQuery("price"),
+			Header:     []string{"id", "name", "price"},
+			Projection: []string{"id", "name", "price"},
+		},
+		{
+			Query:      selectQuery("price"),
+			ForUpdate:  true,
+			Header:     []string{"id", "name", "day", "created", "price", "ratio", "active", "tags", "attr"},
+			Projection: nil,
+		},
+	}
+
+	for i, v := range tests {
+		ctx := ContextForProjection(context.Background(), v.Query)
+		view, err := LoadView(ctx, NewReferenceScope(TestTx).CreateNode(), from, v.ForUpdate, false)
+		if err != nil {
+			t.Errorf("%d: unexpected error %q", i, err)
+			continue
+		}
+
+		header := view.Header.TableColumnNames()
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%d: header = %s, want %s", i, strings.Join(header, ", "), strings.Join(v.Header, ", "))
+		}
+		if !reflect.DeepEqual(view.FileInfo.Projection, v.Projection) {
+			t.Errorf("%d: projection = %v, want %v", i, view.FileInfo.Projection, v.Projection)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func loadPivot(ctx context.Context, scope *ReferenceScope, pivot parser.Pivot, viewName string, forUpdate bool) (*View, error) {
+	view, err := loadView(ctx, scope, pivot.Table, forUpdate, false)
+	if err != nil {
+		return nil, err
+	}
+
+	forIdx, err := view.FieldIndex(pivot.For)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := &projection{columns: make([]string, 0, 4)}
+	refs.walk(reflect.ValueOf(pivot.Aggregate))
+
+	keyIndices := make([]int, 0, view.FieldLen())
+	columns := make([]string, 0, view.FieldLen())
+	for i, f := range view.Header {
+		if !f.IsFromTable || i == forIdx || containsColumn(refs.columns, f.Column) {
+			continue
+		}
+		keyIndices = append(keyIndices, i)
+		columns = append(columns, f.Column)
+	}
+
+	var pivotValues []value.Primary
+	if pivot.Any.IsEmpty() {
+		pivotValues = make([]value.Primary, 0, len(pivot.In))
+		for _, v := range pivot.In {
+			field := v.(parser.Field)
+			p, err := Evaluate(ctx, scope, field.Object)
+			if err != nil {
+				return nil, err
+			}
+			pivotValues = append(pivotValues, p)
+
+			if field.Alias != nil {
+				columns = append(columns, field.Alias.(parser.Identifier).Literal)
+			} else {
+				columns = append(columns, pivotColumnName(p, field.Object.String()))
+			}
+		}
+	} else {
+		pivotValues = distinctPivotValues(view, forIdx, scope)
+		for _, p := range pivotValues {
+			columns = append(columns, pivotColumnName(p, pivot.Any.String()))
+		}
+	}
+
+	pivotIndex := make(map[string]int, len(pivotValues))
+	for i, p := range pivotValues {
+		if value.IsNull(p) {
+			continue
+		}
+		key := serializePivotKey([]value.Primary{p}, scope)
+		if _, ok := pivotIndex[key]; !ok {
+			pivotIndex[key] = i
+		}
+	}
+
+	groupIndex := make(map[string]int, 20)
+	groups := make([][][]int, 0, 20)
+	keyValues := make([][]value.Primary, 0, 20)
+	for i, record := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		values := make([]value.Primary, len(keyIndices))
+		for j, idx := range keyIndices {
+			values[j] = record[idx][0]
+		}
+		key := serializePivotKey(values, scope)
+
+		gIdx, ok := groupIndex[key]
+		if !ok {
+			gIdx = len(groups)
+			groupIndex[key] = gIdx
+			groups = append(groups, make([][]int, len(pivotValues)))
+			keyValues = append(keyValues, values)
+		}
+
+		if value.IsNull(record[forIdx][0]) {
+			continue
+		}
+		if pIdx, ok := pivotIndex[serializePivotKey([]value.Primary{record[forIdx][0]}, scope)]; ok {
+			groups[gIdx][pIdx] = append(groups[gIdx][pIdx], i)
+		}
+	}
+
+	recordSet := make(RecordSet, len(groups))
+	for gIdx, group := range groups {
+		values := make([]value.Primary, 0, len(columns))
+		values = append(values, keyValues[gIdx]...)
+
+		for _, indices := range group {
+			aggView := &View{
+				Header:    view.Header,
+				RecordSet: RecordSet{groupedRecord(view, indices)},
+				isGrouped: true,
+			}
+			p, err := Evaluate(ctx, scope.CreateScopeForRecordEvaluation(aggView, 0), pivot.Aggregate)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, p)
+		}
+		recordSet[gIdx] = NewRecord(values)
+	}
+
+	return &View{
+		Header:    NewHeader(viewName, columns),
+		RecordSet: recordSet,
+	}, nil
+}
+
+func loadUnpivot(ctx context.Context, scope *ReferenceScope, unpivot parser.Unpivot, viewName string, forUpdate bool) (*View, error) {
+	view, err := loadView(ctx, scope, unpivot.Table, forUpdate, false)
+	if err != nil {
+		return nil, err
+	}
+
+	unpivotIndices := make([]int, len(unpivot.In))
+	isUnpivoted := make(map[int]bool, len(unpivot.In))
+	for i, v := range unpivot.In {
+		if unpivotIndices[i], err = view.FieldIndex(v); err != nil {
+			return nil, err
+		}
+		isUnpivoted[unpivotIndices[i]] = true
+	}
+
+	keyIndices := make([]int, 0, view.FieldLen())
+	columns := make([]string, 0, view.FieldLen())
+	for i, f := range view.Header {
+		if !f.IsFromTable || isUnpivoted[i] {
+			continue
+		}
+		keyIndices = append(keyIndices, i)
+		columns = append(columns, f.Column)
+	}
+	columns = append(columns, unpivot.Name.Literal, unpivot.Value.Literal)
+
+	recordSet := make(RecordSet, 0, view.RecordLen()*len(unpivotIndices))
+	for i, record := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for _, idx := range unpivotIndices {
+			if value.IsNull(record[idx][0]) {
+				continue
+			}
+
+			r := make(Record, 0, len(columns))
+			for _, kIdx := range keyIndices {
+				r = append(r, record[kIdx])
+			}
+			r = append(r, NewCell(value.NewString(view.Header[idx].Column)), record[idx])
+			recordSet = append(recordSet, r)
+		}
+	}
+
+	return &View{
+		Header:    NewHeader(viewName, columns),
+		RecordSet: recordSet,
+	}, nil
+}
+
+func groupedRecord(view *View, indices []int) Record {
+	record := make(Record, view.FieldLen())
+	for i := range record {
+		cell := make(Cell, len(indices))
+		for j, idx := range indices {
+			cell[j] = view.RecordSet[idx][i][0]
+		}
+		record[i] = cell
+	}
+	return record
+}
+
+func distinctPivotValues(view *View, fieldIdx int, scope *ReferenceScope) []value.Primary {
+	keys := make(map[string]bool, 20)
+	values := make([]value.Primary, 0, 20)
+	for _, record := range view.RecordSet {
+		p := record[fieldIdx][0]
+		if value.IsNull(p) {
+			continue
+		}
+		key := serializePivotKey([]value.Primary{p}, scope)
+		if !keys[key] {
+			keys[key] = true
+			values = append(values, p)
+		}
+	}
+
+	sortValues := make([]*SortValue, len(values))
+	for i, p := range values {
+		sortValues[i] = NewSortValue(p, scope.Tx.Flags)
+	}
+	indices := make([]int, len(values))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return sortValues[indices[i]].Less(sortValues[indices[j]]) == ternary.TRUE
+	})
+
+	sorted := make([]value.Primary, len(values))
+	for i, idx := range indices {
+		sorted[i] = values[idx]
+	}
+	return sorted
+}
+
+func serializePivotKey(values []value.Primary, scope *ReferenceScope) string {
+	keyBuf := GetComparisonKeysBuf()
+	SerializeComparisonKeys(keyBuf, values, scope.Tx.Flags)
+	key := keyBuf.String()
+	PutComparisonkeysBuf(keyBuf)
+	return key
+}
+
+func pivotColumnName(p value.Primary, defaultName string) string {
+	if s := value.ToString(p); !value.IsNull(s) {
+		return s.(*value.String).Raw()
+	}
+	return defaultName
+}
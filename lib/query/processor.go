@@ -29,6 +29,7 @@ const (
 
 const StoringResultsContextKey = "sqr"
 const StatementReplaceValuesContextKey = "rv"
+const DataModificationContextKey = "dm"
 
 func ContextForStoringResults(ctx context.Context) context.Context {
 	return context.WithValue(ctx, StoringResultsContextKey, true)
@@ -38,6 +39,15 @@ func ContextForPreparedStatement(ctx context.Context, values *ReplaceValues) con
 	return context.WithValue(ctx, StatementReplaceValuesContextKey, values)
 }
 
+func ContextForDataModification(ctx context.Context) context.Context {
+	return context.WithValue(ctx, DataModificationContextKey, true)
+}
+
+func IsDataModificationContext(ctx context.Context) bool {
+	b, ok := ctx.Value(DataModificationContextKey).(bool)
+	return ok && b
+}
+
 type Processor struct {
 	Tx             *Transaction
 	ReferenceScope *ReferenceScope
@@ -113,11 +123,35 @@ func (proc *Processor) executeChild(ctx context.Context, statements []parser.Sta
 	return flow, err
 }
 
+func checkServerModeStatement(stmt parser.Statement) error {
+	switch stmt.(type) {
+	case parser.ExternalCommand:
+		return NewStatementNotAllowedError(stmt.(parser.ExternalCommand), "external command")
+	case parser.Chdir:
+		return NewStatementNotAllowedError(stmt.(parser.Chdir), "CHDIR")
+	case parser.SetEnvVar:
+		return NewStatementNotAllowedError(stmt.(parser.SetEnvVar).EnvVar, "setting environment variables")
+	case parser.UnsetEnvVar:
+		return NewStatementNotAllowedError(stmt.(parser.UnsetEnvVar).EnvVar, "unsetting environment variables")
+	case parser.Source:
+		return NewStatementNotAllowedError(stmt.(parser.Source), "SOURCE")
+	case parser.Reload:
+		return NewStatementNotAllowedError(stmt.(parser.Reload), "RELOAD")
+	}
+	return nil
+}
+
 func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Statement) (StatementFlow, error) {
 	if ctx.Err() != nil {
 		return TerminateWithError, ConvertContextError(ctx.Err())
 	}
 
+	if proc.Tx.ServerMode {
+		if err := checkServerModeStatement(stmt); err != nil {
+			return TerminateWithError, err
+		}
+	}
+
 	flow := Terminate
 
 	var printstr string
@@ -166,6 +200,12 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		err = proc.ReferenceScope.DisposeFunction(stmt.(parser.DisposeFunction).Name)
 	case parser.AggregateDeclaration:
 		err = proc.ReferenceScope.DeclareAggregateFunction(stmt.(parser.AggregateDeclaration))
+	case parser.ProcedureDeclaration:
+		err = proc.ReferenceScope.DeclareProcedure(stmt.(parser.ProcedureDeclaration))
+	case parser.DisposeProcedure:
+		err = proc.ReferenceScope.DisposeProcedure(stmt.(parser.DisposeProcedure).Name)
+	case parser.CallProcedure:
+		flow, err = proc.CallProcedure(ctx, stmt.(parser.CallProcedure))
 	case parser.StatementPreparation:
 		err = proc.Tx.PreparedStatements.Prepare(proc.ReferenceScope.Tx.Flags, stmt.(parser.StatementPreparation))
 	case parser.ExecuteStatement:
@@ -186,46 +226,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				proc.measurementStart = time.Now()
 			}
 
-			view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
-			if e == nil {
-				var warnmsg string
-
-				proc.Tx.Session.mtx.Lock()
-
-				if proc.storeResults {
-					proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
-				}
-
-				if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
-					exportOptions := proc.Tx.Flags.ExportOptions.Copy()
-
-					var writer io.Writer
-					if proc.Tx.Session.OutFile() != nil {
-						writer = proc.Tx.Session.OutFile()
-					} else {
-						writer = proc.Tx.Session.Stdout()
-					}
-					warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
-
-					if e != nil {
-						if e == EmptyResultSetError {
-							warnmsg = warn
-						} else if e == DataEmpty {
-							// Do Nothing
-						} else {
-							err = e
-						}
-					} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
-						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
-						_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
-					}
-				}
-
-				proc.Tx.Session.mtx.Unlock()
-
-				if 0 < len(warnmsg) {
-					proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
-				}
+			if stream := proc.streamingSelect(stmt.(parser.SelectQuery)); stream != nil {
+				err = proc.encodeStreamingSelect(ctx, stream)
+			} else if view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery)); e == nil {
+				err = proc.writeView(ctx, view)
 			} else {
 				err = e
 			}
@@ -234,12 +238,32 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				proc.showExecutionTime(ctx)
 			}
 		}
+	case parser.Explain:
+		explain := stmt.(parser.Explain)
+
+		var plan *ExecutionPlan
+		if explain.IsAnalyze() {
+			plan = NewExecutionPlan()
+			planCtx := ContextForExecutionPlan(ctx, plan)
+
+			if query, ok := explain.Statement.(parser.SelectQuery); ok {
+				_, err = Select(planCtx, proc.ReferenceScope, query)
+			} else {
+				_, err = proc.ExecuteStatement(planCtx, explain.Statement)
+			}
+		} else {
+			plan, err = ExplainStatement(proc.ReferenceScope, explain.Statement)
+		}
+
+		if err == nil {
+			err = proc.writeView(ctx, plan.View(explain.IsAnalyze()))
+		}
 	case parser.InsertQuery:
 		if proc.Tx.Flags.Stats {
 			proc.measurementStart = time.Now()
 		}
 
-		fileInfo, cnt, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
+		fileInfo, cnt, returning, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
 		if e == nil {
 			if 0 < cnt {
 				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
@@ -248,6 +272,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cnt
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -260,7 +287,7 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		infos, cnts, e := Update(ctx, proc.ReferenceScope, stmt.(parser.UpdateQuery))
+		infos, cnts, returning, e := Update(ctx, proc.ReferenceScope, stmt.(parser.UpdateQuery))
 		if e == nil {
 			cntTotal := 0
 			for i, info := range infos {
@@ -273,6 +300,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cntTotal
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -285,7 +315,7 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		fileInfo, cnt, e := Replace(ctx, proc.ReferenceScope, stmt.(parser.ReplaceQuery))
+		fileInfo, cnt, returning, e := Replace(ctx, proc.ReferenceScope, stmt.(parser.ReplaceQuery))
 		if e == nil {
 			if 0 < cnt {
 				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
@@ -294,6 +324,9 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cnt
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
 		} else {
 			err = e
 		}
@@ -306,7 +339,7 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			proc.measurementStart = time.Now()
 		}
 
-		infos, cnts, e := Delete(ctx, proc.ReferenceScope, stmt.(parser.DeleteQuery))
+		infos, cnts, returning, e := Delete(ctx, proc.ReferenceScope, stmt.(parser.DeleteQuery))
 		if e == nil {
 			cntTotal := 0
 			for i, info := range infos {
@@ -319,6 +352,31 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cntTotal
 			}
+			if returning != nil {
+				err = proc.writeView(ctx, returning)
+			}
+		} else {
+			err = e
+		}
+
+		if proc.Tx.Flags.Stats {
+			proc.showExecutionTime(ctx)
+		}
+	case parser.MergeQuery:
+		if proc.Tx.Flags.Stats {
+			proc.measurementStart = time.Now()
+		}
+
+		fileInfo, inserted, updated, deleted, e := Merge(ctx, proc.ReferenceScope, stmt.(parser.MergeQuery))
+		if e == nil {
+			cnt := inserted + updated + deleted
+			if 0 < cnt {
+				proc.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+			}
+			proc.Log(fmt.Sprintf("%s inserted, %s updated and %s deleted on %q.", FormatCount(inserted, "record"), FormatCount(updated, "record"), FormatCount(deleted, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
+			if proc.storeResults {
+				proc.Tx.AffectedRows = cnt
+			}
 		} else {
 			err = e
 		}
@@ -411,6 +469,8 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		flow, err = proc.While(ctx, stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(ctx, stmt.(parser.WhileInCursor))
+	case parser.TryCatch:
+		flow, err = proc.TryCatch(ctx, stmt.(parser.TryCatch))
 	case parser.Echo:
 		if printstr, err = Echo(ctx, proc.ReferenceScope, stmt.(parser.Echo)); err == nil {
 			proc.Log(printstr, false)
@@ -459,6 +519,13 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		trigger := stmt.(parser.Trigger)
 		switch strings.ToUpper(trigger.Event.Literal) {
 		case "ERROR":
+			if trigger.Message == nil && trigger.Code == nil {
+				if caught := proc.ReferenceScope.CaughtError(); caught != nil {
+					err = caught
+					break
+				}
+			}
+
 			var message string
 			if trigger.Message != nil {
 				if pt, ok := trigger.Message.(parser.PrimitiveType); ok && trigger.Code == nil && pt.IsInteger() {
@@ -492,6 +559,99 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 	return flow, err
 }
 
+func (proc *Processor) writeView(ctx context.Context, view *View) error {
+	var err error
+	var warnmsg string
+
+	proc.Tx.Session.mtx.Lock()
+
+	if proc.storeResults {
+		proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
+	}
+
+	// Stored results are returned to the caller, so they are not written to the standard output.
+	if _, ok := proc.Tx.Session.Stdout().(*Discard); (!ok && !proc.storeResults) || proc.Tx.Session.OutFile() != nil {
+		exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+
+		var writer io.Writer
+		if proc.Tx.Session.OutFile() != nil {
+			writer = proc.Tx.Session.OutFile()
+		} else {
+			writer = proc.Tx.Session.Stdout()
+		}
+		warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
+
+		if e != nil {
+			if e == EmptyResultSetError {
+				warnmsg = warn
+			} else if e == DataEmpty {
+				// Do Nothing
+			} else {
+				err = e
+			}
+		} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
+			!isBinaryFormat(exportOptions.Format) &&
+			!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == cmd.FIXED && exportOptions.SingleLine) {
+			_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+		}
+	}
+
+	proc.Tx.Session.mtx.Unlock()
+
+	if 0 < len(warnmsg) {
+		proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+	}
+	return err
+}
+
+func (proc *Processor) streamingSelect(query parser.SelectQuery) *StreamingSelect {
+	if !proc.Tx.Flags.Streaming || proc.storeResults {
+		return nil
+	}
+	if _, ok := proc.Tx.Session.Stdout().(*Discard); ok && proc.Tx.Session.OutFile() == nil {
+		return nil
+	}
+	if !IsStreamingFormat(proc.Tx.Flags.ExportOptions.Format) {
+		proc.LogWarn(fmt.Sprintf("streaming is not available in %s format", proc.Tx.Flags.ExportOptions.Format), proc.Tx.Flags.Quiet)
+		return nil
+	}
+	return NewStreamingSelect(proc.ReferenceScope, query, proc.Tx.Flags.ExportOptions)
+}
+
+func (proc *Processor) encodeStreamingSelect(ctx context.Context, stream *StreamingSelect) error {
+	var warnmsg string
+
+	proc.Tx.Session.mtx.Lock()
+
+	exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+
+	var writer io.Writer
+	if proc.Tx.Session.OutFile() != nil {
+		writer = proc.Tx.Session.OutFile()
+	} else {
+		writer = proc.Tx.Session.Stdout()
+	}
+	warn, err := stream.Encode(ctx, proc.ReferenceScope, writer, exportOptions)
+
+	if err != nil {
+		if err == EmptyResultSetError {
+			warnmsg = warn
+			err = nil
+		} else if err == DataEmpty {
+			err = nil
+		}
+	} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak {
+		_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+	}
+
+	proc.Tx.Session.mtx.Unlock()
+
+	if 0 < len(warnmsg) {
+		proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+	}
+	return err
+}
+
 func (proc *Processor) IfStmt(ctx context.Context, stmt parser.If) (StatementFlow, error) {
 	stmts := make([]parser.ElseIf, 0, len(stmt.ElseIf)+1)
 	stmts = append(stmts, parser.ElseIf{
@@ -518,6 +678,94 @@ func (proc *Processor) IfStmt(ctx context.Context, stmt parser.If) (StatementFlo
 	return Terminate, nil
 }
 
+// TryCatch executes the statements in the TRY block, and if an error occurs, executes the statements in the CATCH block.
+// Errors that terminate the procedure, such as EXIT statements and signals, are not caught.
+func (proc *Processor) TryCatch(ctx context.Context, stmt parser.TryCatch) (StatementFlow, error) {
+	flow, err := proc.executeChild(ctx, stmt.Try)
+	if err == nil {
+		return flow, nil
+	}
+
+	caught, ok := err.(Error)
+	if !ok || !isCatchableError(err) {
+		return flow, err
+	}
+
+	child := proc.NewChildProcessor()
+	child.ReferenceScope.SetCaughtError(caught)
+	flow, err = child.execute(ctx, stmt.Catch)
+	if child.returnVal != nil {
+		proc.returnVal = child.returnVal
+	}
+	child.Close()
+	return flow, err
+}
+
+// CallProcedure executes the statements of a user defined procedure in a child scope,
+// then assigns the values of the OUT parameters to the variables passed as the arguments.
+func (proc *Processor) CallProcedure(ctx context.Context, stmt parser.CallProcedure) (StatementFlow, error) {
+	procedure, err := proc.ReferenceScope.GetProcedure(stmt.Name)
+	if err != nil {
+		return TerminateWithError, err
+	}
+	if err = procedure.CheckArgs(stmt); err != nil {
+		return TerminateWithError, err
+	}
+
+	args := make([]value.Primary, len(procedure.Parameters))
+	for i, param := range procedure.Parameters {
+		if param.IsOut() {
+			if _, err = proc.ReferenceScope.GetVariable(stmt.Args[i].(parser.Variable)); err != nil {
+				return TerminateWithError, err
+			}
+			args[i] = value.NewNull()
+		} else if args[i], err = Evaluate(ctx, proc.ReferenceScope, stmt.Args[i]); err != nil {
+			return TerminateWithError, err
+		}
+	}
+
+	child := proc.NewChildProcessor()
+	child.storeResults = proc.storeResults
+	for i, param := range procedure.Parameters {
+		if err = child.ReferenceScope.DeclareVariableDirectly(param.Variable, args[i]); err != nil {
+			child.Close()
+			return TerminateWithError, err
+		}
+	}
+
+	flow, err := child.execute(ctx, procedure.Statements)
+	if err == nil {
+		if flow == Return {
+			flow = Terminate
+		}
+
+		for i, param := range procedure.Parameters {
+			if !param.IsOut() {
+				continue
+			}
+
+			val, ok := child.ReferenceScope.CurrentBlock().variables.Get(param.Variable)
+			if !ok {
+				err = NewUndeclaredVariableError(param.Variable)
+				break
+			}
+			if _, err = proc.ReferenceScope.SubstituteVariableDirectly(stmt.Args[i].(parser.Variable), val); err != nil {
+				break
+			}
+		}
+	}
+	child.Close()
+	return flow, err
+}
+
+func isCatchableError(err error) bool {
+	switch err.(type) {
+	case *ForcedExit, *SignalReceived, *ContextCanceled, *ContextDone:
+		return false
+	}
+	return true
+}
+
 func (proc *Processor) Case(ctx context.Context, stmt parser.Case) (StatementFlow, error) {
 	var val value.Primary
 	var err error
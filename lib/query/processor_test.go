@@ -376,6 +376,50 @@ var processorExecuteStatementTests = []struct {
 		},
 		Logs: "column1,column2\n1,2\n",
 	},
+	{
+		Input: parser.Explain{
+			Statement: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{
+								Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "tbl"}},
+						},
+					},
+				},
+			},
+		},
+		Logs: "OPERATION,DETAIL\nSELECT QUERY,\n-> TEMPORARY TABLE,tbl\n-> SELECT,column1\n",
+	},
+	{
+		Input: parser.Explain{
+			Analyze: parser.Token{Token: parser.ANALYZE, Literal: "analyze"},
+			Statement: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{
+								Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+						},
+					},
+				},
+			},
+		},
+		Error:      "file notexist does not exist",
+		ReturnCode: ReturnCodeIOError,
+	},
 	{
 		Input: parser.SetFlag{
 			Flag:  parser.Flag{Name: "format"},
@@ -703,6 +747,46 @@ var processorExecuteStatementTests = []struct {
 		},
 		Logs: fmt.Sprintf("1 record updated on %q.\n", GetTestFilePath("table1.csv")),
 	},
+	{
+		Input: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
+		},
+		UncommittedViews: UncommittedViews{
+			mtx:     &sync.RWMutex{},
+			Created: map[string]*FileInfo{},
+			Updated: map[string]*FileInfo{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): {
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+			},
+		},
+		Logs: fmt.Sprintf("1 record updated on %q.\ncolumn1,column2\n2,update\n", GetTestFilePath("table1.csv")),
+	},
 	{
 		Input: parser.ReplaceQuery{
 			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
@@ -781,6 +865,55 @@ var processorExecuteStatementTests = []struct {
 		},
 		Logs: fmt.Sprintf("1 record deleted on %q.\n", GetTestFilePath("table1.csv")),
 	},
+	{
+		Input: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+						},
+					},
+				},
+				{
+					Negation:  parser.Token{Token: parser.NOT, Literal: "not"},
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		UncommittedViews: UncommittedViews{
+			mtx:     &sync.RWMutex{},
+			Created: map[string]*FileInfo{},
+			Updated: map[string]*FileInfo{
+				strings.ToUpper(GetTestFilePath("TABLE1.CSV")): {
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+			},
+		},
+		Logs: fmt.Sprintf("1 record inserted, 2 records updated and no record deleted on %q.\n", GetTestFilePath("table1.csv")),
+	},
 	{
 		Input: parser.CreateTable{
 			Table: parser.Identifier{Literal: "newtable.csv"},
@@ -1122,6 +1255,159 @@ func TestProcessor_ExecuteStatement(t *testing.T) {
 	}
 }
 
+var processorExecuteInServerModeTests = []struct {
+	Name  string
+	Input []parser.Statement
+	Error string
+}{
+	{
+		Name: "External Command",
+		Input: []parser.Statement{
+			parser.ExternalCommand{Command: "echo foo"},
+		},
+		Error: "external command is not allowed in server mode",
+	},
+	{
+		Name: "Chdir",
+		Input: []parser.Statement{
+			parser.Chdir{DirPath: parser.NewStringValue(TestDir)},
+		},
+		Error: "CHDIR is not allowed in server mode",
+	},
+	{
+		Name: "Set Environment Variable",
+		Input: []parser.Statement{
+			parser.SetEnvVar{EnvVar: parser.EnvironmentVariable{Name: "CSVQ_TEST_SERVER_MODE"}, Value: parser.NewStringValue("foo")},
+		},
+		Error: "setting environment variables is not allowed in server mode",
+	},
+	{
+		Name: "Unset Environment Variable",
+		Input: []parser.Statement{
+			parser.UnsetEnvVar{EnvVar: parser.EnvironmentVariable{Name: "CSVQ_TEST_SERVER_MODE"}},
+		},
+		Error: "unsetting environment variables is not allowed in server mode",
+	},
+	{
+		Name: "Source",
+		Input: []parser.Statement{
+			parser.Source{FilePath: parser.NewStringValue("source.sql")},
+		},
+		Error: "SOURCE is not allowed in server mode",
+	},
+	{
+		Name: "Reload",
+		Input: []parser.Statement{
+			parser.Reload{Type: parser.Identifier{Literal: "CONFIG"}},
+		},
+		Error: "RELOAD is not allowed in server mode",
+	},
+	{
+		Name: "Nested Statement",
+		Input: []parser.Statement{
+			parser.If{
+				Condition: parser.NewTernaryValueFromString("true"),
+				Statements: []parser.Statement{
+					parser.ExternalCommand{Command: "echo foo"},
+				},
+			},
+		},
+		Error: "external command is not allowed in server mode",
+	},
+	{
+		Name: "Allowed Statement",
+		Input: []parser.Statement{
+			parser.Print{Value: parser.NewStringValue("foo")},
+		},
+	},
+}
+
+func TestProcessor_ExecuteInServerMode(t *testing.T) {
+	defer func() {
+		TestTx.ServerMode = false
+		TestTx.Session.SetStdout(NewDiscard())
+	}()
+
+	TestTx.ServerMode = true
+	proc := NewProcessor(TestTx)
+	ctx := context.Background()
+
+	for _, v := range processorExecuteInServerModeTests {
+		TestTx.Session.SetStdout(NewDiscard())
+		_, err := proc.Execute(ctx, v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
+
+var processorStreamingSelectTests = []struct {
+	Name         string
+	Format       cmd.Format
+	IsStreamable bool
+	Logs         string
+}{
+	{
+		Name:         "StreamingSelect",
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Logs:         "",
+	},
+	{
+		Name:         "StreamingSelect Unsupported Format",
+		Format:       cmd.TEXT,
+		IsStreamable: false,
+		Logs:         "streaming is not available in TEXT format\n",
+	},
+}
+
+func TestProcessor_StreamingSelect(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.Streaming = true
+
+	tx := TestTx
+	proc := NewProcessor(tx)
+	query := streamingTestQuery(
+		[]parser.QueryExpression{
+			parser.Field{Object: parser.AllColumns{}},
+		},
+		parser.Table{Object: parser.Identifier{Literal: "table1"}},
+		nil,
+		nil,
+	)
+
+	for _, v := range processorStreamingSelectTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ExportOptions.Format = v.Format
+
+		out := NewOutput()
+		tx.Session.SetStdout(out)
+		stream := proc.streamingSelect(query)
+		log := out.String()
+
+		if (stream != nil) != v.IsStreamable {
+			t.Errorf("%s: streamable = %t, want %t", v.Name, stream != nil, v.IsStreamable)
+		}
+		if log != v.Logs {
+			t.Errorf("%s: logs = %q, want %q", v.Name, log, v.Logs)
+		}
+	}
+}
+
 var processorIfStmtTests = []struct {
 	Name        string
 	Stmt        parser.If
@@ -1278,6 +1564,322 @@ func TestProcessor_IfStmt(t *testing.T) {
 	}
 }
 
+var processorTryCatchTests = []struct {
+	Name        string
+	Stmt        parser.TryCatch
+	ResultFlow  StatementFlow
+	ReturnValue value.Primary
+	Result      string
+	Error       string
+}{
+	{
+		Name: "TryCatch Statement",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("1")},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("2")},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'1'\n",
+	},
+	{
+		Name: "TryCatch Statement Catch Error",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("1")},
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+				parser.Print{Value: parser.NewStringValue("2")},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.RuntimeInformation{Name: "error_code"}},
+				parser.Print{Value: parser.RuntimeInformation{Name: "error_message"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'1'\n" + fmt.Sprintf("%d", ReturnCodeApplicationError) + "\n'field notexist does not exist'\n",
+	},
+	{
+		Name: "TryCatch Statement Catch User Triggered Error",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+					Code:    value.NewInteger(200),
+				},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.RuntimeInformation{Name: "error_code"}},
+				parser.Print{Value: parser.RuntimeInformation{Name: "error_message"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "200\n'user error'\n",
+	},
+	{
+		Name: "TryCatch Statement Reraise Error",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("caught")},
+				parser.Trigger{Event: parser.Identifier{Literal: "error"}},
+			},
+		},
+		Result: "'caught'\n",
+		Error:  "field notexist does not exist",
+	},
+	{
+		Name: "TryCatch Statement Error in Catch",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist2"}}},
+			},
+		},
+		Error: "field notexist2 does not exist",
+	},
+	{
+		Name: "TryCatch Statement Exit Is Not Caught",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Exit{},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("caught")},
+			},
+		},
+		ResultFlow: Exit,
+		Result:     "",
+	},
+	{
+		Name: "TryCatch Statement Return Value",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			Catch: []parser.Statement{
+				parser.Return{Value: parser.NewStringValue("1")},
+			},
+		},
+		ResultFlow:  Return,
+		ReturnValue: value.NewString("1"),
+		Result:      "",
+	},
+}
+
+func TestProcessor_TryCatch(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(true)
+	tx := TestTx
+	proc := NewProcessor(tx)
+
+	for _, v := range processorTryCatchTests {
+		out := NewOutput()
+		tx.Session.SetStdout(out)
+
+		proc.returnVal = nil
+		flow, err := proc.TryCatch(context.Background(), v.Stmt)
+		log := out.String()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			if log != v.Result {
+				t.Errorf("%s: result = %q, want %q", v.Name, log, v.Result)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+		}
+		if !reflect.DeepEqual(proc.returnVal, v.ReturnValue) {
+			t.Errorf("%s: return = %t, want %t", v.Name, proc.returnVal, v.ReturnValue)
+		}
+		if log != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, log, v.Result)
+		}
+	}
+}
+
+var processorCallProcedureTests = []struct {
+	Name       string
+	Stmt       parser.CallProcedure
+	ResultFlow StatementFlow
+	OutValue   value.Primary
+	Result     string
+	Error      string
+}{
+	{
+		Name: "CallProcedure",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.Variable{Name: "out"},
+			},
+		},
+		ResultFlow: Terminate,
+		OutValue:   value.NewInteger(2),
+		Result:     "1\n",
+	},
+	{
+		Name: "CallProcedure Return",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "returnproc"},
+		},
+		ResultFlow: Terminate,
+		OutValue:   value.NewString("init"),
+		Result:     "'1'\n",
+	},
+	{
+		Name: "CallProcedure Not Exist Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "notexist"},
+		},
+		Error: "procedure notexist does not exist",
+	},
+	{
+		Name: "CallProcedure Arguments Length Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+			},
+		},
+		Error: "procedure userproc takes exactly 2 arguments",
+	},
+	{
+		Name: "CallProcedure Undeclared Out Variable Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.Variable{Name: "notexist"},
+			},
+		},
+		Error: "variable @notexist is undeclared",
+	},
+	{
+		Name: "CallProcedure Argument Evaluation Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				parser.Variable{Name: "out"},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "CallProcedure Execution Error",
+		Stmt: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("a"),
+				parser.Variable{Name: "out"},
+			},
+		},
+		Result: "'a'\n",
+		Error:  "field notexist does not exist",
+	},
+}
+
+func TestProcessor_CallProcedure(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(true)
+	tx := TestTx
+
+	for _, v := range processorCallProcedureTests {
+		proc := NewProcessor(tx)
+		_ = proc.ReferenceScope.DeclareVariableDirectly(parser.Variable{Name: "out"}, value.NewString("init"))
+		_ = proc.ReferenceScope.DeclareProcedure(parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc"},
+			Parameters: []parser.ProcedureParameter{
+				{Variable: parser.Variable{Name: "arg1"}},
+				{Variable: parser.Variable{Name: "arg2"}, Out: parser.Token{Token: parser.OUT, Literal: "OUT"}},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "arg1"}},
+				parser.If{
+					Condition: parser.Comparison{
+						LHS:      parser.Variable{Name: "arg1"},
+						RHS:      parser.NewStringValue("a"),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+					Statements: []parser.Statement{
+						parser.Print{Value: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				parser.VariableSubstitution{
+					Variable: parser.Variable{Name: "arg2"},
+					Value:    parser.NewIntegerValue(2),
+				},
+			},
+		})
+		_ = proc.ReferenceScope.DeclareProcedure(parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "returnproc"},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("1")},
+				parser.Return{Value: parser.NewNullValue()},
+				parser.Print{Value: parser.NewStringValue("2")},
+			},
+		})
+
+		out := NewOutput()
+		tx.Session.SetStdout(out)
+
+		flow, err := proc.CallProcedure(context.Background(), v.Stmt)
+		log := out.String()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			if log != v.Result {
+				t.Errorf("%s: result = %q, want %q", v.Name, log, v.Result)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+		}
+		if outValue, _ := proc.ReferenceScope.GetVariable(parser.Variable{Name: "out"}); !reflect.DeepEqual(outValue, v.OutValue) {
+			t.Errorf("%s: out value = %s, want %s", v.Name, outValue, v.OutValue)
+		}
+		if log != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, log, v.Result)
+		}
+	}
+}
+
 var processorCaseStmtTests = []struct {
 	Name       string
 	Stmt       parser.Case
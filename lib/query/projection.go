@@ -0,0 +1,164 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const ProjectionContextKey = "pj"
+
+// ContextForProjection returns a context that tells the loaders of the files
+// in the from clause which columns are referred by the query.
+// Only the formats that can read a part of columns, such as Parquet,
+// use the projection.
+func ContextForProjection(ctx context.Context, query parser.SelectQuery) context.Context {
+	if query.IsForUpdate() {
+		return contextWithoutProjection(ctx)
+	}
+	return context.WithValue(ctx, ProjectionContextKey, &projection{query: query})
+}
+
+func contextWithoutProjection(ctx context.Context) context.Context {
+	if ctx.Value(ProjectionContextKey) == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ProjectionContextKey, nil)
+}
+
+// ProjectionColumns returns the column names referred by the query in the context.
+// The nil slice means that all columns are required.
+func ProjectionColumns(ctx context.Context) []string {
+	p, ok := ctx.Value(ProjectionContextKey).(*projection)
+	if !ok || p == nil {
+		return nil
+	}
+	return p.Columns()
+}
+
+type projection struct {
+	query parser.SelectQuery
+
+	columns   []string
+	allColumn bool
+	once      sync.Once
+}
+
+func (p *projection) Columns() []string {
+	p.once.Do(func() {
+		p.columns = make([]string, 0, 10)
+		p.allColumn = !p.walk(reflect.ValueOf(p.query))
+	})
+
+	if p.allColumn {
+		return nil
+	}
+	return p.columns
+}
+
+func (p *projection) add(name string) {
+	if !containsColumn(p.columns, name) {
+		p.columns = append(p.columns, name)
+	}
+}
+
+// walk collects the names of the columns and returns false if the expression
+// may refer all columns of the tables.
+func (p *projection) walk(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return true
+		}
+		return p.walk(v.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !p.walk(v.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+	default:
+		return true
+	}
+
+	switch expr := v.Interface().(type) {
+	case parser.FieldReference:
+		p.add(expr.Column.Literal)
+		return true
+	case parser.Identifier:
+		p.add(expr.Literal)
+		return true
+	case parser.ColumnNumber, parser.AllColumns:
+		return false
+	case parser.Join:
+		if !expr.Natural.IsEmpty() {
+			return false
+		}
+	case parser.Pivot, parser.Unpivot:
+		return false
+	case parser.Function:
+		if strings.EqualFold(expr.Name, "JSON_OBJECT") && len(expr.Args) < 1 {
+			return false
+		}
+	case parser.AggregateFunction:
+		if !p.walkFunctionArgs(expr.Args) {
+			return false
+		}
+		return p.walk(reflect.ValueOf(expr.Filter))
+	case parser.AnalyticFunction:
+		if !p.walkFunctionArgs(expr.Args) {
+			return false
+		}
+		if !p.walk(reflect.ValueOf(expr.OrderBy)) {
+			return false
+		}
+		if !p.walk(reflect.ValueOf(expr.Filter)) {
+			return false
+		}
+		return p.walk(reflect.ValueOf(expr.AnalyticClause))
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		if !p.walk(v.Field(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *projection) walkFunctionArgs(args []parser.QueryExpression) bool {
+	if len(args) == 1 {
+		if _, ok := args[0].(parser.AllColumns); ok {
+			return true
+		}
+	}
+	return p.walk(reflect.ValueOf(args))
+}
+
+func containsColumn(columns []string, name string) bool {
+	for _, c := range columns {
+		if strings.EqualFold(c, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeProjection(columns []string, additional []string) []string {
+	merged := make([]string, len(columns), len(columns)+len(additional))
+	copy(merged, columns)
+	for _, c := range additional {
+		if !containsColumn(merged, c) {
+			merged = append(merged, c)
+		}
+	}
+	return merged
+}
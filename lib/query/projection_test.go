@@ -0,0 +1,189 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var projectionColumnsTests = []struct {
+	Name   string
+	Query  parser.SelectQuery
+	Result []string
+}{
+	{
+		Name: "Field References",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "COLUMN1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+				},
+			},
+		},
+		Result: []string{"column1", "table1", "t", "column2", "column3"},
+	},
+	{
+		Name: "Count All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: []string{"table1"},
+	},
+	{
+		Name: "All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Column Number",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: nil}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Natural Join",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Join{
+								Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+								Natural:   parser.Token{Token: parser.NATURAL, Literal: "natural"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Unpivot",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "val"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{
+							Object: parser.Unpivot{
+								Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								Value: parser.Identifier{Literal: "val"},
+								Name:  parser.Identifier{Literal: "col"},
+								In: []parser.QueryExpression{
+									parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "Json Object with All Columns",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.Function{Name: "json_object"}},
+					},
+				},
+			},
+		},
+		Result: nil,
+	},
+	{
+		Name: "For Update",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+			},
+			Context: parser.Token{Token: parser.UPDATE, Literal: "update"},
+		},
+		Result: nil,
+	},
+}
+
+func TestProjectionColumns(t *testing.T) {
+	for _, v := range projectionColumnsTests {
+		ctx := ContextForProjection(context.Background(), v.Query)
+		result := ProjectionColumns(ctx)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+
+	ctx := contextWithoutProjection(ContextForProjection(context.Background(), projectionColumnsTests[0].Query))
+	if result := ProjectionColumns(ctx); result != nil {
+		t.Errorf("result = %v, want nil for the context without projection", result)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(ctx context.Context, scope *ReferenceScope, name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable) (bool, error) {
@@ -114,6 +116,7 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 		intoVars = intoClause.Variables
 	}
 
+	ctx, step := StartPlanStep(ctx, selectQueryPlanId(query), PlanSelectQuery, "")
 	queryScope := scope.CreateNode()
 
 	if query.WithClause != nil {
@@ -124,7 +127,7 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	}
 
 	view, err := selectEntity(
-		ctx,
+		ContextForProjection(ctx, query),
 		queryScope,
 		query.SelectEntity,
 		query.IsForUpdate(),
@@ -160,6 +163,9 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 
 	err = view.Fix(ctx, queryScope.Tx.Flags)
 	queryScope.CloseCurrentNode()
+	if err == nil {
+		step.Finish(view.RecordLen())
+	}
 
 	if err == nil && intoVars != nil {
 		if view.FieldLen() != len(intoVars) {
@@ -199,6 +205,7 @@ func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryE
 	if err != nil {
 		return nil, err
 	}
+	ctx = contextWithoutProjection(ctx)
 
 	if entity.WhereClause != nil {
 		if err := view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
@@ -218,6 +225,16 @@ func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryE
 		}
 	}
 
+	if entity.WindowClause != nil {
+		if view.windows, err = NewWindowDefinitions(entity.WindowClause.(parser.WindowClause)); err != nil {
+			return nil, err
+		}
+	}
+
+	if entity.QualifyClause != nil {
+		view.qualify = entity.QualifyClause
+	}
+
 	if err := view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
 		return nil, err
 	}
@@ -230,15 +247,24 @@ func selectSetEntity(ctx context.Context, scope *ReferenceScope, expr parser.Que
 		return Select(ctx, scope, subquery.Query)
 	}
 
+	var step *PlanStep
+	if entity, ok := expr.(parser.SelectEntity); ok {
+		ctx, step = StartPlanStep(ctx, entity.SelectClause.GetBaseExpr(), PlanSelectQuery, "")
+	}
+
 	view, err := selectEntity(ctx, scope, expr, forUpdate)
 	if err != nil {
 		return nil, err
 	}
-	err = view.Fix(ctx, scope.Tx.Flags)
+	if err = view.Fix(ctx, scope.Tx.Flags); err == nil {
+		step.Finish(view.RecordLen())
+	}
 	return view, err
 }
 
 func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet, forUpdate bool) (*View, error) {
+	ctx, step := StartPlanStep(ctx, set.Operator, selectSetPlanOperation(set), "")
+
 	lview, err := selectSetEntity(ctx, scope, set.LHS, forUpdate)
 	if err != nil {
 		return nil, err
@@ -276,7 +302,9 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 		}
 	}
 
-	err = lview.SelectAllColumns(ctx, scope)
+	if err = lview.SelectAllColumns(ctx, scope); err == nil {
+		step.Finish(lview.RecordLen())
+	}
 	return lview, err
 }
 
@@ -343,7 +371,8 @@ func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *Vie
 	return selectSetForRecursion(ctx, scope, view, set, forUpdate)
 }
 
-func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, error) {
+func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, *View, error) {
+	ctx, step := StartPlanStep(ctx, query.BaseExpr, PlanInsert, query.Table.String())
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
@@ -351,7 +380,7 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
 		}
 	}
 
@@ -361,10 +390,11 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
+	ctx = ContextForDataModification(ctx)
 
 	view, err := LoadView(ctx, queryScope, tables, true, false)
 	if err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 
 	fields := query.Fields
@@ -373,17 +403,30 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 	}
 
 	if query.ValuesList != nil {
-		if insertRecords, err = view.InsertValues(ctx, queryScope, fields, query.ValuesList); err != nil {
-			return nil, insertRecords, err
+		valuesCtx, valuesStep := StartPlanStep(ctx, nil, PlanValues, "")
+		if insertRecords, err = view.InsertValues(valuesCtx, queryScope, fields, query.ValuesList); err != nil {
+			return nil, insertRecords, nil, err
 		}
+		valuesStep.Finish(insertRecords)
 	} else {
 		if insertRecords, err = view.InsertFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
+		}
+	}
+
+	var returning *View
+	if query.ReturningClause != nil {
+		inserted := &View{
+			Header:    view.Header.Copy(),
+			RecordSet: view.RecordSet[view.RecordLen()-insertRecords:].Copy(),
+		}
+		if returning, err = returningView(ctx, queryScope, inserted, query.ReturningClause.(parser.ReturningClause)); err != nil {
+			return nil, insertRecords, nil, err
 		}
 	}
 
 	if err = view.RestoreHeaderReferences(); err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 
 	if !view.FileInfo.IsFile() {
@@ -392,16 +435,18 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		scope.Tx.cachedViews.Set(view)
 	}
 
-	return view.FileInfo, insertRecords, err
+	step.Finish(insertRecords)
+	return view.FileInfo, insertRecords, returning, err
 }
 
-func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, error) {
+func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, *View, error) {
+	ctx, step := StartPlanStep(ctx, query.BaseExpr, PlanUpdate, listPlanExpressions(query.Tables))
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -411,15 +456,16 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
+	ctx = ContextForDataModification(ctx)
 
 	view, err := LoadView(ctx, queryScope, query.FromClause.(parser.FromClause).Tables, true, true)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
 		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -430,7 +476,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		tableName := table.Name()
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		viewKey := strings.ToUpper(tableName.Literal)
 
@@ -440,31 +486,36 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			viewsToUpdate[viewKey], _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
 		}
 		if err = viewsToUpdate[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
+	setCtx, setStep := StartPlanStep(ctx, nil, PlanSet, updateSetPlanDetail(query.SetList))
 	updatesList := make(map[string]map[int]*UintPool)
 	seqScope := queryScope.CreateScopeForSequentialEvaluation(view)
 	for i := range view.RecordSet {
 		seqScope.Records[0].recordIndex = i
 		internalIds := make(map[string]int)
 		setListLen := len(query.SetList)
+		var returningCells map[int]Cell
+		if query.ReturningClause != nil {
+			returningCells = make(map[int]Cell, setListLen)
+		}
 
 		for _, uset := range query.SetList {
-			val, err := Evaluate(ctx, seqScope, uset.Value)
+			val, err := Evaluate(setCtx, seqScope, uset.Value)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			viewref, err := view.FieldViewName(uset.Field)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			viewref = strings.ToUpper(viewref)
 
 			if _, ok := viewsToUpdate[viewref]; !ok {
-				return nil, nil, NewUpdateFieldNotExistError(uset.Field)
+				return nil, nil, nil, NewUpdateFieldNotExistError(uset.Field)
 			}
 
 			var internalId int
@@ -473,7 +524,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			} else {
 				id, err := view.InternalRecordId(viewref, i)
 				if err != nil {
-					return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+					return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 				}
 
 				internalId = id
@@ -489,10 +540,29 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 				updatedCount[viewref]++
 			}
 			if updatesList[viewref][internalId].Exists(uint(fieldIdx)) {
-				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId].Add(uint(fieldIdx))
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
+
+			if returningCells != nil {
+				idx, _ := view.FieldIndex(uset.Field)
+				returningCells[idx] = NewCell(val)
+			}
+		}
+
+		if returningCells != nil {
+			for idx, cell := range returningCells {
+				view.RecordSet[i][idx] = cell
+			}
+		}
+	}
+	setStep.Finish(view.RecordLen())
+
+	var returning *View
+	if query.ReturningClause != nil {
+		if returning, err = returningView(ctx, queryScope, &View{Header: view.Header, RecordSet: view.RecordSet}, query.ReturningClause.(parser.ReturningClause)); err != nil {
+			return nil, nil, nil, err
 		}
 	}
 
@@ -500,7 +570,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 	updateRecords := make([]int, 0)
 	for k, v := range viewsToUpdate {
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if !v.FileInfo.IsFile() {
@@ -513,10 +583,11 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		updateRecords = append(updateRecords, updatedCount[k])
 	}
 
-	return fileInfos, updateRecords, nil
+	step.Finish(sumRecords(updateRecords))
+	return fileInfos, updateRecords, returning, nil
 }
 
-func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQuery) (*FileInfo, int, error) {
+func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQuery) (*FileInfo, int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
@@ -524,7 +595,7 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, replaceRecords, err
+			return nil, replaceRecords, nil, err
 		}
 	}
 
@@ -534,10 +605,11 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
+	ctx = ContextForDataModification(ctx)
 
 	view, err := LoadView(ctx, queryScope, tables, true, false)
 	if err != nil {
-		return nil, replaceRecords, err
+		return nil, replaceRecords, nil, err
 	}
 
 	fields := query.Fields
@@ -545,18 +617,38 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		fields = view.Header.TableColumns()
 	}
 
+	var recordValues [][]value.Primary
 	if query.ValuesList != nil {
-		if replaceRecords, err = view.ReplaceValues(ctx, queryScope, fields, query.ValuesList, query.Keys); err != nil {
-			return nil, replaceRecords, err
-		}
+		recordValues, err = view.convertListToRecordValues(ctx, queryScope, fields, query.ValuesList)
 	} else {
-		if replaceRecords, err = view.ReplaceFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery), query.Keys); err != nil {
-			return nil, replaceRecords, err
+		recordValues, err = view.convertResultSetToRecordValues(ctx, queryScope, fields, query.Query.(parser.SelectQuery))
+	}
+	if err != nil {
+		return nil, replaceRecords, nil, err
+	}
+
+	replacedIndices, err := view.replace(ctx, queryScope.Tx.Flags, fields, recordValues, query.Keys)
+	if err != nil {
+		return nil, replaceRecords, nil, err
+	}
+	replaceRecords = len(replacedIndices)
+
+	var returning *View
+	if query.ReturningClause != nil {
+		replaced := &View{
+			Header:    view.Header.Copy(),
+			RecordSet: make(RecordSet, len(replacedIndices)),
+		}
+		for i, idx := range replacedIndices {
+			replaced.RecordSet[i] = view.RecordSet[idx].Copy()
+		}
+		if returning, err = returningView(ctx, queryScope, replaced, query.ReturningClause.(parser.ReturningClause)); err != nil {
+			return nil, replaceRecords, nil, err
 		}
 	}
 
 	if err = view.RestoreHeaderReferences(); err != nil {
-		return nil, replaceRecords, err
+		return nil, replaceRecords, nil, err
 	}
 
 	if !view.FileInfo.IsFile() {
@@ -565,44 +657,46 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		scope.Tx.cachedViews.Set(view)
 	}
 
-	return view.FileInfo, replaceRecords, err
+	return view.FileInfo, replaceRecords, returning, err
 }
 
-func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, error) {
+func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, *View, error) {
+	ctx, step := StartPlanStep(ctx, query.BaseExpr, PlanDelete, deletePlanDetail(query))
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	tables := query.FromClause.Tables
 	if query.Tables == nil {
 		if 1 < len(tables) {
-			return nil, nil, NewDeleteTableNotSpecifiedError(query)
+			return nil, nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 		table := tables[0].(parser.Table)
 		switch table.Object.(type) {
 		case parser.Identifier, parser.TableObject, parser.Stdin:
 			query.Tables = tables
 		default:
-			return nil, nil, NewDeleteTableNotSpecifiedError(query)
+			return nil, nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 	}
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
+	ctx = ContextForDataModification(ctx)
 
 	view, err := LoadView(ctx, queryScope, tables, true, true)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
 		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -613,7 +707,7 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		tableName := table.Name()
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		viewKey := strings.ToUpper(tableName.Literal)
@@ -623,14 +717,14 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 			viewsToDelete[viewKey], _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
 		}
 		if err = viewsToDelete[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		deletedIndices[viewKey] = make(map[int]bool)
 	}
 
 	for i := range view.RecordSet {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		for viewref := range viewsToDelete {
@@ -644,11 +738,18 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		}
 	}
 
+	var returning *View
+	if query.ReturningClause != nil {
+		if returning, err = returningView(ctx, queryScope, &View{Header: view.Header, RecordSet: view.RecordSet}, query.ReturningClause.(parser.ReturningClause)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	deletedCounts := make([]int, 0)
 	for k, v := range viewsToDelete {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		records := make(RecordSet, 0, v.RecordLen()-len(deletedIndices[k]))
@@ -660,8 +761,9 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		v.RecordSet = records
 
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
+		v.FileInfo.removeDatabaseRows(deletedIndices[k])
 
 		if !v.FileInfo.IsFile() {
 			scope.ReplaceTemporaryTable(v)
@@ -673,7 +775,182 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		deletedCounts = append(deletedCounts, len(deletedIndices[k]))
 	}
 
-	return fileInfos, deletedCounts, nil
+	step.Finish(sumRecords(deletedCounts))
+	return fileInfos, deletedCounts, returning, nil
+}
+
+func returningView(ctx context.Context, scope *ReferenceScope, view *View, clause parser.ReturningClause) (*View, error) {
+	if err := view.Select(ctx, scope, parser.SelectClause{BaseExpr: clause.BaseExpr, Fields: clause.Fields}); err != nil {
+		return nil, err
+	}
+	if err := view.Fix(ctx, scope.Tx.Flags); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func Merge(ctx context.Context, scope *ReferenceScope, query parser.MergeQuery) (*FileInfo, int, int, int, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	if query.WithClause != nil {
+		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
+			return nil, 0, 0, 0, err
+		}
+	}
+
+	tables := []parser.QueryExpression{
+		parser.Table{
+			Object: parser.Join{
+				BaseExpr:  query.BaseExpr,
+				Table:     query.Source,
+				JoinTable: query.Table,
+				Direction: parser.Token{Token: parser.LEFT, Literal: parser.TokenLiteral(parser.LEFT)},
+				Condition: parser.JoinCondition{On: query.Condition},
+			},
+		},
+	}
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+	ctx = ContextForDataModification(ctx)
+
+	view, err := LoadView(ctx, queryScope, tables, true, true)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	tableName := query.Table.Name()
+	fpath, err := queryScope.GetAlias(tableName)
+	if err != nil {
+		return nil, 0, 0, 0, err
+	}
+
+	var target *View
+	if queryScope.TemporaryTableExists(fpath) {
+		target, _ = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
+	} else {
+		target, _ = queryScope.Tx.cachedViews.Get(parser.Identifier{Literal: fpath})
+	}
+	if err = target.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, 0, 0, 0, err
+	}
+	viewref := strings.ToUpper(tableName.Literal)
+
+	updatedRecords := make(map[int]Record)
+	deletedIndices := make(map[int]bool)
+	insertedRecords := make(RecordSet, 0)
+
+	seqScope := queryScope.CreateScopeForSequentialEvaluation(view)
+	for i := range view.RecordSet {
+		if ctx.Err() != nil {
+			return nil, 0, 0, 0, ConvertContextError(ctx.Err())
+		}
+
+		seqScope.Records[0].recordIndex = i
+		internalId, idErr := view.InternalRecordId(viewref, i)
+		matched := idErr == nil
+
+		clause, ok, err := selectMergeWhenClause(ctx, seqScope, query.WhenClauses, matched)
+		if err != nil {
+			return nil, 0, 0, 0, err
+		}
+		if !ok {
+			continue
+		}
+
+		switch clause.Operation.Token {
+		case parser.UPDATE:
+			if _, ok := updatedRecords[internalId]; ok || deletedIndices[internalId] {
+				return nil, 0, 0, 0, NewMergeRecordAmbiguousError(clause, tableName)
+			}
+
+			record := target.RecordSet[internalId].Copy()
+			for _, uset := range clause.SetList {
+				val, err := Evaluate(ctx, seqScope, uset.Value)
+				if err != nil {
+					return nil, 0, 0, 0, err
+				}
+
+				fieldIdx, err := target.Header.SearchIndex(uset.Field)
+				if err != nil {
+					return nil, 0, 0, 0, NewUpdateFieldNotExistError(uset.Field)
+				}
+				record[fieldIdx] = NewCell(val)
+			}
+			updatedRecords[internalId] = record
+		case parser.DELETE:
+			if _, ok := updatedRecords[internalId]; ok || deletedIndices[internalId] {
+				return nil, 0, 0, 0, NewMergeRecordAmbiguousError(clause, tableName)
+			}
+			deletedIndices[internalId] = true
+		case parser.INSERT:
+			rv := clause.Values.(parser.RowValue)
+			values, err := EvalRowValue(ctx, seqScope, rv)
+			if err != nil {
+				return nil, 0, 0, 0, err
+			}
+
+			fields := clause.Fields
+			if fields == nil {
+				fields = target.Header.TableColumns()
+			}
+			if len(fields) != len(values) {
+				return nil, 0, 0, 0, NewInsertRowValueLengthError(rv, len(fields))
+			}
+
+			records, err := target.convertRecordValuesToRecordSet(ctx, fields, [][]value.Primary{values})
+			if err != nil {
+				return nil, 0, 0, 0, err
+			}
+			insertedRecords = append(insertedRecords, records...)
+		}
+	}
+
+	records := make(RecordSet, 0, target.RecordLen()-len(deletedIndices)+len(insertedRecords))
+	for i, record := range target.RecordSet {
+		if deletedIndices[i] {
+			continue
+		}
+		if r, ok := updatedRecords[i]; ok {
+			record = r
+		}
+		records = append(records, record)
+	}
+	target.RecordSet = records.Merge(insertedRecords)
+
+	if err = target.RestoreHeaderReferences(); err != nil {
+		return nil, 0, 0, 0, err
+	}
+	target.FileInfo.removeDatabaseRows(deletedIndices)
+
+	if !target.FileInfo.IsFile() {
+		scope.ReplaceTemporaryTable(target)
+	} else {
+		scope.Tx.cachedViews.Set(target)
+	}
+
+	return target.FileInfo, len(insertedRecords), len(updatedRecords), len(deletedIndices), nil
+}
+
+func selectMergeWhenClause(ctx context.Context, scope *ReferenceScope, clauses []parser.MergeWhenClause, matched bool) (parser.MergeWhenClause, bool, error) {
+	for _, clause := range clauses {
+		if clause.IsMatched() != matched {
+			continue
+		}
+		if clause.Condition == nil {
+			return clause, true, nil
+		}
+
+		p, err := Evaluate(ctx, scope, clause.Condition)
+		if err != nil {
+			return clause, false, err
+		}
+		if p.Ternary() == ternary.TRUE {
+			return clause, true, nil
+		}
+	}
+	return parser.MergeWhenClause{}, false, nil
 }
 
 func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.CreateTable) (*FileInfo, error) {
@@ -952,7 +1229,7 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	if err != nil {
 		return nil, log, err
 	}
-	if !view.FileInfo.IsFile() {
+	if !view.FileInfo.IsFile() || view.FileInfo.IsDatabaseTable() {
 		return nil, log, NewNotTableError(query.Table)
 	}
 
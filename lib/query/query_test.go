@@ -545,6 +545,346 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Select with Window Clause",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+						parser.Field{Object: parser.AnalyticFunction{
+							Name: "count",
+							Args: []parser.QueryExpression{parser.AllColumns{}},
+							AnalyticClause: parser.AnalyticClause{
+								WindowName: parser.Identifier{Literal: "w"},
+							},
+						}},
+						parser.Field{Object: parser.AnalyticFunction{
+							Name: "first_value",
+							Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+							AnalyticClause: parser.AnalyticClause{
+								WindowName: parser.Identifier{Literal: "w"},
+								OrderByClause: parser.OrderByClause{
+									Items: []parser.QueryExpression{
+										parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+									},
+								},
+								WindowingClause: parser.WindowingClause{
+									FrameType: parser.Token{Token: parser.ROWS},
+									FrameLow: parser.WindowFramePosition{
+										Direction: parser.Token{Token: parser.PRECEDING},
+										Unbounded: parser.Token{Token: parser.UNBOUNDED},
+									},
+									Exclusion: parser.Token{Token: parser.CURRENT},
+								},
+							},
+						}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				WindowClause: parser.WindowClause{
+					Windows: []parser.QueryExpression{
+						parser.WindowDefinition{
+							Name: parser.Identifier{Literal: "w"},
+							Clause: parser.AnalyticClause{
+								PartitionClause: parser.PartitionClause{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column2",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "COUNT(1) OVER w",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column:      "FIRST_VALUE(column2) OVER (w ORDER BY column2 ROWS UNBOUNDED PRECEDING EXCLUDE CURRENT ROW)",
+					Number:      3,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+					value.NewInteger(2),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str2"),
+					value.NewInteger(2),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str3"),
+					value.NewInteger(2),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str4"),
+					value.NewInteger(2),
+					value.NewString("str3"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str5"),
+					value.NewInteger(1),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select with Window Clause Undefined Window Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AnalyticFunction{
+							Name: "count",
+							Args: []parser.QueryExpression{parser.AllColumns{}},
+							AnalyticClause: parser.AnalyticClause{
+								WindowName: parser.Identifier{Literal: "w2"},
+							},
+						}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				WindowClause: parser.WindowClause{
+					Windows: []parser.QueryExpression{
+						parser.WindowDefinition{
+							Name: parser.Identifier{Literal: "w"},
+							Clause: parser.AnalyticClause{
+								PartitionClause: parser.PartitionClause{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "window w2 is undefined",
+	},
+	{
+		Name: "Select with Qualify Clause",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				QualifyClause: parser.QualifyClause{
+					Filter: parser.Comparison{
+						LHS: parser.AnalyticFunction{
+							Name: "row_number",
+							AnalyticClause: parser.AnalyticClause{
+								PartitionClause: parser.PartitionClause{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									},
+								},
+								OrderByClause: parser.OrderByClause{
+									Items: []parser.QueryExpression{
+										parser.OrderItem{
+											Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+											Direction: parser.Token{Token: parser.DESC},
+										},
+									},
+								},
+							},
+						},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "group_table",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str5"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str2"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select with Qualify Clause and Order By Clause",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				QualifyClause: parser.QualifyClause{
+					Filter: parser.Comparison{
+						LHS: parser.AnalyticFunction{
+							Name: "row_number",
+							AnalyticClause: parser.AnalyticClause{
+								PartitionClause: parser.PartitionClause{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									},
+								},
+								OrderByClause: parser.OrderByClause{
+									Items: []parser.QueryExpression{
+										parser.OrderItem{
+											Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+											Direction: parser.Token{Token: parser.DESC},
+										},
+									},
+								},
+							},
+						},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "group_table",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str5"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select with Qualify Clause Filter Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				QualifyClause: parser.QualifyClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
 	{
 		Name: "Union",
 		Query: parser.SelectQuery{
@@ -1374,6 +1714,7 @@ var insertTests = []struct {
 	Query        parser.InsertQuery
 	ResultFile   *FileInfo
 	UpdateCount  int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -1578,9 +1919,9 @@ var insertTests = []struct {
 		UpdateCount: 2,
 	},
 	{
-		Name: "Insert Query File Does Not Exist Error",
+		Name: "Insert Query Returning",
 		Query: parser.InsertQuery{
-			Table: parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
 			Fields: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
 			},
@@ -1600,13 +1941,78 @@ var insertTests = []struct {
 					},
 				},
 			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
 		},
-		Error: "file notexist does not exist",
-	},
-	{
-		Name: "Insert Query Field Does Not Exist Error",
-		Query: parser.InsertQuery{
-			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 2,
+		Returning: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(4),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(5),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Insert Query File Does Not Exist Error",
+		Query: parser.InsertQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+			Fields: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+						},
+					},
+				},
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("5"),
+						},
+					},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+	{
+		Name: "Insert Query Field Does Not Exist Error",
+		Query: parser.InsertQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
 			Fields: []parser.QueryExpression{
 				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
 			},
@@ -1728,7 +2134,7 @@ func TestInsert(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range insertTests {
 		_ = TestTx.ReleaseResources()
-		result, cnt, err := Insert(ctx, scope, v.Query)
+		result, cnt, returning, err := Insert(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1762,6 +2168,10 @@ func TestInsert(t *testing.T) {
 			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -1780,6 +2190,7 @@ var updateTests = []struct {
 	Query        parser.UpdateQuery
 	ResultFiles  []*FileInfo
 	UpdateCounts []int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -1973,6 +2384,65 @@ var updateTests = []struct {
 		},
 		UpdateCounts: []int{2},
 	},
+	{
+		Name: "Update Query Returning",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.NewStringValue("update"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '>', Literal: ">"},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1},
+		Returning: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("update"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Update Query File Does Not Exist Error",
 		Query: parser.UpdateQuery{
@@ -2203,7 +2673,7 @@ func TestUpdate(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range updateTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Update(ctx, scope, v.Query)
+		files, cnt, returning, err := Update(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2237,6 +2707,10 @@ func TestUpdate(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -2255,6 +2729,7 @@ var replaceTests = []struct {
 	Query        parser.ReplaceQuery
 	ResultFile   *FileInfo
 	UpdateCount  int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -2460,6 +2935,69 @@ var replaceTests = []struct {
 		},
 		UpdateCount: 2,
 	},
+	{
+		Name: "Replace Query Returning",
+		Query: parser.ReplaceQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Fields: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			},
+			Keys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+							parser.NewStringValue("str4"),
+						},
+					},
+				},
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("2"),
+							parser.NewStringValue("str22"),
+						},
+					},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 2,
+		Returning: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      1,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str4"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Replace Query File Does Not Exist Error",
 		Query: parser.ReplaceQuery{
@@ -2623,7 +3161,7 @@ func TestReplace(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range replaceTests {
 		_ = TestTx.ReleaseResources()
-		result, cnt, err := Replace(ctx, scope, v.Query)
+		result, cnt, returning, err := Replace(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2657,6 +3195,10 @@ func TestReplace(t *testing.T) {
 			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -2675,6 +3217,7 @@ var deleteTests = []struct {
 	Query        parser.DeleteQuery
 	ResultFiles  []*FileInfo
 	UpdateCounts []int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -2854,6 +3397,54 @@ var deleteTests = []struct {
 		},
 		UpdateCounts: []int{2},
 	},
+	{
+		Name: "Delete Query Returning",
+		Query: parser.DeleteQuery{
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '<', Literal: "<"},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{1},
+		Returning: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      1,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Delete Query Tables Not Specified Error",
 		Query: parser.DeleteQuery{
@@ -2991,7 +3582,7 @@ func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range deleteTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Delete(ctx, scope, v.Query)
+		files, cnt, returning, err := Delete(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3025,6 +3616,378 @@ func TestDelete(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
+		if v.ViewCache.SyncMap != nil {
+			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
+				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
+			}
+		}
+		if v.ResultScopes != nil {
+			if !SyncMapEqual(scope.blocks[0].temporaryTables, v.ResultScopes.blocks[0].temporaryTables) {
+				t.Errorf("%s: temporary views list = %v, want %v", v.Name, scope.blocks[0].temporaryTables, v.ResultScopes.blocks[0].temporaryTables)
+			}
+		}
+	}
+}
+
+var mergeTests = []struct {
+	Name         string
+	Query        parser.MergeQuery
+	ResultFile   *FileInfo
+	InsertCount  int
+	UpdateCount  int
+	DeleteCount  int
+	ViewCache    ViewMap
+	ResultScopes *ReferenceScope
+	Error        string
+}{
+	{
+		Name: "Merge Query",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Condition: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+						RHS:      parser.NewIntegerValueFromString("3"),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+				{
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+							Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+						},
+					},
+				},
+				{
+					Negation:  parser.Token{Token: parser.NOT, Literal: "not"},
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Fields: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		InsertCount: 1,
+		UpdateCount: 1,
+		DeleteCount: 1,
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table2.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("table2", []string{"column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str33"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Merge Query For Temporary View",
+		Query: parser.MergeQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "tmpview"}, Alias: parser.Identifier{Literal: "t"}},
+			Source: parser.Table{
+				Object: parser.Subquery{
+					Query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.NewIntegerValueFromString("3"), As: parser.Token{Token: parser.AS, Literal: "as"}, Alias: parser.Identifier{Literal: "id"}},
+								},
+							},
+						},
+					},
+				},
+				Alias: parser.Identifier{Literal: "s"},
+			},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "id"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+				{
+					Negation:  parser.Token{Token: parser.NOT, Literal: "not"},
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{View: parser.Identifier{Literal: "s"}, Column: parser.Identifier{Literal: "id"}},
+								parser.NewStringValue("str3"),
+							},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      "tmpview",
+			Delimiter: ',',
+			ViewType:  ViewTypeTemporaryTable,
+		},
+		InsertCount: 1,
+		ResultScopes: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"TMPVIEW": &View{
+						Header: NewHeader("tmpview", []string{"column1", "column2"}),
+						RecordSet: []Record{
+							NewRecord([]value.Primary{
+								value.NewString("1"),
+								value.NewString("str1"),
+							}),
+							NewRecord([]value.Primary{
+								value.NewString("2"),
+								value.NewString("str2"),
+							}),
+							NewRecord([]value.Primary{
+								value.NewInteger(3),
+								value.NewString("str3"),
+							}),
+						},
+						FileInfo: &FileInfo{
+							Path:      "tmpview",
+							Delimiter: ',',
+							ViewType:  ViewTypeTemporaryTable,
+						},
+					},
+				},
+			},
+		}, nil, time.Time{}, nil),
+	},
+	{
+		Name: "Merge Query Record Ambiguous Error",
+		Query: parser.MergeQuery{
+			Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source:    parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.NewTernaryValueFromString("true"),
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		Error: "record in table1 is matched by more than one source record",
+	},
+	{
+		Name: "Merge Query Update Field Does Not Exist Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Operation: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{
+							Field: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+							Value: parser.NewStringValue("update"),
+						},
+					},
+				},
+			},
+		},
+		Error: "field column4 does not exist in the tables to update",
+	},
+	{
+		Name: "Merge Query Insert Row Value Length Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Negation:  parser.Token{Token: parser.NOT, Literal: "not"},
+					Operation: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "row value should contain exactly 2 values",
+	},
+	{
+		Name: "Merge Query Condition Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: '=', Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhenClause{
+				{
+					Condition: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					Operation: parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestMerge(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.Quiet = false
+
+	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
+		{
+			scopeNameTempTables: {
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("2"),
+							value.NewString("str2"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:      "tmpview",
+						Delimiter: ',',
+						ViewType:  ViewTypeTemporaryTable,
+					},
+				},
+			},
+		},
+	}, nil, time.Time{}, nil)
+
+	ctx := context.Background()
+	for _, v := range mergeTests {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		file, inserted, updated, deleted, err := Merge(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		TestTx.cachedViews.Range(func(key, value interface{}) bool {
+			view := value.(*View)
+			if view.FileInfo.Handler != nil {
+				if view.FileInfo.Path != view.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", view.FileInfo.Handler.Path(), view.FileInfo.Path, v.Name)
+				}
+				_ = TestTx.FileContainer.Close(view.FileInfo.Handler)
+				view.FileInfo.Handler = nil
+			}
+			return true
+		})
+
+		if !reflect.DeepEqual(file, v.ResultFile) {
+			t.Errorf("%s: fileinfo = %v, want %v", v.Name, file, v.ResultFile)
+		}
+
+		if inserted != v.InsertCount || updated != v.UpdateCount || deleted != v.DeleteCount {
+			t.Errorf("%s: counts = %d, %d, %d, want %d, %d, %d", v.Name, inserted, updated, deleted, v.InsertCount, v.UpdateCount, v.DeleteCount)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.cachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.cachedViews, v.ViewCache)
@@ -4266,7 +5229,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|GFM|ORG|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX|GFM|ORG|TEXT",
 	},
 	{
 		Name: "Set Encoding to SJIS",
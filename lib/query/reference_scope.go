@@ -28,6 +28,7 @@ func GetBlockScope() BlockScope {
 
 func PutBlockScope(scope BlockScope) {
 	scope.Clear()
+	scope.caughtError = nil
 	blockScopePool.Put(scope)
 }
 
@@ -52,6 +53,9 @@ type BlockScope struct {
 	temporaryTables ViewMap
 	cursors         CursorMap
 	functions       UserDefinedFunctionMap
+	procedures      UserDefinedProcedureMap
+
+	caughtError Error
 }
 
 func NewBlockScope() BlockScope {
@@ -60,6 +64,7 @@ func NewBlockScope() BlockScope {
 		temporaryTables: NewViewMap(),
 		cursors:         NewCursorMap(),
 		functions:       NewUserDefinedFunctionMap(),
+		procedures:      NewUserDefinedProcedureMap(),
 	}
 }
 
@@ -68,6 +73,7 @@ func (scope BlockScope) Clear() {
 	scope.temporaryTables.Clear()
 	scope.cursors.Clear()
 	scope.functions.Clear()
+	scope.procedures.Clear()
 }
 
 type NodeScope struct {
@@ -278,6 +284,21 @@ func (rs *ReferenceScope) ClearCurrentBlock() {
 	rs.CurrentBlock().Clear()
 }
 
+// SetCaughtError sets the error caught by a TRY block to the current block.
+func (rs *ReferenceScope) SetCaughtError(err Error) {
+	rs.blocks[0].caughtError = err
+}
+
+// CaughtError returns the error that is being handled in the nearest CATCH block.
+func (rs *ReferenceScope) CaughtError() Error {
+	for i := range rs.blocks {
+		if rs.blocks[i].caughtError != nil {
+			return rs.blocks[i].caughtError
+		}
+	}
+	return nil
+}
+
 func (rs *ReferenceScope) CloseCurrentBlock() {
 	PutBlockScope(rs.CurrentBlock())
 }
@@ -658,6 +679,41 @@ func (rs *ReferenceScope) AllFunctions() (UserDefinedFunctionMap, UserDefinedFun
 	return scalarAll, aggregateAll
 }
 
+func (rs *ReferenceScope) DeclareProcedure(expr parser.ProcedureDeclaration) error {
+	return rs.blocks[0].procedures.Declare(expr)
+}
+
+func (rs *ReferenceScope) GetProcedure(name parser.Identifier) (*UserDefinedProcedure, error) {
+	for i := range rs.blocks {
+		if p, ok := rs.blocks[i].procedures.Load(name.Literal); ok {
+			return p, nil
+		}
+	}
+	return nil, NewProcedureNotExistError(name)
+}
+
+func (rs *ReferenceScope) DisposeProcedure(name parser.Identifier) error {
+	for i := range rs.blocks {
+		if rs.blocks[i].procedures.Dispose(name) {
+			return nil
+		}
+	}
+	return NewProcedureNotExistError(name)
+}
+
+func (rs *ReferenceScope) AllProcedures() UserDefinedProcedureMap {
+	all := NewUserDefinedProcedureMap()
+	for i := range rs.blocks {
+		rs.blocks[i].procedures.Range(func(key, val interface{}) bool {
+			if !all.Exists(key.(string)) {
+				all.Store(key.(string), val.(*UserDefinedProcedure))
+			}
+			return true
+		})
+	}
+	return all
+}
+
 func (rs *ReferenceScope) SetInlineTable(ctx context.Context, inlineTable parser.InlineTable) error {
 	return rs.nodes[0].inlineTables.Set(ctx, rs, inlineTable)
 }
@@ -687,10 +743,16 @@ func (rs *ReferenceScope) InlineTableExists(name parser.Identifier) bool {
 func (rs *ReferenceScope) LoadInlineTable(ctx context.Context, clause parser.WithClause) error {
 	for _, v := range clause.InlineTables {
 		inlineTable := v.(parser.InlineTable)
-		err := rs.SetInlineTable(ctx, inlineTable)
+		stepCtx, step := StartPlanStep(ctx, inlineTable.BaseExpr, PlanWith, inlineTable.Name.Literal)
+		err := rs.SetInlineTable(stepCtx, inlineTable)
 		if err != nil {
 			return err
 		}
+		if step != nil {
+			if view, err := rs.GetInlineTable(inlineTable.Name); err == nil {
+				step.Finish(view.RecordLen())
+			}
+		}
 	}
 
 	return nil
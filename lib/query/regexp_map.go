@@ -0,0 +1,87 @@
+package query
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// RegExpCacheSize is the maximum number of compiled regular expressions held in RegExps.
+const RegExpCacheSize = 256
+
+var RegExps = NewRegExpMap(RegExpCacheSize)
+
+type regExpEntry struct {
+	expr string
+	re   *regexp.Regexp
+}
+
+// RegExpMap is a cache of compiled regular expressions.
+// When the number of the expressions exceeds the capacity, the least recently used one is evicted.
+type RegExpMap struct {
+	mtx      *sync.Mutex
+	capacity int
+	entries  *list.List
+	elements map[string]*list.Element
+}
+
+func NewRegExpMap(capacity int) RegExpMap {
+	return RegExpMap{
+		mtx:      &sync.Mutex{},
+		capacity: capacity,
+		entries:  list.New(),
+		elements: make(map[string]*list.Element, capacity),
+	}
+}
+
+func (m RegExpMap) Store(expr string, re *regexp.Regexp) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if e, ok := m.elements[expr]; ok {
+		e.Value.(*regExpEntry).re = re
+		m.entries.MoveToFront(e)
+		return
+	}
+
+	m.elements[expr] = m.entries.PushFront(&regExpEntry{expr: expr, re: re})
+	for m.capacity < m.entries.Len() {
+		e := m.entries.Back()
+		m.entries.Remove(e)
+		delete(m.elements, e.Value.(*regExpEntry).expr)
+	}
+}
+
+func (m RegExpMap) Load(expr string) (*regexp.Regexp, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if e, ok := m.elements[expr]; ok {
+		m.entries.MoveToFront(e)
+		return e.Value.(*regExpEntry).re, true
+	}
+	return nil, false
+}
+
+func (m RegExpMap) Len() int {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.entries.Len()
+}
+
+func (m RegExpMap) Compile(expr string, flags string) (*regexp.Regexp, error) {
+	if 0 < len(flags) {
+		expr = "(?" + flags + ")" + expr
+	}
+
+	if re, ok := m.Load(expr); ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	m.Store(expr, re)
+	return re, nil
+}
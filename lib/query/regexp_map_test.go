@@ -0,0 +1,46 @@
+package query
+
+import (
+	"testing"
+)
+
+func TestRegExpMap_Compile(t *testing.T) {
+	m := NewRegExpMap(2)
+
+	re1, err := m.Compile("a+", "")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if re, _ := m.Compile("a+", ""); re != re1 {
+		t.Errorf("compiled expression is not reused")
+	}
+
+	if _, err = m.Compile("b+", "i"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, ok := m.Load("(?i)b+"); !ok {
+		t.Errorf("expression with flags is not stored")
+	}
+
+	// "a+" is used more recently than "(?i)b+", so "(?i)b+" is evicted.
+	_, _ = m.Load("a+")
+	if _, err = m.Compile("c+", ""); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if m.Len() != 2 {
+		t.Errorf("length = %d, want %d", m.Len(), 2)
+	}
+	if _, ok := m.Load("(?i)b+"); ok {
+		t.Errorf("least recently used expression is not evicted")
+	}
+	if _, ok := m.Load("a+"); !ok {
+		t.Errorf("recently used expression is evicted")
+	}
+
+	if _, err = m.Compile("(", ""); err == nil {
+		t.Errorf("no error, want error for invalid expression")
+	}
+	if m.Len() != 2 {
+		t.Errorf("length = %d, want %d", m.Len(), 2)
+	}
+}
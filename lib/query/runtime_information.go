@@ -14,8 +14,13 @@ const (
 	UpdatedInformation      = "UPDATED"
 	UpdatedViewsInformation = "UPDATED_VIEWS"
 	LoadedTablesInformation = "LOADED_TABLES"
+	JoinStrategyInformation = "JOIN_STRATEGY"
 	WorkingDirectory        = "WORKING_DIRECTORY"
 	VersionInformation      = "VERSION"
+	ErrorCodeInformation    = "ERROR_CODE"
+	ErrorMessageInformation = "ERROR_MESSAGE"
+	ErrorLineInformation    = "ERROR_LINE"
+	ErrorSourceInformation  = "ERROR_SOURCE"
 )
 
 var RuntimeInformatinList = []string{
@@ -24,12 +29,18 @@ var RuntimeInformatinList = []string{
 	UpdatedInformation,
 	UpdatedViewsInformation,
 	LoadedTablesInformation,
+	JoinStrategyInformation,
 	WorkingDirectory,
 	VersionInformation,
+	ErrorCodeInformation,
+	ErrorMessageInformation,
+	ErrorLineInformation,
+	ErrorSourceInformation,
 }
 
-func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (value.Primary, error) {
+func GetRuntimeInformation(scope *ReferenceScope, expr parser.RuntimeInformation) (value.Primary, error) {
 	var p value.Primary
+	tx := scope.Tx
 
 	switch strings.ToUpper(expr.Name) {
 	case UncommittedInformation:
@@ -42,6 +53,12 @@ func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (val
 		p = value.NewInteger(int64(tx.uncommittedViews.CountUpdatedViews()))
 	case LoadedTablesInformation:
 		p = value.NewInteger(int64(tx.cachedViews.Len()))
+	case JoinStrategyInformation:
+		if strategy := tx.JoinStrategy(); strategy != 0 {
+			p = value.NewString(strategy.String())
+		} else {
+			p = value.NewNull()
+		}
 	case WorkingDirectory:
 		wd, err := os.Getwd()
 		if err != nil {
@@ -50,9 +67,36 @@ func GetRuntimeInformation(tx *Transaction, expr parser.RuntimeInformation) (val
 		p = value.NewString(wd)
 	case VersionInformation:
 		p = value.NewString(Version)
+	case ErrorCodeInformation, ErrorMessageInformation, ErrorLineInformation, ErrorSourceInformation:
+		p = caughtErrorInformation(scope.CaughtError(), strings.ToUpper(expr.Name))
 	default:
 		return p, NewInvalidRuntimeInformationError(expr)
 	}
 
 	return p, nil
 }
+
+// caughtErrorInformation returns the information of the error caught by a TRY block.
+// Outside of CATCH blocks, the information is null.
+func caughtErrorInformation(err Error, name string) value.Primary {
+	if err == nil {
+		return value.NewNull()
+	}
+
+	switch name {
+	case ErrorCodeInformation:
+		return value.NewInteger(int64(err.Code()))
+	case ErrorMessageInformation:
+		return value.NewString(err.Message())
+	case ErrorLineInformation:
+		if err.Line() < 1 {
+			return value.NewNull()
+		}
+		return value.NewInteger(int64(err.Line()))
+	default: // ErrorSourceInformation
+		if len(err.Source()) < 1 {
+			return value.NewNull()
+		}
+		return value.NewString(err.Source())
+	}
+}
@@ -10,9 +10,10 @@ import (
 )
 
 var getRuntimeInformationTests = []struct {
-	Input  parser.RuntimeInformation
-	Expect value.Primary
-	Error  string
+	Input       parser.RuntimeInformation
+	CaughtError Error
+	Expect      value.Primary
+	Error       string
 }{
 	{
 		Input:  parser.RuntimeInformation{Name: "uncommitted"},
@@ -34,6 +35,10 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "loaded_tables"},
 		Expect: value.NewInteger(4),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "join_strategy"},
+		Expect: value.NewString("HASH JOIN"),
+	},
 	{
 		Input:  parser.RuntimeInformation{Name: "working_directory"},
 		Expect: value.NewString(GetWD()),
@@ -42,6 +47,44 @@ var getRuntimeInformationTests = []struct {
 		Input:  parser.RuntimeInformation{Name: "version"},
 		Expect: value.NewString("v1.0.0"),
 	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_code"},
+		Expect: value.NewNull(),
+	},
+	{
+		Input:  parser.RuntimeInformation{Name: "error_message"},
+		Expect: value.NewNull(),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_code"},
+		CaughtError: NewFieldNotExistError(parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 8, SourceFile: "source.sql"}), Column: parser.Identifier{Literal: "notexist"}}).(Error),
+		Expect:      value.NewInteger(ReturnCodeApplicationError),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_code"},
+		CaughtError: NewUserTriggeredError(parser.Trigger{Event: parser.Identifier{Literal: "error"}, Code: value.NewInteger(300)}, "user error").(Error),
+		Expect:      value.NewInteger(300),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_message"},
+		CaughtError: NewFieldNotExistError(parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 8, SourceFile: "source.sql"}), Column: parser.Identifier{Literal: "notexist"}}).(Error),
+		Expect:      value.NewString("field notexist does not exist"),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_line"},
+		CaughtError: NewFieldNotExistError(parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 8, SourceFile: "source.sql"}), Column: parser.Identifier{Literal: "notexist"}}).(Error),
+		Expect:      value.NewInteger(2),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_line"},
+		CaughtError: NewUserTriggeredError(parser.Trigger{Event: parser.Identifier{Literal: "error"}}, "user error").(Error),
+		Expect:      value.NewNull(),
+	},
+	{
+		Input:       parser.RuntimeInformation{Name: "error_source"},
+		CaughtError: NewFieldNotExistError(parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 2, Char: 8, SourceFile: "source.sql"}), Column: parser.Identifier{Literal: "notexist"}}).(Error),
+		Expect:      value.NewString("source.sql"),
+	},
 	{
 		Input: parser.RuntimeInformation{Name: "invalid"},
 		Error: "@#INVALID is an unknown runtime information",
@@ -52,9 +95,12 @@ func TestGetRuntimeInformation(t *testing.T) {
 	defer func() {
 		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
 		TestTx.uncommittedViews.Clean()
+		TestTx.setJoinStrategy(0)
 		initFlag(TestTx.Flags)
 	}()
 
+	TestTx.setJoinStrategy(HashJoin)
+
 	TestTx.cachedViews = GenerateViewMap([]*View{
 		{FileInfo: &FileInfo{Path: "table1"}},
 		{FileInfo: &FileInfo{Path: "table2"}},
@@ -76,7 +122,11 @@ func TestGetRuntimeInformation(t *testing.T) {
 	}
 
 	for _, v := range getRuntimeInformationTests {
-		result, err := GetRuntimeInformation(TestTx, v.Input)
+		scope := NewReferenceScope(TestTx)
+		if v.CaughtError != nil {
+			scope.SetCaughtError(v.CaughtError)
+		}
+		result, err := GetRuntimeInformation(scope, v.Input)
 
 		if err != nil {
 			if v.Error == "" {
@@ -0,0 +1,298 @@
+package query
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// Sketch is a summary of values whose memory usage does not depend on
+// the number of the values.
+// Sketches built from different sets of values can be merged into one.
+type Sketch interface {
+	Add(val value.Primary, flags *cmd.Flags)
+	Merge(sketch Sketch)
+	Result() value.Primary
+}
+
+const (
+	hllPrecision    = 14
+	hllRegisterLen  = 1 << hllPrecision
+	hllSparseLimit  = hllRegisterLen / 16
+	tdigestCompress = 100
+	tdigestBufLen   = tdigestCompress * 5
+)
+
+// HyperLogLog estimates the number of distinct values.
+// Registers are kept in a sparse map while the number of the distinct values
+// is small, and converted to a fixed-size array when the map grows.
+type HyperLogLog struct {
+	sparse    map[uint16]uint8
+	registers []uint8
+}
+
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{
+		sparse: make(map[uint16]uint8, 16),
+	}
+}
+
+func (hll *HyperLogLog) Add(val value.Primary, flags *cmd.Flags) {
+	if value.IsNull(val) {
+		return
+	}
+
+	buf := GetComparisonKeysBuf()
+	SerializeComparisonKeys(buf, []value.Primary{val}, flags)
+	h := fnv.New64a()
+	_, _ = h.Write(buf.Bytes())
+	PutComparisonkeysBuf(buf)
+
+	hll.addHash(mixHash(h.Sum64()))
+}
+
+func (hll *HyperLogLog) addHash(hash uint64) {
+	idx := uint16(hash >> (64 - hllPrecision))
+	rho := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	hll.set(idx, rho)
+}
+
+func (hll *HyperLogLog) set(idx uint16, rho uint8) {
+	if hll.registers != nil {
+		if hll.registers[idx] < rho {
+			hll.registers[idx] = rho
+		}
+		return
+	}
+
+	if hll.sparse[idx] < rho {
+		hll.sparse[idx] = rho
+		if hllSparseLimit < len(hll.sparse) {
+			hll.toDense()
+		}
+	}
+}
+
+func (hll *HyperLogLog) toDense() {
+	hll.registers = make([]uint8, hllRegisterLen)
+	for idx, rho := range hll.sparse {
+		hll.registers[idx] = rho
+	}
+	hll.sparse = nil
+}
+
+func (hll *HyperLogLog) Merge(sketch Sketch) {
+	other := sketch.(*HyperLogLog)
+	if other.registers == nil {
+		for idx, rho := range other.sparse {
+			hll.set(idx, rho)
+		}
+		return
+	}
+
+	if hll.registers == nil {
+		hll.toDense()
+	}
+	for idx, rho := range other.registers {
+		if hll.registers[idx] < rho {
+			hll.registers[idx] = rho
+		}
+	}
+}
+
+func (hll *HyperLogLog) Count() int64 {
+	m := float64(hllRegisterLen)
+
+	var sum float64
+	var zeros int
+	if hll.registers == nil {
+		zeros = hllRegisterLen - len(hll.sparse)
+		sum = float64(zeros)
+		for _, rho := range hll.sparse {
+			sum += math.Ldexp(1, -int(rho))
+		}
+	} else {
+		for _, rho := range hll.registers {
+			if rho == 0 {
+				zeros++
+			}
+			sum += math.Ldexp(1, -int(rho))
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && 0 < zeros {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(estimate))
+}
+
+func (hll *HyperLogLog) Result() value.Primary {
+	return value.NewInteger(hll.Count())
+}
+
+// mixHash improves the distribution of the lower bits of the hash value.
+func mixHash(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+type centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+// TDigest estimates quantiles of float or datetime values.
+// Values near both ends are kept more precisely than values around the median.
+type TDigest struct {
+	Fraction float64
+
+	centroids []centroid
+	buffer    []centroid
+	min       float64
+	max       float64
+}
+
+func NewTDigest(fraction float64) *TDigest {
+	return &TDigest{
+		Fraction: fraction,
+		min:      math.Inf(1),
+		max:      math.Inf(-1),
+	}
+}
+
+func (td *TDigest) Add(val value.Primary, flags *cmd.Flags) {
+	f, ok := floatOrDatetime(val, flags)
+	if !ok {
+		return
+	}
+
+	td.buffer = append(td.buffer, centroid{Mean: f, Weight: 1})
+	td.min = math.Min(td.min, f)
+	td.max = math.Max(td.max, f)
+	if tdigestBufLen <= len(td.buffer) {
+		td.compress()
+	}
+}
+
+func (td *TDigest) Merge(sketch Sketch) {
+	other := sketch.(*TDigest)
+	td.buffer = append(td.buffer, other.centroids...)
+	td.buffer = append(td.buffer, other.buffer...)
+	td.min = math.Min(td.min, other.min)
+	td.max = math.Max(td.max, other.max)
+	if tdigestBufLen <= len(td.buffer) {
+		td.compress()
+	}
+}
+
+func (td *TDigest) compress() {
+	if len(td.buffer) < 1 {
+		return
+	}
+
+	all := append(td.centroids, td.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].Mean < all[j].Mean })
+
+	var total float64
+	for _, c := range all {
+		total += c.Weight
+	}
+
+	merged := make([]centroid, 0, tdigestCompress*2)
+	merged = append(merged, all[0])
+	var cumulative float64
+	limit := tdigestQuantileLimit(0, total)
+	for _, c := range all[1:] {
+		last := &merged[len(merged)-1]
+		if cumulative+last.Weight+c.Weight <= limit {
+			last.Mean = last.Mean + (c.Mean-last.Mean)*c.Weight/(last.Weight+c.Weight)
+			last.Weight = last.Weight + c.Weight
+			continue
+		}
+
+		cumulative = cumulative + last.Weight
+		limit = tdigestQuantileLimit(cumulative, total)
+		merged = append(merged, c)
+	}
+
+	td.centroids = merged
+	td.buffer = td.buffer[:0]
+}
+
+// tdigestQuantileLimit returns the maximum cumulative weight that a centroid
+// starting at the cumulative weight can reach.
+func tdigestQuantileLimit(cumulative float64, total float64) float64 {
+	k := tdigestCompress / (2 * math.Pi) * math.Asin(2*cumulative/total-1)
+	k = k + 1
+	if tdigestCompress/4 <= k {
+		return total
+	}
+	return (math.Sin(k*2*math.Pi/tdigestCompress) + 1) / 2 * total
+}
+
+func (td *TDigest) Quantile(q float64) (float64, bool) {
+	td.compress()
+	if len(td.centroids) < 1 {
+		return 0, false
+	}
+	if len(td.centroids) == 1 {
+		return td.centroids[0].Mean, true
+	}
+
+	var total float64
+	for _, c := range td.centroids {
+		total += c.Weight
+	}
+
+	// The position is adjusted so that the result is equal to the exact
+	// percentile when all centroids hold only one value.
+	target := q*(total-1) + 0.5
+
+	first := td.centroids[0]
+	if target < first.Weight/2 {
+		return td.min + (first.Mean-td.min)*target/(first.Weight/2), true
+	}
+
+	var cumulative float64
+	for i := 0; i < len(td.centroids)-1; i++ {
+		c := td.centroids[i]
+		next := td.centroids[i+1]
+		center := cumulative + c.Weight/2
+		nextCenter := cumulative + c.Weight + next.Weight/2
+		if target <= nextCenter {
+			return c.Mean + (next.Mean-c.Mean)*(target-center)/(nextCenter-center), true
+		}
+		cumulative = cumulative + c.Weight
+	}
+
+	last := td.centroids[len(td.centroids)-1]
+	center := total - last.Weight/2
+	return last.Mean + (td.max-last.Mean)*(target-center)/(last.Weight/2), true
+}
+
+func (td *TDigest) Result() value.Primary {
+	f, ok := td.Quantile(td.Fraction)
+	if !ok {
+		return value.NewNull()
+	}
+	return value.ParseFloat64(f)
+}
+
+func floatOrDatetime(val value.Primary, flags *cmd.Flags) (float64, bool) {
+	if f := value.ToFloat(val); !value.IsNull(f) {
+		return f.(*value.Float).Raw(), true
+	}
+	if d := value.ToDatetime(val, flags.DatetimeFormat); !value.IsNull(d) {
+		return float64(d.(*value.Datetime).Raw().UnixNano()) / 1e9, true
+	}
+	return 0, false
+}
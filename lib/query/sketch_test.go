@@ -0,0 +1,166 @@
+package query
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var hyperLogLogTests = []struct {
+	Name     string
+	Distinct int
+	Repeat   int
+	Split    int
+}{
+	{
+		Name:     "Sparse",
+		Distinct: 100,
+		Repeat:   3,
+		Split:    1,
+	},
+	{
+		Name:     "Dense",
+		Distinct: 100000,
+		Repeat:   1,
+		Split:    1,
+	},
+	{
+		Name:     "Merge Sparse Sketches",
+		Distinct: 500,
+		Repeat:   2,
+		Split:    4,
+	},
+	{
+		Name:     "Merge Dense Sketches",
+		Distinct: 100000,
+		Repeat:   2,
+		Split:    4,
+	},
+}
+
+func TestHyperLogLog(t *testing.T) {
+	for _, v := range hyperLogLogTests {
+		sketches := make([]*HyperLogLog, v.Split)
+		for i := range sketches {
+			sketches[i] = NewHyperLogLog()
+		}
+
+		n := 0
+		for r := 0; r < v.Repeat; r++ {
+			for i := 0; i < v.Distinct; i++ {
+				sketches[n%v.Split].Add(value.NewInteger(int64(i)), TestTx.Flags)
+				n++
+			}
+		}
+		sketches[0].Add(value.NewNull(), TestTx.Flags)
+
+		for _, sk := range sketches[1:] {
+			sketches[0].Merge(sk)
+		}
+
+		count := sketches[0].Count()
+		if 0.02 < math.Abs(float64(count)-float64(v.Distinct))/float64(v.Distinct) {
+			t.Errorf("%s: count = %d, want approximately %d", v.Name, count, v.Distinct)
+		}
+	}
+}
+
+func TestHyperLogLog_Result(t *testing.T) {
+	hll := NewHyperLogLog()
+	if r := hll.Result(); !reflect.DeepEqual(r, value.NewInteger(0)) {
+		t.Errorf("result = %s, want %s for empty sketch", r, value.NewInteger(0))
+	}
+
+	hll.Add(value.NewString("a"), TestTx.Flags)
+	hll.Add(value.NewString("a"), TestTx.Flags)
+	hll.Add(value.NewString("b"), TestTx.Flags)
+	if r := hll.Result(); !reflect.DeepEqual(r, value.NewInteger(2)) {
+		t.Errorf("result = %s, want %s", r, value.NewInteger(2))
+	}
+}
+
+var tdigestTests = []struct {
+	Name     string
+	List     []value.Primary
+	Fraction float64
+	Result   value.Primary
+}{
+	{
+		Name:     "Median",
+		List:     []value.Primary{value.NewNull(), value.NewInteger(1), value.NewInteger(2), value.NewInteger(4), value.NewInteger(8)},
+		Fraction: 0.5,
+		Result:   value.NewInteger(3),
+	},
+	{
+		Name:     "Lower Quartile",
+		List:     []value.Primary{value.NewInteger(8), value.NewInteger(4), value.NewInteger(2), value.NewInteger(1)},
+		Fraction: 0.25,
+		Result:   value.NewFloat(1.75),
+	},
+	{
+		Name:     "Maximum",
+		List:     []value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(4)},
+		Fraction: 1,
+		Result:   value.NewInteger(4),
+	},
+	{
+		Name:     "Single Value",
+		List:     []value.Primary{value.NewInteger(5)},
+		Fraction: 0.3,
+		Result:   value.NewInteger(5),
+	},
+	{
+		Name:     "Datetime Values",
+		List:     []value.Primary{value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())), value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 17, 0, GetTestLocation()))},
+		Fraction: 0.5,
+		Result:   value.NewInteger(time.Date(2012, 2, 3, 9, 18, 16, 0, GetTestLocation()).Unix()),
+	},
+	{
+		Name:     "Empty",
+		List:     []value.Primary{value.NewNull(), value.NewString("a")},
+		Fraction: 0.5,
+		Result:   value.NewNull(),
+	},
+}
+
+func TestTDigest_Result(t *testing.T) {
+	for _, v := range tdigestTests {
+		td := NewTDigest(v.Fraction)
+		for _, p := range v.List {
+			td.Add(p, TestTx.Flags)
+		}
+		r := td.Result()
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, r, v.Result)
+		}
+	}
+}
+
+func TestTDigest_Quantile(t *testing.T) {
+	n := 100000
+	sketches := make([]*TDigest, 4)
+	for i := range sketches {
+		sketches[i] = NewTDigest(0)
+	}
+	for i := 0; i < n; i++ {
+		sketches[(i*7)%len(sketches)].Add(value.NewInteger(int64((i*7919)%n)), TestTx.Flags)
+	}
+	for _, sk := range sketches[1:] {
+		sketches[0].Merge(sk)
+	}
+
+	if len(sketches[0].centroids) > tdigestCompress*2 {
+		t.Errorf("number of centroids = %d, want at most %d", len(sketches[0].centroids), tdigestCompress*2)
+	}
+
+	for _, q := range []float64{0, 0.01, 0.25, 0.5, 0.75, 0.99, 1} {
+		f, ok := sketches[0].Quantile(q)
+		want := q * float64(n-1)
+		if !ok || 0.01 < math.Abs(f-want)/float64(n) {
+			t.Errorf("quantile(%g) = %g, want approximately %g", q, f, want)
+		}
+	}
+}
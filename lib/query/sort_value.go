@@ -18,9 +18,12 @@ const (
 	NullType SortValueType = iota
 	IntegerType
 	FloatType
+	DecimalType
 	DatetimeType
+	IntervalType
 	BooleanType
 	StringType
+	ArrayType
 )
 
 type SortValues []*SortValue
@@ -80,10 +83,16 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 			serializeInteger(buf, val.Integer)
 		case FloatType:
 			serializeFloat(buf, val.Float)
+		case DecimalType:
+			serializeDecimal(buf, val.Decimal)
 		case DatetimeType:
 			serializeDatetimeFromUnixNano(buf, val.Datetime)
+		case IntervalType:
+			serializeInterval(buf, val.Interval)
 		case StringType:
 			serializeString(buf, val.String)
+		case ArrayType:
+			buf.WriteString(val.String)
 		}
 	}
 }
@@ -93,8 +102,11 @@ type SortValue struct {
 
 	Integer  int64
 	Float    float64
+	Decimal  *value.Decimal
 	Datetime int64
+	Interval *value.Interval
 	String   string
+	Array    []*SortValue
 }
 
 func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
@@ -110,6 +122,11 @@ func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
 		sortValue.String = strings.ToUpper(cmd.TrimSpace(s.(*value.String).Raw()))
 		value.Discard(i)
 		value.Discard(s)
+	} else if d, ok := val.(*value.Decimal); ok && !isRepresentableAsFloat(d) {
+		sortValue.Type = DecimalType
+		sortValue.Decimal = d
+		sortValue.Float = d.Float64()
+		sortValue.String = d.String()
 	} else if f := value.ToFloat(val); !value.IsNull(f) {
 		s := value.ToString(val)
 		sortValue.Type = FloatType
@@ -122,6 +139,9 @@ func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
 		sortValue.Type = DatetimeType
 		sortValue.Datetime = t.UnixNano()
 		value.Discard(dt)
+	} else if iv, ok := val.(*value.Interval); ok {
+		sortValue.Type = IntervalType
+		sortValue.Interval = iv
 	} else if b := value.ToBoolean(val); !value.IsNull(b) {
 		sortValue.Type = BooleanType
 		if b.(*value.Boolean).Raw() {
@@ -132,6 +152,17 @@ func NewSortValue(val value.Primary, flags *cmd.Flags) *SortValue {
 	} else if s, ok := val.(*value.String); ok {
 		sortValue.Type = StringType
 		sortValue.String = strings.ToUpper(cmd.TrimSpace(s.Raw()))
+	} else if arr, ok := val.(*value.Array); ok {
+		buf := GetComparisonKeysBuf()
+		serializeArray(buf, arr, flags)
+		sortValue.Type = ArrayType
+		sortValue.String = buf.String()
+		PutComparisonkeysBuf(buf)
+
+		sortValue.Array = make([]*SortValue, arr.Len())
+		for i, v := range arr.Raw() {
+			sortValue.Array[i] = NewSortValue(v, flags)
+		}
 	} else {
 		sortValue.Type = NullType
 	}
@@ -150,6 +181,8 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			return ternary.ConvertFromBool(v.Integer < compareValue.Integer)
 		case FloatType:
 			return ternary.ConvertFromBool(v.Float < compareValue.Float)
+		case DecimalType:
+			return lessDecimal(v.decimal(), compareValue.Decimal)
 		case StringType:
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
@@ -160,6 +193,15 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 				return ternary.UNKNOWN
 			}
 			return ternary.ConvertFromBool(v.Float < compareValue.Float)
+		case DecimalType:
+			return lessDecimal(v.decimal(), compareValue.Decimal)
+		case StringType:
+			return ternary.ConvertFromBool(v.String < compareValue.String)
+		}
+	case DecimalType:
+		switch compareValue.Type {
+		case IntegerType, FloatType, DecimalType:
+			return lessDecimal(v.Decimal, compareValue.decimal())
 		case StringType:
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
@@ -171,14 +213,36 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			}
 			return ternary.ConvertFromBool(v.Datetime < compareValue.Datetime)
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			c := v.Interval.Cmp(compareValue.Interval)
+			if c == 0 {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(c < 0)
+		}
 	case StringType:
 		switch compareValue.Type {
-		case IntegerType, FloatType, StringType:
+		case IntegerType, FloatType, DecimalType, StringType:
 			if v.String == compareValue.String {
 				return ternary.UNKNOWN
 			}
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
+	case ArrayType:
+		switch compareValue.Type {
+		case ArrayType:
+			for i := 0; i < len(v.Array) && i < len(compareValue.Array); i++ {
+				if !v.Array[i].EquivalentTo(compareValue.Array[i]) {
+					return v.Array[i].Less(compareValue.Array[i])
+				}
+			}
+			if len(v.Array) == len(compareValue.Array) {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(len(v.Array) < len(compareValue.Array))
+		}
 	}
 
 	return ternary.UNKNOWN
@@ -196,11 +260,21 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 		case FloatType:
 			return v.Float == compareValue.Float
 		}
+	case DecimalType:
+		switch compareValue.Type {
+		case DecimalType:
+			return v.Decimal.Cmp(compareValue.Decimal) == 0
+		}
 	case DatetimeType:
 		switch compareValue.Type {
 		case DatetimeType:
 			return v.Datetime == compareValue.Datetime
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			return v.Interval.Cmp(compareValue.Interval) == 0
+		}
 	case BooleanType:
 		switch compareValue.Type {
 		case BooleanType, IntegerType:
@@ -211,9 +285,39 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 		case StringType:
 			return v.String == compareValue.String
 		}
+	case ArrayType:
+		switch compareValue.Type {
+		case ArrayType:
+			return v.String == compareValue.String
+		}
 	case NullType:
 		return compareValue.Type == NullType
 	}
 
 	return false
 }
+
+// decimal returns the numeric value as a decimal.
+func (v *SortValue) decimal() *value.Decimal {
+	switch v.Type {
+	case IntegerType:
+		return value.NewDecimalFromInt64(v.Integer)
+	case FloatType:
+		if d, ok := value.NewDecimalFromFloat64(v.Float); ok {
+			return d
+		}
+	}
+	return v.Decimal
+}
+
+func lessDecimal(d1 *value.Decimal, d2 *value.Decimal) ternary.Value {
+	if d1 == nil || d2 == nil {
+		return ternary.UNKNOWN
+	}
+
+	c := d1.Cmp(d2)
+	if c == 0 {
+		return ternary.UNKNOWN
+	}
+	return ternary.ConvertFromBool(c < 0)
+}
@@ -121,6 +121,30 @@ var sortValueLessTests = []struct {
 		CompareValue: NewSortValue(value.NewTernary(ternary.FALSE), TestTx.Flags),
 		Result:       ternary.UNKNOWN,
 	},
+	{
+		Name:         "SortValue Less Decimal and Float",
+		SortValue:    NewSortValue(value.ToDecimal(value.NewString("0.30000000000000000001")), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewFloat(0.3), TestTx.Flags),
+		Result:       ternary.FALSE,
+	},
+	{
+		Name:         "SortValue Less Integer and Decimal",
+		SortValue:    NewSortValue(value.NewInteger(1), TestTx.Flags),
+		CompareValue: NewSortValue(value.ToDecimal(value.NewString("0.30000000000000000001")), TestTx.Flags),
+		Result:       ternary.FALSE,
+	},
+	{
+		Name:         "SortValue Less Decimal Equal",
+		SortValue:    NewSortValue(value.ToDecimal(value.NewString("0.30000000000000000001")), TestTx.Flags),
+		CompareValue: NewSortValue(value.ToDecimal(value.NewString("0.300000000000000000010")), TestTx.Flags),
+		Result:       ternary.UNKNOWN,
+	},
+	{
+		Name:         "SortValue Less Interval",
+		SortValue:    NewSortValue(value.NewInterval(0, 29, 0), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInterval(1, 0, 0), TestTx.Flags),
+		Result:       ternary.TRUE,
+	},
 	{
 		Name:         "SortValue Less Incommensurable Types",
 		SortValue:    NewSortValue(value.NewInteger(3), TestTx.Flags),
@@ -174,6 +198,24 @@ var sortValueEquivalentToTests = []struct {
 		CompareValue: NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 100000, GetTestLocation())), TestTx.Flags),
 		Result:       false,
 	},
+	{
+		Name:         "SortValue EquivalentTo Decimal",
+		SortValue:    NewSortValue(value.ToDecimal(value.NewString("0.30000000000000000001")), TestTx.Flags),
+		CompareValue: NewSortValue(value.ToDecimal(value.NewString("0.300000000000000000010")), TestTx.Flags),
+		Result:       true,
+	},
+	{
+		Name:         "SortValue EquivalentTo Decimal and Float",
+		SortValue:    NewSortValue(value.ToDecimal(value.NewString("0.5")), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewFloat(0.5), TestTx.Flags),
+		Result:       true,
+	},
+	{
+		Name:         "SortValue EquivalentTo Interval",
+		SortValue:    NewSortValue(value.NewInterval(0, 1, 0), TestTx.Flags),
+		CompareValue: NewSortValue(value.NewInterval(0, 0, int64(24*time.Hour)), TestTx.Flags),
+		Result:       true,
+	},
 	{
 		Name:         "SortValue EquivalentTo Datetime",
 		SortValue:    NewSortValue(value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())), TestTx.Flags),
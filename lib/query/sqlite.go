@@ -0,0 +1,676 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+	_ "modernc.org/sqlite"
+)
+
+const sqliteDriverName = "sqlite"
+
+var sqliteExtensions = []string{".db", ".sqlite", ".sqlite3"}
+
+func loadSqliteObject(ctx context.Context, scope *ReferenceScope, tableObject parser.TableObject, tableName parser.Identifier, forUpdate bool, useInternalId bool) (*View, error) {
+	source, err := sqliteObjectSource(ctx, scope, tableObject)
+	if err != nil {
+		return nil, err
+	}
+
+	var table, query string
+	if isSqliteQuery(source) {
+		if forUpdate {
+			return nil, NewTableObjectInvalidArgumentError(tableObject, "the result of a query cannot be updated")
+		}
+		query = source
+	} else {
+		table = source
+	}
+
+	scope.Tx.viewLoadingMutex.Lock()
+	defer scope.Tx.viewLoadingMutex.Unlock()
+
+	dbPath, err := SearchFilePathWithExtType(tableObject.Path.(parser.Identifier), scope.Tx.Flags.Repository, sqliteExtensions)
+	if err != nil {
+		return nil, err
+	}
+	filePath := sqliteViewPath(dbPath, source)
+
+	view, ok := scope.Tx.cachedViews.Load(filePath)
+	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+		if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, filePath); err != nil {
+			return nil, err
+		}
+
+		fileInfo := &FileInfo{
+			Path:          filePath,
+			Encoding:      text.UTF8,
+			DatabasePath:  dbPath,
+			DatabaseTable: table,
+			DatabaseQuery: query,
+			ViewType:      ViewTypeFile,
+		}
+
+		loadView, err := loadViewFromSqlite(ctx, scope.Tx.WaitTimeout, fileInfo)
+		if err != nil {
+			return nil, NewDataParsingError(tableObject.Path, dbPath, err.Error())
+		}
+		loadView.FileInfo.ForUpdate = forUpdate
+		scope.Tx.cachedViews.Set(loadView)
+	}
+
+	return loadCachedView(ctx, scope, filePath, tableName, useInternalId)
+}
+
+// sqliteObjectSource validates the arguments of the table object and returns the table name or the query.
+func sqliteObjectSource(ctx context.Context, scope *ReferenceScope, tableObject parser.TableObject) (string, error) {
+	if tableObject.FormatElement != nil || len(tableObject.Args) != 1 {
+		return "", NewTableObjectJsonArgumentsLengthError(tableObject, 2)
+	}
+
+	if _, ok := tableObject.Path.(parser.Identifier); !ok {
+		return "", NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("database file must be a file path: %s", tableObject.Path.String()))
+	}
+
+	if fr, ok := tableObject.Args[0].(parser.FieldReference); ok && len(fr.View.Literal) < 1 {
+		return fr.Column.Literal, nil
+	}
+
+	p, err := Evaluate(ctx, scope, tableObject.Args[0])
+	if err != nil {
+		return "", err
+	}
+	s := value.ToString(p)
+	if value.IsNull(s) {
+		return "", NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a table name or a query: %s", tableObject.Args[0].String()))
+	}
+	source := cmd.TrimSpace(s.(*value.String).Raw())
+	value.Discard(s)
+	return source, nil
+}
+
+func sqliteViewPath(dbPath string, source string) string {
+	return dbPath + "::" + source
+}
+
+func isSqliteQuery(s string) bool {
+	words := strings.Fields(s)
+	if len(words) < 2 {
+		return false
+	}
+	return strings.EqualFold(words[0], "SELECT") || strings.EqualFold(words[0], "WITH") || strings.EqualFold(words[0], "VALUES")
+}
+
+func openSqliteDatabase(path string, readOnly bool, waitTimeout time.Duration) (*sql.DB, error) {
+	q := url.Values{}
+	q.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", waitTimeout.Milliseconds()))
+	if readOnly {
+		q.Add("mode", "ro")
+	} else {
+		// Transactions acquire the write lock when they begin, so the rows checked
+		// in a transaction cannot be changed by other processes before they are written.
+		q.Add("_txlock", "immediate")
+	}
+	dsn := (&url.URL{Scheme: "file", Path: path, RawQuery: q.Encode()}).String()
+
+	db, err := sql.Open(sqliteDriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+func quoteSqliteIdentifier(s string) string {
+	return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+}
+
+func loadViewFromSqlite(ctx context.Context, waitTimeout time.Duration, fileInfo *FileInfo) (*View, error) {
+	db, err := openSqliteDatabase(fileInfo.DatabasePath, true, waitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	query := fileInfo.DatabaseQuery
+	viewName := parser.FormatTableName(fileInfo.DatabasePath)
+	var keyColumns []string
+	if len(fileInfo.DatabaseTable) < 1 {
+		if len(query) < 1 {
+			return nil, errors.New("table name is empty")
+		}
+	} else {
+		tableInfo, err := sqliteTableInfo(ctx, db, fileInfo.DatabaseTable)
+		if err != nil {
+			return nil, err
+		}
+		if keyColumns, err = sqliteRowKeyColumns(ctx, db, fileInfo.DatabaseTable, tableInfo); err != nil {
+			return nil, err
+		}
+
+		quoted := make([]string, len(keyColumns))
+		for i := range keyColumns {
+			quoted[i] = quoteSqliteIdentifier(keyColumns[i])
+		}
+		query = "SELECT " + strings.Join(quoted, ", ") + ", * FROM " + quoteSqliteIdentifier(fileInfo.DatabaseTable)
+		viewName = fileInfo.DatabaseTable
+	}
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	header := columns[len(keyColumns):]
+
+	records := make(RecordSet, 0, 100)
+	keys := make([][]interface{}, 0, 100)
+	rawValues := make([][]interface{}, 0, 100)
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if len(records)&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		if keyColumns != nil {
+			key := make([]interface{}, len(keyColumns))
+			copy(key, values)
+			keys = append(keys, key)
+
+			raw := make([]interface{}, len(header))
+			copy(raw, values[len(keyColumns):])
+			rawValues = append(rawValues, raw)
+		}
+
+		record := make([]value.Primary, len(header))
+		for i := range header {
+			record[i] = sqliteValueToPrimary(values[i+len(keyColumns)])
+		}
+		records = append(records, NewRecord(record))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if keyColumns != nil {
+		indices := make([]int, len(records))
+		for i := range indices {
+			indices[i] = i
+		}
+		fileInfo.databaseRows = &sqliteRows{
+			keyColumns: keyColumns,
+			columns:    header,
+			keys:       keys,
+			values:     rawValues,
+			indices:    indices,
+		}
+	}
+
+	view := NewView()
+	view.Header = NewHeader(viewName, header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func sqliteValueToPrimary(v interface{}) value.Primary {
+	switch val := v.(type) {
+	case int64:
+		return value.NewInteger(val)
+	case float64:
+		return value.NewFloat(val)
+	case bool:
+		return value.NewBoolean(val)
+	case string:
+		return value.NewString(val)
+	case []byte:
+		return value.NewString(string(val))
+	case time.Time:
+		return value.NewDatetime(val)
+	}
+	return value.NewNull()
+}
+
+func primaryToSqliteValue(p value.Primary) interface{} {
+	switch val := p.(type) {
+	case *value.String:
+		return val.Raw()
+	case *value.Integer:
+		return val.Raw()
+	case *value.Float:
+		return val.Raw()
+	case *value.Decimal:
+		return val.String()
+	case *value.Boolean:
+		return val.Raw()
+	case *value.Ternary:
+		if val.Ternary() != ternary.UNKNOWN {
+			return val.Ternary().ParseBool()
+		}
+	case *value.Datetime:
+		return val.Raw()
+	case *value.Interval:
+		return val.String()
+	case *value.Array:
+		s, _, _ := ConvertFieldContents(val, false)
+		return s
+	}
+	return nil
+}
+
+// sqliteValueEqual returns whether the two values to be bound to a statement or scanned from rows are the same.
+func sqliteValueEqual(v1 interface{}, v2 interface{}) bool {
+	switch t1 := v1.(type) {
+	case time.Time:
+		t2, ok := v2.(time.Time)
+		return ok && t1.Equal(t2)
+	case []byte:
+		t2, ok := v2.([]byte)
+		return ok && bytes.Equal(t1, t2)
+	}
+	return v1 == v2
+}
+
+// sqliteRows holds the rows loaded from a SQLite table so that only the changed rows are written back.
+type sqliteRows struct {
+	keyColumns []string
+	columns    []string
+	keys       [][]interface{}
+
+	// values are the values of the columns scanned from the table.
+	values [][]interface{}
+
+	// indices are the positions in the loaded rows of the records in the current view.
+	// The records following them are the inserted records.
+	indices []int
+}
+
+// removeDatabaseRows removes the positions of the deleted records in the current view.
+func (f *FileInfo) removeDatabaseRows(deleted map[int]bool) {
+	if f.databaseRows == nil {
+		return
+	}
+
+	indices := make([]int, 0, len(f.databaseRows.indices))
+	for i, idx := range f.databaseRows.indices {
+		if !deleted[i] {
+			indices = append(indices, idx)
+		}
+	}
+	f.databaseRows.indices = indices
+}
+
+// sqliteTransaction is a database transaction to write the changes of the tables in a SQLite database.
+type sqliteTransaction struct {
+	path string
+	db   *sql.DB
+	tx   *sql.Tx
+}
+
+// sqliteTransactions holds the database transactions in which the changes of SQLite tables are written,
+// so that they are committed after the changes of all the files are written.
+type sqliteTransactions []*sqliteTransaction
+
+// begin returns the database transaction for the database, and begins it if it has not been begun.
+func (txs *sqliteTransactions) begin(ctx context.Context, path string, waitTimeout time.Duration) (*sql.Tx, error) {
+	for _, t := range *txs {
+		if t.path == path {
+			return t.tx, nil
+		}
+	}
+
+	db, err := openSqliteDatabase(path, false, waitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	*txs = append(*txs, &sqliteTransaction{
+		path: path,
+		db:   db,
+		tx:   tx,
+	})
+	return tx, nil
+}
+
+// commit commits all the database transactions.
+// If one of them fails, the following transactions are not committed and are rolled back by close.
+func (txs sqliteTransactions) commit() error {
+	for _, t := range txs {
+		if err := t.tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close rolls back the database transactions that have not been committed, and closes the databases.
+func (txs sqliteTransactions) close() {
+	for _, t := range txs {
+		_ = t.tx.Rollback()
+		_ = t.db.Close()
+	}
+}
+
+// updateSqliteTable writes the changes of the view to the table in the SQLite database in the database transaction.
+// Only the rows that have been deleted, updated or inserted are written, and they are identified
+// by the rowid, or by the primary key if the table has no rowid.
+// The rows to be deleted or updated must not have been changed since they were loaded, so that
+// changes by other processes are not overwritten.
+// Columns that have been added to or dropped from the view are also added to or dropped from the table.
+func updateSqliteTable(ctx context.Context, tx *sql.Tx, view *View, fileInfo *FileInfo) error {
+	loaded := fileInfo.databaseRows
+	if loaded == nil {
+		return errors.New(fmt.Sprintf("rows of table %s are not loaded", fileInfo.DatabaseTable))
+	}
+
+	table := quoteSqliteIdentifier(fileInfo.DatabaseTable)
+
+	tableInfo, err := sqliteTableInfo(ctx, tx, fileInfo.DatabaseTable)
+	if err != nil {
+		return err
+	}
+
+	columns := view.Header.TableColumnNames()
+
+	quoted := make([]string, len(columns))
+	loadedIndices := make([]int, len(columns))
+	isBlob := make([]bool, len(columns))
+	for i, c := range columns {
+		quoted[i] = quoteSqliteIdentifier(c)
+
+		loadedIndices[i] = -1
+		for j := range loaded.columns {
+			if strings.EqualFold(loaded.columns[j], c) {
+				loadedIndices[i] = j
+				break
+			}
+		}
+
+		for j := range tableInfo {
+			if strings.EqualFold(tableInfo[j].name, c) {
+				isBlob[i] = strings.Contains(strings.ToUpper(tableInfo[j].typeName), "BLOB")
+				break
+			}
+		}
+	}
+
+	bindValue := func(p value.Primary, columnIndex int) interface{} {
+		v := primaryToSqliteValue(p)
+		if s, ok := v.(string); ok && isBlob[columnIndex] {
+			return []byte(s)
+		}
+		return v
+	}
+
+	keyConditions := make([]string, len(loaded.keyColumns))
+	for i := range loaded.keyColumns {
+		keyConditions[i] = quoteSqliteIdentifier(loaded.keyColumns[i]) + " = ?"
+	}
+	keyCondition := strings.Join(keyConditions, " AND ")
+
+	deleted := make([]bool, len(loaded.keys))
+	for i := range deleted {
+		deleted[i] = true
+	}
+	for _, idx := range loaded.indices {
+		deleted[idx] = false
+	}
+
+	type sqliteUpdate struct {
+		idx  int
+		sets []string
+		args []interface{}
+	}
+	updates := make([]sqliteUpdate, 0, 10)
+	for i, idx := range loaded.indices {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		sets := make([]string, 0, len(columns))
+		args := make([]interface{}, 0, len(columns)+len(loaded.keyColumns))
+		for j := range columns {
+			v := primaryToSqliteValue(view.RecordSet[i][j][0])
+			var old interface{}
+			if -1 < loadedIndices[j] {
+				old = primaryToSqliteValue(sqliteValueToPrimary(loaded.values[idx][loadedIndices[j]]))
+			}
+			if sqliteValueEqual(v, old) {
+				continue
+			}
+
+			sets = append(sets, quoted[j]+" = ?")
+			args = append(args, bindValue(view.RecordSet[i][j][0], j))
+		}
+		if len(sets) < 1 {
+			continue
+		}
+		updates = append(updates, sqliteUpdate{idx: idx, sets: sets, args: append(args, loaded.keys[idx]...)})
+	}
+
+	loadedColumns := make([]string, len(loaded.columns))
+	for i := range loaded.columns {
+		loadedColumns[i] = quoteSqliteIdentifier(loaded.columns[i])
+	}
+	checkQuery := "SELECT " + strings.Join(loadedColumns, ", ") + " FROM " + table + " WHERE " + keyCondition
+	for idx := range deleted {
+		if deleted[idx] {
+			if err = checkSqliteRow(ctx, tx, checkQuery, loaded, idx, fileInfo.DatabaseTable); err != nil {
+				return err
+			}
+		}
+	}
+	for _, u := range updates {
+		if err = checkSqliteRow(ctx, tx, checkQuery, loaded, u.idx, fileInfo.DatabaseTable); err != nil {
+			return err
+		}
+	}
+
+	if !equalColumns(columns, loaded.columns) {
+		tableColumns := make([]string, len(tableInfo))
+		for i := range tableInfo {
+			tableColumns[i] = tableInfo[i].name
+		}
+
+		for _, c := range columns {
+			if !containsColumn(tableColumns, c) {
+				if _, err = tx.ExecContext(ctx, "ALTER TABLE "+table+" ADD COLUMN "+quoteSqliteIdentifier(c)); err != nil {
+					return err
+				}
+			}
+		}
+		for _, c := range tableColumns {
+			if !containsColumn(columns, c) {
+				if _, err = tx.ExecContext(ctx, "ALTER TABLE "+table+" DROP COLUMN "+quoteSqliteIdentifier(c)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for idx := range deleted {
+		if !deleted[idx] {
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE "+keyCondition, loaded.keys[idx]...); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range updates {
+		if _, err = tx.ExecContext(ctx, "UPDATE "+table+" SET "+strings.Join(u.sets, ", ")+" WHERE "+keyCondition, u.args...); err != nil {
+			return err
+		}
+	}
+
+	if len(loaded.indices) < view.RecordLen() && 0 < len(columns) {
+		stmt, e := tx.PrepareContext(ctx, "INSERT INTO "+table+" ("+strings.Join(quoted, ", ")+") VALUES (?"+strings.Repeat(", ?", len(columns)-1)+")")
+		if e != nil {
+			return e
+		}
+		defer func() {
+			_ = stmt.Close()
+		}()
+
+		args := make([]interface{}, len(columns))
+		for i := len(loaded.indices); i < view.RecordLen(); i++ {
+			for j := range args {
+				args[j] = bindValue(view.RecordSet[i][j][0], j)
+			}
+			if _, err = stmt.ExecContext(ctx, args...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSqliteRow returns an error if the loaded row has been deleted or changed in the table.
+func checkSqliteRow(ctx context.Context, tx *sql.Tx, query string, loaded *sqliteRows, idx int, table string) error {
+	values := make([]interface{}, len(loaded.columns))
+	dest := make([]interface{}, len(values))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	if err := tx.QueryRowContext(ctx, query, loaded.keys[idx]...).Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New(fmt.Sprintf("row in table %s has been deleted by another process", table))
+		}
+		return err
+	}
+
+	for i := range values {
+		if !sqliteValueEqual(values[i], loaded.values[idx][i]) {
+			return errors.New(fmt.Sprintf("row in table %s has been changed by another process", table))
+		}
+	}
+	return nil
+}
+
+func equalColumns(columns1 []string, columns2 []string) bool {
+	if len(columns1) != len(columns2) {
+		return false
+	}
+	for _, c := range columns1 {
+		if !containsColumn(columns2, c) {
+			return false
+		}
+	}
+	return true
+}
+
+type sqliteQueryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+type sqliteColumn struct {
+	name     string
+	typeName string
+	pk       int
+}
+
+// sqliteRowKeyColumns returns the columns to identify the rows of the table.
+// The rowid is used if the table has it, otherwise the primary key is used.
+func sqliteRowKeyColumns(ctx context.Context, q sqliteQueryer, table string, tableInfo []sqliteColumn) ([]string, error) {
+	names := make([]string, len(tableInfo))
+	for i := range tableInfo {
+		names[i] = tableInfo[i].name
+	}
+
+	for _, alias := range []string{"rowid", "_rowid_", "oid"} {
+		if containsColumn(names, alias) {
+			continue
+		}
+		rows, err := q.QueryContext(ctx, "SELECT "+alias+" FROM "+quoteSqliteIdentifier(table)+" LIMIT 0")
+		if err != nil {
+			// The table is created as a WITHOUT ROWID table.
+			break
+		}
+		_ = rows.Close()
+		return []string{alias}, nil
+	}
+
+	pk := make([]sqliteColumn, 0, len(tableInfo))
+	for _, c := range tableInfo {
+		if 0 < c.pk {
+			pk = append(pk, c)
+		}
+	}
+	if len(pk) < 1 {
+		return nil, errors.New(fmt.Sprintf("rows of table %s cannot be identified", table))
+	}
+	sort.Slice(pk, func(i, j int) bool { return pk[i].pk < pk[j].pk })
+
+	keys := make([]string, len(pk))
+	for i := range pk {
+		keys[i] = pk[i].name
+	}
+	return keys, nil
+}
+
+func sqliteTableInfo(ctx context.Context, q sqliteQueryer, table string) ([]sqliteColumn, error) {
+	rows, err := q.QueryContext(ctx, "SELECT name, type, pk FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columns := make([]sqliteColumn, 0, 10)
+	for rows.Next() {
+		var c sqliteColumn
+		if err = rows.Scan(&c.name, &c.typeName, &c.pk); err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) < 1 {
+		return nil, errors.New(fmt.Sprintf("table %s does not exist", table))
+	}
+	return columns, nil
+}
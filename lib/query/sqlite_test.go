@@ -0,0 +1,430 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func createSqliteTestDatabase(t *testing.T) string {
+	dbPath := filepath.Join(t.TempDir(), "app.db")
+
+	db, err := sql.Open(sqliteDriverName, dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	for _, q := range []string{
+		"CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, score REAL, data BLOB)",
+		"INSERT INTO users VALUES (1, 'str1', 1.5, x'6162')",
+		"INSERT INTO users VALUES (2, NULL, 2, NULL)",
+		"CREATE TABLE logs (operation TEXT, id INTEGER)",
+		"CREATE TRIGGER users_update AFTER UPDATE ON users BEGIN INSERT INTO logs VALUES ('update', old.id); END",
+		"CREATE TRIGGER users_delete AFTER DELETE ON users BEGIN INSERT INTO logs VALUES ('delete', old.id); END",
+		"CREATE TABLE codes (code TEXT PRIMARY KEY, num NUMERIC, note TEXT) WITHOUT ROWID",
+		"INSERT INTO codes VALUES ('a', 1, 'str1')",
+		"INSERT INTO codes VALUES ('b', 2, '007')",
+	} {
+		if _, err = db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dbPath
+}
+
+var loadViewFromSqliteTests = []struct {
+	Name   string
+	Table  string
+	Query  string
+	Result *View
+	Error  string
+}{
+	{
+		Name:  "Load Sqlite Table",
+		Table: "users",
+		Result: &View{
+			Header: NewHeader("users", []string{"id", "name", "score", "data"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewFloat(1.5),
+					value.NewString("ab"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewFloat(2),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name:  "Load Sqlite Query",
+		Query: "SELECT id, upper(name) AS name FROM users WHERE name IS NOT NULL",
+		Result: &View{
+			Header: NewHeader("app", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("STR1"),
+				}),
+			},
+		},
+	},
+	{
+		Name:  "Load Sqlite Table Not Exist Error",
+		Table: "notexist",
+		Error: "table notexist does not exist",
+	},
+}
+
+func TestLoadViewFromSqlite(t *testing.T) {
+	dbPath := createSqliteTestDatabase(t)
+
+	for _, v := range loadViewFromSqliteTests {
+		fileInfo := &FileInfo{
+			Path:          dbPath + "::" + v.Table + v.Query,
+			DatabasePath:  dbPath,
+			DatabaseTable: v.Table,
+			DatabaseQuery: v.Query,
+			ViewType:      ViewTypeFile,
+		}
+
+		view, err := loadViewFromSqlite(context.Background(), time.Second, fileInfo)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, view.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %v, want %v", v.Name, view.RecordSet, v.Result.RecordSet)
+		}
+		if !view.FileInfo.IsDatabaseTable() {
+			t.Errorf("%s: file info is not a database table", v.Name)
+		}
+	}
+}
+
+func sqliteTestQueryResult(t *testing.T, dbPath string, query string) []string {
+	db, err := sql.Open(sqliteDriverName, dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	columns, _ := rows.Columns()
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	result := make([]string, 0, 10)
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			t.Fatal(err)
+		}
+		fields := make([]string, len(values))
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				v = string(b)
+			}
+			fields[i] = fmt.Sprint(v)
+		}
+		result = append(result, strings.Join(fields, ","))
+	}
+	return result
+}
+
+var updateSqliteTableTests = []struct {
+	Name       string
+	Table      string
+	Update     func(view *View)
+	Concurrent string
+	Query      string
+	Result     []string
+	Error      string
+}{
+	{
+		Name:  "Update Sqlite Table",
+		Table: "users",
+		Update: func(view *View) {
+			view.RecordSet[0][1] = NewCell(value.NewString("updated"))
+			view.RecordSet = append(view.RecordSet[:1], NewRecord([]value.Primary{
+				value.NewInteger(3),
+				value.NewString("str3"),
+				value.NewNull(),
+				value.NewString("xy"),
+			}))
+			view.FileInfo.removeDatabaseRows(map[int]bool{1: true})
+		},
+		Query: "SELECT id, name, score, data, typeof(data) FROM users ORDER BY id",
+		Result: []string{
+			"1,updated,1.5,ab,blob",
+			"3,str3,<nil>,xy,blob",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table Triggers",
+		Table: "users",
+		Update: func(view *View) {
+			view.RecordSet[0][1] = NewCell(value.NewString("updated"))
+			view.RecordSet[1][2] = NewCell(value.NewFloat(2))
+			view.RecordSet = append(view.RecordSet, NewRecord([]value.Primary{
+				value.NewInteger(3),
+				value.NewString("str3"),
+				value.NewNull(),
+				value.NewNull(),
+			}))
+			view.FileInfo.removeDatabaseRows(map[int]bool{2: true})
+			view.RecordSet = view.RecordSet[:2]
+		},
+		Query: "SELECT operation, id FROM logs",
+		Result: []string{
+			"update,1",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table Without Rowid",
+		Table: "codes",
+		Update: func(view *View) {
+			view.RecordSet[0][2] = NewCell(value.NewString("updated"))
+			view.RecordSet = view.RecordSet[:1]
+			view.FileInfo.removeDatabaseRows(map[int]bool{1: true})
+		},
+		Query: "SELECT code, num, note FROM codes ORDER BY code",
+		Result: []string{
+			"a,1,updated",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table Unchanged Rows",
+		Table: "codes",
+		Update: func(view *View) {
+			view.RecordSet[0][1] = NewCell(value.NewInteger(10))
+		},
+		Query: "SELECT code, num, note, typeof(note) FROM codes ORDER BY code",
+		Result: []string{
+			"a,10,str1,text",
+			"b,2,007,text",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table Columns",
+		Table: "users",
+		Update: func(view *View) {
+			view.Header = NewHeader("users", []string{"id", "name", "active"})
+			view.RecordSet = RecordSet{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewString("str1"),
+					value.NewTernary(ternary.TRUE),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewNull(),
+					value.NewTernary(ternary.UNKNOWN),
+				}),
+			}
+		},
+		Query: "SELECT * FROM users ORDER BY id",
+		Result: []string{
+			"1,str1,1",
+			"2,<nil>,<nil>",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table with Concurrent Changes of Other Rows",
+		Table: "users",
+		Update: func(view *View) {
+			view.RecordSet[0][1] = NewCell(value.NewString("updated"))
+		},
+		Concurrent: "UPDATE users SET name = 'concurrent' WHERE id = 2; INSERT INTO users VALUES (3, 'str3', NULL, NULL)",
+		Query:      "SELECT id, name FROM users ORDER BY id",
+		Result: []string{
+			"1,updated",
+			"2,concurrent",
+			"3,str3",
+		},
+	},
+	{
+		Name:  "Update Sqlite Table Changed Row Error",
+		Table: "users",
+		Update: func(view *View) {
+			view.RecordSet[0][1] = NewCell(value.NewString("updated"))
+		},
+		Concurrent: "UPDATE users SET score = 3 WHERE id = 1",
+		Error:      "row in table users has been changed by another process",
+	},
+	{
+		Name:  "Update Sqlite Table Deleted Row Error",
+		Table: "codes",
+		Update: func(view *View) {
+			view.RecordSet = view.RecordSet[:1]
+			view.FileInfo.removeDatabaseRows(map[int]bool{1: true})
+		},
+		Concurrent: "DELETE FROM codes WHERE code = 'b'",
+		Error:      "row in table codes has been deleted by another process",
+	},
+	{
+		Name:  "Update Sqlite Table Not Loaded Error",
+		Table: "users",
+		Update: func(view *View) {
+			view.FileInfo.databaseRows = nil
+		},
+		Error: "rows of table users are not loaded",
+	},
+}
+
+func TestUpdateSqliteTable(t *testing.T) {
+	for _, v := range updateSqliteTableTests {
+		dbPath := createSqliteTestDatabase(t)
+
+		fileInfo := &FileInfo{
+			Path:          dbPath + "::" + v.Table,
+			DatabasePath:  dbPath,
+			DatabaseTable: v.Table,
+			ViewType:      ViewTypeFile,
+		}
+
+		view, err := loadViewFromSqlite(context.Background(), time.Second, fileInfo)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		v.Update(view)
+
+		if 0 < len(v.Concurrent) {
+			sqliteTestExec(t, dbPath, v.Concurrent)
+		}
+
+		var txs sqliteTransactions
+		dbTx, err := txs.begin(context.Background(), dbPath, time.Second)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+		err = updateSqliteTable(context.Background(), dbTx, view, fileInfo)
+		if err == nil {
+			err = txs.commit()
+		}
+		txs.close()
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result := sqliteTestQueryResult(t, dbPath, v.Query)
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %q, want %q", v.Name, result, v.Result)
+		}
+	}
+}
+
+func sqliteTestExec(t *testing.T, dbPath string, query string) {
+	db, err := sql.Open(sqliteDriverName, dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	for _, q := range strings.Split(query, ";") {
+		if _, err = db.Exec(q); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSqliteTransactions(t *testing.T) {
+	ctx := context.Background()
+
+	for _, commit := range []bool{false, true} {
+		dbPath := createSqliteTestDatabase(t)
+
+		var txs sqliteTransactions
+		for _, table := range []string{"users", "codes"} {
+			fileInfo := &FileInfo{
+				Path:          dbPath + "::" + table,
+				DatabasePath:  dbPath,
+				DatabaseTable: table,
+				ViewType:      ViewTypeFile,
+			}
+			view, err := loadViewFromSqlite(ctx, time.Second, fileInfo)
+			if err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+			view.RecordSet = view.RecordSet[:1]
+			view.FileInfo.removeDatabaseRows(map[int]bool{1: true})
+
+			dbTx, err := txs.begin(ctx, dbPath, time.Second)
+			if err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+			if err = updateSqliteTable(ctx, dbTx, view, fileInfo); err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+		}
+		if len(txs) != 1 {
+			t.Errorf("%d transactions, want %d", len(txs), 1)
+		}
+
+		expect := []string{"2"}
+		if commit {
+			if err := txs.commit(); err != nil {
+				t.Fatalf("unexpected error %q", err)
+			}
+			expect = []string{"1"}
+		}
+		txs.close()
+
+		for _, table := range []string{"users", "codes"} {
+			result := sqliteTestQueryResult(t, dbPath, "SELECT COUNT(*) FROM "+table)
+			if !reflect.DeepEqual(result, expect) {
+				t.Errorf("count of %s = %q, want %q, commit %t", table, result, expect, commit)
+			}
+		}
+	}
+}
@@ -0,0 +1,709 @@
+package query
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/csv"
+	"github.com/mithrandie/go-text/ltsv"
+)
+
+const streamingRecordSetCap = 1000
+
+var streamingAggregateFunctions = map[string]bool{
+	"COUNT": true,
+	"SUM":   true,
+	"AVG":   true,
+	"MIN":   true,
+	"MAX":   true,
+}
+
+// StreamingSelect processes a select query record by record without loading
+// the whole file, so that the memory usage does not depend on the file size.
+type StreamingSelect struct {
+	Query parser.SelectQuery
+
+	entity          parser.SelectEntity
+	tableIdentifier parser.Identifier
+	tableName       parser.Identifier
+	fileInfo        *FileInfo
+	withoutNull     bool
+
+	aggregates []parser.AggregateFunction
+
+	reader *csv.Reader
+	labels []string
+	header Header
+}
+
+// IsStreamingFormat returns whether the results in the format can be written record by record.
+func IsStreamingFormat(format cmd.Format) bool {
+	switch format {
+	case cmd.CSV, cmd.TSV, cmd.LTSV:
+		return true
+	}
+	return false
+}
+
+// NewStreamingSelect returns nil if the query needs the whole result set,
+// or the output format or the table cannot be processed sequentially.
+func NewStreamingSelect(scope *ReferenceScope, query parser.SelectQuery, options cmd.ExportOptions) *StreamingSelect {
+	if !IsStreamingFormat(options.Format) {
+		return nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || query.WithClause != nil || query.OrderByClause != nil || query.IsForUpdate() {
+		return nil
+	}
+	if entity.IntoClause != nil || entity.GroupByClause != nil || entity.HavingClause != nil || entity.WindowClause != nil || entity.QualifyClause != nil || entity.FromClause == nil {
+		return nil
+	}
+	if query.LimitClause != nil && query.LimitClause.(parser.LimitClause).Percentage() {
+		return nil
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok || !table.Lateral.IsEmpty() {
+		return nil
+	}
+	tableIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok {
+		return nil
+	}
+	if scope.InlineTableExists(tableIdentifier) || scope.TemporaryTableExists(tableIdentifier.Literal) {
+		return nil
+	}
+
+	importOptions := scope.Tx.Flags.ImportOptions.Copy()
+	importOptions.Format = cmd.AutoSelect
+	fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, importOptions, scope.Tx.Flags.ImportOptions.Format)
+	if err != nil {
+		return nil
+	}
+	switch fileInfo.Format {
+	case cmd.CSV, cmd.TSV:
+	default:
+		return nil
+	}
+	if scope.Tx.cachedViews.Exists(fileInfo.Path) {
+		return nil
+	}
+	fileInfo.NoHeader = importOptions.NoHeader
+	fileInfo.LineBreak = scope.Tx.Flags.ExportOptions.LineBreak
+	fileInfo.ViewType = ViewTypeFile
+
+	aggregates, ok := streamingAggregates(scope, selectClause.Fields)
+	if !ok {
+		return nil
+	}
+
+	return &StreamingSelect{
+		Query:           query,
+		entity:          entity,
+		tableIdentifier: tableIdentifier,
+		tableName:       table.Name(),
+		fileInfo:        fileInfo,
+		withoutNull:     importOptions.WithoutNull,
+		aggregates:      aggregates,
+	}
+}
+
+func streamingAggregates(scope *ReferenceScope, fields []parser.QueryExpression) ([]parser.AggregateFunction, bool) {
+	var aggregates []parser.AggregateFunction
+
+	for i, f := range fields {
+		fn, ok := f.(parser.Field).Object.(parser.AggregateFunction)
+		if !ok {
+			if aggregates != nil || !isStreamableExpr(scope, f.(parser.Field).Object) {
+				return nil, false
+			}
+			continue
+		}
+
+		if 0 < i && aggregates == nil {
+			return nil, false
+		}
+		uname := strings.ToUpper(fn.Name)
+		if skfn, ok := SketchAggregateFunctions[uname]; ok {
+			if fn.IsDistinct() || fn.Filter != nil || len(fn.Args) != skfn.ArgsLen || !isStreamableExprList(scope, fn.Args) {
+				return nil, false
+			}
+			if _, ok := fn.Args[0].(parser.AllColumns); ok {
+				return nil, false
+			}
+			aggregates = append(aggregates, fn)
+			continue
+		}
+
+		if !streamingAggregateFunctions[uname] || fn.IsDistinct() || fn.Filter != nil || len(fn.Args) != 1 {
+			return nil, false
+		}
+		if _, ok := fn.Args[0].(parser.AllColumns); !ok && !isStreamableExpr(scope, fn.Args[0]) {
+			return nil, false
+		}
+		aggregates = append(aggregates, fn)
+	}
+	return aggregates, true
+}
+
+func isStreamableExpr(scope *ReferenceScope, expr parser.QueryExpression) bool {
+	if expr == nil {
+		return true
+	}
+
+	switch expr.(type) {
+	case parser.PrimitiveType, parser.Placeholder, parser.FieldReference, parser.ColumnNumber, parser.AllColumns,
+		parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation, parser.Flag,
+		parser.Subquery, parser.Exists:
+		return true
+	case parser.Parentheses:
+		return isStreamableExpr(scope, expr.(parser.Parentheses).Expr)
+	case parser.RowValue:
+		return isStreamableExpr(scope, expr.(parser.RowValue).Value)
+	case parser.ValueList:
+		return isStreamableExprList(scope, expr.(parser.ValueList).Values)
+	case parser.RowValueList:
+		return isStreamableExprList(scope, expr.(parser.RowValueList).RowValues)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.RHS)
+	case parser.Is:
+		e := expr.(parser.Is)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.RHS)
+	case parser.Between:
+		e := expr.(parser.Between)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Low) && isStreamableExpr(scope, e.High)
+	case parser.In:
+		e := expr.(parser.In)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Values)
+	case parser.All:
+		e := expr.(parser.All)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Values)
+	case parser.Any:
+		e := expr.(parser.Any)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Values)
+	case parser.Like:
+		e := expr.(parser.Like)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Pattern)
+	case parser.RegExp:
+		e := expr.(parser.RegExp)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.Pattern)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.RHS)
+	case parser.UnaryArithmetic:
+		return isStreamableExpr(scope, expr.(parser.UnaryArithmetic).Operand)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		return isStreamableExpr(scope, e.LHS) && isStreamableExpr(scope, e.RHS)
+	case parser.UnaryLogic:
+		return isStreamableExpr(scope, expr.(parser.UnaryLogic).Operand)
+	case parser.Concat:
+		return isStreamableExprList(scope, expr.(parser.Concat).Items)
+	case parser.Function:
+		e := expr.(parser.Function)
+		if udfn, err := scope.GetFunction(e, e.Name); err == nil && udfn.IsAggregate {
+			return false
+		}
+		return isStreamableExprList(scope, e.Args)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		return isStreamableExpr(scope, e.Value) && isStreamableExprList(scope, e.When) && isStreamableExpr(scope, e.Else)
+	case parser.CaseExprWhen:
+		e := expr.(parser.CaseExprWhen)
+		return isStreamableExpr(scope, e.Condition) && isStreamableExpr(scope, e.Result)
+	case parser.CaseExprElse:
+		return isStreamableExpr(scope, expr.(parser.CaseExprElse).Result)
+	}
+	return false
+}
+
+func isStreamableExprList(scope *ReferenceScope, exprs []parser.QueryExpression) bool {
+	for _, e := range exprs {
+		if !isStreamableExpr(scope, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Encode executes the query and writes the results to fp in the format specified by options.
+func (s *StreamingSelect) Encode(ctx context.Context, scope *ReferenceScope, fp io.Writer, options cmd.ExportOptions) (warn string, err error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	h, err := file.NewHandlerForRead(ctx, scope.Tx.FileContainer, s.fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		tableIdentifier := s.tableIdentifier
+		tableIdentifier.Literal = s.fileInfo.Path
+		return "", ConvertFileHandlerError(err, tableIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	if err = queryScope.AddAlias(s.tableName, s.fileInfo.Path); err != nil {
+		return "", err
+	}
+
+	if err = s.open(h.File()); err != nil {
+		return "", err
+	}
+
+	if s.aggregates != nil {
+		return s.encodeAggregates(ctx, queryScope, fp, options)
+	}
+
+	offset := 0
+	limit := -1
+	if s.Query.LimitClause != nil {
+		limitClause := s.Query.LimitClause.(parser.LimitClause)
+		if limitClause.OffsetClause != nil {
+			if offset, err = evalOffsetNumber(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+				return "", err
+			}
+		}
+		if !limitClause.Type.IsEmpty() {
+			val, e := Evaluate(ctx, queryScope, limitClause.Value)
+			if e != nil {
+				return "", e
+			}
+			if limit, err = convertLimitNumber(val, limitClause); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	selectClause := s.entity.SelectClause.(parser.SelectClause)
+	encoder := newStreamEncoder(fp, options)
+
+	for limit != 0 {
+		view, eof, e := s.next(ctx, queryScope)
+		if e != nil {
+			return "", e
+		}
+
+		if 0 < offset {
+			if view.RecordLen() <= offset {
+				offset = offset - view.RecordLen()
+				view.RecordSet = view.RecordSet[:0]
+			} else {
+				view.RecordSet = view.RecordSet[offset:]
+				offset = 0
+			}
+		}
+		if -1 < limit && limit < view.RecordLen() {
+			view.RecordSet = view.RecordSet[:limit]
+		}
+
+		if 0 < view.RecordLen() {
+			if err = view.Select(ctx, queryScope, selectClause); err != nil {
+				return "", err
+			}
+			if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
+				return "", err
+			}
+			if err = encoder.Write(ctx, view); err != nil {
+				return "", err
+			}
+			if -1 < limit {
+				limit = limit - view.RecordLen()
+			}
+		}
+
+		if eof {
+			break
+		}
+	}
+
+	if !encoder.IsStarted() {
+		view := s.newView(RecordSet{})
+		if err = view.Select(ctx, queryScope, selectClause); err != nil {
+			return "", err
+		}
+		if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
+			return "", err
+		}
+		return EncodeView(ctx, fp, view, options, scope.Tx.Palette)
+	}
+
+	return "", encoder.Flush()
+}
+
+func (s *StreamingSelect) encodeAggregates(ctx context.Context, scope *ReferenceScope, fp io.Writer, options cmd.ExportOptions) (string, error) {
+	accumulators := make([]*streamingAggregate, len(s.aggregates))
+	for i := range s.aggregates {
+		accumulators[i] = newStreamingAggregate(s.aggregates[i])
+	}
+
+	for {
+		view, eof, err := s.next(ctx, scope)
+		if err != nil {
+			return "", err
+		}
+
+		for _, acc := range accumulators {
+			if err := acc.Add(ctx, scope, view); err != nil {
+				return "", err
+			}
+		}
+
+		if eof {
+			break
+		}
+	}
+
+	fields := s.entity.SelectClause.(parser.SelectClause).Fields
+	labels := make([]string, len(fields))
+	values := make([]value.Primary, len(accumulators))
+	for i := range fields {
+		labels[i] = fields[i].(parser.Field).Name()
+		values[i] = accumulators[i].Result()
+	}
+
+	view := NewView()
+	view.Header = NewHeader("", labels)
+	view.RecordSet = RecordSet{NewRecord(values)}
+
+	if s.Query.LimitClause != nil {
+		limitClause := s.Query.LimitClause.(parser.LimitClause)
+		if limitClause.OffsetClause != nil {
+			if err := view.Offset(ctx, scope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
+				return "", err
+			}
+		}
+		if !limitClause.Type.IsEmpty() {
+			if err := view.Limit(ctx, scope, limitClause); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return EncodeView(ctx, fp, view, options, scope.Tx.Palette)
+}
+
+func (s *StreamingSelect) open(fp io.ReadSeeker) error {
+	enc, err := text.DetectInSpecifiedEncoding(fp, s.fileInfo.Encoding)
+	if err != nil {
+		return NewCannotDetectFileEncodingError(s.tableIdentifier)
+	}
+	s.fileInfo.Encoding = enc
+
+	if s.reader, err = csv.NewReader(fp, s.fileInfo.Encoding); err != nil {
+		return err
+	}
+	s.reader.Delimiter = s.fileInfo.Delimiter
+	s.reader.WithoutNull = s.withoutNull
+
+	s.header = nil
+	s.labels = nil
+	if !s.fileInfo.NoHeader {
+		s.labels, err = s.reader.ReadHeader()
+		if err != nil && err != io.EOF {
+			return NewDataParsingError(s.tableIdentifier, s.fileInfo.Path, err.Error())
+		}
+	}
+	return nil
+}
+
+// next reads the next records from the file and returns them as a view filtered by the where clause.
+func (s *StreamingSelect) next(ctx context.Context, scope *ReferenceScope) (*View, bool, error) {
+	recordSet, err := readStreamingRecordSet(ctx, s.reader)
+	eof := err == io.EOF
+	if err != nil && !eof {
+		if _, ok := err.(Error); ok {
+			return nil, false, err
+		}
+		return nil, false, NewDataParsingError(s.tableIdentifier, s.fileInfo.Path, err.Error())
+	}
+
+	view := s.newView(recordSet)
+	if 0 < view.RecordLen() && s.entity.WhereClause != nil {
+		if err = view.Where(ctx, scope, s.entity.WhereClause.(parser.WhereClause)); err != nil {
+			return nil, false, err
+		}
+	}
+	return view, eof, nil
+}
+
+func (s *StreamingSelect) newView(recordSet RecordSet) *View {
+	if s.header == nil {
+		labels := s.labels
+		if labels == nil {
+			labels = make([]string, s.reader.FieldsPerRecord)
+			for i := 0; i < s.reader.FieldsPerRecord; i++ {
+				labels[i] = "c" + strconv.Itoa(i+1)
+			}
+		}
+		s.header = NewHeader(parser.FormatTableName(s.fileInfo.Path), labels)
+		if !strings.EqualFold(parser.FormatTableName(s.fileInfo.Path), s.tableName.Literal) {
+			_ = s.header.Update(s.tableName.Literal, nil)
+		}
+	}
+
+	view := NewView()
+	view.Header = s.header.Copy()
+	view.RecordSet = recordSet
+	view.FileInfo = s.fileInfo
+	return view
+}
+
+func readStreamingRecordSet(ctx context.Context, reader RecordReader) (RecordSet, error) {
+	recordSet := make(RecordSet, 0, streamingRecordSetCap)
+
+	for len(recordSet) < streamingRecordSetCap {
+		if len(recordSet)&15 == 0 && ctx.Err() != nil {
+			return recordSet, ConvertContextError(ctx.Err())
+		}
+
+		row, err := reader.Read()
+		if err != nil {
+			return recordSet, err
+		}
+
+		record := make(Record, len(row))
+		for i, v := range row {
+			if v == nil {
+				record[i] = NewCell(value.NewNull())
+			} else {
+				record[i] = NewCell(value.NewString(string(v)))
+			}
+		}
+		recordSet = append(recordSet, record)
+	}
+	return recordSet, nil
+}
+
+type streamingAggregate struct {
+	Function parser.AggregateFunction
+
+	name       string
+	count      int64
+	sum        float64
+	decimalSum *value.Decimal
+	result     value.Primary
+
+	sketch    Sketch
+	newSketch func() Sketch
+}
+
+func newStreamingAggregate(fn parser.AggregateFunction) *streamingAggregate {
+	return &streamingAggregate{
+		Function: fn,
+		name:     strings.ToUpper(fn.Name),
+		result:   value.NewNull(),
+	}
+}
+
+func (agg *streamingAggregate) Add(ctx context.Context, scope *ReferenceScope, view *View) error {
+	if skfn, ok := SketchAggregateFunctions[agg.name]; ok {
+		return agg.addToSketch(ctx, scope, view, skfn)
+	}
+
+	if view.RecordLen() < 1 {
+		return nil
+	}
+
+	if _, ok := agg.Function.Args[0].(parser.AllColumns); ok {
+		agg.count = agg.count + int64(view.RecordLen())
+		return nil
+	}
+
+	list := make([]value.Primary, view.RecordLen())
+	if err := EvaluateSequentially(ctx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
+		p, e := Evaluate(ctx, seqScope, agg.Function.Args[0])
+		if e != nil {
+			return e
+		}
+		list[rIdx] = p
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	switch agg.name {
+	case "COUNT":
+		agg.count = agg.count + Count(list, scope.Tx.Flags).(*value.Integer).Raw()
+	case "SUM", "AVG":
+		if agg.decimalSum != nil || scope.Tx.Flags.ExactDecimal || containsDecimal(list) {
+			if agg.decimalSum == nil {
+				agg.decimalSum = value.NewDecimalFromInt64(0)
+				if d, ok := value.NewDecimalFromFloat64(agg.sum); ok {
+					agg.decimalSum = d
+				}
+			}
+			values := toDecimalList(list)
+			agg.count = agg.count + int64(len(values))
+			agg.decimalSum = agg.decimalSum.Add(sumDecimal(values))
+		} else {
+			values := floatList(list)
+			agg.count = agg.count + int64(len(values))
+			agg.sum = agg.sum + sum(values)
+		}
+	case "MIN":
+		agg.result = Min(append(list, agg.result), scope.Tx.Flags)
+	case "MAX":
+		agg.result = Max(append(list, agg.result), scope.Tx.Flags)
+	}
+	return nil
+}
+
+// addToSketch builds a sketch from the records in the view and merges it,
+// so that only the sketch is kept between chunks.
+func (agg *streamingAggregate) addToSketch(ctx context.Context, scope *ReferenceScope, view *View, fn SketchAggregateFunction) error {
+	if agg.sketch == nil {
+		newSketch, err := initSketch(ctx, scope, agg.Function, agg.Function.Name, agg.Function.Args, agg.Function.IsDistinct(), fn)
+		if err != nil {
+			return err
+		}
+		agg.sketch = newSketch()
+		agg.newSketch = newSketch
+	}
+
+	if view.RecordLen() < 1 {
+		return nil
+	}
+
+	sketch, err := view.SketchForAggregateFunctions(ctx, scope, agg.Function, agg.Function.Args[0], agg.newSketch)
+	if err != nil {
+		return err
+	}
+	agg.sketch.Merge(sketch)
+	return nil
+}
+
+func (agg *streamingAggregate) Result() value.Primary {
+	if agg.sketch != nil {
+		return agg.sketch.Result()
+	}
+
+	switch agg.name {
+	case "COUNT":
+		return value.NewInteger(agg.count)
+	case "SUM":
+		if agg.count < 1 {
+			return value.NewNull()
+		}
+		if agg.decimalSum != nil {
+			return agg.decimalSum
+		}
+		return value.ParseFloat64(agg.sum)
+	case "AVG":
+		if agg.count < 1 {
+			return value.NewNull()
+		}
+		if agg.decimalSum != nil {
+			avg, _ := agg.decimalSum.Quo(value.NewDecimalFromInt64(agg.count))
+			return avg
+		}
+		return value.ParseFloat64(agg.sum / float64(agg.count))
+	}
+	return agg.result
+}
+
+type streamEncoder struct {
+	fp      io.Writer
+	options cmd.ExportOptions
+
+	csvWriter  *csv.Writer
+	csvFields  []csv.Field
+	ltsvWriter *ltsv.Writer
+	ltsvFields []string
+}
+
+func newStreamEncoder(fp io.Writer, options cmd.ExportOptions) *streamEncoder {
+	if options.Format == cmd.TSV {
+		options.Delimiter = '\t'
+	}
+	return &streamEncoder{
+		fp:      fp,
+		options: options,
+	}
+}
+
+func (e *streamEncoder) IsStarted() bool {
+	return e.csvWriter != nil || e.ltsvWriter != nil
+}
+
+func (e *streamEncoder) start(view *View) error {
+	var err error
+
+	if e.options.Format == cmd.LTSV {
+		hfields := make([]string, view.FieldLen())
+		for i := range view.Header {
+			hfields[i] = view.Header[i].Column
+		}
+		if e.ltsvWriter, err = ltsv.NewWriter(e.fp, hfields, e.options.LineBreak, e.options.Encoding); err != nil {
+			return NewDataEncodingError(err.Error())
+		}
+		e.ltsvFields = make([]string, view.FieldLen())
+		return nil
+	}
+
+	if e.csvWriter, err = csv.NewWriter(e.fp, e.options.LineBreak, e.options.Encoding); err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	e.csvWriter.Delimiter = e.options.Delimiter
+	e.csvFields = make([]csv.Field, view.FieldLen())
+
+	if !e.options.WithoutHeader {
+		for i := range view.Header {
+			e.csvFields[i] = csv.NewField(view.Header[i].Column, e.options.EncloseAll)
+		}
+		if err = e.csvWriter.Write(e.csvFields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	return nil
+}
+
+func (e *streamEncoder) Write(ctx context.Context, view *View) error {
+	if !e.IsStarted() {
+		if err := e.start(view); err != nil {
+			return err
+		}
+	}
+
+	if e.ltsvWriter != nil {
+		if err := writeLTSVRecordSet(ctx, e.ltsvWriter, e.ltsvFields, view.RecordSet, e.options); err != nil {
+			return err
+		}
+		return e.Flush()
+	}
+
+	if err := writeCSVRecordSet(ctx, e.csvWriter, e.csvFields, view.RecordSet, e.options); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+func (e *streamEncoder) Flush() error {
+	var err error
+	if e.ltsvWriter != nil {
+		err = e.ltsvWriter.Flush()
+	} else if e.csvWriter != nil {
+		err = e.csvWriter.Flush()
+	}
+	if err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
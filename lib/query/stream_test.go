@@ -0,0 +1,328 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+func streamingTestQuery(fields []parser.QueryExpression, table parser.QueryExpression, where parser.QueryExpression, limit parser.QueryExpression) parser.SelectQuery {
+	entity := parser.SelectEntity{
+		SelectClause: parser.SelectClause{Fields: fields},
+		FromClause:   parser.FromClause{Tables: []parser.QueryExpression{table}},
+	}
+	if where != nil {
+		entity.WhereClause = parser.WhereClause{Filter: where}
+	}
+	return parser.SelectQuery{
+		SelectEntity: entity,
+		LimitClause:  limit,
+	}
+}
+
+var streamingSelectTests = []struct {
+	Name         string
+	Query        parser.SelectQuery
+	Format       cmd.Format
+	NoHeader     bool
+	IsStreamable bool
+	Result       string
+	Error        string
+}{
+	{
+		Name: "StreamingSelect",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				parser.Field{Object: parser.Arithmetic{LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Operator: parser.Token{Token: '*', Literal: "*"}, RHS: parser.NewIntegerValue(10)}, Alias: parser.Identifier{Literal: "c1"}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			parser.Comparison{LHS: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"}, RHS: parser.NewIntegerValue(1)},
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "column2,c1\n" +
+			"str2,20\n" +
+			"str3,30",
+	},
+	{
+		Name: "StreamingSelect All Columns with Alias",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AllColumns{}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+			parser.Comparison{LHS: parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column1"}}, Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"}, RHS: parser.NewIntegerValue(3)},
+			nil,
+		),
+		Format:       cmd.LTSV,
+		IsStreamable: true,
+		Result: "column1:1\tcolumn2:str1\n" +
+			"column1:2\tcolumn2:str2",
+	},
+	{
+		Name: "StreamingSelect Limit and Offset",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			parser.LimitClause{
+				Type:         parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value:        parser.NewIntegerValue(1),
+				OffsetClause: parser.OffsetClause{Value: parser.NewIntegerValue(1)},
+			},
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "column1\n" +
+			"2",
+	},
+	{
+		Name: "StreamingSelect No Header",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "c2"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table_noheader"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.TSV,
+		NoHeader:     true,
+		IsStreamable: true,
+		Result: "c2\n" +
+			"str1\n" +
+			"str2",
+	},
+	{
+		Name: "StreamingSelect Empty Result",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			parser.NewTernaryValueFromString("false"),
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result:       "column1",
+	},
+	{
+		Name: "StreamingSelect Aggregate Functions",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "avg", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "min", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "max", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}}, Alias: parser.Identifier{Literal: "max"}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "COUNT(*),SUM(column1),AVG(column1),MIN(column2),max\n" +
+			"3,6,2,str1,str3",
+	},
+	{
+		Name: "StreamingSelect Aggregate Functions with Empty Records",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			parser.NewTernaryValueFromString("false"),
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "COUNT(*),SUM(column1)\n" +
+			"0,",
+	},
+	{
+		Name: "StreamingSelect Approximate Aggregate Functions",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "approx_count_distinct", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "approx_percentile", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, parser.NewFloatValue(0.25)}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "APPROX_COUNT_DISTINCT(column2),\"APPROX_PERCENTILE(column1, 0.25)\"\n" +
+			"3,1.5",
+	},
+	{
+		Name: "StreamingSelect Approximate Aggregate Functions with Empty Records",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AggregateFunction{Name: "approx_count_distinct", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "approx_percentile", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, parser.NewFloatValue(0.25)}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			parser.NewTernaryValueFromString("false"),
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Result: "APPROX_COUNT_DISTINCT(column2),\"APPROX_PERCENTILE(column1, 0.25)\"\n" +
+			"0,",
+	},
+	{
+		Name: "StreamingSelect Field Error",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: true,
+		Error:        "field notexist does not exist",
+	},
+	{
+		Name: "StreamingSelect Not Streamable Format",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.TEXT,
+		IsStreamable: false,
+	},
+	{
+		Name: "StreamingSelect Not Streamable Aggregate Function in Expression",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.Arithmetic{LHS: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}, Operator: parser.Token{Token: '+', Literal: "+"}, RHS: parser.NewIntegerValue(1)}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: false,
+	},
+	{
+		Name: "StreamingSelect Not Streamable Mixed Fields",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: false,
+	},
+	{
+		Name: "StreamingSelect Not Streamable Analytic Function",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.AnalyticFunction{Name: "row_number", AnalyticClause: parser.AnalyticClause{}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			nil,
+		),
+		Format:       cmd.CSV,
+		IsStreamable: false,
+	},
+	{
+		Name: "StreamingSelect Not Streamable Percentage Limit",
+		Query: streamingTestQuery(
+			[]parser.QueryExpression{
+				parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+			},
+			parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			nil,
+			parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+				Value: parser.NewIntegerValue(50),
+				Unit:  parser.Token{Token: parser.PERCENT, Literal: "percent"},
+			},
+		),
+		Format:       cmd.CSV,
+		IsStreamable: false,
+	},
+	{
+		Name: "StreamingSelect Not Streamable Joined Tables",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{Fields: []parser.QueryExpression{parser.Field{Object: parser.AllColumns{}}}},
+				FromClause: parser.FromClause{Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					parser.Table{Object: parser.Identifier{Literal: "table2"}},
+				}},
+			},
+		},
+		Format:       cmd.CSV,
+		IsStreamable: false,
+	},
+}
+
+func TestStreamingSelect_Encode(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range streamingSelectTests {
+		_ = TestTx.ReleaseResources()
+		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+
+		scope := NewReferenceScope(TestTx)
+		options := TestTx.Flags.ExportOptions.Copy()
+		options.Format = v.Format
+
+		stream := NewStreamingSelect(scope, v.Query, options)
+		if (stream != nil) != v.IsStreamable {
+			t.Errorf("%s: streamable = %t, want %t", v.Name, stream != nil, v.IsStreamable)
+			continue
+		}
+		if stream == nil {
+			continue
+		}
+
+		out := NewOutput()
+		_, err := stream.Encode(ctx, scope, out, options)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if out.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, out.String(), v.Result)
+		}
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -44,7 +46,13 @@ type Transaction struct {
 	SelectedViews []*View
 	AffectedRows  int
 
+	joinStrategy int32
+
 	AutoCommit bool
+
+	// ServerMode disallows the statements that affect the whole process or the host,
+	// such as external commands and changes of the working directory or environment variables.
+	ServerMode bool
 }
 
 func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retryDelay time.Duration, session *Session) (*Transaction, error) {
@@ -78,6 +86,7 @@ func NewTransaction(ctx context.Context, defaultWaitTimeout time.Duration, retry
 		SelectedViews:      nil,
 		AffectedRows:       0,
 		AutoCommit:         false,
+		ServerMode:         false,
 	}, nil
 }
 
@@ -110,26 +119,17 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	createFileInfo := make([]*FileInfo, 0, len(createdFiles))
 	updateFileInfo := make([]*FileInfo, 0, len(updatedFiles))
 
+	// Changes of SQLite tables are committed after the changes of all the files are written.
+	var sqliteTxs sqliteTransactions
+	defer func() {
+		sqliteTxs.close()
+	}()
+
 	if 0 < len(createdFiles) {
 		for _, fileinfo := range createdFiles {
 			view, _ := tx.cachedViews.Get(parser.Identifier{Literal: fileinfo.Path})
-
-			fp, _ := view.FileInfo.Handler.FileForUpdate()
-			if err := fp.Truncate(0); err != nil {
-				return NewSystemError(err.Error())
-			}
-			if _, err := fp.Seek(0, io.SeekStart); err != nil {
-				return NewSystemError(err.Error())
-			}
-
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
-				return NewCommitError(expr, err.Error())
-			}
-
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
+			if err := tx.writeFile(ctx, &sqliteTxs, view, fileinfo, expr); err != nil {
+				return err
 			}
 
 			createFileInfo = append(createFileInfo, view.FileInfo)
@@ -139,29 +139,18 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	if 0 < len(updatedFiles) {
 		for _, fileinfo := range updatedFiles {
 			view, _ := tx.cachedViews.Get(parser.Identifier{Literal: fileinfo.Path})
-
-			fp, _ := view.FileInfo.Handler.FileForUpdate()
-			if err := fp.Truncate(0); err != nil {
-				return NewSystemError(err.Error())
-			}
-			if _, err := fp.Seek(0, io.SeekStart); err != nil {
-				return NewSystemError(err.Error())
-			}
-
-			if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
-				return NewCommitError(expr, err.Error())
-			}
-
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
+			if err := tx.writeFile(ctx, &sqliteTxs, view, fileinfo, expr); err != nil {
+				return err
 			}
 
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
 		}
 	}
 
+	if err := sqliteTxs.commit(); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+
 	for _, f := range createFileInfo {
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
@@ -189,6 +178,59 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	return nil
 }
 
+func (tx *Transaction) writeFile(ctx context.Context, sqliteTxs *sqliteTransactions, view *View, fileinfo *FileInfo, expr parser.Expression) error {
+	if fileinfo.IsDatabaseTable() {
+		dbTx, err := sqliteTxs.begin(ctx, fileinfo.DatabasePath, tx.WaitTimeout)
+		if err == nil {
+			err = updateSqliteTable(ctx, dbTx, view, fileinfo)
+		}
+		if err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		return nil
+	}
+
+	var workbook []byte
+	if fileinfo.Format == cmd.XLSX {
+		// The original workbook is updated so that the other sheets are preserved.
+		src := view.FileInfo.Handler.File()
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			return NewSystemError(err.Error())
+		}
+		b, err := ioutil.ReadAll(src)
+		if err != nil {
+			return NewSystemError(err.Error())
+		}
+		workbook = b
+	}
+
+	fp, _ := view.FileInfo.Handler.FileForUpdate()
+	if err := fp.Truncate(0); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if _, err := fp.Seek(0, io.SeekStart); err != nil {
+		return NewSystemError(err.Error())
+	}
+
+	if fileinfo.Format == cmd.XLSX {
+		if err := updateXlsxFile(ctx, fp, workbook, view, fileinfo, fileinfo.ExportOptions(tx)); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+		return nil
+	}
+
+	if _, err := EncodeView(ctx, fp, view, fileinfo.ExportOptions(tx), tx.Palette); err != nil {
+		return NewCommitError(expr, err.Error())
+	}
+
+	if !tx.Flags.ExportOptions.StripEndingLineBreak && !isBinaryFormat(fileinfo.Format) && !(fileinfo.Format == cmd.FIXED && fileinfo.SingleLine) {
+		if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			return NewCommitError(expr, err.Error())
+		}
+	}
+	return nil
+}
+
 func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) error {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
@@ -328,6 +370,14 @@ func (tx *Transaction) LogError(log string) {
 	}
 }
 
+func (tx *Transaction) setJoinStrategy(strategy JoinStrategy) {
+	atomic.StoreInt32(&tx.joinStrategy, int32(strategy))
+}
+
+func (tx *Transaction) JoinStrategy() JoinStrategy {
+	return JoinStrategy(atomic.LoadInt32(&tx.joinStrategy))
+}
+
 var errNotAllowdFlagFormat = errors.New("not allowed flag format")
 var errInvalidFlagName = errors.New("invalid flag name")
 
@@ -370,6 +420,12 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.ExactDecimalFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetExactDecimal(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.WaitTimeoutFlag:
 		if f, ok := value.(float64); ok {
 			tx.UpdateWaitTimeout(f, file.DefaultRetryDelay)
@@ -460,6 +516,12 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.ArraySeparatorFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetArraySeparator(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case cmd.JsonEscapeFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetJsonEscape(s)
@@ -526,6 +588,12 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case cmd.StreamingFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetStreaming(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	default:
 		err = errInvalidFlagName
 	}
@@ -557,6 +625,8 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(s)
 	case cmd.AnsiQuotesFlag:
 		val = value.NewBoolean(tx.Flags.AnsiQuotes)
+	case cmd.ExactDecimalFlag:
+		val = value.NewBoolean(tx.Flags.ExactDecimal)
 	case cmd.WaitTimeoutFlag:
 		val = value.NewFloat(tx.Flags.WaitTimeout)
 	case cmd.ImportFormatFlag:
@@ -595,6 +665,8 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(tx.Flags.ExportOptions.LineBreak.String())
 	case cmd.EncloseAllFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.EncloseAll)
+	case cmd.ArraySeparatorFlag:
+		val = value.NewString(tx.Flags.ExportOptions.ArraySeparator)
 	case cmd.JsonEscapeFlag:
 		val = value.NewString(cmd.JsonEscapeTypeToString(tx.Flags.ExportOptions.JsonEscape))
 	case cmd.PrettyPrintFlag:
@@ -617,6 +689,8 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewInteger(int64(tx.Flags.CPU))
 	case cmd.StatsFlag:
 		val = value.NewBoolean(tx.Flags.Stats)
+	case cmd.StreamingFlag:
+		val = value.NewBoolean(tx.Flags.Streaming)
 	default:
 		ok = false
 	}
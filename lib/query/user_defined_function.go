@@ -126,6 +126,12 @@ func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	if _, ok := AggregateFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
+	if _, ok := BivariateAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
+	if _, ok := SketchAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
 	if _, ok := AnalyticFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
@@ -0,0 +1,95 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+type UserDefinedProcedureMap struct {
+	*SyncMap
+}
+
+func NewUserDefinedProcedureMap() UserDefinedProcedureMap {
+	return UserDefinedProcedureMap{
+		NewSyncMap(),
+	}
+}
+
+func (m UserDefinedProcedureMap) IsEmpty() bool {
+	return m.SyncMap == nil
+}
+
+func (m UserDefinedProcedureMap) Store(name string, val *UserDefinedProcedure) {
+	m.store(strings.ToUpper(name), val)
+}
+
+func (m UserDefinedProcedureMap) LoadDirect(name string) (interface{}, bool) {
+	return m.load(strings.ToUpper(name))
+}
+
+func (m UserDefinedProcedureMap) Load(name string) (*UserDefinedProcedure, bool) {
+	if v, ok := m.load(strings.ToUpper(name)); ok {
+		return v.(*UserDefinedProcedure), true
+	}
+	return nil, false
+}
+
+func (m UserDefinedProcedureMap) Delete(name string) {
+	m.delete(strings.ToUpper(name))
+}
+
+func (m UserDefinedProcedureMap) Exists(name string) bool {
+	return m.exists(strings.ToUpper(name))
+}
+
+func (m UserDefinedProcedureMap) Declare(expr parser.ProcedureDeclaration) error {
+	if m.Exists(expr.Name.Literal) {
+		return NewProcedureRedeclaredError(expr.Name)
+	}
+
+	for i := range expr.Parameters {
+		for j := 0; j < i; j++ {
+			if expr.Parameters[i].Variable.Name == expr.Parameters[j].Variable.Name {
+				return NewDuplicateParameterError(expr.Parameters[i].Variable)
+			}
+		}
+	}
+
+	m.Store(expr.Name.Literal, &UserDefinedProcedure{
+		Name:       expr.Name,
+		Statements: expr.Statements,
+		Parameters: expr.Parameters,
+	})
+	return nil
+}
+
+func (m UserDefinedProcedureMap) Dispose(name parser.Identifier) bool {
+	if m.Exists(name.Literal) {
+		m.Delete(name.Literal)
+		return true
+	}
+	return false
+}
+
+type UserDefinedProcedure struct {
+	Name       parser.Identifier
+	Statements []parser.Statement
+	Parameters []parser.ProcedureParameter
+}
+
+func (p *UserDefinedProcedure) CheckArgs(expr parser.CallProcedure) error {
+	if len(expr.Args) != len(p.Parameters) {
+		return NewProcedureArgumentLengthError(expr, len(p.Parameters))
+	}
+
+	for i, param := range p.Parameters {
+		if !param.IsOut() {
+			continue
+		}
+		if _, ok := expr.Args[i].(parser.Variable); !ok {
+			return NewProcedureOutArgumentError(expr.Args[i], param.Variable)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var userDefinedProcedureMapDeclareTests = []struct {
+	Name   string
+	Expr   parser.ProcedureDeclaration
+	Result UserDefinedProcedureMap
+	Error  string
+}{
+	{
+		Name: "UserDefinedProcedureMap Declare",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc"},
+			Parameters: []parser.ProcedureParameter{
+				{Variable: parser.Variable{Name: "arg1"}},
+				{Variable: parser.Variable{Name: "arg2"}, Out: parser.Token{Token: parser.OUT, Literal: "OUT"}},
+			},
+			Statements: []parser.Statement{
+				parser.Print{Value: parser.Variable{Name: "arg1"}},
+			},
+		},
+		Result: GenerateUserDefinedProcedureMap([]*UserDefinedProcedure{
+			{
+				Name: parser.Identifier{Literal: "userproc"},
+				Parameters: []parser.ProcedureParameter{
+					{Variable: parser.Variable{Name: "arg1"}},
+					{Variable: parser.Variable{Name: "arg2"}, Out: parser.Token{Token: parser.OUT, Literal: "OUT"}},
+				},
+				Statements: []parser.Statement{
+					parser.Print{Value: parser.Variable{Name: "arg1"}},
+				},
+			},
+		}),
+	},
+	{
+		Name: "UserDefinedProcedureMap Declare Redeclaration Error",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc"},
+		},
+		Error: "procedure userproc is redeclared",
+	},
+	{
+		Name: "UserDefinedProcedureMap Declare Duplicate Parameters Error",
+		Expr: parser.ProcedureDeclaration{
+			Name: parser.Identifier{Literal: "userproc2"},
+			Parameters: []parser.ProcedureParameter{
+				{Variable: parser.Variable{Name: "arg1"}},
+				{Variable: parser.Variable{Name: "arg1"}, Out: parser.Token{Token: parser.OUT, Literal: "OUT"}},
+			},
+		},
+		Error: "parameter @arg1 is a duplicate",
+	},
+}
+
+func TestUserDefinedProcedureMap_Declare(t *testing.T) {
+	procs := NewUserDefinedProcedureMap()
+
+	for _, v := range userDefinedProcedureMapDeclareTests {
+		err := procs.Declare(v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !SyncMapEqual(procs, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, procs, v.Result)
+		}
+	}
+}
+
+var userDefinedProcedureCheckArgsTests = []struct {
+	Name  string
+	Expr  parser.CallProcedure
+	Error string
+}{
+	{
+		Name: "UserDefinedProcedure CheckArgs",
+		Expr: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.Variable{Name: "var1"},
+			},
+		},
+	},
+	{
+		Name: "UserDefinedProcedure CheckArgs Arguments Length Error",
+		Expr: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+			},
+		},
+		Error: "procedure userproc takes exactly 2 arguments",
+	},
+	{
+		Name: "UserDefinedProcedure CheckArgs Out Argument Error",
+		Expr: parser.CallProcedure{
+			Name: parser.Identifier{Literal: "userproc"},
+			Args: []parser.QueryExpression{
+				parser.NewIntegerValue(1),
+				parser.NewIntegerValue(2),
+			},
+		},
+		Error: "argument 2 for OUT parameter @arg2 is not a variable",
+	},
+}
+
+func TestUserDefinedProcedure_CheckArgs(t *testing.T) {
+	proc := &UserDefinedProcedure{
+		Name: parser.Identifier{Literal: "userproc"},
+		Parameters: []parser.ProcedureParameter{
+			{Variable: parser.Variable{Name: "arg1"}},
+			{Variable: parser.Variable{Name: "arg2"}, Out: parser.Token{Token: parser.OUT, Literal: "OUT"}},
+		},
+	}
+
+	for _, v := range userDefinedProcedureCheckArgsTests {
+		err := proc.CheckArgs(v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+		}
+	}
+}
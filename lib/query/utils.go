@@ -140,6 +140,8 @@ func SerializeKey(buf *bytes.Buffer, val value.Primary, flags *cmd.Flags) {
 	} else if in := value.ToInteger(val); !value.IsNull(in) {
 		serializeInteger(buf, in.(*value.Integer).Raw())
 		value.Discard(in)
+	} else if d, ok := val.(*value.Decimal); ok && !isRepresentableAsFloat(d) {
+		serializeDecimal(buf, d)
 	} else if f := value.ToFloat(val); !value.IsNull(f) {
 		serializeFloat(buf, f.(*value.Float).Raw())
 		value.Discard(f)
@@ -154,6 +156,10 @@ func SerializeKey(buf *bytes.Buffer, val value.Primary, flags *cmd.Flags) {
 		}
 	} else if s, ok := val.(*value.String); ok {
 		serializeString(buf, s.Raw())
+	} else if iv, ok := val.(*value.Interval); ok {
+		serializeInterval(buf, iv)
+	} else if arr, ok := val.(*value.Array); ok {
+		serializeArray(buf, arr, flags)
 	} else {
 		serializeNull(buf)
 	}
@@ -179,6 +185,18 @@ func serializeFloat(buf *bytes.Buffer, f float64) {
 	_ = binary.Write(buf, binary.LittleEndian, f)
 }
 
+// isRepresentableAsFloat returns whether the decimal can be converted to a float without loss
+// so that the decimal has the same key as the equal float.
+func isRepresentableAsFloat(d *value.Decimal) bool {
+	f, ok := value.NewDecimalFromFloat64(d.Float64())
+	return ok && f.Cmp(d) == 0
+}
+
+func serializeDecimal(buf *bytes.Buffer, d *value.Decimal) {
+	buf.Write([]byte{91, 77, 93})
+	buf.WriteString(d.Normalize().String())
+}
+
 func serializeDatetime(buf *bytes.Buffer, t time.Time) {
 	serializeDatetimeFromUnixNano(buf, t.UnixNano())
 }
@@ -188,6 +206,21 @@ func serializeDatetimeFromUnixNano(buf *bytes.Buffer, t int64) {
 	_ = binary.Write(buf, binary.LittleEndian, t)
 }
 
+func serializeInterval(buf *bytes.Buffer, iv *value.Interval) {
+	days, nanos := iv.Normalize()
+	buf.Write([]byte{91, 86, 93})
+	_ = binary.Write(buf, binary.LittleEndian, days)
+	_ = binary.Write(buf, binary.LittleEndian, nanos)
+}
+
+func serializeArray(buf *bytes.Buffer, arr *value.Array, flags *cmd.Flags) {
+	buf.Write([]byte{91, 65, 93})
+	_ = binary.Write(buf, binary.LittleEndian, int64(arr.Len()))
+	for _, v := range arr.Raw() {
+		SerializeKey(buf, v, flags)
+	}
+}
+
 func serializeString(buf *bytes.Buffer, s string) {
 	buf.Write([]byte{91, 83, 93})
 	buf.WriteString(strings.ToUpper(cmd.TrimSpace(s)))
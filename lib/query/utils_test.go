@@ -82,8 +82,14 @@ func TestSerializeComparisonKeys(t *testing.T) {
 		value.NewBoolean(false),
 		value.NewTernary(ternary.UNKNOWN),
 		value.NewNull(),
-	}
-	expect := "[S]STR:[I]1:[I]0:[I]\x03\x00\x00\x00\x00\x00\x00\x00:[F]\x58\x39\xb4\xc8\x76\xbe\xf3\x3f:[D]\x00\xa6\x5b\x14\x42\x08\x6f\x12:[D]\xc0\x7a\xb0\x1b\x42\x08\x6f\x12:[D]\x15\x73\xb7\x1b\x42\x08\x6f\x12:[I]1:[I]0:[N]:[N]"
+		value.ToDecimal(value.NewString("3.000")),
+		value.ToDecimal(value.NewString("1.2340")),
+		value.ToDecimal(value.NewString("0.300000000000000000010")),
+		value.NewInterval(1, 1, int64(time.Hour)),
+	}
+	expect := "[S]STR:[I]1:[I]0:[I]\x03\x00\x00\x00\x00\x00\x00\x00:[F]\x58\x39\xb4\xc8\x76\xbe\xf3\x3f:[D]\x00\xa6\x5b\x14\x42\x08\x6f\x12:[D]\xc0\x7a\xb0\x1b\x42\x08\x6f\x12:[D]\x15\x73\xb7\x1b\x42\x08\x6f\x12:[I]1:[I]0:[N]:[N]" +
+		":[I]\x03\x00\x00\x00\x00\x00\x00\x00:[F]\x58\x39\xb4\xc8\x76\xbe\xf3\x3f:[M]0.30000000000000000001" +
+		":[V]\x1f\x00\x00\x00\x00\x00\x00\x00\x00\xa0\xb8\x30\x46\x03\x00\x00"
 
 	buf := &bytes.Buffer{}
 	SerializeComparisonKeys(buf, values, TestTx.Flags)
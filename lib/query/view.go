@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
 	"github.com/mithrandie/csvq/lib/file"
@@ -47,6 +48,8 @@ type View struct {
 	selectFields []int
 	selectLabels []string
 	isGrouped    bool
+	windows      WindowDefinitions
+	qualify      parser.QueryExpression
 
 	comparisonKeysInEachRecord []string
 	sortValuesInEachCell       [][]*SortValue
@@ -106,11 +109,25 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 	table := tableExpr.(parser.Table)
 	tableName := table.Name()
 
+	ctx, step := startTablePlanStep(ctx, scope, table, forUpdate)
+	defer func() {
+		if err == nil {
+			step.Finish(view.RecordLen())
+		}
+	}()
+
 	switch table.Object.(type) {
 	case parser.Dual:
 		view = loadDualView()
 	case parser.TableObject:
 		tableObject := table.Object.(parser.TableObject)
+		if tableObject.Type.Token == parser.SQLITE {
+			if view, err = loadSqliteObject(ctx, scope, tableObject, tableName, forUpdate, useInternalId); err != nil {
+				return nil, err
+			}
+			break
+		}
+
 		options := scope.Tx.Flags.ImportOptions.Copy()
 
 		var felem value.Primary
@@ -126,6 +143,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		cellRangeIdx := -1
 
 		switch tableObject.Type.Token {
 		case parser.CSV:
@@ -193,6 +211,16 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			options.Format = cmd.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case parser.XLSX:
+			if 3 < len(tableObject.Args) {
+				return nil, NewTableObjectArgumentsLengthError(tableObject, 5)
+			}
+			if felem != nil && !value.IsNull(felem) {
+				options.SheetName = felem.(*value.String).Raw()
+			}
+			options.Format = cmd.XLSX
+			options.Encoding = text.UTF8
+			encodingIdx, cellRangeIdx = -1, 0
 		default:
 			return nil, NewInvalidTableObjectError(tableObject, tableObject.Type.Literal)
 		}
@@ -227,6 +255,13 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				} else {
 					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a encoding value: %s", tableObject.Args[encodingIdx].String()))
 				}
+			case cellRangeIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(tableObject, fmt.Sprintf("cannot be converted as a cell range value: %s", tableObject.Args[cellRangeIdx].String()))
+				}
 			case noHeaderIdx:
 				v := value.ToBoolean(p)
 				if !value.IsNull(v) {
@@ -244,11 +279,14 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 		}
 
-		if args[encodingIdx] != nil {
-			if options.Encoding, err = cmd.ParseEncoding(args[0].(*value.String).Raw()); err != nil {
+		if 0 <= encodingIdx && args[encodingIdx] != nil {
+			if options.Encoding, err = cmd.ParseEncoding(args[encodingIdx].(*value.String).Raw()); err != nil {
 				return nil, NewTableObjectInvalidArgumentError(tableObject, err.Error())
 			}
 		}
+		if 0 <= cellRangeIdx && args[cellRangeIdx] != nil {
+			options.CellRange = args[cellRangeIdx].(*value.String).Raw()
+		}
 		if args[noHeaderIdx] != nil {
 			options.NoHeader = args[noHeaderIdx].(*value.Boolean).Raw()
 		}
@@ -300,20 +338,32 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 
 			joinTableName := t.Name()
 
-			subquery := t.Object.(parser.Subquery)
 			var hfields Header
 			resultSetList := make([]RecordSet, view.RecordLen())
 
 			if err := EvaluateSequentially(ctx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
-				appliedView, err := Select(ctx, seqScope, subquery.Query)
-				if err != nil {
-					return err
-				}
+				var appliedView *View
+				var err error
 
-				if 0 < len(joinTableName.Literal) {
-					if err = appliedView.Header.Update(joinTableName.Literal, nil); err != nil {
+				if unnest, ok := t.Object.(parser.Unnest); ok {
+					appliedView, err = loadUnnest(ctx, seqScope, unnest, joinTableName.Literal)
+					if err != nil {
+						return err
+					}
+				} else {
+					subquery := t.Object.(parser.Subquery)
+					subqueryCtx, subqueryStep := StartPlanStep(ctx, t.BaseExpr, PlanSubquery, joinTableName.Literal)
+					appliedView, err = Select(subqueryCtx, seqScope, subquery.Query)
+					if err != nil {
 						return err
 					}
+					subqueryStep.Finish(appliedView.RecordLen())
+
+					if 0 < len(joinTableName.Literal) {
+						if err = appliedView.Header.Update(joinTableName.Literal, nil); err != nil {
+							return err
+						}
+					}
 				}
 
 				calcView := NewView()
@@ -427,6 +477,55 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			return nil, err
 		}
 
+		if 0 < len(tableName.Literal) {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+
+			if err = view.Header.Update(tableName.Literal, nil); err != nil {
+				return nil, err
+			}
+		}
+	case parser.Unnest:
+		view, err = loadUnnest(ctx, scope, table.Object.(parser.Unnest), tableName.Literal)
+		if err != nil {
+			return nil, err
+		}
+
+		if 0 < len(tableName.Literal) {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+		}
+	case parser.Pivot:
+		view, err = loadPivot(ctx, scope, table.Object.(parser.Pivot), tableName.Literal, forUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		if table.Alias != nil {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+		}
+	case parser.Unpivot:
+		view, err = loadUnpivot(ctx, scope, table.Object.(parser.Unpivot), tableName.Literal, forUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		if table.Alias != nil {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+		}
+	case parser.DataModifyingSubquery:
+		subquery := table.Object.(parser.DataModifyingSubquery)
+		view, err = loadDataModifyingSubquery(ctx, scope, subquery)
+		if err != nil {
+			return nil, err
+		}
+
 		if 0 < len(tableName.Literal) {
 			if err := scope.AddAlias(tableName, ""); err != nil {
 				return nil, err
@@ -441,6 +540,72 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 	return view, err
 }
 
+func loadDataModifyingSubquery(ctx context.Context, scope *ReferenceScope, subquery parser.DataModifyingSubquery) (*View, error) {
+	if IsDataModificationContext(ctx) {
+		return nil, NewNestedDataModifyingQueryError(subquery)
+	}
+
+	var returning *View
+	var err error
+
+	switch subquery.Query.(type) {
+	case parser.InsertQuery:
+		query := subquery.Query.(parser.InsertQuery)
+		if query.ReturningClause == nil {
+			return nil, NewReturningClauseNotSpecifiedError(subquery)
+		}
+
+		var fileInfo *FileInfo
+		var cnt int
+		if fileInfo, cnt, returning, err = Insert(ctx, scope, query); err == nil && 0 < cnt {
+			scope.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+		}
+	case parser.ReplaceQuery:
+		query := subquery.Query.(parser.ReplaceQuery)
+		if query.ReturningClause == nil {
+			return nil, NewReturningClauseNotSpecifiedError(subquery)
+		}
+
+		var fileInfo *FileInfo
+		var cnt int
+		if fileInfo, cnt, returning, err = Replace(ctx, scope, query); err == nil && 0 < cnt {
+			scope.Tx.uncommittedViews.SetForUpdatedView(fileInfo)
+		}
+	case parser.UpdateQuery:
+		query := subquery.Query.(parser.UpdateQuery)
+		if query.ReturningClause == nil {
+			return nil, NewReturningClauseNotSpecifiedError(subquery)
+		}
+
+		var infos []*FileInfo
+		var cnts []int
+		if infos, cnts, returning, err = Update(ctx, scope, query); err == nil {
+			for i, info := range infos {
+				if 0 < cnts[i] {
+					scope.Tx.uncommittedViews.SetForUpdatedView(info)
+				}
+			}
+		}
+	case parser.DeleteQuery:
+		query := subquery.Query.(parser.DeleteQuery)
+		if query.ReturningClause == nil {
+			return nil, NewReturningClauseNotSpecifiedError(subquery)
+		}
+
+		var infos []*FileInfo
+		var cnts []int
+		if infos, cnts, returning, err = Delete(ctx, scope, query); err == nil {
+			for i, info := range infos {
+				if 0 < cnts[i] {
+					scope.Tx.uncommittedViews.SetForUpdatedView(info)
+				}
+			}
+		}
+	}
+
+	return returning, err
+}
+
 func joinViews(ctx context.Context, scope *ReferenceScope, view *View, joinView *View, join parser.Join) error {
 	condition, includeFields, excludeFields, err := ParseJoinCondition(join, view, joinView)
 	if err != nil {
@@ -579,6 +744,30 @@ func loadStdin(ctx context.Context, scope *ReferenceScope, fileInfo *FileInfo, s
 	return view, nil
 }
 
+// loadUnnest expands an array into a view that has one record for each element.
+// If the value is not an array, then the view has no records.
+func loadUnnest(ctx context.Context, scope *ReferenceScope, unnest parser.Unnest, viewName string) (*View, error) {
+	p, err := Evaluate(ctx, scope, unnest.Array)
+	if err != nil {
+		return nil, err
+	}
+
+	var recordSet RecordSet
+	if arr, ok := p.(*value.Array); ok {
+		recordSet = make(RecordSet, arr.Len())
+		for i, v := range arr.Raw() {
+			recordSet[i] = NewRecord([]value.Primary{v})
+		}
+	} else {
+		recordSet = make(RecordSet, 0)
+	}
+
+	return &View{
+		Header:    NewHeader(viewName, []string{"value"}),
+		RecordSet: recordSet,
+	}, nil
+}
+
 func loadObject(
 	ctx context.Context,
 	scope *ReferenceScope,
@@ -600,6 +789,8 @@ func loadObject(
 			DelimiterPositions: options.DelimiterPositions,
 			SingleLine:         options.SingleLine,
 			JsonQuery:          options.JsonQuery,
+			SheetName:          options.SheetName,
+			CellRange:          options.CellRange,
 			Encoding:           options.Encoding,
 			LineBreak:          scope.Tx.Flags.ExportOptions.LineBreak,
 			NoHeader:           options.NoHeader,
@@ -673,7 +864,12 @@ func loadObject(
 		return nil, err
 	}
 
+	return loadCachedView(ctx, scope, filePath, tableName, useInternalId)
+}
+
+func loadCachedView(ctx context.Context, scope *ReferenceScope, filePath string, tableName parser.Identifier, useInternalId bool) (*View, error) {
 	var view *View
+	var err error
 	pathIdent := parser.Identifier{Literal: filePath}
 	if useInternalId {
 		if view, err = scope.Tx.cachedViews.GetWithInternalId(ctx, pathIdent, scope.Tx.Flags); err != nil {
@@ -719,8 +915,13 @@ func cacheViewFromFile(
 		filePath = p
 	}
 
+	var projection []string
+	if !forUpdate {
+		projection = ProjectionColumns(ctx)
+	}
+
 	view, ok := scope.Tx.cachedViews.Load(filePath)
-	if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+	if !ok || (forUpdate && !view.FileInfo.ForUpdate) || !view.FileInfo.HasColumns(projection) {
 		fileInfo, err := NewFileInfo(tableIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
 		if err != nil {
 			return filePath, err
@@ -728,18 +929,28 @@ func cacheViewFromFile(
 		filePath = fileInfo.Path
 
 		view, ok = scope.Tx.cachedViews.Load(filePath)
-		if !ok || (forUpdate && !view.FileInfo.ForUpdate) {
+		if !ok || (forUpdate && !view.FileInfo.ForUpdate) || !view.FileInfo.HasColumns(projection) {
 			fileInfo.DelimiterPositions = options.DelimiterPositions
 			fileInfo.SingleLine = options.SingleLine
 			fileInfo.JsonQuery = cmd.TrimSpace(options.JsonQuery)
+			fileInfo.SheetName = options.SheetName
+			fileInfo.CellRange = cmd.TrimSpace(options.CellRange)
 			fileInfo.LineBreak = scope.Tx.Flags.ExportOptions.LineBreak
 			fileInfo.NoHeader = options.NoHeader
 			fileInfo.EncloseAll = scope.Tx.Flags.ExportOptions.EncloseAll
 			fileInfo.JsonEscape = scope.Tx.Flags.ExportOptions.JsonEscape
 
 			if ok {
+				if projection != nil && view.FileInfo.Projection != nil {
+					projection = mergeProjection(view.FileInfo.Projection, projection)
+				}
 				fileInfo = view.FileInfo
 			}
+			if fileInfo.Format == cmd.PARQUET {
+				fileInfo.Projection = projection
+			} else {
+				fileInfo.Projection = nil
+			}
 
 			if err = scope.Tx.cachedViews.Dispose(scope.Tx.FileContainer, fileInfo.Path); err != nil {
 				return filePath, err
@@ -791,6 +1002,10 @@ func loadViewFromFile(ctx context.Context, flags *cmd.Flags, fp io.ReadSeeker, f
 		return loadViewFromLTSVFile(ctx, flags, fp, fileInfo, withoutNull, expr)
 	case cmd.JSON:
 		return loadViewFromJsonFile(fp, fileInfo, expr)
+	case cmd.PARQUET:
+		return loadViewFromParquetFile(ctx, fp, fileInfo, expr)
+	case cmd.XLSX:
+		return loadViewFromXlsxFile(ctx, fp, fileInfo, withoutNull, expr)
 	}
 	return loadViewFromCSVFile(ctx, fp, fileInfo, withoutNull, expr)
 }
@@ -1079,14 +1294,26 @@ func NewViewFromGroupedRecord(ctx context.Context, flags *cmd.Flags, referenceRe
 
 	view.RecordSet = make(RecordSet, record.GroupLen())
 
+	cells := record
+	if gIdx, ok := view.Header.ContainsGroupingId(); ok {
+		cells = record.Copy()
+		pos := gIdx + 1
+		for j := 0; j < gIdx; j++ {
+			if view.Header[j].IsGroupKey {
+				cells[j] = record[pos]
+				pos++
+			}
+		}
+	}
+
 	if err := NewGoroutineTaskManager(record.GroupLen(), -1, flags.CPU).Run(ctx, func(index int) error {
 		view.RecordSet[index] = make(Record, view.FieldLen())
-		for j := range record {
+		for j := range cells {
 			grpIdx := index
-			if len(record[j]) < 2 {
+			if len(cells[j]) < 2 {
 				grpIdx = 0
 			}
-			view.RecordSet[index][j] = record[j][grpIdx : grpIdx+1]
+			view.RecordSet[index][j] = cells[j][grpIdx : grpIdx+1]
 		}
 		return nil
 	}); err != nil {
@@ -1096,7 +1323,12 @@ func NewViewFromGroupedRecord(ctx context.Context, flags *cmd.Flags, referenceRe
 }
 
 func (view *View) Where(ctx context.Context, scope *ReferenceScope, clause parser.WhereClause) error {
-	return view.filter(ctx, scope, clause.Filter)
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanWhere, clause.Filter.String())
+	if err := view.filter(ctx, scope, clause.Filter); err != nil {
+		return err
+	}
+	step.Finish(view.RecordLen())
+	return nil
 }
 
 func (view *View) filter(ctx context.Context, scope *ReferenceScope, condition parser.QueryExpression) error {
@@ -1121,17 +1353,28 @@ func (view *View) filter(ctx context.Context, scope *ReferenceScope, condition p
 		if ok {
 			if i != newIdx {
 				view.RecordSet[newIdx] = view.RecordSet[i]
+				if view.sortValuesInEachCell != nil {
+					view.sortValuesInEachCell[newIdx] = view.sortValuesInEachCell[i]
+				}
 			}
 			newIdx++
 		}
 	}
 
 	view.RecordSet = view.RecordSet[:newIdx]
+	if view.sortValuesInEachCell != nil {
+		view.sortValuesInEachCell = view.sortValuesInEachCell[:newIdx]
+	}
 	return nil
 }
 
 func (view *View) GroupBy(ctx context.Context, scope *ReferenceScope, clause parser.GroupByClause) error {
-	return view.group(ctx, scope, clause.Items)
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanGroupBy, listPlanExpressions(clause.Items))
+	if err := view.group(ctx, scope, clause.Items); err != nil {
+		return err
+	}
+	step.Finish(view.RecordLen())
+	return nil
 }
 
 func (view *View) group(ctx context.Context, scope *ReferenceScope, items []parser.QueryExpression) error {
@@ -1139,6 +1382,418 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		return view.groupAll(ctx, scope.Tx.Flags)
 	}
 
+	if sets, ok := expandGroupingSets(items); ok {
+		return view.groupBySets(ctx, scope, sets)
+	}
+
+	exprKeyIndices, err := view.evalGroupKeyColumns(ctx, scope, items)
+	if err != nil {
+		return err
+	}
+
+	records, err := view.groupRecords(ctx, scope, items)
+	if err != nil {
+		return err
+	}
+
+	view.RecordSet = records
+	view.isGrouped = true
+	for _, item := range items {
+		switch item.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			idx, _ := view.Header.SearchIndex(item)
+			view.Header[idx].IsGroupKey = true
+		}
+	}
+	for _, idx := range exprKeyIndices {
+		view.Header[idx].IsGroupKey = true
+	}
+
+	if gapfill, ok := searchTimeBucketGapfill(items); ok {
+		return view.fillTimeBucketGaps(ctx, scope, gapfill)
+	}
+	return nil
+}
+
+func searchTimeBucketGapfill(items []parser.QueryExpression) (parser.Function, bool) {
+	for _, item := range items {
+		if fn, ok := item.(parser.Function); ok && strings.EqualFold(fn.Name, "TIME_BUCKET_GAPFILL") {
+			return fn, true
+		}
+	}
+	return parser.Function{}, false
+}
+
+// evalGroupKeyColumns adds the columns of the group keys that are not fields
+// so that the values of the keys can be referred after grouping.
+func (view *View) evalGroupKeyColumns(ctx context.Context, scope *ReferenceScope, items []parser.QueryExpression) ([]int, error) {
+	indices := make([]int, 0, len(items))
+	for _, item := range items {
+		switch item.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			continue
+		}
+
+		if idx, ok := view.Header.ContainsObject(item); ok {
+			indices = append(indices, idx)
+			continue
+		}
+
+		var idx int
+		if 0 < view.RecordLen() {
+			var err error
+			if idx, err = view.evalColumn(ctx, scope, item, ""); err != nil {
+				return nil, err
+			}
+		} else {
+			view.Header, idx = AddHeaderField(view.Header, parser.FormatFieldIdentifier(item), "")
+		}
+		indices = append(indices, idx)
+	}
+	return indices, nil
+}
+
+// TimeBucketGapfillLimit is the maximum number of empty groups that TIME_BUCKET_GAPFILL generates.
+const TimeBucketGapfillLimit = 100000
+
+type timeBucketSeries struct {
+	template Record
+	buckets  []time.Time
+	records  []Record
+	nulls    []Record
+}
+
+// fillTimeBucketGaps generates empty groups for the buckets missing in each
+// series of the other group keys, and sorts the groups in each series by bucket.
+func (view *View) fillTimeBucketGaps(ctx context.Context, scope *ReferenceScope, fn parser.Function) error {
+	if len(fn.Args) < 2 || 4 < len(fn.Args) {
+		return NewFunctionArgumentLengthError(fn, fn.Name, []int{2, 3, 4})
+	}
+	if view.RecordLen() < 1 {
+		return nil
+	}
+
+	bounds := make([]value.Primary, len(fn.Args))
+	for i, arg := range fn.Args {
+		if i == 1 {
+			continue
+		}
+		p, err := Evaluate(ctx, scope, arg)
+		if err != nil {
+			return err
+		}
+		bounds[i] = p
+	}
+
+	iv, err := bucketWidth(fn, bounds[0])
+	if err != nil {
+		return err
+	}
+	var start, finish *time.Time
+	for i := 2; i < len(bounds); i++ {
+		dt := value.ToDatetime(bounds[i], scope.Tx.Flags.DatetimeFormat)
+		if value.IsNull(dt) {
+			continue
+		}
+		t := dt.(*value.Datetime).Raw()
+		value.Discard(dt)
+		if i == 2 {
+			start = &t
+		} else {
+			finish = &t
+		}
+	}
+
+	if view.Header[0].IsGroupKey {
+		view.prependGroupIdColumn()
+	}
+
+	bucketIdx, _ := view.Header.ContainsObject(fn)
+	keyIndices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if view.Header[i].IsGroupKey && i != bucketIdx {
+			keyIndices = append(keyIndices, i)
+		}
+	}
+
+	seriesList := make([]*timeBucketSeries, 0, 10)
+	seriesMap := make(map[string]*timeBucketSeries, 10)
+	for _, record := range view.RecordSet {
+		keyBuf := GetComparisonKeysBuf()
+		for i, idx := range keyIndices {
+			if 0 < i {
+				keyBuf.WriteByte(58)
+			}
+			SerializeKey(keyBuf, record[idx][0], scope.Tx.Flags)
+		}
+		key := keyBuf.String()
+		PutComparisonkeysBuf(keyBuf)
+
+		series, ok := seriesMap[key]
+		if !ok {
+			series = &timeBucketSeries{template: record}
+			seriesMap[key] = series
+			seriesList = append(seriesList, series)
+		}
+
+		if dt, ok := record[bucketIdx][0].(*value.Datetime); ok {
+			series.buckets = append(series.buckets, dt.Raw())
+			series.records = append(series.records, record)
+		} else {
+			series.nulls = append(series.nulls, record)
+		}
+	}
+
+	records := make(RecordSet, 0, view.RecordLen())
+	filled := 0
+	for _, series := range seriesList {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		existing := make(map[int64]bool, len(series.buckets))
+		var min, max time.Time
+		for i, t := range series.buckets {
+			existing[t.UnixNano()] = true
+			if i == 0 || t.Before(min) {
+				min = t
+			}
+			if i == 0 || max.Before(t) {
+				max = t
+			}
+		}
+
+		if 0 < len(series.buckets) || (start != nil && finish != nil) {
+			loc := min.Location()
+			if len(series.buckets) < 1 {
+				loc = start.Location()
+			}
+			origin := timeBucketOrigin(iv, loc)
+
+			if start != nil {
+				min = iv.Bucket(start.In(loc), origin)
+			}
+			isInRange := func(t time.Time) bool {
+				if finish != nil {
+					return t.Before(*finish)
+				}
+				return !max.Before(t)
+			}
+
+			for t := min; isInRange(t); {
+				if !existing[t.UnixNano()] {
+					if TimeBucketGapfillLimit <= filled {
+						return NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("the number of generated buckets exceeds the limit of %d", TimeBucketGapfillLimit))
+					}
+					series.records = append(series.records, emptyTimeBucketGroup(series.template, bucketIdx, keyIndices, t))
+					filled++
+				}
+
+				next := iv.Bucket(iv.AddTo(t), origin)
+				if !t.Before(next) {
+					break
+				}
+				t = next
+			}
+		}
+
+		sort.SliceStable(series.records, func(i, j int) bool {
+			return series.records[i][bucketIdx][0].(*value.Datetime).Raw().Before(series.records[j][bucketIdx][0].(*value.Datetime).Raw())
+		})
+		records = append(records, series.records...)
+		records = append(records, series.nulls...)
+	}
+
+	view.RecordSet = records
+	return nil
+}
+
+// prependGroupIdColumn adds an internal id column to the head of the grouped view.
+// The length of a group is the length of the first cell, so the first column
+// must not be a group key to represent a group that has no records.
+func (view *View) prependGroupIdColumn() {
+	view.Header = NewHeaderWithId(view.Header[0].View, []string{}).Merge(view.Header)
+	for i, record := range view.RecordSet {
+		ids := make(Cell, record.GroupLen())
+		for j := range ids {
+			ids[j] = value.NewNull()
+		}
+		view.RecordSet[i] = append(Record{ids}, record...)
+	}
+}
+
+// emptyTimeBucketGroup returns a group that has no records.
+// The group has only the values of the group keys, and other cells are empty.
+func emptyTimeBucketGroup(template Record, bucketIdx int, keyIndices []int, t time.Time) Record {
+	record := make(Record, len(template))
+	for i := range record {
+		record[i] = Cell{}
+	}
+	for _, idx := range keyIndices {
+		record[idx] = NewCell(template[idx][0])
+	}
+	record[bucketIdx] = NewCell(value.NewDatetime(t))
+	return record
+}
+
+// groupBySets groups the records by each grouping set and concatenates the results.
+// Group keys that are not included in a grouping set are set to null in the records
+// of that set, and their original values are kept in hidden columns following the
+// grouping id column so that aggregate functions can still refer to them.
+// The empty grouping set always makes a group, even if there are no records.
+func (view *View) groupBySets(ctx context.Context, scope *ReferenceScope, sets [][]parser.QueryExpression) error {
+	if view.RecordLen() < 1 && 0 < view.FieldLen() && isGroupingSetsKey(view.Header, sets, 0) {
+		view.prependGroupIdColumn()
+	}
+
+	setRecords := make([]RecordSet, len(sets))
+	setIndices := make([]map[int]bool, len(sets))
+	keys := make(map[int]bool)
+	for i, set := range sets {
+		records, err := view.groupRecords(ctx, scope, set)
+		if err != nil {
+			return err
+		}
+		if len(set) < 1 && len(records) < 1 {
+			records = RecordSet{make(Record, view.FieldLen())}
+			for j := range records[0] {
+				records[0][j] = Cell{}
+			}
+		}
+		setRecords[i] = records
+
+		setIndices[i] = make(map[int]bool, len(set))
+		for _, item := range set {
+			switch item.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				idx, _ := view.Header.SearchIndex(item)
+				setIndices[i][idx] = true
+				keys[idx] = true
+			}
+		}
+	}
+
+	keyIndices := make([]int, 0, len(keys))
+	for idx := range keys {
+		keyIndices = append(keyIndices, idx)
+	}
+	sort.Ints(keyIndices)
+
+	fieldLen := view.FieldLen()
+	recordSet := make(RecordSet, 0, view.RecordLen())
+	for i, records := range setRecords {
+		mask := make([]byte, len(keyIndices))
+		for j, idx := range keyIndices {
+			if setIndices[i][idx] {
+				mask[j] = '0'
+			} else {
+				mask[j] = '1'
+			}
+		}
+		groupingId := value.NewString(string(mask))
+
+		for _, record := range records {
+			r := make(Record, fieldLen, fieldLen+1+len(keyIndices))
+			copy(r, record)
+			r = append(r, NewCell(groupingId))
+			for j, idx := range keyIndices {
+				r = append(r, record[idx])
+				if mask[j] == '1' {
+					nulls := make(Cell, len(record[idx]))
+					if len(nulls) < 1 {
+						nulls = make(Cell, 1)
+					}
+					for k := range nulls {
+						nulls[k] = value.NewNull()
+					}
+					r[idx] = nulls
+				}
+			}
+			recordSet = append(recordSet, r)
+		}
+	}
+
+	view.Header = append(view.Header, HeaderField{Column: GroupingIdColumn})
+	for _, idx := range keyIndices {
+		view.Header[idx].IsGroupKey = true
+		view.Header = append(view.Header, HeaderField{View: view.Header[idx].View, Column: GroupingIdColumn})
+	}
+	view.RecordSet = recordSet
+	view.isGrouped = true
+	return nil
+}
+
+func isGroupingSetsKey(header Header, sets [][]parser.QueryExpression, index int) bool {
+	for _, set := range sets {
+		for _, item := range set {
+			switch item.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				if idx, err := header.SearchIndex(item); err == nil && idx == index {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func expandGroupingSets(items []parser.QueryExpression) ([][]parser.QueryExpression, bool) {
+	sets := [][]parser.QueryExpression{{}}
+	expanded := false
+
+	var product = func(sets [][]parser.QueryExpression, elements [][]parser.QueryExpression) [][]parser.QueryExpression {
+		list := make([][]parser.QueryExpression, 0, len(sets)*len(elements))
+		for _, set := range sets {
+			for _, elem := range elements {
+				s := make([]parser.QueryExpression, 0, len(set)+len(elem))
+				s = append(s, set...)
+				s = append(s, elem...)
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+
+	for _, item := range items {
+		gs, ok := item.(parser.GroupingSets)
+		if !ok {
+			sets = product(sets, [][]parser.QueryExpression{{item}})
+			continue
+		}
+		expanded = true
+
+		var elements [][]parser.QueryExpression
+		switch gs.Type.Token {
+		case parser.ROLLUP:
+			elements = make([][]parser.QueryExpression, 0, len(gs.Sets)+1)
+			for i := len(gs.Sets); 0 <= i; i-- {
+				elements = append(elements, gs.Sets[:i])
+			}
+		case parser.CUBE:
+			n := len(gs.Sets)
+			elements = make([][]parser.QueryExpression, 0, 1<<n)
+			for mask := 1<<n - 1; 0 <= mask; mask-- {
+				elem := make([]parser.QueryExpression, 0, n)
+				for i := 0; i < n; i++ {
+					if mask&(1<<(n-1-i)) != 0 {
+						elem = append(elem, gs.Sets[i])
+					}
+				}
+				elements = append(elements, elem)
+			}
+		default: // parser.SETS
+			elements = make([][]parser.QueryExpression, 0, len(gs.Sets))
+			for _, set := range gs.Sets {
+				elements = append(elements, set.(parser.GroupingSet).Values)
+			}
+		}
+		sets = product(sets, elements)
+	}
+
+	return sets, expanded
+}
+
+func (view *View) groupRecords(ctx context.Context, scope *ReferenceScope, items []parser.QueryExpression) (RecordSet, error) {
 	gm := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU)
 	groupsList := make([]map[string][]int, gm.Number)
 	groupKeyCnt := make(map[string]int, 20)
@@ -1207,10 +1862,10 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 	}
 
 	if gm.HasError() {
-		return gm.Err()
+		return nil, gm.Err()
 	}
 	if ctx.Err() != nil {
-		return ConvertContextError(ctx.Err())
+		return nil, ConvertContextError(ctx.Err())
 	}
 
 	for i := range groupsList {
@@ -1245,19 +1900,10 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		records[gIdx] = record
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	view.RecordSet = records
-	view.isGrouped = true
-	for _, item := range items {
-		switch item.(type) {
-		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.Header.SearchIndex(item)
-			view.Header[idx].IsGroupKey = true
-		}
-	}
-	return nil
+	return records, nil
 }
 
 func (view *View) groupAll(ctx context.Context, flags *cmd.Flags) error {
@@ -1289,6 +1935,7 @@ func (view *View) groupAll(ctx context.Context, flags *cmd.Flags) error {
 }
 
 func (view *View) Having(ctx context.Context, scope *ReferenceScope, clause parser.HavingClause) error {
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanHaving, clause.Filter.String())
 	err := view.filter(ctx, scope, clause.Filter)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
@@ -1302,10 +1949,34 @@ func (view *View) Having(ctx context.Context, scope *ReferenceScope, clause pars
 			return err
 		}
 	}
+	step.Finish(view.RecordLen())
 	return nil
 }
 
-func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause parser.SelectClause) error {
+func (view *View) Qualify(ctx context.Context, scope *ReferenceScope, clause parser.QualifyClause) error {
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanQualify, clause.Filter.String())
+	if err := view.ExtendRecordCapacity(ctx, scope, []parser.QueryExpression{clause.Filter}); err != nil {
+		return err
+	}
+	if err := view.evalNestedAnalyticFunctions(ctx, scope, clause.Filter); err != nil {
+		return err
+	}
+	if err := view.filter(ctx, scope, clause.Filter); err != nil {
+		return err
+	}
+	step.Finish(view.RecordLen())
+	return nil
+}
+
+func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause parser.SelectClause) (err error) {
+	parentCtx := ctx
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanSelect, selectPlanDetail(clause))
+	defer func() {
+		if err == nil {
+			step.Finish(view.RecordLen())
+		}
+	}()
+
 	var parseAllColumns = func(view *View, fields []parser.QueryExpression) []parser.QueryExpression {
 		insertIdx := -1
 
@@ -1377,7 +2048,7 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 	fields := parseAllColumns(view, clause.Fields)
 
 	origFieldLen := view.FieldLen()
-	err := evalFields(view, fields)
+	err = evalFields(view, fields)
 	if err != nil {
 		if _, ok := err.(*NotGroupingRecordsError); ok {
 			view.Header = view.Header[:origFieldLen]
@@ -1404,6 +2075,12 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 		}
 	}
 
+	if view.qualify != nil {
+		if err = view.Qualify(parentCtx, scope, view.qualify.(parser.QualifyClause)); err != nil {
+			return err
+		}
+	}
+
 	if clause.IsDistinct() {
 		if err = view.GenerateComparisonKeys(ctx, scope.Tx.Flags); err != nil {
 			return err
@@ -1464,10 +2141,17 @@ func (view *View) SelectAllColumns(ctx context.Context, scope *ReferenceScope) e
 			parser.Field{Object: parser.AllColumns{}},
 		},
 	}
-	return view.Select(ctx, scope, selectClause)
+	return view.Select(contextWithoutExecutionPlan(ctx), scope, selectClause)
 }
 
-func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause parser.OrderByClause) error {
+func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause parser.OrderByClause) (err error) {
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanOrderBy, listPlanExpressions(clause.Items))
+	defer func() {
+		if err == nil {
+			step.Finish(view.RecordLen())
+		}
+	}()
+
 	orderValues := make([]parser.QueryExpression, len(clause.Items))
 	for i, item := range clause.Items {
 		orderValues[i] = item.(parser.OrderItem).Value
@@ -1487,28 +2171,7 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 	}
 
 	view.sortValuesInEachRecord = make([]SortValues, view.RecordLen())
-	view.sortDirections = make([]int, len(clause.Items))
-	view.sortNullPositions = make([]int, len(clause.Items))
-
-	for i, v := range clause.Items {
-		oi := v.(parser.OrderItem)
-		if oi.Direction.IsEmpty() {
-			view.sortDirections[i] = parser.ASC
-		} else {
-			view.sortDirections[i] = oi.Direction.Token
-		}
-
-		if oi.NullsPosition.IsEmpty() {
-			switch view.sortDirections[i] {
-			case parser.ASC:
-				view.sortNullPositions[i] = parser.FIRST
-			default: //parser.DESC
-				view.sortNullPositions[i] = parser.LAST
-			}
-		} else {
-			view.sortNullPositions[i] = oi.NullsPosition.Token
-		}
-	}
+	view.sortDirections, view.sortNullPositions = sortDirections(clause.Items)
 
 	if err := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU).Run(ctx, func(index int) error {
 		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
@@ -1536,6 +2199,32 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 	return nil
 }
 
+func sortDirections(items []parser.QueryExpression) ([]int, []int) {
+	directions := make([]int, len(items))
+	nullPositions := make([]int, len(items))
+
+	for i, v := range items {
+		oi := v.(parser.OrderItem)
+		if oi.Direction.IsEmpty() {
+			directions[i] = parser.ASC
+		} else {
+			directions[i] = oi.Direction.Token
+		}
+
+		if oi.NullsPosition.IsEmpty() {
+			switch directions[i] {
+			case parser.ASC:
+				nullPositions[i] = parser.FIRST
+			default: //parser.DESC
+				nullPositions[i] = parser.LAST
+			}
+		} else {
+			nullPositions[i] = oi.NullsPosition.Token
+		}
+	}
+	return directions, nullPositions
+}
+
 func (view *View) additionalColumns(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) (map[string]bool, error) {
 	m := make(map[string]bool, 5)
 
@@ -1558,6 +2247,11 @@ func (view *View) additionalColumns(ctx context.Context, scope *ReferenceScope,
 		}
 	case parser.AnalyticFunction:
 		fn := expr.(parser.AnalyticFunction)
+		analyticClause, err := view.windows.Resolve(fn.AnalyticClause)
+		if err != nil {
+			return nil, err
+		}
+		fn.AnalyticClause = analyticClause
 		pvalues := fn.AnalyticClause.PartitionValues()
 		ovalues := []parser.QueryExpression(nil)
 		if fn.AnalyticClause.OrderByClause != nil {
@@ -1591,6 +2285,18 @@ func (view *View) additionalColumns(ctx context.Context, scope *ReferenceScope,
 				}
 			}
 		}
+	default:
+		for _, fn := range searchAnalyticFunctions(expr) {
+			columns, err := view.additionalColumns(ctx, scope, fn)
+			if err != nil {
+				return nil, err
+			}
+			for k := range columns {
+				if _, ok := m[k]; !ok {
+					m[k] = true
+				}
+			}
+		}
 	}
 
 	if _, ok := view.Header.ContainsObject(expr); !ok {
@@ -1634,7 +2340,9 @@ func (view *View) ExtendRecordCapacity(ctx context.Context, scope *ReferenceScop
 
 func (view *View) evalColumn(ctx context.Context, scope *ReferenceScope, obj parser.QueryExpression, alias string) (idx int, err error) {
 	idx, ok := view.Header.ContainsObject(obj)
-	if ok {
+	if ok && view.Header[idx].IsGroupKey {
+		// The value has already been evaluated as a group key.
+	} else if ok {
 		rScope := scope.CreateScopeForRecordEvaluation(view, -1)
 		if _, err = Evaluate(ctx, rScope, obj); err != nil {
 			return
@@ -1645,6 +2353,8 @@ func (view *View) evalColumn(ctx context.Context, scope *ReferenceScope, obj par
 			if err != nil {
 				return
 			}
+		} else if err = view.evalNestedAnalyticFunctions(ctx, scope, obj); err != nil {
+			return
 		} else if view.RecordLen() < 1 {
 			if view.tempRecord == nil {
 				view.tempRecord = NewEmptyRecord(view.FieldLen())
@@ -1682,13 +2392,26 @@ func (view *View) evalColumn(ctx context.Context, scope *ReferenceScope, obj par
 	return
 }
 
+func (view *View) evalNestedAnalyticFunctions(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression) error {
+	for _, fn := range searchAnalyticFunctions(expr) {
+		if _, err := view.evalColumn(ctx, scope, fn, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScope, expr parser.AnalyticFunction) error {
+	analyticClause, err := view.windows.Resolve(expr.AnalyticClause)
+	if err != nil {
+		return err
+	}
+	expr.AnalyticClause = analyticClause
+
 	name := strings.ToUpper(expr.Name)
-	if _, ok := AggregateFunctions[name]; !ok {
-		if _, ok := AnalyticFunctions[name]; !ok {
-			if udfn, err := scope.GetFunction(expr, expr.Name); err != nil || !udfn.IsAggregate {
-				return NewFunctionNotExistError(expr, expr.Name)
-			}
+	if !isBuiltInAnalyticFunction(name) {
+		if udfn, err := scope.GetFunction(expr, expr.Name); err != nil || !udfn.IsAggregate {
+			return NewFunctionNotExistError(expr, expr.Name)
 		}
 	}
 
@@ -1717,7 +2440,7 @@ func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScop
 		}
 	}
 
-	err := Analyze(ctx, scope, view, expr, partitionIndices)
+	err = Analyze(ctx, scope, view, expr, partitionIndices)
 
 	view.sortValuesInEachRecord = nil
 	view.sortDirections = nil
@@ -1727,20 +2450,12 @@ func (view *View) evalAnalyticFunction(ctx context.Context, scope *ReferenceScop
 }
 
 func (view *View) Offset(ctx context.Context, scope *ReferenceScope, clause parser.OffsetClause) error {
-	val, err := Evaluate(ctx, scope, clause.Value)
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanOffset, clause.Value.String())
+	offset, err := evalOffsetNumber(ctx, scope, clause)
 	if err != nil {
 		return err
 	}
-	number := value.ToInteger(val)
-	if value.IsNull(number) {
-		return NewInvalidOffsetNumberError(clause)
-	}
-	view.offset = int(number.(*value.Integer).Raw())
-	value.Discard(number)
-
-	if view.offset < 0 {
-		view.offset = 0
-	}
+	view.offset = offset
 
 	if view.RecordLen() <= view.offset {
 		view.RecordSet = RecordSet{}
@@ -1751,10 +2466,18 @@ func (view *View) Offset(ctx context.Context, scope *ReferenceScope, clause pars
 			view.RecordSet[i] = newSet[i]
 		}
 	}
+	step.Finish(view.RecordLen())
 	return nil
 }
 
-func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parser.LimitClause) error {
+func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parser.LimitClause) (err error) {
+	ctx, step := StartPlanStep(ctx, clause.BaseExpr, PlanLimit, limitPlanDetail(clause))
+	defer func() {
+		if err == nil {
+			step.Finish(view.RecordLen())
+		}
+	}()
+
 	val, err := Evaluate(ctx, scope, clause.Value)
 	if err != nil {
 		return err
@@ -1777,15 +2500,8 @@ func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parse
 			limit = int(math.Ceil(float64(view.RecordLen()+view.offset) * percentage / 100))
 		}
 	} else {
-		number := value.ToInteger(val)
-		if value.IsNull(number) {
-			return NewInvalidLimitNumberError(clause)
-		}
-		limit = int(number.(*value.Integer).Raw())
-		value.Discard(number)
-
-		if limit < 0 {
-			limit = 0
+		if limit, err = convertLimitNumber(val, clause); err != nil {
+			return err
 		}
 	}
 
@@ -1807,6 +2523,38 @@ func (view *View) Limit(ctx context.Context, scope *ReferenceScope, clause parse
 	return nil
 }
 
+func evalOffsetNumber(ctx context.Context, scope *ReferenceScope, clause parser.OffsetClause) (int, error) {
+	val, err := Evaluate(ctx, scope, clause.Value)
+	if err != nil {
+		return 0, err
+	}
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidOffsetNumberError(clause)
+	}
+	offset := int(number.(*value.Integer).Raw())
+	value.Discard(number)
+
+	if offset < 0 {
+		offset = 0
+	}
+	return offset, nil
+}
+
+func convertLimitNumber(val value.Primary, clause parser.LimitClause) (int, error) {
+	number := value.ToInteger(val)
+	if value.IsNull(number) {
+		return 0, NewInvalidLimitNumberError(clause)
+	}
+	limit := int(number.(*value.Integer).Raw())
+	value.Discard(number)
+
+	if limit < 0 {
+		limit = 0
+	}
+	return limit, nil
+}
+
 func (view *View) InsertValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) (int, error) {
 	recordValues, err := view.convertListToRecordValues(ctx, scope, fields, list)
 	if err != nil {
@@ -1828,7 +2576,8 @@ func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fiel
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	replacedIndices, err := view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	return len(replacedIndices), err
 }
 
 func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery, keys []parser.QueryExpression) (int, error) {
@@ -1836,7 +2585,8 @@ func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, f
 	if err != nil {
 		return 0, err
 	}
-	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	replacedIndices, err := view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
+	return len(replacedIndices), err
 }
 
 func (view *View) convertListToRecordValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) ([][]value.Primary, error) {
@@ -1933,10 +2683,10 @@ func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, r
 	return len(recordValues), nil
 }
 
-func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) (int, error) {
+func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) ([]int, error) {
 	fieldIndices, err := view.FieldIndices(fields)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	fieldIndicesMap := make(map[uint]bool, len(fieldIndices))
 	for _, v := range fieldIndices {
@@ -1945,7 +2695,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 
 	keyIndices, err := view.FieldIndices(keys)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	keyIndicesMap := make(map[uint]bool, len(keyIndices))
 	for _, v := range keyIndices {
@@ -1954,7 +2704,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 
 	for idx, i := range keyIndices {
 		if _, ok := fieldIndicesMap[uint(i)]; !ok {
-			return 0, NewReplaceKeyNotSetError(keys[idx])
+			return nil, NewReplaceKeyNotSetError(keys[idx])
 		}
 	}
 	updateIndices := make([]int, 0, len(fieldIndices)-len(keyIndices))
@@ -1966,7 +2716,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 
 	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	sortValuesInEachRecord := make([]SortValues, view.RecordLen())
@@ -1978,7 +2728,7 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 		sortValuesInEachRecord[index] = sortValues
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	sortValuesInInsertRecords := make([]SortValues, view.RecordLen())
@@ -1990,19 +2740,19 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 		sortValuesInInsertRecords[index] = sortValues
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	replacedRecord := make(map[int]bool, len(records))
-	replacedCount := 0
+	replacedIndices := make([]int, 0, len(records))
 	for i := range records {
 		replacedRecord[i] = false
 	}
 	replaceMtx := &sync.Mutex{}
-	var replaced = func(idx int) {
+	var replaced = func(idx int, recordIdx int) {
 		replaceMtx.Lock()
 		replacedRecord[idx] = true
-		replacedCount++
+		replacedIndices = append(replacedIndices, recordIdx)
 		replaceMtx.Unlock()
 	}
 	if err := NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
@@ -2011,13 +2761,13 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 				for _, fidx := range updateIndices {
 					view.RecordSet[index][fidx] = records[j][fidx]
 				}
-				replaced(j)
+				replaced(j, index)
 				break
 			}
 		}
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	insertRecords := make(RecordSet, 0, len(records))
@@ -2026,8 +2776,12 @@ func (view *View) replace(ctx context.Context, flags *cmd.Flags, fields []parser
 			insertRecords = append(insertRecords, records[i])
 		}
 	}
+	sort.Ints(replacedIndices)
+	for i := range insertRecords {
+		replacedIndices = append(replacedIndices, view.RecordLen()+i)
+	}
 	view.RecordSet = view.RecordSet.Merge(insertRecords)
-	return len(insertRecords) + replacedCount, nil
+	return replacedIndices, nil
 }
 
 func (view *View) Fix(ctx context.Context, flags *cmd.Flags) error {
@@ -2087,6 +2841,8 @@ func (view *View) Fix(ctx context.Context, flags *cmd.Flags) error {
 	view.selectFields = nil
 	view.selectLabels = nil
 	view.isGrouped = false
+	view.windows = nil
+	view.qualify = nil
 	view.comparisonKeysInEachRecord = nil
 	view.sortValuesInEachCell = nil
 	view.sortValuesInEachRecord = nil
@@ -2190,6 +2946,16 @@ func (view *View) Intersect(ctx context.Context, flags *cmd.Flags, calcView *Vie
 	return
 }
 
+func (view *View) filterForAggregateFunctions(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, clause parser.FilterClause) error {
+	if err := view.filter(ctx, scope, clause.Filter); err != nil {
+		if _, ok := err.(*NotGroupingRecordsError); ok {
+			err = NewNestedAggregateFunctionsError(expr)
+		}
+		return err
+	}
+	return nil
+}
+
 func (view *View) ListValuesForAggregateFunctions(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, arg parser.QueryExpression, distinct bool) ([]value.Primary, error) {
 	list := make([]value.Primary, view.RecordLen())
 
@@ -2214,6 +2980,68 @@ func (view *View) ListValuesForAggregateFunctions(ctx context.Context, scope *Re
 	return list, nil
 }
 
+func (view *View) ListPairsForAggregateFunctions(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, yarg parser.QueryExpression, xarg parser.QueryExpression, distinct bool) ([]value.Primary, []value.Primary, error) {
+	ylist, err := view.ListValuesForAggregateFunctions(ctx, scope, expr, yarg, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	xlist, err := view.ListValuesForAggregateFunctions(ctx, scope, expr, xarg, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if distinct {
+		ylist, xlist = DistinguishPairs(ylist, xlist, scope.Tx.Flags)
+	}
+
+	return ylist, xlist, nil
+}
+
+// SketchForAggregateFunctions returns a sketch of the values of the argument.
+// Each goroutine builds its own sketch from its range of the records,
+// and the sketches are merged at the end.
+func (view *View) SketchForAggregateFunctions(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, arg parser.QueryExpression, newSketch func() Sketch) (Sketch, error) {
+	gm := NewGoroutineTaskManager(view.Len(), -1, scope.Tx.Flags.CPU)
+	sketches := make([]Sketch, gm.Number)
+
+	routine := func(thIdx int) {
+		sketches[thIdx] = newSketch()
+		evaluateSequentialRoutine(ctx, scope, view, func(seqScope *ReferenceScope, _ int) error {
+			p, e := Evaluate(ctx, seqScope, arg)
+			if e != nil {
+				if _, ok := e.(*NotGroupingRecordsError); ok {
+					e = NewNestedAggregateFunctionsError(expr)
+				}
+				return e
+			}
+			sketches[thIdx].Add(p, scope.Tx.Flags)
+			return nil
+		}, thIdx, gm)
+	}
+
+	if 1 < gm.Number {
+		for i := 0; i < gm.Number; i++ {
+			gm.Add()
+			go routine(i)
+		}
+		gm.Wait()
+	} else {
+		routine(0)
+	}
+
+	if gm.HasError() {
+		return nil, gm.Err()
+	}
+	if ctx.Err() != nil {
+		return nil, ConvertContextError(ctx.Err())
+	}
+
+	for _, sk := range sketches[1:] {
+		sketches[0].Merge(sk)
+	}
+	return sketches[0], nil
+}
+
 func (view *View) RestoreHeaderReferences() error {
 	return view.Header.Update(parser.FormatTableName(view.FileInfo.Path), nil)
 }
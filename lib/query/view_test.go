@@ -1308,6 +1308,104 @@ var viewLoadTests = []struct {
 		},
 		Error: "file notexist does not exist",
 	},
+	{
+		Name: "LoadView TableObject From XLSX File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type:          parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						FormatElement: parser.NewStringValue("report"),
+						Path:          parser.Identifier{Literal: "table8"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("B3:C5"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"month", "amount"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("Jan"),
+					value.NewString("100"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("Feb"),
+					value.NewString("200"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table8.xlsx",
+				Delimiter: ',',
+				Format:    cmd.XLSX,
+				SheetName: "report",
+				CellRange: "B3:C5",
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table8.xlsx")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView TableObject From XLSX File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "table8"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("A1"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object xlsx takes at most 5 arguments",
+	},
+	{
+		Name: "LoadView TableObject From SQLite Database Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.SQLITE, Literal: "sqlite"},
+						Path: parser.Identifier{Literal: "app.db"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object sqlite takes exactly 2 arguments",
+	},
+	{
+		Name: "LoadView TableObject From SQLite Database Query For Update Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableObject{
+						Type: parser.Token{Token: parser.SQLITE, Literal: "sqlite"},
+						Path: parser.Identifier{Literal: "app.db"},
+						Args: []parser.QueryExpression{parser.NewStringValue("select * from users")},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		ForUpdate: true,
+		Error:     "invalid argument for sqlite: the result of a query cannot be updated",
+	},
 	{
 		Name: "LoadView TableObject From LTSV File",
 		From: parser.FromClause{
@@ -2627,69 +2725,119 @@ var viewLoadTests = []struct {
 		Error: "table name t is a duplicate",
 	},
 	{
-		Name: "LoadView CSV Parse Error",
+		Name: "LoadView Data-Modifying Subquery with Table Name Alias",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "table_broken.csv"},
+					Object: parser.DataModifyingSubquery{
+						Query: parser.DeleteQuery{
+							FromClause: parser.FromClause{
+								Tables: []parser.QueryExpression{
+									parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								},
+							},
+							WhereClause: parser.WhereClause{
+								Filter: parser.Comparison{
+									LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									RHS:      parser.NewIntegerValueFromString("2"),
+									Operator: parser.Token{Token: '>', Literal: ">"},
+								},
+							},
+							ReturningClause: parser.ReturningClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.AllColumns{}},
+								},
+							},
+						},
+					},
+					Alias: parser.Identifier{Literal: "alias"},
 				},
 			},
 		},
-		Error: fmt.Sprintf("data parse error in file %s: line 3, column 7: wrong number of fields in line", GetTestFilePath("table_broken.csv")),
+		Result: &View{
+			Header: NewHeader("alias", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"ALIAS": "",
+				},
+			},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "Inner Join Join Error",
+		Name: "LoadView Data-Modifying Subquery Returning Clause Not Specified Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Join{
-						Table: parser.Table{
-							Object: parser.Identifier{Literal: "table1"},
-						},
-						JoinTable: parser.Table{
-							Object: parser.Identifier{Literal: "table2"},
-						},
-						Condition: parser.JoinCondition{
-							On: parser.Comparison{
-								LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "notexist"}},
-								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
-								Operator: parser.Token{Token: '=', Literal: "="},
+					Object: parser.DataModifyingSubquery{
+						Query: parser.DeleteQuery{
+							FromClause: parser.FromClause{
+								Tables: []parser.QueryExpression{
+									parser.Table{Object: parser.Identifier{Literal: "table1"}},
+								},
 							},
 						},
 					},
 				},
 			},
 		},
-		Error: "field table1.notexist does not exist",
+		Error: "data-modifying query used as a table requires a RETURNING clause",
 	},
 	{
-		Name: "Outer Join Join Error",
+		Name: "LoadView Unnest",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Join{
-						Table: parser.Table{
-							Object: parser.Identifier{Literal: "table1"},
-						},
-						JoinTable: parser.Table{
-							Object: parser.Identifier{Literal: "table2"},
-						},
-						Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
-						Condition: parser.JoinCondition{
-							On: parser.Comparison{
-								LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
-								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "notexist"}},
-								Operator: parser.Token{Token: '=', Literal: "="},
+					Object: parser.Unnest{
+						Array: parser.ArrayConstructor{
+							Values: []parser.QueryExpression{
+								parser.NewIntegerValue(1),
+								parser.NewNullValue(),
 							},
 						},
 					},
+					Alias: parser.Identifier{Literal: "u"},
 				},
 			},
 		},
-		Error: "field table2.notexist does not exist",
+		Result: &View{
+			Header: NewHeader("u", []string{"value"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewNull()}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"U": "",
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "Inner Join Using Condition Error",
+		Name: "LoadView Unnest Not Array",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unnest{
+						Array: parser.NewStringValue("str"),
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"value"}),
+			RecordSet: []Record{},
+		},
+	},
+	{
+		Name: "LoadView Lateral Unnest",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
@@ -2698,35 +2846,275 @@ var viewLoadTests = []struct {
 							Object: parser.Identifier{Literal: "table1"},
 						},
 						JoinTable: parser.Table{
-							Object: parser.Identifier{Literal: "table1b"},
-						},
-						Condition: parser.JoinCondition{
-							Using: []parser.QueryExpression{
-								parser.Identifier{Literal: "notexist"},
+							Lateral: parser.Token{Token: parser.LATERAL},
+							Object: parser.Unnest{
+								Array: parser.ArrayConstructor{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+										parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
 							},
+							Alias: parser.Identifier{Literal: "u"},
+						},
+						JoinType: parser.Token{Token: parser.CROSS, Literal: "cross"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "u", Column: "value", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1"), value.NewString("1")}),
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2"), value.NewString("2")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2"), value.NewString("str2")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3"), value.NewString("3")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3"), value.NewString("str3")}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Pivot",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Pivot{
+						Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						Aggregate: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}}},
+						For:       parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						In: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("str1")},
+							parser.Field{Object: parser.NewStringValue("str3"), Alias: parser.Identifier{Literal: "s3"}},
+							parser.Field{Object: parser.NewStringValue("str4")},
 						},
 					},
+					Alias: parser.Identifier{Literal: "p"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("p", []string{"str1", "s3", "str4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+					value.NewInteger(1),
+					value.NewInteger(0),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+				"P":      "",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Pivot with Any",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Pivot{
+						Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						Aggregate: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}},
+						For:       parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Any:       parser.Token{Token: parser.ANY, Literal: "any"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("table1", []string{"column2", "1", "2", "3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("str1"),
+					value.NewInteger(1),
+					value.NewInteger(0),
+					value.NewInteger(0),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str2"),
+					value.NewInteger(0),
+					value.NewInteger(1),
+					value.NewInteger(0),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("str3"),
+					value.NewInteger(0),
+					value.NewInteger(0),
+					value.NewInteger(1),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Pivot Field Does Not Exist Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Pivot{
+						Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						Aggregate: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}},
+						For:       parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+						Any:       parser.Token{Token: parser.ANY, Literal: "any"},
+					},
 				},
 			},
 		},
 		Error: "field notexist does not exist",
 	},
-}
-
-func TestView_Load(t *testing.T) {
-	defer func() {
-		_ = TestTx.ReleaseResources()
-		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
-		_ = TestTx.Session.SetStdin(os.Stdin)
-		initFlag(TestTx.Flags)
-	}()
-
-	TestTx.Flags.Repository = TestDir
-	ctx := context.Background()
-
-	for _, v := range viewLoadTests {
-		TestTx.UnlockStdin()
-		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+	{
+		Name: "LoadView Unpivot",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unpivot{
+						Table: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						Value: parser.Identifier{Literal: "val"},
+						Name:  parser.Identifier{Literal: "col"},
+						In: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+							parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+						},
+					},
+					Alias: parser.Identifier{Literal: "u"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("u", []string{"col", "val"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("column3"), value.NewString("2")}),
+				NewRecord([]value.Primary{value.NewString("column4"), value.NewString("str22")}),
+				NewRecord([]value.Primary{value.NewString("column3"), value.NewString("3")}),
+				NewRecord([]value.Primary{value.NewString("column4"), value.NewString("str33")}),
+				NewRecord([]value.Primary{value.NewString("column3"), value.NewString("4")}),
+				NewRecord([]value.Primary{value.NewString("column4"), value.NewString("str44")}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE2": strings.ToUpper(GetTestFilePath("table2.csv")),
+				"U":      "",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView CSV Parse Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_broken.csv"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("data parse error in file %s: line 3, column 7: wrong number of fields in line", GetTestFilePath("table_broken.csv")),
+	},
+	{
+		Name: "Inner Join Join Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "notexist"}},
+								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+								Operator: parser.Token{Token: '=', Literal: "="},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "field table1.notexist does not exist",
+	},
+	{
+		Name: "Outer Join Join Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+						},
+						Direction: parser.Token{Token: parser.LEFT, Literal: "left"},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "notexist"}},
+								Operator: parser.Token{Token: '=', Literal: "="},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "field table2.notexist does not exist",
+	},
+	{
+		Name: "Inner Join Using Condition Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table1b"},
+						},
+						Condition: parser.JoinCondition{
+							Using: []parser.QueryExpression{
+								parser.Identifier{Literal: "notexist"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestView_Load(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
+		_ = TestTx.Session.SetStdin(os.Stdin)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range viewLoadTests {
+		TestTx.UnlockStdin()
+		_ = TestTx.cachedViews.Clean(TestTx.FileContainer)
 
 		_ = TestTx.Session.SetStdin(os.Stdin)
 		TestTx.Flags.ImportOptions.Format = v.ImportFormat
@@ -3267,6 +3655,461 @@ var viewGroupByTests = []struct {
 			isGrouped: true,
 		},
 	},
+	{
+		Name: "Group By Rollup",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("group1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("group2")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("group1")}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.Token{Token: parser.ROLLUP, Literal: "rollup"},
+					Sets: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					Column: GroupingIdColumn,
+				},
+				{
+					View:   "table1",
+					Column: GroupingIdColumn,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+					NewCell(value.NewString("0")),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group1")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewString("2")}),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+					NewCell(value.NewString("0")),
+					NewGroupCell([]value.Primary{value.NewString("group2")}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewString("1"), value.NewString("2"), value.NewString("3")}),
+					NewGroupCell([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+					NewCell(value.NewString("1")),
+					NewGroupCell([]value.Primary{value.NewString("group1"), value.NewString("group2"), value.NewString("group1")}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Rollup With Empty Records",
+		View: &View{
+			Header:    NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.Token{Token: parser.ROLLUP, Literal: "rollup"},
+					Sets: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column: GroupingIdColumn,
+				},
+				{
+					View:   "table1",
+					Column: GroupingIdColumn,
+				},
+			},
+			RecordSet: []Record{
+				{
+					Cell{},
+					NewCell(value.NewNull()),
+					Cell{},
+					NewCell(value.NewString("1")),
+					Cell{},
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By With TimeBucketGapfill",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2", "column3"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("a"),
+					value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(1),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewString("b"),
+					value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(2),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewString("a"),
+					value.NewDatetime(time.Date(2012, 1, 3, 11, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(3),
+				}),
+				NewRecordWithId(4, []value.Primary{
+					value.NewString("a"),
+					value.NewNull(),
+					value.NewInteger(4),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.Function{
+					Name: "time_bucket_gapfill",
+					Args: []parser.QueryExpression{
+						parser.NewStringValue("P1D"),
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column3",
+					Number:      3,
+					IsFromTable: true,
+				},
+				{
+					Column:     "TIME_BUCKET_GAPFILL('P1D', column2)",
+					IsGroupKey: true,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewInteger(1)}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation()))}),
+				},
+				{
+					Cell{},
+					NewCell(value.NewString("a")),
+					Cell{},
+					Cell{},
+					NewCell(value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation()))),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 3, 11, 0, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 3, 0, 0, 0, 0, GetTestLocation()))}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(4)}),
+					NewGroupCell([]value.Primary{value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewInteger(4)}),
+					NewGroupCell([]value.Primary{value.NewNull()}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("b")}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation()))}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By With TimeBucketGapfill Interval Error",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewString("a"),
+					value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.Function{
+					Name: "time_bucket_gapfill",
+					Args: []parser.QueryExpression{
+						parser.NewStringValue("abc"),
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+			},
+		},
+		Error: "the first argument must be a positive interval of either months or days and time for function time_bucket_gapfill",
+	},
+	{
+		Name: "Group By With TimeBucketGapfill Limit Error",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewDatetime(time.Date(2012, 1, 3, 0, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.Function{
+					Name: "time_bucket_gapfill",
+					Args: []parser.QueryExpression{
+						parser.NewStringValue("PT1S"),
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "the number of generated buckets exceeds the limit of 100000 for function time_bucket_gapfill",
+	},
+	{
+		Name: "Group By With TimeBucketGapfill Without Internal Id",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+					value.NewDatetime(time.Date(2012, 1, 3, 11, 0, 0, 0, GetTestLocation())),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.Function{
+					Name: "time_bucket_gapfill",
+					Args: []parser.QueryExpression{
+						parser.NewStringValue("P1D"),
+						parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column:     "TIME_BUCKET_GAPFILL('P1D', column2)",
+					IsGroupKey: true,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 0, 0, 0, 0, GetTestLocation()))}),
+				},
+				{
+					Cell{},
+					NewCell(value.NewString("a")),
+					Cell{},
+					NewCell(value.NewDatetime(time.Date(2012, 1, 2, 0, 0, 0, 0, GetTestLocation()))),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 3, 11, 0, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 3, 0, 0, 0, 0, GetTestLocation()))}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By With TimeBucket",
+		View: &View{
+			Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(1, []value.Primary{
+					value.NewDatetime(time.Date(2012, 1, 1, 10, 10, 0, 0, GetTestLocation())),
+					value.NewInteger(1),
+				}),
+				NewRecordWithId(2, []value.Primary{
+					value.NewDatetime(time.Date(2012, 1, 1, 10, 50, 0, 0, GetTestLocation())),
+					value.NewInteger(2),
+				}),
+				NewRecordWithId(3, []value.Primary{
+					value.NewDatetime(time.Date(2012, 1, 1, 12, 10, 0, 0, GetTestLocation())),
+					value.NewInteger(3),
+				}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.Function{
+					Name: "time_bucket",
+					Args: []parser.QueryExpression{
+						parser.NewStringValue("PT1H"),
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:   "table1",
+					Column: InternalIdColumn,
+				},
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column:     "TIME_BUCKET('PT1H', column1)",
+					IsGroupKey: true,
+				},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{
+						value.NewDatetime(time.Date(2012, 1, 1, 10, 10, 0, 0, GetTestLocation())),
+						value.NewDatetime(time.Date(2012, 1, 1, 10, 50, 0, 0, GetTestLocation())),
+					}),
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{
+						value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation())),
+						value.NewDatetime(time.Date(2012, 1, 1, 10, 0, 0, 0, GetTestLocation())),
+					}),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 12, 10, 0, 0, GetTestLocation()))}),
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewDatetime(time.Date(2012, 1, 1, 12, 0, 0, 0, GetTestLocation()))}),
+				},
+			},
+			isGrouped: true,
+		},
+	},
+	{
+		Name: "Group By Grouping Sets Field Does Not Exist Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("group1")}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.Token{Token: parser.SETS, Literal: "sets"},
+					Sets: []parser.QueryExpression{
+						parser.GroupingSet{
+							Values: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+							},
+						},
+						parser.GroupingSet{},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
 }
 
 func TestView_GroupBy(t *testing.T) {
@@ -0,0 +1,316 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxDefaultSheetName = "Sheet1"
+
+var xlsxDatetimeFormat = "yyyy-mm-dd hh:mm:ss"
+
+// xlsxCellRange is a rectangle of cells in a sheet.
+// The zero values of the EndCol and EndRow mean that the range has no limit.
+type xlsxCellRange struct {
+	StartCol int
+	StartRow int
+	EndCol   int
+	EndRow   int
+}
+
+func parseXlsxCellRange(s string) (xlsxCellRange, error) {
+	r := xlsxCellRange{StartCol: 1, StartRow: 1}
+
+	s = cmd.TrimSpace(s)
+	if len(s) < 1 {
+		return r, nil
+	}
+
+	cells := strings.Split(s, ":")
+	if 2 < len(cells) {
+		return r, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+	}
+
+	var err error
+	if r.StartCol, r.StartRow, err = excelize.CellNameToCoordinates(cmd.TrimSpace(cells[0])); err != nil {
+		return r, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+	}
+	if len(cells) == 2 {
+		if r.EndCol, r.EndRow, err = excelize.CellNameToCoordinates(cmd.TrimSpace(cells[1])); err != nil {
+			return r, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+		}
+		if r.EndCol < r.StartCol || r.EndRow < r.StartRow {
+			return r, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+		}
+	}
+	return r, nil
+}
+
+func (r xlsxCellRange) containsRow(row int) bool {
+	return r.StartRow <= row && (r.EndRow < 1 || row <= r.EndRow)
+}
+
+func (r xlsxCellRange) containsCol(col int) bool {
+	return r.StartCol <= col && (r.EndCol < 1 || col <= r.EndCol)
+}
+
+func xlsxSheetName(book *excelize.File, sheetName string) (string, error) {
+	list := book.GetSheetList()
+	if len(sheetName) < 1 {
+		if len(list) < 1 {
+			return xlsxDefaultSheetName, nil
+		}
+		return list[0], nil
+	}
+
+	for _, name := range list {
+		if strings.EqualFold(name, sheetName) {
+			return name, nil
+		}
+	}
+	return sheetName, errors.New(fmt.Sprintf("sheet %s does not exist", sheetName))
+}
+
+func loadViewFromXlsxFile(ctx context.Context, fp io.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	cellRange, err := parseXlsxCellRange(fileInfo.CellRange)
+	if err != nil {
+		return nil, err
+	}
+
+	book, err := excelize.OpenReader(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	defer func() {
+		_ = book.Close()
+	}()
+
+	sheetName, err := xlsxSheetName(book, fileInfo.SheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := book.GetRows(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	cells := make([][]string, 0, len(rows))
+	fieldLen := 0
+	for i := range rows {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		if !cellRange.containsRow(i + 1) {
+			continue
+		}
+
+		row := make([]string, 0, len(rows[i]))
+		for j := range rows[i] {
+			if cellRange.containsCol(j + 1) {
+				row = append(row, rows[i][j])
+			}
+		}
+		if fieldLen < len(row) {
+			fieldLen = len(row)
+		}
+		cells = append(cells, row)
+	}
+	if 0 < cellRange.EndCol {
+		fieldLen = cellRange.EndCol - cellRange.StartCol + 1
+	}
+
+	var header []string
+	if !fileInfo.NoHeader && 0 < len(cells) {
+		header = cells[0]
+		cells = cells[1:]
+	}
+	if len(header) < fieldLen {
+		header = append(header, make([]string, fieldLen-len(header))...)
+	}
+	for i := range header {
+		if len(header[i]) < 1 {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	records := make(RecordSet, len(cells))
+	for i := range cells {
+		record := make([]value.Primary, len(header))
+		for j := range record {
+			if j < len(cells[i]) && 0 < len(cells[i][j]) {
+				record[j] = value.NewString(cells[i][j])
+			} else if withoutNull {
+				record[j] = value.NewString("")
+			} else {
+				record[j] = value.NewNull()
+			}
+		}
+		records[i] = NewRecord(record)
+	}
+
+	fileInfo.SheetName = sheetName
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(parser.FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func encodeXlsx(ctx context.Context, fp io.Writer, view *View, options cmd.ExportOptions) error {
+	book := excelize.NewFile()
+	defer func() {
+		_ = book.Close()
+	}()
+
+	if err := writeXlsxSheet(ctx, book, xlsxDefaultSheetName, xlsxCellRange{StartCol: 1, StartRow: 1}, view, options.WithoutHeader); err != nil {
+		return err
+	}
+	return book.Write(fp)
+}
+
+// updateXlsxFile writes the view into the sheet of the workbook data, and writes
+// the updated workbook to fp.
+// Other sheets and the cells outside of the range of the view are preserved.
+func updateXlsxFile(ctx context.Context, fp io.Writer, data []byte, view *View, fileInfo *FileInfo, options cmd.ExportOptions) error {
+	cellRange, err := parseXlsxCellRange(fileInfo.CellRange)
+	if err != nil {
+		return err
+	}
+
+	var book *excelize.File
+	if 0 < len(data) {
+		// The data is not a workbook if the format of the table has been changed.
+		book, _ = excelize.OpenReader(bytes.NewReader(data))
+	}
+	if book == nil {
+		book = excelize.NewFile()
+	}
+	defer func() {
+		_ = book.Close()
+	}()
+
+	sheetName := fileInfo.SheetName
+	if len(sheetName) < 1 {
+		sheetName, _ = xlsxSheetName(book, "")
+	}
+	if idx, _ := book.GetSheetIndex(sheetName); idx < 0 {
+		if _, err = book.NewSheet(sheetName); err != nil {
+			return err
+		}
+	} else if err = clearXlsxCells(book, sheetName, cellRange); err != nil {
+		return err
+	}
+
+	if err = writeXlsxSheet(ctx, book, sheetName, cellRange, view, options.WithoutHeader); err != nil {
+		return err
+	}
+
+	return book.Write(fp)
+}
+
+func clearXlsxCells(book *excelize.File, sheetName string, cellRange xlsxCellRange) error {
+	rows, err := book.GetRows(sheetName, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return err
+	}
+
+	for i := range rows {
+		if !cellRange.containsRow(i + 1) {
+			continue
+		}
+		for j := range rows[i] {
+			if !cellRange.containsCol(j+1) || len(rows[i][j]) < 1 {
+				continue
+			}
+			cell, _ := excelize.CoordinatesToCellName(j+1, i+1)
+			if err = book.SetCellValue(sheetName, cell, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeXlsxSheet(ctx context.Context, book *excelize.File, sheetName string, cellRange xlsxCellRange, view *View, withoutHeader bool) error {
+	datetimeStyle, err := book.NewStyle(&excelize.Style{CustomNumFmt: &xlsxDatetimeFormat})
+	if err != nil {
+		return err
+	}
+
+	row := cellRange.StartRow
+	if !withoutHeader {
+		for i := range view.Header {
+			cell, _ := excelize.CoordinatesToCellName(cellRange.StartCol+i, row)
+			if err = book.SetCellStr(sheetName, cell, view.Header[i].Column); err != nil {
+				return NewDataEncodingError(err.Error())
+			}
+		}
+		row++
+	}
+
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			cell, _ := excelize.CoordinatesToCellName(cellRange.StartCol+j, row)
+
+			var v interface{}
+			switch p := view.RecordSet[i][j][0].(type) {
+			case *value.String:
+				v = p.Raw()
+			case *value.Integer:
+				v = p.Raw()
+			case *value.Float:
+				v = p.Raw()
+			case *value.Decimal:
+				v = p.Float64()
+			case *value.Boolean:
+				v = p.Raw()
+			case *value.Ternary:
+				if p.Ternary() != ternary.UNKNOWN {
+					v = p.Ternary().ParseBool()
+				}
+			case *value.Datetime:
+				v = p.Raw()
+			case *value.Interval:
+				v = p.String()
+			case *value.Array:
+				v, _, _ = ConvertFieldContents(p, false)
+			}
+			if v == nil {
+				continue
+			}
+
+			if err = book.SetCellValue(sheetName, cell, v); err != nil {
+				return NewDataEncodingError(err.Error())
+			}
+			if _, ok := v.(time.Time); ok {
+				if err = book.SetCellStyle(sheetName, cell, cell, datetimeStyle); err != nil {
+					return NewDataEncodingError(err.Error())
+				}
+			}
+		}
+		row++
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+var loadViewFromXlsxFileTests = []struct {
+	Name        string
+	SheetName   string
+	CellRange   string
+	NoHeader    bool
+	WithoutNull bool
+	Result      *View
+	Error       string
+}{
+	{
+		Name: "Load Xlsx File",
+		Result: &View{
+			Header: NewHeader("table8", []string{"id", "name", "price", "created"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+					value.NewString("12.5"),
+					value.NewString("2012-02-03 09:18:15"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewNull(),
+					value.NewString("3"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+					value.NewNull(),
+					value.NewNull(),
+				}),
+			},
+		},
+	},
+	{
+		Name:        "Load Xlsx File Without Null",
+		CellRange:   "A1:B3",
+		WithoutNull: true,
+		Result: &View{
+			Header: NewHeader("table8", []string{"id", "name"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString(""),
+				}),
+			},
+		},
+	},
+	{
+		Name:      "Load Xlsx File with Sheet Name and Cell Range",
+		SheetName: "REPORT",
+		CellRange: "B3:C5",
+		Result: &View{
+			Header: NewHeader("table8", []string{"month", "amount"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("Jan"),
+					value.NewString("100"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("Feb"),
+					value.NewString("200"),
+				}),
+			},
+		},
+	},
+	{
+		Name:      "Load Xlsx File with Start Cell and No Header",
+		SheetName: "report",
+		CellRange: "C4",
+		NoHeader:  true,
+		Result: &View{
+			Header: NewHeader("table8", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("100")}),
+				NewRecord([]value.Primary{value.NewString("200")}),
+				NewRecord([]value.Primary{value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("300")}),
+			},
+		},
+	},
+	{
+		Name:      "Load Xlsx File Sheet Not Exist Error",
+		SheetName: "notexist",
+		Error:     "sheet notexist does not exist",
+	},
+	{
+		Name:      "Load Xlsx File Invalid Cell Range Error",
+		CellRange: "C4:A1",
+		Error:     "invalid cell range: C4:A1",
+	},
+}
+
+func TestLoadViewFromXlsxFile(t *testing.T) {
+	for _, v := range loadViewFromXlsxFileTests {
+		fp, err := os.Open(GetTestFilePath("table8.xlsx"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fileInfo := &FileInfo{
+			Path:      GetTestFilePath("table8.xlsx"),
+			Format:    cmd.XLSX,
+			SheetName: v.SheetName,
+			CellRange: v.CellRange,
+			NoHeader:  v.NoHeader,
+		}
+		view, err := loadViewFromXlsxFile(context.Background(), fp, fileInfo, v.WithoutNull, parser.Identifier{Literal: "table8"})
+		_ = fp.Close()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, view.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %v, want %v", v.Name, view.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
+
+func TestEncodeXlsx(t *testing.T) {
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewInteger(1),
+				value.NewString("str1"),
+				value.NewBoolean(true),
+				value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			}),
+			NewRecord([]value.Primary{
+				value.NewFloat(1.5),
+				value.NewNull(),
+				value.NewTernary(ternary.UNKNOWN),
+				value.NewNull(),
+			}),
+		},
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{
+			value.NewString("1"),
+			value.NewString("str1"),
+			value.NewString("TRUE"),
+			value.NewString("2012-02-03 09:18:15"),
+		}),
+		NewRecord([]value.Primary{
+			value.NewString("1.5"),
+			value.NewNull(),
+			value.NewNull(),
+			value.NewNull(),
+		}),
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err := EncodeView(context.Background(), buf, view, cmd.ExportOptions{Format: cmd.XLSX}, nil); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	fileInfo := &FileInfo{Path: "test.xlsx", Format: cmd.XLSX}
+	loaded, err := loadViewFromXlsxFile(context.Background(), bytes.NewReader(buf.Bytes()), fileInfo, false, parser.Identifier{Literal: "test"})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(loaded.Header, view.Header) {
+		t.Errorf("header = %v, want %v", loaded.Header, view.Header)
+	}
+	if !reflect.DeepEqual(loaded.RecordSet, expect) {
+		t.Errorf("records = %v, want %v", loaded.RecordSet, expect)
+	}
+	if fileInfo.SheetName != "Sheet1" {
+		t.Errorf("sheet name = %q, want %q", fileInfo.SheetName, "Sheet1")
+	}
+}
+
+func TestUpdateXlsxFile(t *testing.T) {
+	data, err := os.ReadFile(GetTestFilePath("table8.xlsx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	view := &View{
+		Header: NewHeader("table8", []string{"month", "amount"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{
+				value.NewString("Mar"),
+				value.NewInteger(300),
+			}),
+		},
+	}
+	fileInfo := &FileInfo{
+		Path:      GetTestFilePath("table8.xlsx"),
+		Format:    cmd.XLSX,
+		SheetName: "report",
+		CellRange: "B3:C5",
+	}
+
+	buf := &bytes.Buffer{}
+	if err := updateXlsxFile(context.Background(), buf, data, view, fileInfo, cmd.ExportOptions{Format: cmd.XLSX}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	loadSheet := func(sheetName string) *View {
+		v, err := loadViewFromXlsxFile(
+			context.Background(),
+			bytes.NewReader(buf.Bytes()),
+			&FileInfo{Path: fileInfo.Path, Format: cmd.XLSX, SheetName: sheetName, NoHeader: true},
+			false,
+			parser.Identifier{Literal: "table8"},
+		)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		return v
+	}
+
+	expect := RecordSet{
+		NewRecord([]value.Primary{value.NewString("Monthly Report"), value.NewNull(), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewString("month"), value.NewString("amount")}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewString("Mar"), value.NewString("300")}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+		NewRecord([]value.Primary{value.NewNull(), value.NewString("Total"), value.NewString("300")}),
+	}
+	if result := loadSheet("report").RecordSet; !reflect.DeepEqual(result, expect) {
+		t.Errorf("records = %v, want %v", result, expect)
+	}
+
+	if result := loadSheet("items").RecordSet; len(result) != 4 {
+		t.Errorf("records length of the other sheet = %d, want %d", len(result), 4)
+	}
+}
@@ -0,0 +1,393 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/ternary"
+)
+
+// MaxHTTPRequestSize is the maximum size of a request body accepted by the HTTPServer.
+const MaxHTTPRequestSize = 32 * 1024 * 1024
+
+const defaultHTTPFormat = "JSON"
+
+// HTTPRequest represents a JSON object posted to the HTTPServer.
+//
+// Params is an array of the values for the ordinal placeholders, or an object of the values
+// for the named placeholders. Timeout is specified in seconds.
+type HTTPRequest struct {
+	Query   string          `json:"query"`
+	Params  json.RawMessage `json:"params"`
+	Format  string          `json:"format"`
+	Timeout float64         `json:"timeout"`
+}
+
+type httpError struct {
+	status  int
+	number  int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func newHTTPBadRequestError(message string) error {
+	return &httpError{
+		status:  http.StatusBadRequest,
+		message: message,
+	}
+}
+
+// HTTPServer serves csvq to HTTP clients.
+//
+// Statements are posted to the path "/query" as JSON objects, and the last result set is returned in the requested format.
+// Each request is processed by its own processor created by the ProcessorFactory, and committed
+// on success or rolled back on failure.
+type HTTPServer struct {
+	newProcessor ProcessorFactory
+	timeout      time.Duration
+	token        string
+	logError     func(string)
+}
+
+// NewHTTPServer returns an HTTPServer.
+// The timeout limits the execution time of a request. Zero means no limit.
+// If the token is not empty, requests must have the token in the Authorization header as a bearer token.
+func NewHTTPServer(newProcessor ProcessorFactory, timeout time.Duration, token string, logError func(string)) *HTTPServer {
+	return &HTTPServer{
+		newProcessor: newProcessor,
+		timeout:      timeout,
+		token:        token,
+		logError:     logError,
+	}
+}
+
+// Serve accepts HTTP requests on the listener until the context is done.
+func (s *HTTPServer) Serve(ctx context.Context, listener net.Listener) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/query", s.handleQuery)
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(_ net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = srv.Shutdown(shutdownCtx)
+		case <-done:
+		}
+	}()
+
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *HTTPServer) handleQuery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		s.writeError(w, &httpError{status: http.StatusMethodNotAllowed, message: "method must be POST"})
+		return
+	}
+
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		s.writeError(w, &httpError{status: http.StatusUnauthorized, message: "invalid token"})
+		return
+	}
+
+	req, err := readHTTPRequest(r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	values, err := decodeHTTPParams(req.Params)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	if timeout := s.requestTimeout(req.Timeout); 0 < timeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	proc, err := s.newProcessor(ctx)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	proc.Tx.ServerMode = true
+	defer func() {
+		if e := proc.ReleaseResourcesWithErrors(); e != nil {
+			s.logError(e.Error())
+		}
+	}()
+
+	format := req.Format
+	if len(format) < 1 {
+		format = defaultHTTPFormat
+	}
+	if err = proc.Tx.SetFormatFlag(format, ""); err != nil {
+		s.writeError(w, newHTTPBadRequestError(err.Error()))
+		return
+	}
+
+	view, affectedRows, err := executeHTTPQuery(ctx, proc, req.Query, values)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	if view == nil {
+		s.writeJSON(w, http.StatusOK, map[string]int{"affected_rows": affectedRows})
+		return
+	}
+
+	options := proc.Tx.Flags.ExportOptions.Copy()
+	options.Color = false
+	options.Encoding = text.UTF8
+
+	buf := &bytes.Buffer{}
+	if _, err = query.EncodeView(ctx, buf, view, options, proc.Tx.Palette); err != nil && err != query.EmptyResultSetError && err != query.DataEmpty {
+		s.writeError(w, err)
+		return
+	}
+	if !options.StripEndingLineBreak && !isBinaryHTTPFormat(options.Format) && 0 < buf.Len() {
+		buf.WriteString(options.LineBreak.Value())
+	}
+
+	w.Header().Set("Content-Type", httpContentType(options.Format))
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (s *HTTPServer) authorized(r *http.Request) bool {
+	if len(s.token) < 1 {
+		return true
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func (s *HTTPServer) requestTimeout(seconds float64) time.Duration {
+	timeout := time.Duration(seconds * float64(time.Second))
+	if timeout <= 0 || (0 < s.timeout && s.timeout < timeout) {
+		return s.timeout
+	}
+	return timeout
+}
+
+func (s *HTTPServer) writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	number := 0
+
+	switch e := err.(type) {
+	case *httpError:
+		status = e.status
+		number = e.number
+	case *query.SyntaxError, *query.PreparedStatementSyntaxError, *query.StatementReplaceValueNotSpecifiedError:
+		status = http.StatusBadRequest
+	case *query.StatementNotAllowedError:
+		status = http.StatusForbidden
+	case *query.FileLockTimeoutError:
+		status = http.StatusConflict
+	case *query.ContextCanceled, *query.ContextDone:
+		status = http.StatusGatewayTimeout
+	default:
+		if _, ok := err.(query.Error); ok {
+			status = http.StatusUnprocessableEntity
+		} else {
+			s.logError(err.Error())
+		}
+	}
+	if appErr, ok := err.(query.Error); ok {
+		number = appErr.Number()
+	}
+
+	body := map[string]interface{}{"error": err.Error()}
+	if 0 < number {
+		body["number"] = number
+	}
+	s.writeJSON(w, status, body)
+}
+
+func (s *HTTPServer) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, _ := json.Marshal(v)
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(b, '\n'))
+}
+
+// readHTTPRequest reads a request from a JSON object.
+//
+// Other content types are rejected so that requests from web pages in other origins
+// cannot be sent without the preflight of CORS.
+func readHTTPRequest(r *http.Request) (*HTTPRequest, error) {
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		return nil, &httpError{status: http.StatusUnsupportedMediaType, message: "content type must be application/json"}
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, MaxHTTPRequestSize))
+	if err != nil {
+		return nil, &httpError{status: http.StatusRequestEntityTooLarge, message: err.Error()}
+	}
+
+	req := &HTTPRequest{}
+	if err = json.Unmarshal(body, req); err != nil {
+		return nil, newHTTPBadRequestError(fmt.Sprintf("invalid request: %s", err.Error()))
+	}
+
+	if len(strings.TrimSpace(req.Query)) < 1 {
+		return nil, newHTTPBadRequestError("query is empty")
+	}
+	return req, nil
+}
+
+// decodeHTTPParams converts the parameters in a request to values for placeholders.
+// It returns nil if no parameter is specified.
+func decodeHTTPParams(params json.RawMessage) (*query.ReplaceValues, error) {
+	if len(params) < 1 || string(params) == "null" {
+		return nil, nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(params))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, newHTTPBadRequestError(fmt.Sprintf("invalid params: %s", err.Error()))
+	}
+
+	switch p := v.(type) {
+	case []interface{}:
+		values := make([]parser.QueryExpression, len(p))
+		for i := range p {
+			e, err := httpParamValue(p[i])
+			if err != nil {
+				return nil, err
+			}
+			values[i] = e
+		}
+		return &query.ReplaceValues{Values: values, Names: make(map[string]int)}, nil
+	case map[string]interface{}:
+		replace := &query.ReplaceValues{
+			Values: make([]parser.QueryExpression, 0, len(p)),
+			Names:  make(map[string]int, len(p)),
+		}
+		for name := range p {
+			e, err := httpParamValue(p[name])
+			if err != nil {
+				return nil, err
+			}
+			replace.Names[strings.TrimPrefix(name, ":")] = len(replace.Values)
+			replace.Values = append(replace.Values, e)
+		}
+		return replace, nil
+	}
+	return nil, newHTTPBadRequestError("params must be an array or an object")
+}
+
+func httpParamValue(v interface{}) (parser.QueryExpression, error) {
+	switch p := v.(type) {
+	case nil:
+		return parser.NewNullValue(), nil
+	case bool:
+		return parser.NewTernaryValue(ternary.ConvertFromBool(p)), nil
+	case string:
+		return parser.NewStringValue(p), nil
+	case json.Number:
+		if i, err := p.Int64(); err == nil {
+			return parser.NewIntegerValue(i), nil
+		}
+		f, err := p.Float64()
+		if err != nil {
+			return nil, newHTTPBadRequestError(fmt.Sprintf("invalid number in params: %s", p.String()))
+		}
+		return parser.NewFloatValue(f), nil
+	}
+	return nil, newHTTPBadRequestError("values in params must be strings, numbers, booleans or nulls")
+}
+
+// executeHTTPQuery executes the statements and returns the last result set and the number of rows affected by the last statement.
+func executeHTTPQuery(ctx context.Context, proc *query.Processor, q string, values *query.ReplaceValues) (*query.View, int, error) {
+	statements, _, err := parser.Parse(q, "", proc.Tx.Flags.DatetimeFormat, values != nil, proc.Tx.Flags.AnsiQuotes)
+	if err != nil {
+		return nil, 0, query.NewSyntaxError(err.(*parser.SyntaxError))
+	}
+
+	ctx = query.ContextForStoringResults(ctx)
+	if values != nil {
+		ctx = query.ContextForPreparedStatement(ctx, values)
+	}
+
+	proc.Tx.AutoCommit = true
+	if _, err = proc.Execute(ctx, statements); err != nil {
+		if e := proc.AutoRollback(); e != nil {
+			proc.LogError(e.Error())
+		}
+		return nil, 0, err
+	}
+
+	var view *query.View
+	if views := proc.Tx.SelectedViews; 0 < len(views) {
+		view = views[len(views)-1]
+	}
+	return view, proc.Tx.AffectedRows, nil
+}
+
+func isBinaryHTTPFormat(format cmd.Format) bool {
+	return format == cmd.PARQUET || format == cmd.XLSX
+}
+
+func httpContentType(format cmd.Format) string {
+	switch format {
+	case cmd.CSV:
+		return "text/csv; charset=utf-8"
+	case cmd.TSV:
+		return "text/tab-separated-values; charset=utf-8"
+	case cmd.JSON:
+		return "application/json; charset=utf-8"
+	case cmd.GFM:
+		return "text/markdown; charset=utf-8"
+	case cmd.PARQUET:
+		return "application/vnd.apache.parquet"
+	case cmd.XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/plain; charset=utf-8"
+}
@@ -0,0 +1,297 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+func newHTTPTestServer(t *testing.T) (*HTTPServer, string) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "table1.csv"), []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	newProcessor := func(ctx context.Context) (*query.Processor, error) {
+		session := query.NewSession()
+		session.SetStdout(query.NewDiscard())
+		session.SetStderr(query.NewDiscard())
+		_ = session.SetStdin(nil)
+
+		tx, err := query.NewTransaction(ctx, file.DefaultWaitTimeout, file.DefaultRetryDelay, session)
+		if err != nil {
+			return nil, err
+		}
+		if err = tx.SetFlag(cmd.RepositoryFlag, dir); err != nil {
+			return nil, err
+		}
+		return query.NewProcessor(tx), nil
+	}
+
+	return NewHTTPServer(newProcessor, time.Minute, "", func(s string) { t.Log(s) }), dir
+}
+
+var httpServerQueryTests = []struct {
+	Name        string
+	Method      string
+	ContentType string
+	URL         string
+	Body        string
+	Status      int
+	Type        string
+	Result      string
+	File        string
+}{
+	{
+		Name:        "JSON Request",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT * FROM table1 WHERE column1 = ?", "params": [2]}`,
+		Status:      http.StatusOK,
+		Type:        "application/json; charset=utf-8",
+		Result:      "[{\"column1\":\"2\",\"column2\":\"str2\"}]\n",
+	},
+	{
+		Name:        "JSON Request with Named Parameters and Format",
+		ContentType: "application/json; charset=utf-8",
+		Body:        `{"query": "SELECT column2, :n * 2 AS n FROM table1 WHERE column2 = :s", "params": {"s": "str3", "n": 1.5}, "format": "csv"}`,
+		Status:      http.StatusOK,
+		Type:        "text/csv; charset=utf-8",
+		Result:      "column2,n\nstr3,3\n",
+	},
+	{
+		Name:        "JSON Request with TSV Format",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT column1, column2 FROM table1 WHERE column1 > 2", "format": "tsv"}`,
+		Status:      http.StatusOK,
+		Type:        "text/tab-separated-values; charset=utf-8",
+		Result:      "column1\tcolumn2\n3\tstr3\n",
+	},
+	{
+		Name:        "Update Statement",
+		ContentType: "application/json",
+		Body:        `{"query": "UPDATE table1 SET column2 = $2 WHERE column1 = $1", "params": [1, "updated"]}`,
+		Status:      http.StatusOK,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"affected_rows\":1}\n",
+		File:        "column1,column2\n1,updated\n2,str2\n3,str3\n",
+	},
+	{
+		Name:        "Syntax Error",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT FROM"}`,
+		Status:      http.StatusBadRequest,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:8] syntax error: unexpected token \\\"FROM\\\"\",\"number\":90040}\n",
+	},
+	{
+		Name:        "Query Error",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT * FROM notexist"}`,
+		Status:      http.StatusUnprocessableEntity,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:15] file notexist does not exist\",\"number\":90181}\n",
+	},
+	{
+		Name:        "Invalid Format Error",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT 1", "format": "invalid"}`,
+		Status:      http.StatusBadRequest,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"format must be one of CSV|TSV|FIXED|JSON|LTSV|PARQUET|XLSX|GFM|ORG|TEXT\"}\n",
+	},
+	{
+		Name:        "Invalid Params Error",
+		ContentType: "application/json",
+		Body:        `{"query": "SELECT ?", "params": [[1]]}`,
+		Status:      http.StatusBadRequest,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"values in params must be strings, numbers, booleans or nulls\"}\n",
+	},
+	{
+		Name:        "Empty Query Error",
+		ContentType: "application/json",
+		Body:        `{"query": " "}`,
+		Status:      http.StatusBadRequest,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"query is empty\"}\n",
+	},
+	{
+		Name:   "Unsupported Content Type Error",
+		Body:   "SELECT 1",
+		Status: http.StatusUnsupportedMediaType,
+		Type:   "application/json; charset=utf-8",
+		Result: "{\"error\":\"content type must be application/json\"}\n",
+	},
+	{
+		Name:        "Text Content Type Error",
+		ContentType: "text/plain",
+		Body:        "$ echo foo",
+		Status:      http.StatusUnsupportedMediaType,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"content type must be application/json\"}\n",
+	},
+	{
+		Name:        "External Command Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "$ echo foo"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:1] external command is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:        "Chdir Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "CHDIR '/'"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:1] CHDIR is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:        "Set Environment Variable Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "SET @%CSVQ_TEST_SERVER_MODE = 'foo'"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:5] setting environment variables is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:        "Unset Environment Variable Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "UNSET @%CSVQ_TEST_SERVER_MODE"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:7] unsetting environment variables is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:        "Source Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "SOURCE 'source.sql'"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:1] SOURCE is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:        "Reload Not Allowed",
+		ContentType: "application/json",
+		Body:        `{"query": "RELOAD CONFIG"}`,
+		Status:      http.StatusForbidden,
+		Type:        "application/json; charset=utf-8",
+		Result:      "{\"error\":\"[L:1 C:1] RELOAD is not allowed in server mode\",\"number\":14701}\n",
+	},
+	{
+		Name:   "Method Error",
+		Method: http.MethodGet,
+		Status: http.StatusMethodNotAllowed,
+		Type:   "application/json; charset=utf-8",
+		Result: "{\"error\":\"method must be POST\"}\n",
+	},
+}
+
+func TestHTTPServer_HandleQuery(t *testing.T) {
+	for _, v := range httpServerQueryTests {
+		s, dir := newHTTPTestServer(t)
+
+		method := v.Method
+		if len(method) < 1 {
+			method = http.MethodPost
+		}
+		url := v.URL
+		if len(url) < 1 {
+			url = "/query"
+		}
+
+		req := httptest.NewRequest(method, url, strings.NewReader(v.Body))
+		if 0 < len(v.ContentType) {
+			req.Header.Set("Content-Type", v.ContentType)
+		}
+		rec := httptest.NewRecorder()
+		s.handleQuery(rec, req)
+
+		if rec.Code != v.Status {
+			t.Errorf("%s: status = %d, want %d", v.Name, rec.Code, v.Status)
+		}
+		if rec.Header().Get("Content-Type") != v.Type {
+			t.Errorf("%s: content type = %q, want %q", v.Name, rec.Header().Get("Content-Type"), v.Type)
+		}
+		if rec.Body.String() != v.Result {
+			t.Errorf("%s: body = %q, want %q", v.Name, rec.Body.String(), v.Result)
+		}
+		if 0 < len(v.File) {
+			contents, _ := os.ReadFile(filepath.Join(dir, "table1.csv"))
+			if string(contents) != v.File {
+				t.Errorf("%s: file contents = %q, want %q", v.Name, string(contents), v.File)
+			}
+		}
+	}
+}
+
+var httpServerTokenTests = []struct {
+	Name          string
+	Authorization string
+	Status        int
+}{
+	{
+		Name:          "Valid Token",
+		Authorization: "Bearer secret",
+		Status:        http.StatusOK,
+	},
+	{
+		Name:   "No Token",
+		Status: http.StatusUnauthorized,
+	},
+	{
+		Name:          "Invalid Token",
+		Authorization: "Bearer wrong",
+		Status:        http.StatusUnauthorized,
+	},
+	{
+		Name:          "Invalid Scheme",
+		Authorization: "Basic secret",
+		Status:        http.StatusUnauthorized,
+	},
+}
+
+func TestHTTPServer_Token(t *testing.T) {
+	s, _ := newHTTPTestServer(t)
+	s.token = "secret"
+
+	for _, v := range httpServerTokenTests {
+		req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query": "SELECT 1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		if 0 < len(v.Authorization) {
+			req.Header.Set("Authorization", v.Authorization)
+		}
+		rec := httptest.NewRecorder()
+		s.handleQuery(rec, req)
+
+		if rec.Code != v.Status {
+			t.Errorf("%s: status = %d, want %d", v.Name, rec.Code, v.Status)
+		}
+		if v.Status == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") != "Bearer" {
+			t.Errorf("%s: WWW-Authenticate = %q, want %q", v.Name, rec.Header().Get("WWW-Authenticate"), "Bearer")
+		}
+	}
+}
+
+func TestHTTPServer_Timeout(t *testing.T) {
+	s, _ := newHTTPTestServer(t)
+	s.timeout = time.Nanosecond
+
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query": "SELECT * FROM table1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	s.handleQuery(rec, req)
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+}
@@ -0,0 +1,812 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	cryptorand "crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+)
+
+// PostgresServerVersion is the version of PostgreSQL reported to clients.
+const PostgresServerVersion = "14.0"
+
+// SQLSTATE error codes.
+const (
+	pgCodeSyntaxError                 = "42601"
+	pgCodeDuplicatePreparedStatement  = "42P05"
+	pgCodeInvalidSQLStatementName     = "26000"
+	pgCodeInvalidCursorName           = "34000"
+	pgCodeProtocolViolation           = "08P01"
+	pgCodeFeatureNotSupported         = "0A000"
+	pgCodeInvalidTextRepresentation   = "22P02"
+	pgCodeInvalidBinaryRepresentation = "22P03"
+	pgCodeLockNotAvailable            = "55P03"
+	pgCodeQueryCanceled               = "57014"
+	pgCodeInvalidAuthorization        = "28000"
+	pgCodeInsufficientPrivilege       = "42501"
+	pgCodeInvalidPassword             = "28P01"
+	pgCodeInternalError               = "XX000"
+)
+
+type PostgresError struct {
+	Code    string
+	Message string
+}
+
+func newPostgresError(code string, message string) error {
+	return &PostgresError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *PostgresError) Error() string {
+	return e.Message
+}
+
+func postgresErrorResponse(err error) *pgproto3.ErrorResponse {
+	code := pgCodeInternalError
+	switch e := err.(type) {
+	case *PostgresError:
+		code = e.Code
+	case *query.SyntaxError, *query.PreparedStatementSyntaxError:
+		code = pgCodeSyntaxError
+	case *query.FileLockTimeoutError:
+		code = pgCodeLockNotAvailable
+	case *query.StatementNotAllowedError:
+		code = pgCodeInsufficientPrivilege
+	case *query.ContextCanceled, *query.ContextDone:
+		code = pgCodeQueryCanceled
+	}
+
+	return &pgproto3.ErrorResponse{
+		Severity:            "ERROR",
+		SeverityUnlocalized: "ERROR",
+		Code:                code,
+		Message:             err.Error(),
+	}
+}
+
+// postgresBegin represents a statement that starts a transaction block.
+// The statement is handled by the server because transactions are implicitly started in csvq.
+type postgresBegin struct{}
+
+func isPostgresBegin(s string) bool {
+	words := strings.Fields(strings.TrimRight(strings.TrimSpace(s), ";"))
+	switch len(words) {
+	case 1:
+		return strings.EqualFold(words[0], "BEGIN")
+	case 2:
+		return (strings.EqualFold(words[0], "BEGIN") && (strings.EqualFold(words[1], "TRANSACTION") || strings.EqualFold(words[1], "WORK"))) ||
+			(strings.EqualFold(words[0], "START") && strings.EqualFold(words[1], "TRANSACTION"))
+	}
+	return false
+}
+
+// PostgresAuth is the configuration of the client authentication.
+type PostgresAuth struct {
+	// Password is required with the MD5 authentication from the clients that are not connecting from the trusted hosts.
+	// If Password is empty, only the connections from the trusted hosts are accepted.
+	Password string
+
+	// TrustedHosts are the networks from which the clients are accepted without passwords.
+	TrustedHosts []*net.IPNet
+}
+
+func (a PostgresAuth) isTrusted(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, n := range a.TrustedHosts {
+		if n.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// PostgresServer serves csvq to the clients of PostgreSQL with the frontend/backend protocol version 3.
+//
+// Each connection has its own processor created by the ProcessorFactory, so
+// transactions of the clients are independent of each other, and updates to the same files
+// are serialized by the file locks.
+type PostgresServer struct {
+	newProcessor ProcessorFactory
+	auth         PostgresAuth
+	logError     func(string)
+
+	lastProcessID uint32
+
+	mtx   *sync.Mutex
+	conns map[uint32]*postgresConn
+	wg    *sync.WaitGroup
+}
+
+func NewPostgresServer(newProcessor ProcessorFactory, auth PostgresAuth, logError func(string)) *PostgresServer {
+	return &PostgresServer{
+		newProcessor: newProcessor,
+		auth:         auth,
+		logError:     logError,
+		mtx:          &sync.Mutex{},
+		conns:        make(map[uint32]*postgresConn),
+		wg:           &sync.WaitGroup{},
+	}
+}
+
+// Serve accepts connections on the listener until the context is done.
+func (s *PostgresServer) Serve(ctx context.Context, listener net.Listener) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = listener.Close()
+		case <-done:
+		}
+	}()
+
+	var err error
+	for {
+		netConn, e := listener.Accept()
+		if e != nil {
+			if ctx.Err() == nil {
+				err = e
+			}
+			break
+		}
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.serveConn(ctx, netConn)
+		}()
+	}
+
+	s.closeAll()
+	s.wg.Wait()
+	return err
+}
+
+func (s *PostgresServer) register(c *postgresConn) {
+	s.mtx.Lock()
+	s.conns[c.processID] = c
+	s.mtx.Unlock()
+}
+
+func (s *PostgresServer) unregister(c *postgresConn) {
+	s.mtx.Lock()
+	delete(s.conns, c.processID)
+	s.mtx.Unlock()
+}
+
+func (s *PostgresServer) cancel(processID uint32, secretKey uint32) {
+	s.mtx.Lock()
+	c, ok := s.conns[processID]
+	s.mtx.Unlock()
+
+	if ok && c.secretKey == secretKey {
+		c.cancelQuery()
+	}
+}
+
+func (s *PostgresServer) closeAll() {
+	s.mtx.Lock()
+	for _, c := range s.conns {
+		_ = c.netConn.Close()
+	}
+	s.mtx.Unlock()
+}
+
+func (s *PostgresServer) serveConn(ctx context.Context, netConn net.Conn) {
+	defer func() {
+		_ = netConn.Close()
+	}()
+
+	c := &postgresConn{
+		netConn:    netConn,
+		backend:    pgproto3.NewBackend(netConn, netConn),
+		processID:  atomic.AddUint32(&s.lastProcessID, 1),
+		secretKey:  rand.Uint32(),
+		statements: make(map[string]*postgresStatement),
+		portals:    make(map[string]*postgresPortal),
+		notices:    &noticeBuffer{},
+		warnings:   &noticeBuffer{},
+	}
+
+	msg, err := c.receiveStartupMessage()
+	if err != nil {
+		return
+	}
+	startup, ok := msg.(*pgproto3.StartupMessage)
+	if !ok {
+		if cancel, ok := msg.(*pgproto3.CancelRequest); ok {
+			s.cancel(cancel.ProcessID, cancel.SecretKey)
+		}
+		return
+	}
+
+	if err = c.authenticate(s.auth, startup.Parameters["user"]); err != nil {
+		c.backend.Send(postgresErrorResponse(err))
+		_ = c.backend.Flush()
+		return
+	}
+
+	proc, err := s.newProcessor(ctx)
+	if err != nil {
+		c.backend.Send(postgresErrorResponse(err))
+		_ = c.backend.Flush()
+		return
+	}
+	proc.Tx.ServerMode = true
+	c.proc = proc
+	proc.Tx.Session.SetStdout(c.notices)
+	proc.Tx.Session.SetStderr(c.warnings)
+
+	defer func() {
+		if e := proc.AutoRollback(); e != nil {
+			s.logError(e.Error())
+		}
+		if e := proc.ReleaseResourcesWithErrors(); e != nil {
+			s.logError(e.Error())
+		}
+	}()
+
+	s.register(c)
+	defer s.unregister(c)
+
+	c.backend.Send(&pgproto3.AuthenticationOk{})
+	for _, param := range []struct{ Name, Value string }{
+		{"server_version", PostgresServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"TimeZone", proc.Tx.Flags.Location},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"application_name", startup.Parameters["application_name"]},
+	} {
+		c.backend.Send(&pgproto3.ParameterStatus{Name: param.Name, Value: param.Value})
+	}
+	c.backend.Send(&pgproto3.BackendKeyData{ProcessID: c.processID, SecretKey: c.secretKey})
+	c.sendReadyForQuery()
+	if err = c.backend.Flush(); err != nil {
+		return
+	}
+
+	if err = c.run(ctx); err != nil && ctx.Err() == nil {
+		s.logError(err.Error())
+	}
+}
+
+type noticeBuffer struct {
+	bytes.Buffer
+}
+
+func (b *noticeBuffer) Close() error {
+	return nil
+}
+
+type postgresStatement struct {
+	Statements    []parser.Statement
+	ParameterOIDs []uint32
+}
+
+type postgresPortal struct {
+	Statement     *postgresStatement
+	Values        *query.ReplaceValues
+	ResultFormats []int16
+
+	Result *postgresResult
+}
+
+type postgresResult struct {
+	Tag     string
+	View    *query.View
+	Types   []uint32
+	Formats []int16
+
+	sent int
+}
+
+func (r *postgresResult) rowDescription() *pgproto3.RowDescription {
+	fields := make([]pgproto3.FieldDescription, len(r.Types))
+	for i := range r.Types {
+		fields[i] = postgresFieldDescription(r.View.Header[i].Column, r.Types[i], r.Formats[i])
+	}
+	return &pgproto3.RowDescription{Fields: fields}
+}
+
+func (r *postgresResult) dataRow(idx int) *pgproto3.DataRow {
+	record := r.View.RecordSet[idx]
+	values := make([][]byte, len(record))
+	for i := range record {
+		values[i] = encodePostgresValue(record[i][0], r.Types[i], r.Formats[i])
+	}
+	return &pgproto3.DataRow{Values: values}
+}
+
+type postgresConn struct {
+	netConn   net.Conn
+	backend   *pgproto3.Backend
+	proc      *query.Processor
+	processID uint32
+	secretKey uint32
+
+	inTransaction   bool
+	ignoreUntilSync bool
+	statements      map[string]*postgresStatement
+	portals         map[string]*postgresPortal
+
+	notices  *noticeBuffer
+	warnings *noticeBuffer
+
+	cancelMtx    sync.Mutex
+	cancelFunc   context.CancelFunc
+	lastCanceled bool
+}
+
+// receiveStartupMessage returns the startup message or the cancel request after negotiating encryption.
+func (c *postgresConn) receiveStartupMessage() (pgproto3.FrontendMessage, error) {
+	for {
+		msg, err := c.backend.ReceiveStartupMessage()
+		if err != nil {
+			return nil, err
+		}
+
+		switch msg.(type) {
+		case *pgproto3.SSLRequest, *pgproto3.GSSEncRequest:
+			if _, err = c.netConn.Write([]byte{'N'}); err != nil {
+				return nil, err
+			}
+		default:
+			return msg, nil
+		}
+	}
+}
+
+// authenticate authenticates the client with the MD5 password unless the client is connecting from a trusted host.
+func (c *postgresConn) authenticate(auth PostgresAuth, user string) error {
+	if auth.isTrusted(c.netConn.RemoteAddr()) {
+		return nil
+	}
+	if len(auth.Password) < 1 {
+		return newPostgresError(pgCodeInvalidAuthorization, fmt.Sprintf("connection from host %s is not allowed", c.netConn.RemoteAddr()))
+	}
+
+	var salt [4]byte
+	if _, err := cryptorand.Read(salt[:]); err != nil {
+		return err
+	}
+	c.backend.Send(&pgproto3.AuthenticationMD5Password{Salt: salt})
+	if err := c.backend.Flush(); err != nil {
+		return err
+	}
+	if err := c.backend.SetAuthType(pgproto3.AuthTypeMD5Password); err != nil {
+		return err
+	}
+
+	msg, err := c.backend.Receive()
+	if err != nil {
+		return err
+	}
+	password, ok := msg.(*pgproto3.PasswordMessage)
+	if !ok {
+		return newPostgresError(pgCodeProtocolViolation, fmt.Sprintf("expected password message, got %T", msg))
+	}
+
+	expected := "md5" + md5Hex(md5Hex(auth.Password+user)+string(salt[:]))
+	if subtle.ConstantTimeCompare([]byte(password.Password), []byte(expected)) != 1 {
+		return newPostgresError(pgCodeInvalidPassword, fmt.Sprintf("password authentication failed for user %q", user))
+	}
+	return nil
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *postgresConn) run(ctx context.Context) error {
+	for {
+		msg, err := c.backend.Receive()
+		if err != nil {
+			// The connection is closed by the client or the server.
+			return nil
+		}
+
+		if c.ignoreUntilSync {
+			if _, ok := msg.(*pgproto3.Sync); !ok {
+				continue
+			}
+			c.ignoreUntilSync = false
+		}
+
+		switch m := msg.(type) {
+		case *pgproto3.Query:
+			c.simpleQuery(ctx, m.String)
+			err = c.backend.Flush()
+		case *pgproto3.Parse:
+			err = c.parse(m)
+		case *pgproto3.Bind:
+			err = c.bind(m)
+		case *pgproto3.Describe:
+			err = c.describe(ctx, m)
+		case *pgproto3.Execute:
+			err = c.execute(ctx, m)
+		case *pgproto3.Close:
+			c.close(m)
+		case *pgproto3.Sync:
+			if !c.inTransaction {
+				c.portals = make(map[string]*postgresPortal)
+			}
+			c.sendReadyForQuery()
+			err = c.backend.Flush()
+		case *pgproto3.Flush:
+			err = c.backend.Flush()
+		case *pgproto3.Terminate:
+			return nil
+		default:
+			err = newPostgresError(pgCodeFeatureNotSupported, fmt.Sprintf("message type %T is not supported", msg))
+		}
+
+		if err != nil {
+			if _, ok := err.(net.Error); ok {
+				return err
+			}
+			c.backend.Send(postgresErrorResponse(err))
+			c.ignoreUntilSync = true
+		}
+	}
+}
+
+func (c *postgresConn) sendReadyForQuery() {
+	status := byte('I')
+	if c.inTransaction {
+		status = 'T'
+	}
+	c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: status})
+}
+
+func (c *postgresConn) sendNotices() {
+	for _, n := range []struct {
+		buf      *noticeBuffer
+		severity string
+		code     string
+	}{
+		{c.notices, "NOTICE", "00000"},
+		{c.warnings, "WARNING", "01000"},
+	} {
+		if n.buf.Len() < 1 {
+			continue
+		}
+		c.backend.Send(&pgproto3.NoticeResponse{
+			Severity:            n.severity,
+			SeverityUnlocalized: n.severity,
+			Code:                n.code,
+			Message:             strings.TrimRight(n.buf.String(), "\n"),
+		})
+		n.buf.Reset()
+	}
+}
+
+func (c *postgresConn) cancelQuery() {
+	c.cancelMtx.Lock()
+	if c.cancelFunc != nil {
+		c.cancelFunc()
+	}
+	c.cancelMtx.Unlock()
+}
+
+func (c *postgresConn) setCancelFunc(fn context.CancelFunc) {
+	c.cancelMtx.Lock()
+	c.cancelFunc = fn
+	c.cancelMtx.Unlock()
+}
+
+func (c *postgresConn) simpleQuery(ctx context.Context, s string) {
+	defer c.sendReadyForQuery()
+
+	var statements []parser.Statement
+	if isPostgresBegin(s) {
+		statements = []parser.Statement{postgresBegin{}}
+	} else {
+		var err error
+		statements, _, err = parser.Parse(s, "", c.proc.Tx.Flags.DatetimeFormat, false, c.proc.Tx.Flags.AnsiQuotes)
+		if err != nil {
+			c.backend.Send(postgresErrorResponse(query.NewSyntaxError(err.(*parser.SyntaxError))))
+			return
+		}
+	}
+
+	if len(statements) < 1 {
+		c.backend.Send(&pgproto3.EmptyQueryResponse{})
+		return
+	}
+
+	for _, stmt := range statements {
+		result, err := c.executeStatement(ctx, stmt, nil)
+		if err != nil {
+			c.backend.Send(postgresErrorResponse(err))
+			return
+		}
+
+		if result.View != nil {
+			c.backend.Send(result.rowDescription())
+			for i := range result.View.RecordSet {
+				c.backend.Send(result.dataRow(i))
+			}
+		}
+		c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(result.Tag)})
+	}
+}
+
+func (c *postgresConn) parse(m *pgproto3.Parse) error {
+	if 0 < len(m.Name) {
+		if _, ok := c.statements[m.Name]; ok {
+			return newPostgresError(pgCodeDuplicatePreparedStatement, fmt.Sprintf("prepared statement %q already exists", m.Name))
+		}
+	}
+
+	var statements []parser.Statement
+	var holderNumber int
+	if isPostgresBegin(m.Query) {
+		statements = []parser.Statement{postgresBegin{}}
+	} else {
+		var err error
+		statements, holderNumber, err = parser.Parse(m.Query, "", c.proc.Tx.Flags.DatetimeFormat, true, c.proc.Tx.Flags.AnsiQuotes)
+		if err != nil {
+			return query.NewSyntaxError(err.(*parser.SyntaxError))
+		}
+		if 1 < len(statements) {
+			return newPostgresError(pgCodeSyntaxError, "cannot insert multiple commands into a prepared statement")
+		}
+	}
+
+	if holderNumber < len(m.ParameterOIDs) {
+		holderNumber = len(m.ParameterOIDs)
+	}
+	// The types of the parameters not specified by the client are left unspecified,
+	// so that the client encodes the values as text in its own way.
+	oids := make([]uint32, holderNumber)
+	copy(oids, m.ParameterOIDs)
+
+	c.statements[m.Name] = &postgresStatement{
+		Statements:    statements,
+		ParameterOIDs: oids,
+	}
+	c.backend.Send(&pgproto3.ParseComplete{})
+	return nil
+}
+
+func (c *postgresConn) bind(m *pgproto3.Bind) error {
+	stmt, ok := c.statements[m.PreparedStatement]
+	if !ok {
+		return newPostgresError(pgCodeInvalidSQLStatementName, fmt.Sprintf("prepared statement %q does not exist", m.PreparedStatement))
+	}
+	if 0 < len(m.DestinationPortal) {
+		if _, ok := c.portals[m.DestinationPortal]; ok {
+			return newPostgresError(pgCodeInvalidCursorName, fmt.Sprintf("portal %q already exists", m.DestinationPortal))
+		}
+	}
+	if len(m.Parameters) != len(stmt.ParameterOIDs) {
+		return newPostgresError(pgCodeProtocolViolation, fmt.Sprintf("bind message supplies %d parameters, but prepared statement %q requires %d", len(m.Parameters), m.PreparedStatement, len(stmt.ParameterOIDs)))
+	}
+
+	values := make([]parser.QueryExpression, len(m.Parameters))
+	for i := range m.Parameters {
+		v, err := decodePostgresParameter(m.Parameters[i], stmt.ParameterOIDs[i], formatCode(m.ParameterFormatCodes, i))
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+
+	c.portals[m.DestinationPortal] = &postgresPortal{
+		Statement:     stmt,
+		Values:        &query.ReplaceValues{Values: values, Names: make(map[string]int)},
+		ResultFormats: m.ResultFormatCodes,
+	}
+	c.backend.Send(&pgproto3.BindComplete{})
+	return nil
+}
+
+// describe describes a prepared statement or a portal.
+//
+// The fields of the results of a statement cannot be determined until the statement is executed,
+// so a portal is executed when it is described, and no field is returned for a prepared statement.
+func (c *postgresConn) describe(ctx context.Context, m *pgproto3.Describe) error {
+	if m.ObjectType == 'S' {
+		stmt, ok := c.statements[m.Name]
+		if !ok {
+			return newPostgresError(pgCodeInvalidSQLStatementName, fmt.Sprintf("prepared statement %q does not exist", m.Name))
+		}
+		c.backend.Send(&pgproto3.ParameterDescription{ParameterOIDs: stmt.ParameterOIDs})
+		c.backend.Send(&pgproto3.NoData{})
+		return nil
+	}
+
+	portal, ok := c.portals[m.Name]
+	if !ok {
+		return newPostgresError(pgCodeInvalidCursorName, fmt.Sprintf("portal %q does not exist", m.Name))
+	}
+	if err := c.executePortal(ctx, portal); err != nil {
+		return err
+	}
+
+	if portal.Result != nil && portal.Result.View != nil {
+		c.backend.Send(portal.Result.rowDescription())
+	} else {
+		c.backend.Send(&pgproto3.NoData{})
+	}
+	return nil
+}
+
+func (c *postgresConn) execute(ctx context.Context, m *pgproto3.Execute) error {
+	portal, ok := c.portals[m.Portal]
+	if !ok {
+		return newPostgresError(pgCodeInvalidCursorName, fmt.Sprintf("portal %q does not exist", m.Portal))
+	}
+	if err := c.executePortal(ctx, portal); err != nil {
+		return err
+	}
+
+	result := portal.Result
+	if result == nil {
+		c.backend.Send(&pgproto3.EmptyQueryResponse{})
+		return nil
+	}
+
+	if result.View != nil {
+		end := len(result.View.RecordSet)
+		if 0 < m.MaxRows && result.sent+int(m.MaxRows) < end {
+			end = result.sent + int(m.MaxRows)
+		}
+		for ; result.sent < end; result.sent++ {
+			c.backend.Send(result.dataRow(result.sent))
+		}
+		if result.sent < len(result.View.RecordSet) {
+			c.backend.Send(&pgproto3.PortalSuspended{})
+			return nil
+		}
+	}
+	c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(result.Tag)})
+	return nil
+}
+
+func (c *postgresConn) executePortal(ctx context.Context, portal *postgresPortal) error {
+	if portal.Result != nil || len(portal.Statement.Statements) < 1 {
+		return nil
+	}
+
+	result, err := c.executeStatement(ctx, portal.Statement.Statements[0], portal.Values)
+	if err != nil {
+		return err
+	}
+	if result.View != nil {
+		for i := range result.Formats {
+			result.Formats[i] = formatCode(portal.ResultFormats, i)
+		}
+	}
+	portal.Result = result
+	return nil
+}
+
+func (c *postgresConn) close(m *pgproto3.Close) {
+	if m.ObjectType == 'S' {
+		delete(c.statements, m.Name)
+	} else {
+		delete(c.portals, m.Name)
+	}
+	c.backend.Send(&pgproto3.CloseComplete{})
+}
+
+// executeStatement executes the statement in the transaction of the connection.
+// Outside of transaction blocks, every statement is committed on success and rolled back on failure.
+func (c *postgresConn) executeStatement(ctx context.Context, stmt parser.Statement, values *query.ReplaceValues) (*postgresResult, error) {
+	if _, ok := stmt.(postgresBegin); ok {
+		if c.inTransaction {
+			c.backend.Send(&pgproto3.NoticeResponse{
+				Severity:            "WARNING",
+				SeverityUnlocalized: "WARNING",
+				Code:                "25001",
+				Message:             "there is already a transaction in progress",
+			})
+		}
+		c.inTransaction = true
+		return &postgresResult{Tag: "BEGIN"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(query.ContextForStoringResults(ctx))
+	c.setCancelFunc(cancel)
+	defer func() {
+		c.setCancelFunc(nil)
+		cancel()
+	}()
+
+	if values != nil {
+		ctx = query.ContextForPreparedStatement(ctx, values)
+	}
+
+	c.proc.Tx.AutoCommit = !c.inTransaction
+	_, err := c.proc.Execute(ctx, []parser.Statement{stmt})
+	if err != nil && !c.inTransaction {
+		if e := c.proc.AutoRollback(); e != nil {
+			c.proc.LogError(e.Error())
+		}
+	}
+	c.sendNotices()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := stmt.(parser.TransactionControl); ok {
+		c.inTransaction = false
+	}
+
+	result := &postgresResult{
+		Tag: postgresCommandTag(stmt, c.proc.Tx.AffectedRows),
+	}
+	if views := c.proc.Tx.SelectedViews; 0 < len(views) {
+		view := views[len(views)-1]
+		result.View = view
+		result.Tag = "SELECT " + strconv.Itoa(view.RecordLen())
+		result.Types = make([]uint32, view.FieldLen())
+		result.Formats = make([]int16, view.FieldLen())
+		for i := range result.Types {
+			result.Types[i] = postgresColumnType(view, i)
+		}
+	}
+	return result, nil
+}
+
+func postgresCommandTag(stmt parser.Statement, affectedRows int) string {
+	rows := strconv.Itoa(affectedRows)
+
+	switch stmt.(type) {
+	case parser.InsertQuery, parser.ReplaceQuery:
+		return "INSERT 0 " + rows
+	case parser.UpdateQuery:
+		return "UPDATE " + rows
+	case parser.DeleteQuery:
+		return "DELETE " + rows
+	case parser.MergeQuery:
+		return "MERGE " + rows
+	case parser.SelectQuery:
+		return "SELECT " + rows
+	case parser.CreateTable:
+		return "CREATE TABLE"
+	case parser.AddColumns, parser.DropColumns, parser.RenameColumn, parser.SetTableAttribute:
+		return "ALTER TABLE"
+	case parser.TransactionControl:
+		if stmt.(parser.TransactionControl).Token == parser.ROLLBACK {
+			return "ROLLBACK"
+		}
+		return "COMMIT"
+	case parser.VariableDeclaration, parser.ViewDeclaration, parser.CursorDeclaration, parser.FunctionDeclaration, parser.AggregateDeclaration:
+		return "DECLARE"
+	case parser.SetFlag, parser.VariableSubstitution, parser.SetEnvVar:
+		return "SET"
+	case parser.StatementPreparation:
+		return "PREPARE"
+	case parser.ExecuteStatement:
+		return "EXECUTE"
+	}
+	return "OK"
+}
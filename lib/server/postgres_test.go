@@ -0,0 +1,333 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var postgresTestTrustedHosts = PostgresAuth{
+	TrustedHosts: []*net.IPNet{
+		{IP: net.IPv4(127, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	},
+}
+
+func startPostgresTestServer(t *testing.T, auth PostgresAuth) (string, string) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "table1.csv"), []byte("column1,column2\n1,str1\n2,str2\n3,str3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newProcessor := func(ctx context.Context) (*query.Processor, error) {
+		session := query.NewSession()
+		session.SetStdout(query.NewDiscard())
+		session.SetStderr(query.NewDiscard())
+		_ = session.SetStdin(nil)
+
+		tx, err := query.NewTransaction(ctx, file.DefaultWaitTimeout, file.DefaultRetryDelay, session)
+		if err != nil {
+			return nil, err
+		}
+		if err = tx.SetFlag(cmd.RepositoryFlag, dir); err != nil {
+			return nil, err
+		}
+		return query.NewProcessor(tx), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- NewPostgresServer(newProcessor, auth, func(s string) { t.Log(s) }).Serve(ctx, listener)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("unexpected error %q", err)
+		}
+	})
+
+	return "postgres://csvq@" + listener.Addr().String() + "/csvq?sslmode=disable", dir
+}
+
+func connectPostgresTestServer(t *testing.T, url string) *pgx.Conn {
+	conn, err := pgx.Connect(context.Background(), url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close(context.Background())
+	})
+	return conn
+}
+
+var postgresServerQueryTests = []struct {
+	Name   string
+	Query  string
+	Args   []any
+	Result [][]any
+	Code   string
+}{
+	{
+		Name:  "Select Query",
+		Query: "SELECT column1, column2 FROM table1 WHERE column1 < 3",
+		Result: [][]any{
+			{"1", "str1"},
+			{"2", "str2"},
+		},
+	},
+	{
+		Name:  "Select Query with Parameters",
+		Query: "SELECT column2, column1 * $2 FROM table1 WHERE column2 = $1",
+		Args:  []any{"str3", 1.5},
+		Result: [][]any{
+			{"str3", 4.5},
+		},
+	},
+	{
+		Name:  "Select Query with Null and Boolean",
+		Query: "SELECT NULL, TRUE, 'abc'",
+		Result: [][]any{
+			{nil, true, "abc"},
+		},
+	},
+	{
+		Name:  "Select Query with Decimal",
+		Query: "SELECT DECIMAL('1.50') * 2, DECIMAL('-12345.00001'), DECIMAL('0')",
+		Result: [][]any{
+			{pgtype.Numeric{Int: big.NewInt(300), Exp: -2, Valid: true}, pgtype.Numeric{Int: big.NewInt(-1234500001), Exp: -5, Valid: true}, pgtype.Numeric{Int: big.NewInt(0), Exp: 0, Valid: true}},
+		},
+	},
+	{
+		Name:  "Syntax Error",
+		Query: "SELECT FROM",
+		Code:  pgCodeSyntaxError,
+	},
+	{
+		Name:  "Query Error",
+		Query: "SELECT * FROM notexist",
+		Code:  pgCodeInternalError,
+	},
+	{
+		Name:  "Chdir Not Allowed",
+		Query: "CHDIR '/'",
+		Code:  pgCodeInsufficientPrivilege,
+	},
+	{
+		Name:  "Set Environment Variable Not Allowed",
+		Query: "SET @%CSVQ_TEST_SERVER_MODE = 'foo'",
+		Code:  pgCodeInsufficientPrivilege,
+	},
+	{
+		Name:  "Source Not Allowed",
+		Query: "SOURCE 'source.sql'",
+		Code:  pgCodeInsufficientPrivilege,
+	},
+}
+
+func TestPostgresServer_Query(t *testing.T) {
+	url, _ := startPostgresTestServer(t, postgresTestTrustedHosts)
+	conn := connectPostgresTestServer(t, url)
+
+	for _, v := range postgresServerQueryTests {
+		rows, err := conn.Query(context.Background(), v.Query, v.Args...)
+		var result [][]any
+		if err == nil {
+			for rows.Next() {
+				values, e := rows.Values()
+				if e != nil {
+					t.Fatalf("%s: unexpected error %q", v.Name, e)
+				}
+				result = append(result, values)
+			}
+			err = rows.Err()
+		}
+
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if !errors.As(err, &pgErr) {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if len(v.Code) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if pgErr.Code != v.Code {
+				t.Errorf("%s: error code %q, want error code %q", v.Name, pgErr.Code, v.Code)
+			}
+			continue
+		}
+		if 0 < len(v.Code) {
+			t.Errorf("%s: no error, want error code %q", v.Name, v.Code)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestPostgresServer_ExternalCommand(t *testing.T) {
+	url, _ := startPostgresTestServer(t, postgresTestTrustedHosts)
+	conn := connectPostgresTestServer(t, url)
+
+	_, err := conn.PgConn().Exec(context.Background(), "$ echo foo").ReadAll()
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		t.Fatalf("error %v, want a postgres error", err)
+	}
+	if pgErr.Code != pgCodeInsufficientPrivilege {
+		t.Errorf("error code %q, want error code %q", pgErr.Code, pgCodeInsufficientPrivilege)
+	}
+}
+
+var postgresServerAuthenticationTests = []struct {
+	Name     string
+	Auth     PostgresAuth
+	Password string
+	Code     string
+}{
+	{
+		Name: "Trusted Host",
+		Auth: postgresTestTrustedHosts,
+	},
+	{
+		Name:     "Password",
+		Auth:     PostgresAuth{Password: "secret"},
+		Password: "secret",
+	},
+	{
+		Name:     "Password on Trusted Host",
+		Auth:     PostgresAuth{Password: "secret", TrustedHosts: postgresTestTrustedHosts.TrustedHosts},
+		Password: "",
+	},
+	{
+		Name:     "Wrong Password",
+		Auth:     PostgresAuth{Password: "secret"},
+		Password: "wrong",
+		Code:     pgCodeInvalidPassword,
+	},
+	{
+		Name: "Untrusted Host",
+		Auth: PostgresAuth{
+			TrustedHosts: []*net.IPNet{
+				{IP: net.IPv4(192, 0, 2, 0), Mask: net.CIDRMask(24, 32)},
+			},
+		},
+		Code: pgCodeInvalidAuthorization,
+	},
+}
+
+func TestPostgresServer_Authentication(t *testing.T) {
+	for _, v := range postgresServerAuthenticationTests {
+		url, _ := startPostgresTestServer(t, v.Auth)
+		if 0 < len(v.Password) {
+			url = strings.Replace(url, "postgres://csvq@", "postgres://csvq:"+v.Password+"@", 1)
+		}
+
+		conn, err := pgx.Connect(context.Background(), url)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if !errors.As(err, &pgErr) || len(v.Code) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if pgErr.Code != v.Code {
+				t.Errorf("%s: error code %q, want error code %q", v.Name, pgErr.Code, v.Code)
+			}
+			continue
+		}
+		_ = conn.Close(context.Background())
+		if 0 < len(v.Code) {
+			t.Errorf("%s: no error, want error code %q", v.Name, v.Code)
+		}
+	}
+}
+
+func TestPostgresServer_SimpleProtocol(t *testing.T) {
+	url, _ := startPostgresTestServer(t, postgresTestTrustedHosts)
+	conn := connectPostgresTestServer(t, url)
+
+	results, err := conn.PgConn().Exec(context.Background(), "SELECT column1 FROM table1 WHERE column1 = 1; SELECT COUNT(*) FROM table1").ReadAll()
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("%d results, want %d", len(results), 2)
+	}
+
+	expect := []string{"SELECT 1", "SELECT 1"}
+	for i := range results {
+		if results[i].CommandTag.String() != expect[i] {
+			t.Errorf("command tag = %q, want %q", results[i].CommandTag.String(), expect[i])
+		}
+	}
+	if string(results[1].Rows[0][0]) != "3" {
+		t.Errorf("value = %q, want %q", string(results[1].Rows[0][0]), "3")
+	}
+}
+
+func TestPostgresServer_Transaction(t *testing.T) {
+	url, dir := startPostgresTestServer(t, postgresTestTrustedHosts)
+	conn := connectPostgresTestServer(t, url)
+	ctx := context.Background()
+
+	tag, err := conn.Exec(ctx, "INSERT INTO table1 VALUES ($1, $2)", 4, "str4")
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if tag.String() != "INSERT 0 1" {
+		t.Errorf("command tag = %q, want %q", tag.String(), "INSERT 0 1")
+	}
+
+	expect := "column1,column2\n1,str1\n2,str2\n3,str3\n4,str4\n"
+	contents, _ := os.ReadFile(filepath.Join(dir, "table1.csv"))
+	if string(contents) != expect {
+		t.Errorf("file contents = %q, want %q", string(contents), expect)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = tx.Exec(ctx, "DELETE FROM table1 WHERE column1 > 1"); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	var count int64
+	if err = tx.QueryRow(ctx, "SELECT COUNT(*) FROM table1").Scan(&count); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if count != 1 {
+		t.Errorf("count in transaction = %d, want %d", count, 1)
+	}
+
+	if err = tx.Rollback(ctx); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	if err = conn.QueryRow(ctx, "SELECT COUNT(*) FROM table1").Scan(&count); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if count != 4 {
+		t.Errorf("count after rollback = %d, want %d", count, 4)
+	}
+
+	contents, _ = os.ReadFile(filepath.Join(dir, "table1.csv"))
+	if string(contents) != expect {
+		t.Errorf("file contents = %q, want %q", string(contents), expect)
+	}
+}
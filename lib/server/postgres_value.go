@@ -0,0 +1,344 @@
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/mithrandie/ternary"
+)
+
+// Object IDs of the PostgreSQL data types.
+const (
+	pgTypeUnspecified uint32 = 0
+	pgTypeBool        uint32 = 16
+	pgTypeBytea       uint32 = 17
+	pgTypeName        uint32 = 19
+	pgTypeInt8        uint32 = 20
+	pgTypeInt2        uint32 = 21
+	pgTypeInt4        uint32 = 23
+	pgTypeText        uint32 = 25
+	pgTypeFloat4      uint32 = 700
+	pgTypeFloat8      uint32 = 701
+	pgTypeUnknown     uint32 = 705
+	pgTypeBpchar      uint32 = 1042
+	pgTypeVarchar     uint32 = 1043
+	pgTypeTimestamp   uint32 = 1114
+	pgTypeTimestamptz uint32 = 1184
+	pgTypeNumeric     uint32 = 1700
+)
+
+const (
+	pgTextFormat   int16 = 0
+	pgBinaryFormat int16 = 1
+)
+
+const pgTimestampFormat = "2006-01-02 15:04:05.999999-07:00"
+
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// postgresColumnType returns the data type of the column in the view.
+// Columns that have values of different types are treated as texts.
+func postgresColumnType(view *query.View, idx int) uint32 {
+	var oid uint32
+
+	for i := range view.RecordSet {
+		var t uint32
+		switch view.RecordSet[i][idx][0].(type) {
+		case *value.Null:
+			continue
+		case *value.Integer:
+			t = pgTypeInt8
+		case *value.Float:
+			t = pgTypeFloat8
+		case *value.Decimal:
+			t = pgTypeNumeric
+		case *value.Boolean, *value.Ternary:
+			t = pgTypeBool
+		case *value.Datetime:
+			t = pgTypeTimestamptz
+		default:
+			return pgTypeText
+		}
+
+		switch {
+		case oid == 0 || oid == t:
+			oid = t
+		case (oid == pgTypeInt8 && t == pgTypeFloat8) || (oid == pgTypeFloat8 && t == pgTypeInt8):
+			oid = pgTypeFloat8
+		case (oid == pgTypeNumeric && (t == pgTypeInt8 || t == pgTypeFloat8)) || ((oid == pgTypeInt8 || oid == pgTypeFloat8) && t == pgTypeNumeric):
+			oid = pgTypeNumeric
+		default:
+			return pgTypeText
+		}
+	}
+
+	if oid == 0 {
+		return pgTypeText
+	}
+	return oid
+}
+
+func postgresTypeSize(oid uint32) int16 {
+	switch oid {
+	case pgTypeBool:
+		return 1
+	case pgTypeInt8, pgTypeFloat8, pgTypeTimestamptz:
+		return 8
+	}
+	return -1
+}
+
+func postgresFieldDescription(name string, oid uint32, format int16) pgproto3.FieldDescription {
+	return pgproto3.FieldDescription{
+		Name:         []byte(name),
+		DataTypeOID:  oid,
+		DataTypeSize: postgresTypeSize(oid),
+		TypeModifier: -1,
+		Format:       format,
+	}
+}
+
+// encodePostgresValue encodes the value as the data type of the column.
+// The returned nil represents NULL.
+func encodePostgresValue(p value.Primary, oid uint32, format int16) []byte {
+	switch p.(type) {
+	case *value.Null:
+		return nil
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+			return nil
+		}
+	}
+
+	switch oid {
+	case pgTypeBool:
+		b := value.ToBoolean(p)
+		if value.IsNull(b) {
+			return nil
+		}
+		t := b.(*value.Boolean).Raw()
+		if format == pgBinaryFormat {
+			if t {
+				return []byte{1}
+			}
+			return []byte{0}
+		}
+		if t {
+			return []byte("t")
+		}
+		return []byte("f")
+	case pgTypeInt8:
+		i := value.ToInteger(p)
+		if value.IsNull(i) {
+			return nil
+		}
+		n := i.(*value.Integer).Raw()
+		value.Discard(i)
+		if format == pgBinaryFormat {
+			return binary.BigEndian.AppendUint64(nil, uint64(n))
+		}
+		return []byte(strconv.FormatInt(n, 10))
+	case pgTypeFloat8:
+		f := value.ToFloat(p)
+		if value.IsNull(f) {
+			return nil
+		}
+		n := f.(*value.Float).Raw()
+		value.Discard(f)
+		if format == pgBinaryFormat {
+			return binary.BigEndian.AppendUint64(nil, math.Float64bits(n))
+		}
+		return []byte(formatPostgresFloat(n))
+	case pgTypeNumeric:
+		d := value.ToDecimal(p)
+		if value.IsNull(d) {
+			return nil
+		}
+		if format == pgBinaryFormat {
+			return encodePostgresNumeric(d.(*value.Decimal))
+		}
+		return []byte(d.String())
+	case pgTypeTimestamptz:
+		dt, ok := p.(*value.Datetime)
+		if !ok {
+			return nil
+		}
+		if format == pgBinaryFormat {
+			return binary.BigEndian.AppendUint64(nil, uint64(dt.Raw().Sub(pgEpoch).Microseconds()))
+		}
+		return []byte(dt.Raw().Format(pgTimestampFormat))
+	}
+
+	if dt, ok := p.(*value.Datetime); ok {
+		return []byte(dt.Raw().Format(pgTimestampFormat))
+	}
+	s, _, _ := query.ConvertFieldContents(p, false)
+	return []byte(s)
+}
+
+// encodePostgresNumeric encodes the decimal in the binary format of the numeric type,
+// that consists of the number of digits, the weight of the first digit, the sign,
+// the display scale and the digits in base 10000.
+func encodePostgresNumeric(d *value.Decimal) []byte {
+	s := d.Abs().String()
+	intPart, fracPart := s, ""
+	if idx := strings.IndexByte(s, '.'); -1 < idx {
+		intPart, fracPart = s[:idx], s[idx+1:]
+	}
+	if pad := len(intPart) % 4; 0 < pad {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; 0 < pad {
+		fracPart = fracPart + strings.Repeat("0", 4-pad)
+	}
+
+	digitsStr := intPart + fracPart
+	digits := make([]uint16, 0, len(digitsStr)/4)
+	for i := 0; i < len(digitsStr); i += 4 {
+		n, _ := strconv.ParseUint(digitsStr[i:i+4], 10, 16)
+		digits = append(digits, uint16(n))
+	}
+
+	weight := len(intPart)/4 - 1
+	for 0 < len(digits) && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for 0 < len(digits) && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) < 1 {
+		weight = 0
+	}
+
+	var sign uint16 = 0x0000
+	if d.Sign() < 0 {
+		sign = 0x4000
+	}
+
+	buf := make([]byte, 0, 8+len(digits)*2)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(digits)))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(int16(weight)))
+	buf = binary.BigEndian.AppendUint16(buf, sign)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(d.Scale()))
+	for _, v := range digits {
+		buf = binary.BigEndian.AppendUint16(buf, v)
+	}
+	return buf
+}
+
+func formatPostgresFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// decodePostgresParameter converts the parameter value passed by the client to a value for a placeholder.
+func decodePostgresParameter(data []byte, oid uint32, format int16) (parser.QueryExpression, error) {
+	if data == nil {
+		return parser.NewNullValue(), nil
+	}
+
+	if format == pgBinaryFormat {
+		return decodePostgresBinaryParameter(data, oid)
+	}
+
+	s := string(data)
+	switch oid {
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, newPostgresError(pgCodeInvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type integer: %q", s))
+		}
+		return parser.NewIntegerValue(i), nil
+	case pgTypeNumeric:
+		d, ok := value.ParseDecimal(s)
+		if !ok {
+			return nil, newPostgresError(pgCodeInvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type numeric: %q", s))
+		}
+		return parser.PrimitiveType{Literal: s, Value: d}, nil
+	case pgTypeFloat4, pgTypeFloat8:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, newPostgresError(pgCodeInvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type double precision: %q", s))
+		}
+		return parser.NewFloatValue(f), nil
+	case pgTypeBool:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return parser.NewTernaryValue(ternary.TRUE), nil
+		case "f", "false", "n", "no", "off", "0":
+			return parser.NewTernaryValue(ternary.FALSE), nil
+		}
+		return nil, newPostgresError(pgCodeInvalidTextRepresentation, fmt.Sprintf("invalid input syntax for type boolean: %q", s))
+	}
+	return parser.NewStringValue(s), nil
+}
+
+func decodePostgresBinaryParameter(data []byte, oid uint32) (parser.QueryExpression, error) {
+	switch oid {
+	case pgTypeInt2:
+		if len(data) == 2 {
+			return parser.NewIntegerValue(int64(int16(binary.BigEndian.Uint16(data)))), nil
+		}
+	case pgTypeInt4:
+		if len(data) == 4 {
+			return parser.NewIntegerValue(int64(int32(binary.BigEndian.Uint32(data)))), nil
+		}
+	case pgTypeInt8:
+		if len(data) == 8 {
+			return parser.NewIntegerValue(int64(binary.BigEndian.Uint64(data))), nil
+		}
+	case pgTypeFloat4:
+		if len(data) == 4 {
+			return parser.NewFloatValue(float64(math.Float32frombits(binary.BigEndian.Uint32(data)))), nil
+		}
+	case pgTypeFloat8:
+		if len(data) == 8 {
+			return parser.NewFloatValue(math.Float64frombits(binary.BigEndian.Uint64(data))), nil
+		}
+	case pgTypeBool:
+		if len(data) == 1 {
+			return parser.NewTernaryValue(ternary.ConvertFromBool(data[0] != 0)), nil
+		}
+	case pgTypeTimestamp, pgTypeTimestamptz:
+		if len(data) == 8 {
+			t := pgEpoch.Add(time.Duration(int64(binary.BigEndian.Uint64(data))) * time.Microsecond)
+			return parser.NewDatetimeValue(t), nil
+		}
+	case pgTypeUnspecified, pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeName, pgTypeUnknown, pgTypeBytea:
+		return parser.NewStringValue(string(data)), nil
+	default:
+		return nil, newPostgresError(pgCodeFeatureNotSupported, fmt.Sprintf("binary format is not supported for the parameter of type %d", oid))
+	}
+	return nil, newPostgresError(pgCodeInvalidBinaryRepresentation, fmt.Sprintf("invalid binary data for the parameter of type %d", oid))
+}
+
+// formatCode returns the format code for the index from the list of the format codes in the message.
+func formatCode(codes []int16, idx int) int16 {
+	switch len(codes) {
+	case 0:
+		return pgTextFormat
+	case 1:
+		return codes[0]
+	}
+	if idx < len(codes) {
+		return codes[idx]
+	}
+	return pgTextFormat
+}
@@ -0,0 +1,12 @@
+// Package server provides network services that execute statements with csvq.
+package server
+
+import (
+	"context"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+// ProcessorFactory creates a processor for a client.
+// Each processor must have its own session and transaction.
+type ProcessorFactory func(ctx context.Context) (*query.Processor, error)
@@ -22,7 +22,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "select_entity",
 				Group: []Grammar{
-					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}},
+					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("window_clause")}, Option{Link("qualify_clause")}},
 					{Link("select_set_entity"), Link("Set Operators"), Option{Keyword("ALL")}, Link("select_set_entity")},
 				},
 			},
@@ -86,11 +86,30 @@ var CsvqSyntax = []Expression{
 							{Link("table_entity"), Identifier("alias")},
 							{Link("table_entity"), Keyword("AS"), Identifier("alias")},
 							{Link("join")},
+							{Link("pivot_table")},
+							{Link("pivot_table"), Identifier("alias")},
+							{Link("pivot_table"), Keyword("AS"), Identifier("alias")},
 							{Keyword("DUAL")},
 							{Link("laterable_table")},
+							{Parentheses{Link("data_modifying_query")}},
+							{Parentheses{Link("data_modifying_query")}, Identifier("alias")},
+							{Parentheses{Link("data_modifying_query")}, Keyword("AS"), Identifier("alias")},
 							{Parentheses{Link("table")}},
 						},
 					},
+					{
+						Name: "data_modifying_query",
+						Group: []Grammar{
+							{Link("insert_query")},
+							{Link("update_query")},
+							{Link("replace_query")},
+							{Link("delete_query")},
+						},
+						Description: Description{
+							Template: "%s, %s, %s or %s with a %s.",
+							Values:   []Element{Link("insert_query"), Link("update_query"), Link("replace_query"), Link("delete_query"), Link("returning_clause")},
+						},
+					},
 					{
 						Name: "table_entity",
 						Group: []Grammar{
@@ -112,6 +131,9 @@ var CsvqSyntax = []Expression{
 							{Link("subquery")},
 							{Link("subquery"), Identifier("alias")},
 							{Link("subquery"), Keyword("AS"), Identifier("alias")},
+							{Link("unnest")},
+							{Link("unnest"), Identifier("alias")},
+							{Link("unnest"), Keyword("AS"), Identifier("alias")},
 						},
 					},
 					{
@@ -120,6 +142,16 @@ var CsvqSyntax = []Expression{
 							{Parentheses{Link("select_query")}},
 						},
 					},
+					{
+						Name: "unnest",
+						Group: []Grammar{
+							{Function{Name: "UNNEST", Args: []Element{Italic("array")}}},
+						},
+						Description: Description{
+							Template: "Expands %s into a table that has a column named \"value\" and one record for each element.",
+							Values:   []Element{Italic("array")},
+						},
+					},
 					{
 						Name: "join",
 						Group: []Grammar{
@@ -142,6 +174,38 @@ var CsvqSyntax = []Expression{
 							{Keyword("USING"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 						},
 					},
+					{
+						Name: "pivot_table",
+						Group: []Grammar{
+							{Link("table"), Keyword("PIVOT"), Parentheses{Link("aggregate_expr"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{ContinuousOption{Link("pivot_value")}}}},
+							{Link("table"), Keyword("PIVOT"), Parentheses{Link("aggregate_expr"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{Keyword("ANY")}}},
+							{Link("table"), Keyword("UNPIVOT"), Parentheses{Identifier("value_column"), Keyword("FOR"), Identifier("name_column"), Keyword("IN"), Parentheses{ContinuousOption{Identifier("column_name")}}}},
+						},
+						Description: Description{
+							Template: "PIVOT groups the records by the columns except %s and the columns referred in %s, " +
+								"and adds a column calculated with %s for each %s. " +
+								"If ANY is specified, the distinct values of %s are used in ascending order. " +
+								"UNPIVOT creates a record with %s and %s for each %s, excluding null values.",
+							Values: []Element{Identifier("column_name"), Link("aggregate_expr"), Link("aggregate_expr"), Link("pivot_value"), Identifier("column_name"), Identifier("name_column"), Identifier("value_column"), Identifier("column_name")},
+						},
+					},
+					{
+						Name: "aggregate_expr",
+						Group: []Grammar{
+							{Link("value")},
+						},
+						Description: Description{
+							Template: "A %s including aggregate functions.",
+							Values:   []Element{Link("value")},
+						},
+					},
+					{
+						Name: "pivot_value",
+						Group: []Grammar{
+							{Link("value")},
+							{Link("value"), Keyword("AS"), Identifier("alias")},
+						},
+					},
 					{
 						Name: "table_object",
 						Group: []Grammar{
@@ -149,6 +213,8 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "FIXED", Args: []Element{String("delimiter_positions"), Link("table_identifier"), Option{String("encoding"), Boolean("no_header"), Boolean("without_null")}}}},
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "XLSX", Args: []Element{String("sheet_name"), Link("table_identifier"), Option{String("cell_range"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "SQLITE", Args: []Element{Link("table_identifier"), String("table_name_or_query")}}},
 						},
 					},
 					{
@@ -183,7 +249,30 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "group_by_clause",
 						Group: []Grammar{
-							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("field")}},
+							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("grouping_element")}},
+						},
+					},
+					{
+						Name: "grouping_element",
+						Group: []Grammar{
+							{Link("field")},
+							{Keyword("ROLLUP"), Parentheses{ContinuousOption{Link("field")}}},
+							{Keyword("CUBE"), Parentheses{ContinuousOption{Link("field")}}},
+							{Keyword("GROUPING"), Keyword("SETS"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+						},
+						Description: Description{
+							Template: "%s is expanded to the grouping sets (a, b), (a) and (). " +
+								"%s is expanded to the grouping sets of all combinations of the fields. " +
+								"Fields that are not included in a grouping set are set to null in the records grouped by the set. " +
+								"If %s is specified as a field, then groups of missing time buckets are generated.",
+							Values: []Element{Keyword("ROLLUP(a, b)"), Keyword("CUBE"), Link("time_bucket_gapfill")},
+						},
+					},
+					{
+						Name: "grouping_set",
+						Group: []Grammar{
+							{Link("field")},
+							{Parentheses{Option{ContinuousOption{Link("field")}}}},
 						},
 					},
 				},
@@ -199,6 +288,39 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "WINDOW Clause",
+				Grammar: []Definition{
+					{
+						Name: "window_clause",
+						Group: []Grammar{
+							{Keyword("WINDOW"), ContinuousOption{Link("window_definition")}},
+						},
+					},
+					{
+						Name: "window_definition",
+						Group: []Grammar{
+							{Identifier("window_name"), Keyword("AS"), Parentheses{Option{Identifier("window_name")}, Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+						},
+					},
+				},
+			},
+			{
+				Label: "QUALIFY Clause",
+				Grammar: []Definition{
+					{
+						Name: "qualify_clause",
+						Group: []Grammar{
+							{Keyword("QUALIFY"), Link("condition")},
+						},
+						Description: Description{
+							Template: "Filters records by the results of analytic functions. " +
+								"%s can include analytic functions and refer to the aliases of the fields in the select clause.",
+							Values: []Element{Link("condition")},
+						},
+					},
+				},
+			},
 			{
 				Label: "ORDER BY Clause",
 				Grammar: []Definition{
@@ -275,8 +397,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "insert_query",
 				Group: []Grammar{
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}},
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query")},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}, Option{Link("returning_clause")}},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query"), Option{Link("returning_clause")}},
 				},
 			},
 		},
@@ -293,8 +415,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "update_query",
 				Group: []Grammar{
-					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}},
-					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
 				},
 			},
 			{
@@ -303,6 +425,12 @@ var CsvqSyntax = []Expression{
 					{Identifier("column_name"), Token("="), Link("value")},
 				},
 			},
+			{
+				Name: "returning_clause",
+				Group: []Grammar{
+					{Keyword("RETURNING"), ContinuousOption{Link("field")}},
+				},
+			},
 		},
 	},
 	{
@@ -317,8 +445,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "replace_query",
 				Group: []Grammar{
-					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}},
-					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Link("select_query")},
+					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}, Option{Link("returning_clause")}},
+					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Link("select_query"), Option{Link("returning_clause")}},
 				},
 			},
 		},
@@ -335,8 +463,36 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "delete_query",
 				Group: []Grammar{
-					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}},
-					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+				},
+			},
+		},
+	},
+	{
+		Label: "MERGE Statement",
+		Grammar: []Definition{
+			{
+				Name: "merge_statement",
+				Group: []Grammar{
+					{Option{Link("with_clause")}, Link("merge_query")},
+				},
+			},
+			{
+				Name: "merge_query",
+				Group: []Grammar{
+					{Keyword("MERGE"), Keyword("INTO"), Identifier("table_name"), Option{Option{Keyword("AS")}, Identifier("alias")}, Keyword("USING"), Link("table"), Keyword("ON"), Link("condition"), Link("merge_when_clause"), Option{Link("merge_when_clause"), Token("...")}},
+				},
+			},
+			{
+				Name: "merge_when_clause",
+				Group: []Grammar{
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("INSERT"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), Link("row_value")},
+				},
+				Description: Description{
+					Template: "For each record of the source table, the first clause that applies is executed.",
 				},
 			},
 		},
@@ -410,6 +566,21 @@ var CsvqSyntax = []Expression{
 			},
 		},
 	},
+	{
+		Label: "EXPLAIN Statement",
+		Grammar: []Definition{
+			{
+				Name: "explain_statement",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, AnyOne{Link("select_query"), Link("insert_statement"), Link("update_statement"), Link("delete_statement")}},
+				},
+				Description: Description{
+					Template: "Return the execution plan of the statement as a result set. If %s is specified, the statement is executed and the loops, the number of rows and the elapsed time of each step are reported.",
+					Values:   []Element{Keyword("ANALYZE")},
+				},
+			},
+		},
+	},
 	{
 		Label: "Prepared Statement",
 		Grammar: []Definition{
@@ -500,6 +671,7 @@ var CsvqSyntax = []Expression{
 				Name: "declare_cursor_statement",
 				Group: []Grammar{
 					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("select_query")},
+					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("data_modifying_query")},
 					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Identifier("prepared_statement_name")},
 				},
 			},
@@ -613,13 +785,48 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "aggregate_function_call",
 				Group: []Grammar{
-					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Option{Link("filter_clause")}},
 				},
 			},
 			{
 				Name: "analytic_function_call",
 				Group: []Grammar{
-					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Option{Link("filter_clause")}, Keyword("OVER"), Parentheses{Option{Identifier("window_name")}, Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}},
+					{Identifier("function_name"), Parentheses{Option{Keyword("DISTINCT")}, Link("list_value"), Option{ContinuousOption{Link("argument")}}}, Option{Link("filter_clause")}, Keyword("OVER"), Identifier("window_name")},
+				},
+			},
+		},
+	},
+	{
+		Label: "Stored Procedures",
+		Grammar: []Definition{
+			{
+				Name: "declare_procedure_statement",
+				Group: []Grammar{
+					{Keyword("DECLARE"), Identifier("procedure_name"), Keyword("PROCEDURE"), Parentheses{Option{ContinuousOption{Link("procedure_parameter")}}}, Keyword("AS"), Keyword("BEGIN"), Token("statements"), Keyword("END")},
+				},
+			},
+			{
+				Name: "procedure_parameter",
+				Group: []Grammar{
+					{Variable("@parameter")},
+					{Keyword("OUT"), Variable("@parameter")},
+				},
+				Description: Description{
+					Template: "When the procedure ends, the value of an %s parameter is set to the variable passed as the argument.",
+					Values:   []Element{Keyword("OUT")},
+				},
+			},
+			{
+				Name: "call_statement",
+				Group: []Grammar{
+					{Keyword("CALL"), Identifier("procedure_name"), Parentheses{Option{ContinuousOption{Link("argument")}}}},
+				},
+			},
+			{
+				Name: "dispose_procedure_statement",
+				Group: []Grammar{
+					{Keyword("DISPOSE"), Keyword("PROCEDURE"), Identifier("procedure_name")},
 				},
 			},
 		},
@@ -684,6 +891,19 @@ var CsvqSyntax = []Expression{
 					Values:   []Element{Token("1"), Integer("exit_code")},
 				},
 			},
+			{
+				Name: "try_catch_statement",
+				Group: []Grammar{
+					{Keyword("BEGIN"), Keyword("TRY"), Token("statements"), Keyword("END"), Keyword("TRY"), Keyword("BEGIN"), Keyword("CATCH"), Token("statements"), Keyword("END"), Keyword("CATCH")},
+				},
+				Description: Description{
+					Template: "" +
+						"If an error occurs in the TRY block, the statements in the CATCH block are executed. " +
+						"The caught error can be referred with %s, %s, %s and %s in the CATCH block, " +
+						"and a %s statement without any arguments raises the caught error again.",
+					Values: []Element{Variable("@#ERROR_CODE"), Variable("@#ERROR_MESSAGE"), Variable("@#ERROR_LINE"), Variable("@#ERROR_SOURCE"), Link("trigger_error_statement")},
+				},
+			},
 		},
 	},
 	{
@@ -837,22 +1057,31 @@ var CsvqSyntax = []Expression{
 						"%s\n" +
 						"  > 64-bit floating point numbers.\n" +
 						"%s\n" +
+						"  > Arbitrary-precision decimal numbers.\n" +
+						"%s\n" +
 						"  > Boolean values. true or false.\n" +
 						"%s\n" +
 						"  > Values of three-valued logic. TRUE, UNKNOWN or FALSE.\n" +
 						"%s\n" +
 						"  > Values of Date and time with nano seconds.\n" +
 						"%s\n" +
-						"  > Representations of missing values." +
+						"  > Periods of time represented by months, days and nano seconds.\n" +
+						"%s\n" +
+						"  > Representations of missing values.\n" +
+						"%s\n" +
+						"  > Ordered lists of values." +
 						"",
 					Values: []Element{
 						String("String"),
 						Integer("Integer"),
 						Float("Float"),
+						Italic("Decimal"),
 						Boolean("Boolean"),
 						Ternary("Ternary"),
 						Datetime("Datetime"),
+						Italic("Interval"),
 						Null("Null"),
+						Italic("Array"),
 					},
 				},
 			},
@@ -892,6 +1121,7 @@ var CsvqSyntax = []Expression{
 						"   > %s\n" +
 						"   > %s\n" +
 						"   > %s\n" +
+						"   > %s\n" +
 						"",
 					Values: []Element{
 						Link("Logical Functions"),
@@ -899,6 +1129,7 @@ var CsvqSyntax = []Expression{
 						Link("DateTime Functions"),
 						Link("String Functions"),
 						Link("Cryptographic Hash Functions"),
+						Link("Array Functions"),
 						Link("Cast Functions"),
 						Link("System Functions"),
 						Link("Aggregate Functions"),
@@ -954,6 +1185,40 @@ var CsvqSyntax = []Expression{
 					{Parentheses{Link("value")}},
 				},
 			},
+			{
+				Name: "array_constructor",
+				Group: []Grammar{
+					{Keyword("ARRAY"), Token("["), Option{Link("value"), Option{Token(","), Link("value"), Token("...")}}, Token("]")},
+				},
+				Description: Description{Template: "Returns an array that has the values as its elements."},
+			},
+			{
+				Name: "interval",
+				Group: []Grammar{
+					{Keyword("INTERVAL"), String("duration")},
+					{Keyword("INTERVAL"), Float("amount"), Identifier("unit")},
+				},
+				Description: Description{
+					Template: "" +
+						"Returns an interval. " +
+						"The %s is an ISO 8601 duration such as 'P1DT2H' or a duration string such as '1h30m'. " +
+						"The %s is one of YEAR, MONTH, WEEK, DAY, HOUR, MINUTE, SECOND, MILLISECOND, MICROSECOND and NANOSECOND. " +
+						"Amounts for YEAR, MONTH, WEEK and DAY must be integers.",
+					Values: []Element{String("duration"), Identifier("unit")},
+				},
+			},
+			{
+				Name: "array_subscript",
+				Group: []Grammar{
+					{ConnectedGroup{Italic("array"), Token("["), Integer("index"), Token("]")}},
+				},
+				Description: Description{
+					Template: "Returns the element at the %s of the %s. " +
+						"The first element is at index 1, and negative indices count from the end of the array. " +
+						"If the %s is out of range, then returns %s.",
+					Values: []Element{Integer("index"), Italic("array"), Integer("index"), Null("NULL")},
+				},
+			},
 			{
 				Name: "case_expression",
 				Group: []Grammar{
@@ -1011,6 +1276,8 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Use double quotation mark(U+0022 \") as identifier enclosure.\n" +
 				"%s  <type::%s>\n" +
+				"  > Calculate numbers as exact decimals.\n" +
+				"%s  <type::%s>\n" +
 				"  > Limit of the waiting time in seconds to wait for locked files to be released.\n" +
 				"%s  <type::%s>\n" +
 				"  > Default format to load files.\n" +
@@ -1066,6 +1333,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@TIMEZONE"), String("string"), Link("Timezone"),
 				Flag("@@DATETIME_FORMAT"), String("string"),
 				Flag("@@ANSI_QUOTES"), String("boolean"),
+				Flag("@@EXACT_DECIMAL"), Boolean("boolean"),
 				Flag("@@WAIT_TIMEOUT"), Float("float"),
 				Flag("@@IMPORT_FORMAT"), String("string"),
 				Flag("@@DELIMITER"), String("string"),
@@ -1082,6 +1350,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@WITHOUT_HEADER"), Boolean("boolean"),
 				Flag("@@LINE_BREAK"), String("string"), Link("Line Break"),
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
+				Flag("@@ARRAY_SEPARATOR"), String("string"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
@@ -1091,6 +1360,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@QUIET"), Boolean("boolean"),
 				Flag("@@CPU"), Integer("integer"),
 				Flag("@@STATS"), Boolean("boolean"),
+				Flag("@@STREAMING"), Boolean("boolean"),
 			},
 		},
 		Grammar: []Definition{
@@ -1155,9 +1425,19 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Number of loaded tables.\n" +
 				"%s  <type::%s>\n" +
+				"  > Strategy used for the last join.\n" +
+				"%s  <type::%s>\n" +
 				"  > Current working directory.\n" +
 				"%s  <type::%s>\n" +
 				"  > Version of csvq.\n" +
+				"%s  <type::%s>\n" +
+				"  > Error number of the error caught in the current CATCH block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Error message of the error caught in the current CATCH block.\n" +
+				"%s  <type::%s>\n" +
+				"  > Line number where the error caught in the current CATCH block occurred.\n" +
+				"%s  <type::%s>\n" +
+				"  > Source file where the error caught in the current CATCH block occurred.\n" +
 				"",
 			Values: []Element{
 				Variable("@#UNCOMMITTED"), Boolean("boolean"),
@@ -1165,8 +1445,13 @@ var CsvqSyntax = []Expression{
 				Variable("@#UPDATED"), Integer("integer"),
 				Variable("@#UPDATED_VIEWS"), Integer("integer"),
 				Variable("@#LOADED_TABLES"), Integer("integer"),
+				Variable("@#JOIN_STRATEGY"), String("string"),
 				Variable("@#WORKING_DIRECTORY"), String("string"),
 				Variable("@#VERSION"), String("string"),
+				Variable("@#ERROR_CODE"), Integer("integer"),
+				Variable("@#ERROR_MESSAGE"), String("string"),
+				Variable("@#ERROR_LINE"), Integer("integer"),
+				Variable("@#ERROR_SOURCE"), String("string"),
 			},
 		},
 	},
@@ -1271,6 +1556,7 @@ var CsvqSyntax = []Expression{
 						"  |            | BETWEEN             | n/a           |\n" +
 						"  |            | IN                  | n/a           |\n" +
 						"  |            | LIKE                | n/a           |\n" +
+						"  |            | REGEXP              | n/a           |\n" +
 						"  |          6 | NOT                 | Right-to-Left |\n" +
 						"  |          7 | AND                 | Left-to-Right |\n" +
 						"  |          8 | OR                  | Left-to-Right |\n" +
@@ -1393,6 +1679,16 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("str"), String("pattern"), String("str"), Ternary("UNKNOWN"), String("pattern"), Token("%")},
 						},
 					},
+					{
+						Name: "regexp",
+						Group: []Grammar{
+							{String("str"), Option{Keyword("NOT")}, Keyword("REGEXP"), String("pattern")},
+						},
+						Description: Description{
+							Template: "Check if %s matches the regular expression %s. If %s or %s is null, then returns %s. The match is case-sensitive.",
+							Values:   []Element{String("str"), String("pattern"), String("str"), String("pattern"), Ternary("UNKNOWN")},
+						},
+					},
 					{
 						Name: "in",
 						Group: []Grammar{
@@ -1542,9 +1838,10 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "round",
 						Group: []Grammar{
-							{Function{Name: "ROUND", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}}, Return: Return("float or integer")}},
+							{Function{Name: "ROUND", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}}, Return: Return("float or integer or decimal")}},
 						},
-						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place")}},
+						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part. " +
+							"If %s is a decimal or %s is true, then %s is rounded as a decimal.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place"), Float("number"), Flag("@@EXACT_DECIMAL"), Float("number")}},
 					},
 					{
 						Name: "abs",
@@ -1993,6 +2290,30 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the difference of time between two %s values as nanoseconds.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "time_bucket",
+						Group: []Grammar{
+							{Function{Name: "TIME_BUCKET", Args: []Element{Link("interval"), Datetime("datetime"), Option{Datetime("origin")}}, Return: Return("datetime")}},
+						},
+						Description: Description{
+							Template: "Returns the start of the bucket of %s that contains %s. " +
+								"Buckets are aligned to %s, and the default is 2000-01-01 for buckets of months and 2000-01-03, a Monday, for other buckets. " +
+								"%s must be positive, and cannot mix months with days or time.",
+							Values: []Element{Link("interval"), Datetime("datetime"), Datetime("origin"), Link("interval")},
+						},
+					},
+					{
+						Name: "time_bucket_gapfill",
+						Group: []Grammar{
+							{Function{Name: "TIME_BUCKET_GAPFILL", Args: []Element{Link("interval"), Datetime("datetime"), Option{Datetime("start"), Datetime("finish")}}, Return: Return("datetime")}},
+						},
+						Description: Description{
+							Template: "Returns the start of the bucket of %s that contains %s. " +
+								"In a %s clause, groups of missing buckets from the bucket containing %s to the last bucket before %s are generated for each combination of the other grouping elements. " +
+								"If %s or %s is omitted, then the first or last bucket in the records is used.",
+							Values: []Element{Link("interval"), Datetime("datetime"), Keyword("GROUP BY"), Datetime("start"), Datetime("finish"), Datetime("start"), Datetime("finish")},
+						},
+					},
 					{
 						Name: "utc",
 						Group: []Grammar{
@@ -2000,6 +2321,45 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the datetime value of %s in UTC.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "convert_tz",
+						Group: []Grammar{
+							{Function{Name: "CONVERT_TZ", Args: []Element{Datetime("datetime"), String("from_time_zone"), String("to_time_zone")}, Return: Return("datetime")}},
+						},
+						Description: Description{
+							Template: "Interprets the date and time of %s as the time in %s, and returns the datetime value converted to %s. " +
+								"Time zones are IANA time zone names, %s, %s, or offsets from UTC such as \"+09:00\".",
+							Values: []Element{Datetime("datetime"), String("from_time_zone"), String("to_time_zone"), Keyword("Local"), Keyword("UTC")},
+						},
+					},
+					{
+						Name: "tz_offset",
+						Group: []Grammar{
+							{Function{Name: "TZ_OFFSET", Args: []Element{Datetime("datetime")}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the offset from UTC of %s formatted as \"+09:00\".", Values: []Element{Datetime("datetime")}},
+					},
+					{
+						Name: "tz_name",
+						Group: []Grammar{
+							{Function{Name: "TZ_NAME", Args: []Element{Datetime("datetime")}, Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the IANA time zone name of %s. " +
+								"If %s does not have the name, then returns the abbreviation of the time zone or the offset from UTC.",
+							Values: []Element{Datetime("datetime"), Datetime("datetime")},
+						},
+					},
+					{
+						Name: "at_time_zone",
+						Group: []Grammar{
+							{Datetime("datetime"), Keyword("AT TIME ZONE"), String("time_zone")},
+						},
+						Description: Description{
+							Template: "Returns the datetime value representing the same instant as %s in %s.",
+							Values:   []Element{Datetime("datetime"), String("time_zone")},
+						},
+					},
 				},
 			},
 			{
@@ -2181,6 +2541,34 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the string that is replaced all occurrences of %s with %s in %s.", Values: []Element{String("old"), String("new"), String("str")}},
 					},
+					{
+						Name: "regexp_match",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_MATCH", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns whether %s contains any match of the regular expression %s. %s is a combination of the characters \"i\", \"m\", \"s\" and \"U\".", Values: []Element{String("str"), String("pattern"), String("flags")}},
+					},
+					{
+						Name: "regexp_find",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the leftmost substring of %s that matches the regular expression %s. If there is no match, then returns %s.", Values: []Element{String("str"), String("pattern"), Null("NULL")}},
+					},
+					{
+						Name: "regexp_find_all",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("pattern"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns a string formatted in JSON array containing all substrings of %s that match the regular expression %s.", Values: []Element{String("str"), String("pattern")}},
+					},
+					{
+						Name: "regexp_replace",
+						Group: []Grammar{
+							{Function{Name: "REGEXP_REPLACE", Args: []Element{String("str"), String("pattern"), String("replacement"), Option{String("flags")}}, Return: Return("string")}},
+						},
+						Description: Description{Template: "Returns the string that is replaced all matches of the regular expression %s with %s in %s. In %s, $1 or ${1} is replaced by the text of the first submatch.", Values: []Element{String("pattern"), String("replacement"), String("str"), String("replacement")}},
+					},
 					{
 						Name: "format",
 						Group: []Grammar{
@@ -2265,6 +2653,41 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "Array Functions",
+				Grammar: []Definition{
+					{
+						Name: "split",
+						Group: []Grammar{
+							{Function{Name: "SPLIT", Args: []Element{String("str"), String("separator")}, Return: Return("array")}},
+						},
+						Description: Description{
+							Template: "Splits %s by %s and returns an array of the substrings.",
+							Values:   []Element{String("str"), String("separator")},
+						},
+					},
+					{
+						Name: "array_length",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_LENGTH", Args: []Element{Italic("array")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the number of elements in %s.",
+							Values:   []Element{Italic("array")},
+						},
+					},
+					{
+						Name: "array_contains",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_CONTAINS", Args: []Element{Italic("array"), Link("value")}, Return: Return("ternary")}},
+						},
+						Description: Description{
+							Template: "Returns whether any element of %s is equal to %s.",
+							Values:   []Element{Italic("array"), Link("value")},
+						},
+					},
+				},
+			},
 			{
 				Label: "Cast Functions",
 				Grammar: []Definition{
@@ -2289,6 +2712,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a float.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "decimal",
+						Group: []Grammar{
+							{Function{Name: "DECIMAL", Args: []Element{Link("value"), Option{Integer("scale")}}, Return: Return("decimal")}},
+						},
+						Description: Description{Template: "Converts %s to a decimal. If %s is specified, then the result is rounded half away from zero to have %s digits after the decimal point.", Values: []Element{Link("value"), Integer("scale"), Integer("scale")}},
+					},
 					{
 						Name: "datetime",
 						Group: []Grammar{
@@ -2334,10 +2764,11 @@ var CsvqSyntax = []Expression{
 					Template: "" +
 						"Aggregate functions calculate groupd records retrieved by a select query. " +
 						"If records are not grouped, all records are dealt with as one group. " +
-						"If %s keyword is specified, aggregate functions calculate only unique values.\n" +
+						"If %s keyword is specified, aggregate functions calculate only unique values. " +
+						"If %s is specified, aggregate functions calculate only the values of the records that satisfy the condition.\n" +
 						"\n" +
 						"Analytic Functions can be used only in %s, %s and %s",
-					Values: []Element{Keyword("DISTINCT"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
+					Values: []Element{Keyword("DISTINCT"), Link("filter_clause"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
 					{
@@ -2377,7 +2808,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "sum",
 						Group: []Grammar{
-							{Function{Name: "SUM", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "SUM", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer or decimal")}},
 						},
 						Description: Description{
 							Template: "Returns the sum of float values of %s. " +
@@ -2388,7 +2819,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "avg",
 						Group: []Grammar{
-							{Function{Name: "AVG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+							{Function{Name: "AVG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer or decimal")}},
 						},
 						Description: Description{
 							Template: "Returns the average of float values of %s. " +
@@ -2454,6 +2885,149 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at the position of %s in the sorted non-null values of %s, interpolating linearly between the two adjacent values. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value whose cumulative distribution is greater than or equal to %s in the sorted non-null values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "skewness",
+						Group: []Grammar{
+							{Function{Name: "SKEWNESS", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample skewness of float values of %s. " +
+								"If the number of values is less than 3, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "kurtosis",
+						Group: []Grammar{
+							{Function{Name: "KURTOSIS", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample excess kurtosis of float values of %s. " +
+								"If the number of values is less than 4, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the linear regression line of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the linear regression line of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the linear regression of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "approx_count_distinct",
+						Group: []Grammar{
+							{Function{Name: "APPROX_COUNT_DISTINCT", Args: []Element{Link("value")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the approximate number of distinct non-null values of %s. " +
+								"The values are summarized by a HyperLogLog sketch, so the memory usage does not depend on the number of the values.",
+							Values: []Element{Link("value")},
+						},
+					},
+					{
+						Name: "approx_percentile",
+						Group: []Grammar{
+							{Function{Name: "APPROX_PERCENTILE", Args: []Element{Float("value"), Float("fraction")}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the approximate value at the position of %s in the sorted non-null values of %s. " +
+								"The values are summarized by a t-digest sketch, so the memory usage does not depend on the number of the values.",
+							Values: []Element{Float("fraction"), Float("value")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2479,13 +3053,49 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "array_agg",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}}, Return: Return("array")}},
+						},
+						Description: Description{
+							Template: "Returns the array of %s. " +
+								"By using %s, you can sort values.",
+							Values: []Element{Link("value"), Link("order_by_clause")},
+						},
+					},
+					{
+						Name: "grouping",
+						Group: []Grammar{
+							{Function{Name: "GROUPING", Args: []Element{ContinuousOption{Link("field")}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns a bit mask indicating whether each %s is aggregated away by ROLLUP, CUBE or GROUPING SETS. " +
+								"A bit is 1 if the corresponding %s is not included in the grouping set of the record, otherwise 0. " +
+								"The bit of the last argument is the least significant bit.",
+							Values: []Element{Link("field"), Link("field")},
+						},
+					},
+				},
+				Children: []Expression{
+					{
+						Label: "Filter Clause",
+						Grammar: []Definition{
+							{
+								Name: "filter_clause",
+								Group: []Grammar{
+									{Keyword("FILTER"), Parentheses{Keyword("WHERE"), Link("condition")}},
+								},
+							},
+						},
+					},
 				},
 			},
 			{
 				Label: "Analytic Functions",
 				Description: Description{
-					Template: "Analytic functions calculate values of groups. Analytic Functions can be used only in %s and %s",
-					Values:   []Element{Link("Select Clause"), Link("Order By Clause")},
+					Template: "Analytic functions calculate values of groups. Analytic Functions can be used only in %s, %s and %s",
+					Values:   []Element{Link("Select Clause"), Link("Qualify Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
 					{
@@ -2598,6 +3208,29 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Keyword("IGNORE"), Keyword("NULLS")},
 						},
 					},
+					{
+						Name: "locf",
+						Group: []Grammar{
+							{Function{Name: "LOCF", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the value of %s in the current row. " +
+								"If the value is null, then returns the last non-null value in previous rows.",
+							Values: []Element{Link("value")},
+						},
+					},
+					{
+						Name: "interpolate",
+						Group: []Grammar{
+							{Function{Name: "INTERPOLATE", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value of %s in the current row. " +
+								"If the value is null, then returns the value linearly interpolated from the nearest non-null values in previous and following rows. " +
+								"The distances between rows are measured by the first item of %s if the values are numbers or datetimes.",
+							Values: []Element{Link("value"), Link("order_by_clause")},
+						},
+					},
 					{
 						Name: "count",
 						Group: []Grammar{
@@ -2632,7 +3265,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "sum",
 						Group: []Grammar{
-							{Function{Name: "SUM", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "SUM", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer or decimal")}},
 						},
 						Description: Description{
 							Template: "Returns the sum of float values of %s. If all values are null, then returns %s.",
@@ -2642,7 +3275,7 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "avg",
 						Group: []Grammar{
-							{Function{Name: "AVG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+							{Function{Name: "AVG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer or decimal")}},
 						},
 						Description: Description{
 							Template: "Returns the average of float values of %s. If all values are null, then returns %s.",
@@ -2702,10 +3335,153 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the value at the position of %s in the sorted non-null values of %s, interpolating linearly between the two adjacent values. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Keyword("ORDER"), Keyword("BY"), Link("value"), Option{AnyOne{Keyword("ASC"), Keyword("DESC")}}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause")}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value whose cumulative distribution is greater than or equal to %s in the sorted non-null values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "skewness",
+						Group: []Grammar{
+							{Function{Name: "SKEWNESS", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample skewness of float values of %s. " +
+								"If the number of values is less than 3, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "kurtosis",
+						Group: []Grammar{
+							{Function{Name: "KURTOSIS", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample excess kurtosis of float values of %s. " +
+								"If the number of values is less than 4, then returns %s.",
+							Values: []Element{Link("value"), Null("NULL")},
+						},
+					},
+					{
+						Name: "corr",
+						Group: []Grammar{
+							{Function{Name: "CORR", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the correlation coefficient of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_pop",
+						Group: []Grammar{
+							{Function{Name: "COVAR_POP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the population covariance of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "covar_samp",
+						Group: []Grammar{
+							{Function{Name: "COVAR_SAMP", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the sample covariance of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_slope",
+						Group: []Grammar{
+							{Function{Name: "REGR_SLOPE", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the slope of the linear regression line of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_intercept",
+						Group: []Grammar{
+							{Function{Name: "REGR_INTERCEPT", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the y-intercept of the linear regression line of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "regr_r2",
+						Group: []Grammar{
+							{Function{Name: "REGR_R2", Args: []Element{Option{Keyword("DISTINCT")}, Float("y"), Float("x")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the coefficient of determination of the linear regression of pairs of float values of %s and %s. " +
+								"Pairs including null values are ignored.",
+							Values: []Element{Float("y"), Float("x")},
+						},
+					},
+					{
+						Name: "approx_count_distinct",
+						Group: []Grammar{
+							{Function{Name: "APPROX_COUNT_DISTINCT", Args: []Element{Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the approximate number of distinct non-null values of %s. " +
+								"The values are summarized by a HyperLogLog sketch, so the memory usage does not depend on the number of the values.",
+							Values: []Element{Link("value")},
+						},
+					},
+					{
+						Name: "approx_percentile",
+						Group: []Grammar{
+							{Function{Name: "APPROX_PERCENTILE", Args: []Element{Float("value"), Float("fraction")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("float or integer")}},
+						},
+						Description: Description{
+							Template: "Returns the approximate value at the position of %s in the sorted non-null values of %s. " +
+								"The values are summarized by a t-digest sketch, so the memory usage does not depend on the number of the values.",
+							Values: []Element{Float("fraction"), Float("value")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
-							{Function{Name: "LISTAGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
+							{Function{Name: "LISTAGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value"), Option{String("sep")}}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
 						},
 						Description: Description{
 							Template: "Returns the string result with the concatenated non-null values of %s. If all values are null, then returns %s.\n" +
@@ -2717,13 +3493,23 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "json_agg",
 						Group: []Grammar{
-							{Function{Name: "JSON_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
+							{Function{Name: "JSON_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("string")}},
 						},
 						Description: Description{
 							Template: "Returns the string formatted in JSON array of %s.",
 							Values:   []Element{Link("value")},
 						},
 					},
+					{
+						Name: "array_agg",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_AGG", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, AfterArgs: []Element{Option{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Keyword("OVER"), Parentheses{Option{Link("partition_clause")}, Option{Link("order_by_clause"), Option{Link("windowing_clause")}}}}, Return: Return("array")}},
+						},
+						Description: Description{
+							Template: "Returns the array of %s.",
+							Values:   []Element{Link("value")},
+						},
+					},
 				},
 				Children: []Expression{
 					{
@@ -2743,15 +3529,39 @@ var CsvqSyntax = []Expression{
 							{
 								Name: "windowing_clause",
 								Group: []Grammar{
-									{Keyword("ROWS"), Link("window_position")},
-									{Keyword("ROWS"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high")},
+									{Link("window_frame_type"), Link("window_position"), Option{Link("window_exclusion")}},
+									{Link("window_frame_type"), Keyword("BETWEEN"), Link("window_frame_low"), Keyword("AND"), Link("window_frame_high"), Option{Link("window_exclusion")}},
+								},
+							},
+							{
+								Name: "window_frame_type",
+								Group: []Grammar{
+									{Keyword("ROWS")},
+									{Keyword("RANGE")},
+									{Keyword("GROUPS")},
+								},
+							},
+							{
+								Name: "window_exclusion",
+								Group: []Grammar{
+									{Keyword("EXCLUDE"), Keyword("CURRENT"), Keyword("ROW")},
+									{Keyword("EXCLUDE"), Keyword("GROUP")},
+									{Keyword("EXCLUDE"), Keyword("TIES")},
+								},
+							},
+							{
+								Name: "offset",
+								Group: []Grammar{
+									{Integer("offset")},
+									{Float("offset")},
+									{Keyword("INTERVAL"), String("amount"), Identifier("unit")},
 								},
 							},
 							{
 								Name: "window_position",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("PRECEDING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2759,8 +3569,8 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_low",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Link("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2768,8 +3578,8 @@ var CsvqSyntax = []Expression{
 								Name: "window_frame_high",
 								Group: []Grammar{
 									{Keyword("UNBOUNDED"), Keyword("FOLLOWING")},
-									{Integer("offset"), Keyword("PRECEDING")},
-									{Integer("offset"), Keyword("FOLLOWING")},
+									{Link("offset"), Keyword("PRECEDING")},
+									{Link("offset"), Keyword("FOLLOWING")},
 									{Keyword("CURRENT"), Keyword("ROW")},
 								},
 							},
@@ -2901,17 +3711,17 @@ var CsvqSyntax = []Expression{
 				Name: "Reserved Words",
 				Description: Description{
 					Template: "" +
-						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL AND ANY AS ASC AVG BEFORE BEGIN " +
+						"ABSOLUTE ADD AFTER AGGREGATE ALTER ALL ANALYZE AND ANY AS ASC AVG BEFORE BEGIN " +
 						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
 						"CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"EXIT EXPLAIN FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
 						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN " +
 						"JSON_AGG JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
 						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
 						"NTILE NULL OFFSET ON ONLY OPEN OR ORDER OUTER OVER PARTITION PERCENT " +
 						"PERCENT_RANK PRECEDING PREPARE PRINT PRINTF PRIOR PWD RANGE RANK RECURSIVE " +
-						"RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
+						"REGEXP RELATIVE RELOAD REMOVE RENAME REPLACE RETURN RIGHT ROLLBACK ROW ROW_NUMBER " +
 						"SELECT SEPARATOR SET SHOW SOURCE STDEV STDEVP STDIN SUBSTRING SUM SYNTAX TABLE " +
 						"THEN TO TRIGGER TRUE " +
 						"UNBOUNDED UNION UNKNOWN UNSET UPDATE USING VALUES VAR VARP VIEW WHEN WHERE " +
@@ -3115,18 +3925,20 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-mode            |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+---------+------------------------------------------+\n" +
+						"|  Value  |                  Format                  |\n" +
+						"+---------+------------------------------------------+\n" +
+						"| CSV     | Character separated values               |\n" +
+						"| TSV     | Tab separated values                     |\n" +
+						"| FIXED   | Fixed-Length Format                      |\n" +
+						"| JSON    | JSON Format                              |\n" +
+						"| LTSV    | Labeled Tab-separated Values             |\n" +
+						"| PARQUET | Apache Parquet                           |\n" +
+						"| XLSX    | Excel Workbook                           |\n" +
+						"| GFM     | Text Table for GitHub Flavored Markdown  |\n" +
+						"| ORG     | Text Table for Emacs Org-mode            |\n" +
+						"| TEXT    | Text Table for console                   |\n" +
+						"+---------+------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3169,7 +3981,7 @@ var CsvqSyntax = []Expression{
 				Name: "Timezone",
 				Description: Description{
 					Template: "" +
-						"%s, %s or a timezone name in the IANA TimeZone database(in the form of \"Area/Location\". e.g. \"America/Los_Angeles\").\n" +
+						"%s, %s, a timezone name in the IANA TimeZone database(in the form of \"Area/Location\". e.g. \"America/Los_Angeles\"), or an offset from UTC such as \"+09:00\".\n" +
 						"\n" +
 						"The timezone database is required in order to use the timezone names. " +
 						"Most Unix-like systems provide the database. " +
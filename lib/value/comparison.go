@@ -38,6 +38,45 @@ func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string) Compari
 		return IsIncommensurable
 	}
 
+	if a1, ok := p1.(*Array); ok {
+		if a2, ok := p2.(*Array); ok {
+			return compareArrays(a1, a2, datetimeFormats)
+		}
+		return IsIncommensurable
+	}
+	if _, ok := p2.(*Array); ok {
+		return IsIncommensurable
+	}
+
+	if isIntervalValue(p1) || isIntervalValue(p2) {
+		if iv1 := ToInterval(p1); !IsNull(iv1) {
+			if iv2 := ToInterval(p2); !IsNull(iv2) {
+				switch iv1.(*Interval).Cmp(iv2.(*Interval)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				}
+				return IsGreater
+			}
+		}
+		return IsIncommensurable
+	}
+
+	if isDecimalValue(p1) || isDecimalValue(p2) {
+		if d1 := ToDecimal(p1); !IsNull(d1) {
+			if d2 := ToDecimal(p2); !IsNull(d2) {
+				switch d1.(*Decimal).Cmp(d2.(*Decimal)) {
+				case 0:
+					return IsEqual
+				case -1:
+					return IsLess
+				}
+				return IsGreater
+			}
+		}
+	}
+
 	if i1 := ToInteger(p1); !IsNull(i1) {
 		if i2 := ToInteger(p2); !IsNull(i2) {
 			v1 := i1.(*Integer).Raw()
@@ -115,6 +154,34 @@ func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string) Compari
 	return IsIncommensurable
 }
 
+func isDecimalValue(p Primary) bool {
+	_, ok := p.(*Decimal)
+	return ok
+}
+
+func isIntervalValue(p Primary) bool {
+	_, ok := p.(*Interval)
+	return ok
+}
+
+// compareArrays compares elements of the arrays in order.
+// The first pair of elements that are not equal determines the result.
+func compareArrays(a1 *Array, a2 *Array, datetimeFormats []string) ComparisonResult {
+	for i := 0; i < len(a1.values) && i < len(a2.values); i++ {
+		if r := CompareCombinedly(a1.values[i], a2.values[i], datetimeFormats); r != IsEqual && r != IsBoolEqual {
+			return r
+		}
+	}
+
+	switch {
+	case len(a1.values) < len(a2.values):
+		return IsLess
+	case len(a2.values) < len(a1.values):
+		return IsGreater
+	}
+	return IsEqual
+}
+
 func Identical(p1 Primary, p2 Primary) ternary.Value {
 	if t, ok := p1.(*Ternary); (ok && t.value == ternary.UNKNOWN) || IsNull(p1) {
 		return ternary.UNKNOWN
@@ -159,6 +226,31 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 		}
 	}
 
+	if v1, ok := p1.(*Decimal); ok {
+		if v2, ok := p2.(*Decimal); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
+	if v1, ok := p1.(*Interval); ok {
+		if v2, ok := p2.(*Interval); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
+	if v1, ok := p1.(*Array); ok {
+		if v2, ok := p2.(*Array); ok {
+			if len(v1.values) != len(v2.values) {
+				return ternary.FALSE
+			}
+			result := ternary.TRUE
+			for i := range v1.values {
+				result = ternary.And(result, Identical(v1.values[i], v2.values[i]))
+			}
+			return result
+		}
+	}
+
 	return ternary.FALSE
 }
 
@@ -1,7 +1,9 @@
 package value
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/ternary"
 )
@@ -107,6 +109,86 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewString("A"),
 		Result: IsLess,
 	},
+	{
+		LHS:    NewDecimal(big.NewInt(30), 2),
+		RHS:    NewDecimal(big.NewInt(3), 1),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewDecimal(big.NewInt(30000000000000001), 17),
+		RHS:    NewFloat(0.3),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewInteger(1),
+		RHS:    NewDecimal(big.NewInt(15), 1),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewDecimal(big.NewInt(15), 1),
+		RHS:    NewString("1.5"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewDecimal(big.NewInt(15), 1),
+		RHS:    NewString("abc"),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewDatetime(time.Date(2024, 1, 1, 9, 0, 0, 0, time.FixedZone("", 9*3600))),
+		RHS:    NewDatetime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewDatetime(time.Date(2024, 1, 1, 9, 0, 0, 0, time.FixedZone("", 9*3600))),
+		RHS:    NewDatetime(time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewInterval(0, 0, int64(90*time.Minute)),
+		RHS:    NewInterval(0, 0, int64(time.Hour)),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewInterval(1, 0, 0),
+		RHS:    NewString("P30D"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewFloat(1), NewString("A")}),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewInteger(3)}),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		RHS:    NewArray([]Primary{NewInteger(1)}),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewString("1"),
+		RHS:    NewArray([]Primary{NewInteger(1)}),
+		Result: IsIncommensurable,
+	},
 }
 
 func TestCompareCombinedly(t *testing.T) {
@@ -183,6 +265,41 @@ var identicalTests = []struct {
 		RHS:    NewFloat(1),
 		Result: ternary.FALSE,
 	},
+	{
+		LHS:    NewDecimal(big.NewInt(10), 1),
+		RHS:    NewDecimal(big.NewInt(1), 0),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewDecimal(big.NewInt(1), 0),
+		RHS:    NewInteger(1),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInterval(0, 0, int64(24*time.Hour)),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("A")}),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewInteger(2)}),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewNull()}),
+		Result: ternary.UNKNOWN,
+	},
 }
 
 func TestIdentical(t *testing.T) {
@@ -1,6 +1,8 @@
 package value
 
 import (
+	"math"
+	"math/big"
 	"testing"
 	"time"
 
@@ -316,6 +318,18 @@ func TestToInteger(t *testing.T) {
 		t.Errorf("primary type = %T, want Null for %#v", i, p)
 	}
 
+	p = NewDecimal(big.NewInt(100), 2)
+	i = ToInteger(p)
+	if _, ok := i.(*Integer); !ok {
+		t.Errorf("primary type = %T, want Integer for %#v", i, p)
+	}
+
+	p = NewDecimal(big.NewInt(105), 2)
+	i = ToInteger(p)
+	if _, ok := i.(*Null); !ok {
+		t.Errorf("primary type = %T, want Null for %#v", i, p)
+	}
+
 	p = NewString(" 1")
 	i = ToInteger(p)
 	if _, ok := i.(*Integer); !ok {
@@ -393,6 +407,12 @@ func TestToFloat(t *testing.T) {
 		t.Errorf("primary type = %T, want Float for %#v", f, p)
 	}
 
+	p = NewDecimal(big.NewInt(1234), 3)
+	f = ToFloat(p)
+	if _, ok := f.(*Float); !ok {
+		t.Errorf("primary type = %T, want Float for %#v", f, p)
+	}
+
 	p = NewString("1")
 	f = ToFloat(p)
 	if _, ok := f.(*Float); !ok {
@@ -406,6 +426,45 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestToDecimal(t *testing.T) {
+	for _, v := range []struct {
+		Value  Primary
+		Result string
+	}{
+		{Value: NewInteger(-12), Result: "-12"},
+		{Value: NewFloat(0.1), Result: "0.1"},
+		{Value: NewDecimal(big.NewInt(1050), 2), Result: "10.50"},
+		{Value: NewString(" 1.230 "), Result: "1.230"},
+		{Value: NewString("1.5e+3"), Result: "1500"},
+		{Value: NewString("error"), Result: "NULL"},
+		{Value: NewFloat(math.NaN()), Result: "NULL"},
+		{Value: NewBoolean(true), Result: "NULL"},
+	} {
+		r := ToDecimal(v.Value)
+		if r.String() != v.Result {
+			t.Errorf("result = %s, want %s for %#v", r, v.Result, v.Value)
+		}
+	}
+}
+
+func TestToInterval(t *testing.T) {
+	for _, v := range []struct {
+		Value  Primary
+		Result string
+	}{
+		{Value: NewInterval(0, 1, 0), Result: "P1D"},
+		{Value: NewString(" PT1H "), Result: "PT1H"},
+		{Value: NewString("1h30m"), Result: "PT1H30M"},
+		{Value: NewString("error"), Result: "NULL"},
+		{Value: NewInteger(1), Result: "NULL"},
+	} {
+		r := ToInterval(v.Value)
+		if r.String() != v.Result {
+			t.Errorf("result = %s, want %s for %#v", r, v.Result, v.Value)
+		}
+	}
+}
+
 func TestToDatetime(t *testing.T) {
 	var p Primary
 	var dt Primary
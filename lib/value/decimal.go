@@ -0,0 +1,289 @@
+package value
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/ternary"
+)
+
+// DecimalDivisionScale is the minimum number of digits after the decimal point
+// in the results of divisions.
+const DecimalDivisionScale = 16
+
+// DecimalMaxScale is the maximum absolute value of the scale of decimal numbers
+// that can be parsed from strings.
+const DecimalMaxScale = 4096
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an arbitrary-precision decimal number.
+// The number is represented by an unscaled integer and the number of digits
+// after the decimal point.
+type Decimal struct {
+	value *big.Int
+	scale int
+}
+
+func NewDecimal(unscaled *big.Int, scale int) *Decimal {
+	v := new(big.Int).Set(unscaled)
+	if scale < 0 {
+		v.Mul(v, pow10(-scale))
+		scale = 0
+	}
+	return &Decimal{
+		value: v,
+		scale: scale,
+	}
+}
+
+func NewDecimalFromInt64(i int64) *Decimal {
+	return &Decimal{
+		value: big.NewInt(i),
+		scale: 0,
+	}
+}
+
+// NewDecimalFromFloat64 returns the decimal that has the shortest representation
+// of the float value. NaN and infinities cannot be converted.
+func NewDecimalFromFloat64(f float64) (*Decimal, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal parses a decimal number that can have a sign, a decimal point
+// and an exponent. Numbers whose scale exceeds DecimalMaxScale are not parsed.
+func ParseDecimal(s string) (*Decimal, bool) {
+	s = cmd.TrimSpace(s)
+	if !MaybeNumber(s) {
+		return nil, false
+	}
+
+	exp := 0
+	if idx := strings.IndexAny(s, "eE"); -1 < idx {
+		e, err := strconv.Atoi(s[idx+1:])
+		if err != nil {
+			return nil, false
+		}
+		if e < -DecimalMaxScale || DecimalMaxScale < e {
+			return nil, false
+		}
+		exp = e
+		s = s[:idx]
+	}
+
+	scale := 0
+	if idx := strings.IndexByte(s, '.'); -1 < idx {
+		scale = len(s) - idx - 1
+		s = s[:idx] + s[idx+1:]
+	}
+
+	scale = scale - exp
+	if scale < -DecimalMaxScale || DecimalMaxScale < scale {
+		return nil, false
+	}
+
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, false
+	}
+	return NewDecimal(v, scale), true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.value).String()
+	if 0 < d.scale {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.value.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Unscaled returns the integer that the decimal number is multiplied by 10 to the power of the scale.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.value)
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.value.Sign()
+}
+
+func (d Decimal) Ternary() ternary.Value {
+	if d.value.Sign() == 0 {
+		return ternary.FALSE
+	}
+	if i, ok := d.Int64(); ok && i == 1 {
+		return ternary.TRUE
+	}
+	return ternary.UNKNOWN
+}
+
+// Int64 returns the integer value if the decimal has no fractional part
+// and the value fits in int64.
+func (d Decimal) Int64() (int64, bool) {
+	if d.scale == 0 {
+		if d.value.IsInt64() {
+			return d.value.Int64(), true
+		}
+		return 0, false
+	}
+
+	q, r := new(big.Int).QuoRem(d.value, pow10(d.scale), new(big.Int))
+	if r.Sign() != 0 || !q.IsInt64() {
+		return 0, false
+	}
+	return q.Int64(), true
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) rescale(scale int) *big.Int {
+	if scale <= d.scale {
+		return d.value
+	}
+	return new(big.Int).Mul(d.value, pow10(scale-d.scale))
+}
+
+func (d Decimal) Neg() *Decimal {
+	return &Decimal{value: new(big.Int).Neg(d.value), scale: d.scale}
+}
+
+func (d Decimal) Abs() *Decimal {
+	return &Decimal{value: new(big.Int).Abs(d.value), scale: d.scale}
+}
+
+func (d Decimal) Add(d2 *Decimal) *Decimal {
+	scale := maxInt(d.scale, d2.scale)
+	return &Decimal{value: new(big.Int).Add(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(d2 *Decimal) *Decimal {
+	scale := maxInt(d.scale, d2.scale)
+	return &Decimal{value: new(big.Int).Sub(d.rescale(scale), d2.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Mul(d2 *Decimal) *Decimal {
+	return &Decimal{value: new(big.Int).Mul(d.value, d2.value), scale: d.scale + d2.scale}
+}
+
+// Quo returns the quotient rounded half away from zero at DecimalDivisionScale digits
+// after the decimal point, and removes trailing zeros down to the larger scale of the operands.
+// If the divisor is zero, then returns false.
+func (d Decimal) Quo(d2 *Decimal) (*Decimal, bool) {
+	if d2.value.Sign() == 0 {
+		return nil, false
+	}
+
+	minScale := maxInt(d.scale, d2.scale)
+	scale := maxInt(DecimalDivisionScale, minScale)
+
+	num := new(big.Int).Mul(d.value, pow10(scale+d2.scale-d.scale))
+	q := divRound(num, d2.value)
+	return (&Decimal{value: q, scale: scale}).trim(minScale), true
+}
+
+// Rem returns the remainder of the truncated division.
+// If the divisor is zero, then returns false.
+func (d Decimal) Rem(d2 *Decimal) (*Decimal, bool) {
+	if d2.value.Sign() == 0 {
+		return nil, false
+	}
+
+	scale := maxInt(d.scale, d2.scale)
+	return &Decimal{value: new(big.Int).Rem(d.rescale(scale), d2.rescale(scale)), scale: scale}, true
+}
+
+// Round rounds the number half away from zero at the place after the decimal point.
+// A negative place rounds the number at the place before the decimal point.
+func (d Decimal) Round(place int) *Decimal {
+	if d.scale <= place {
+		return &Decimal{value: new(big.Int).Set(d.value), scale: d.scale}
+	}
+
+	q := divRound(d.value, pow10(d.scale-place))
+	if place < 0 {
+		return &Decimal{value: q.Mul(q, pow10(-place)), scale: 0}
+	}
+	return &Decimal{value: q, scale: place}
+}
+
+// SetScale returns the number that has the specified number of digits after the decimal point.
+// The number is rounded half away from zero if the scale is smaller than the current one.
+func (d Decimal) SetScale(scale int) *Decimal {
+	if scale < d.scale {
+		return d.Round(scale)
+	}
+	return &Decimal{value: d.rescale(scale), scale: scale}
+}
+
+// Normalize removes trailing zeros after the decimal point.
+func (d Decimal) Normalize() *Decimal {
+	return d.trim(0)
+}
+
+func (d Decimal) trim(minScale int) *Decimal {
+	v := new(big.Int).Set(d.value)
+	scale := d.scale
+	r := new(big.Int)
+	for minScale < scale {
+		q, m := new(big.Int).QuoRem(v, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		v = q
+		scale--
+	}
+	return &Decimal{value: v, scale: scale}
+}
+
+func (d Decimal) Cmp(d2 *Decimal) int {
+	scale := maxInt(d.scale, d2.scale)
+	return d.rescale(scale).Cmp(d2.rescale(scale))
+}
+
+// divRound returns the quotient of x and y rounded half away from zero.
+func divRound(x *big.Int, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	if 0 <= new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(y)) {
+		if x.Sign()*y.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func maxInt(i1 int, i2 int) int {
+	if i1 < i2 {
+		return i2
+	}
+	return i1
+}
@@ -0,0 +1,174 @@
+package value
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/ternary"
+)
+
+var parseDecimalTests = []struct {
+	Input  string
+	Result string
+	OK     bool
+}{
+	{Input: "123", Result: "123", OK: true},
+	{Input: "-0.05", Result: "-0.05", OK: true},
+	{Input: "+1.50", Result: "1.50", OK: true},
+	{Input: "1.25e+2", Result: "125", OK: true},
+	{Input: "1.25e-3", Result: "0.00125", OK: true},
+	{Input: "123456789012345678901234567890.123456789", Result: "123456789012345678901234567890.123456789", OK: true},
+	{Input: "1e-4096", Result: "0." + strings.Repeat("0", 4095) + "1", OK: true},
+	{Input: "1e-4097", OK: false},
+	{Input: "1e+4097", OK: false},
+	{Input: "1e-40000000", OK: false},
+	{Input: "0." + strings.Repeat("0", 4096) + "1", OK: false},
+	{Input: "1.", OK: false},
+	{Input: "abc", OK: false},
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, v := range parseDecimalTests {
+		d, ok := ParseDecimal(v.Input)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Input)
+			continue
+		}
+		if ok && d.String() != v.Result {
+			t.Errorf("result = %s, want %s for %q", d.String(), v.Result, v.Input)
+		}
+	}
+}
+
+func TestNewDecimalFromFloat64(t *testing.T) {
+	d, _ := NewDecimalFromFloat64(0.1)
+	if d.String() != "0.1" {
+		t.Errorf("result = %s, want %s", d.String(), "0.1")
+	}
+}
+
+func TestDecimal_Ternary(t *testing.T) {
+	for _, v := range []struct {
+		Value  *Decimal
+		Result ternary.Value
+	}{
+		{Value: NewDecimal(big.NewInt(10), 1), Result: ternary.TRUE},
+		{Value: NewDecimal(big.NewInt(0), 2), Result: ternary.FALSE},
+		{Value: NewDecimal(big.NewInt(15), 1), Result: ternary.UNKNOWN},
+	} {
+		if v.Value.Ternary() != v.Result {
+			t.Errorf("ternary = %s, want %s for %s", v.Value.Ternary(), v.Result, v.Value)
+		}
+	}
+}
+
+var decimalArithmeticTests = []struct {
+	LHS      string
+	RHS      string
+	Operator byte
+	Result   string
+	OK       bool
+}{
+	{LHS: "0.1", RHS: "0.2", Operator: '+', Result: "0.3", OK: true},
+	{LHS: "1.10", RHS: "0.1", Operator: '-', Result: "1.00", OK: true},
+	{LHS: "1.5", RHS: "-0.25", Operator: '*', Result: "-0.375", OK: true},
+	{LHS: "10", RHS: "4", Operator: '/', Result: "2.5", OK: true},
+	{LHS: "1.00", RHS: "4", Operator: '/', Result: "0.25", OK: true},
+	{LHS: "1.000", RHS: "2", Operator: '/', Result: "0.500", OK: true},
+	{LHS: "2", RHS: "3", Operator: '/', Result: "0.6666666666666667", OK: true},
+	{LHS: "-2", RHS: "3", Operator: '/', Result: "-0.6666666666666667", OK: true},
+	{LHS: "1", RHS: "0", Operator: '/', OK: false},
+	{LHS: "5.5", RHS: "2", Operator: '%', Result: "1.5", OK: true},
+	{LHS: "-5.5", RHS: "2", Operator: '%', Result: "-1.5", OK: true},
+	{LHS: "5.5", RHS: "0.0", Operator: '%', OK: false},
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	for _, v := range decimalArithmeticTests {
+		d1, _ := ParseDecimal(v.LHS)
+		d2, _ := ParseDecimal(v.RHS)
+
+		var result *Decimal
+		ok := true
+		switch v.Operator {
+		case '+':
+			result = d1.Add(d2)
+		case '-':
+			result = d1.Sub(d2)
+		case '*':
+			result = d1.Mul(d2)
+		case '/':
+			result, ok = d1.Quo(d2)
+		case '%':
+			result, ok = d1.Rem(d2)
+		}
+
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %s %c %s", ok, v.OK, v.LHS, v.Operator, v.RHS)
+			continue
+		}
+		if ok && result.String() != v.Result {
+			t.Errorf("result = %s, want %s for %s %c %s", result.String(), v.Result, v.LHS, v.Operator, v.RHS)
+		}
+	}
+}
+
+var decimalRoundTests = []struct {
+	Value  string
+	Place  int
+	Result string
+}{
+	{Value: "2.345", Place: 2, Result: "2.35"},
+	{Value: "-2.345", Place: 2, Result: "-2.35"},
+	{Value: "2.344", Place: 2, Result: "2.34"},
+	{Value: "2.5", Place: 0, Result: "3"},
+	{Value: "2.5", Place: 3, Result: "2.5"},
+	{Value: "1250", Place: -2, Result: "1300"},
+}
+
+func TestDecimal_Round(t *testing.T) {
+	for _, v := range decimalRoundTests {
+		d, _ := ParseDecimal(v.Value)
+		result := d.Round(v.Place)
+		if result.String() != v.Result {
+			t.Errorf("result = %s, want %s for round(%s, %d)", result.String(), v.Result, v.Value, v.Place)
+		}
+	}
+}
+
+func TestDecimal_SetScale(t *testing.T) {
+	d, _ := ParseDecimal("1.5")
+
+	if r := d.SetScale(3).String(); r != "1.500" {
+		t.Errorf("result = %s, want %s", r, "1.500")
+	}
+	if r := d.SetScale(0).String(); r != "2" {
+		t.Errorf("result = %s, want %s", r, "2")
+	}
+}
+
+func TestDecimal_Normalize(t *testing.T) {
+	d, _ := ParseDecimal("1.500")
+
+	if r := d.Normalize().String(); r != "1.5" {
+		t.Errorf("result = %s, want %s", r, "1.5")
+	}
+}
+
+func TestDecimal_Int64(t *testing.T) {
+	d, _ := ParseDecimal("12.00")
+	if i, ok := d.Int64(); !ok || i != 12 {
+		t.Errorf("result = %d, %t, want %d, %t", i, ok, 12, true)
+	}
+
+	d, _ = ParseDecimal("12.5")
+	if _, ok := d.Int64(); ok {
+		t.Errorf("ok = %t, want %t", ok, false)
+	}
+
+	d, _ = ParseDecimal("9223372036854775808")
+	if _, ok := d.Int64(); ok {
+		t.Errorf("ok = %t, want %t", ok, false)
+	}
+}
@@ -0,0 +1,325 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/cmd"
+
+	"github.com/mithrandie/ternary"
+)
+
+const nanosPerDay = int64(24 * time.Hour)
+
+// daysPerMonth is the number of days used to compare months with days.
+const daysPerMonth = 30
+
+var iso8601DurationExp = regexp.MustCompile(`^([+-])?P(?:([+-]?\d+)Y)?(?:([+-]?\d+)M)?(?:([+-]?\d+)W)?(?:([+-]?\d+)D)?(?:T(?:([+-]?\d+)H)?(?:([+-]?\d+)M)?(?:([+-]?\d+(?:[.,]\d{1,9})?)S)?)?$`)
+
+// Interval is a period of time represented by months, days and nanoseconds.
+// Months and days are kept separately from nanoseconds because their lengths
+// vary with datetimes.
+type Interval struct {
+	months int64
+	days   int64
+	nanos  int64
+}
+
+func NewInterval(months int64, days int64, nanos int64) *Interval {
+	return &Interval{
+		months: months,
+		days:   days,
+		nanos:  nanos,
+	}
+}
+
+func NewIntervalFromDuration(d time.Duration) *Interval {
+	return NewInterval(0, 0, int64(d))
+}
+
+// NewIntervalFromUnit returns the interval of the amount of the unit.
+// Amounts for YEAR, MONTH, WEEK and DAY must be integers.
+func NewIntervalFromUnit(amount float64, unit string) (*Interval, error) {
+	unitName := strings.ToUpper(unit)
+
+	var d time.Duration
+	switch unitName {
+	case "YEAR", "MONTH", "WEEK", "DAY":
+		if amount != math.Trunc(amount) {
+			return nil, errors.New(fmt.Sprintf("the amount of interval for %s must be an integer", unitName))
+		}
+		n := int64(amount)
+		switch unitName {
+		case "YEAR":
+			return NewInterval(n*12, 0, 0), nil
+		case "MONTH":
+			return NewInterval(n, 0, 0), nil
+		case "WEEK":
+			return NewInterval(0, n*7, 0), nil
+		default:
+			return NewInterval(0, n, 0), nil
+		}
+	case "HOUR":
+		d = time.Hour
+	case "MINUTE":
+		d = time.Minute
+	case "SECOND":
+		d = time.Second
+	case "MILLISECOND":
+		d = time.Millisecond
+	case "MICROSECOND":
+		d = time.Microsecond
+	case "NANOSECOND":
+		d = time.Nanosecond
+	default:
+		return nil, errors.New(fmt.Sprintf("%s is an unknown interval unit", unit))
+	}
+	return NewInterval(0, 0, int64(amount*float64(d))), nil
+}
+
+// ParseInterval parses an ISO 8601 duration such as "P1DT2H" or a duration
+// string such as "1h30m".
+func ParseInterval(s string) (*Interval, bool) {
+	s = cmd.TrimSpace(s)
+	if len(s) < 1 {
+		return nil, false
+	}
+
+	if iv, ok := parseISO8601Duration(s); ok {
+		return iv, true
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, false
+	}
+	return NewIntervalFromDuration(d), true
+}
+
+func parseISO8601Duration(s string) (*Interval, bool) {
+	m := iso8601DurationExp.FindStringSubmatch(strings.ToUpper(s))
+	if m == nil {
+		return nil, false
+	}
+
+	hasComponent := false
+	component := func(idx int) int64 {
+		if len(m[idx]) < 1 {
+			return 0
+		}
+		hasComponent = true
+		i, _ := strconv.ParseInt(m[idx], 10, 64)
+		return i
+	}
+
+	months := component(2)*12 + component(3)
+	days := component(4)*7 + component(5)
+	nanos := component(6)*int64(time.Hour) + component(7)*int64(time.Minute)
+	if 0 < len(m[8]) {
+		hasComponent = true
+		sec := strings.Replace(m[8], ",", ".", 1)
+		frac := ""
+		if idx := strings.IndexByte(sec, '.'); -1 < idx {
+			frac = sec[idx+1:]
+			sec = sec[:idx]
+		}
+		i, _ := strconv.ParseInt(sec, 10, 64)
+		f, _ := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64)
+		if strings.HasPrefix(sec, "-") {
+			f = -f
+		}
+		nanos += i*int64(time.Second) + f
+	}
+
+	if !hasComponent {
+		return nil, false
+	}
+
+	iv := NewInterval(months, days, nanos)
+	if m[1] == "-" {
+		iv = iv.Neg()
+	}
+	return iv, true
+}
+
+// String returns the interval formatted as an ISO 8601 duration.
+func (iv Interval) String() string {
+	if iv.months == 0 && iv.days == 0 && iv.nanos == 0 {
+		return "PT0S"
+	}
+
+	sign := ""
+	if iv.months <= 0 && iv.days <= 0 && iv.nanos <= 0 {
+		sign = "-"
+		iv = *iv.Neg()
+	}
+
+	var buf strings.Builder
+	buf.WriteString(sign)
+	buf.WriteByte('P')
+
+	if y := iv.months / 12; y != 0 {
+		buf.WriteString(strconv.FormatInt(y, 10) + "Y")
+	}
+	if m := iv.months % 12; m != 0 {
+		buf.WriteString(strconv.FormatInt(m, 10) + "M")
+	}
+	if iv.days != 0 {
+		buf.WriteString(strconv.FormatInt(iv.days, 10) + "D")
+	}
+
+	if iv.nanos != 0 {
+		buf.WriteByte('T')
+		if h := iv.nanos / int64(time.Hour); h != 0 {
+			buf.WriteString(strconv.FormatInt(h, 10) + "H")
+		}
+		if m := iv.nanos % int64(time.Hour) / int64(time.Minute); m != 0 {
+			buf.WriteString(strconv.FormatInt(m, 10) + "M")
+		}
+		if ns := iv.nanos % int64(time.Minute); ns != 0 {
+			sec := strconv.FormatInt(ns/int64(time.Second), 10)
+			if ns < 0 && -int64(time.Second) < ns {
+				sec = "-" + sec
+			}
+			if frac := ns % int64(time.Second); frac != 0 {
+				if frac < 0 {
+					frac = -frac
+				}
+				sec = sec + "." + strings.TrimRight(fmt.Sprintf("%09d", frac), "0")
+			}
+			buf.WriteString(sec + "S")
+		}
+	}
+
+	return buf.String()
+}
+
+func (iv Interval) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+func (iv Interval) Months() int64 {
+	return iv.months
+}
+
+func (iv Interval) Days() int64 {
+	return iv.days
+}
+
+func (iv Interval) Nanoseconds() int64 {
+	return iv.nanos
+}
+
+func (iv Interval) Neg() *Interval {
+	return NewInterval(-iv.months, -iv.days, -iv.nanos)
+}
+
+func (iv Interval) Add(iv2 *Interval) *Interval {
+	return NewInterval(iv.months+iv2.months, iv.days+iv2.days, iv.nanos+iv2.nanos)
+}
+
+func (iv Interval) Sub(iv2 *Interval) *Interval {
+	return NewInterval(iv.months-iv2.months, iv.days-iv2.days, iv.nanos-iv2.nanos)
+}
+
+func (iv Interval) Mul(n int64) *Interval {
+	return NewInterval(iv.months*n, iv.days*n, iv.nanos*n)
+}
+
+// AddTo returns the datetime shifted by the interval.
+// Months and days are added as calendar units, and then nanoseconds are added.
+func (iv Interval) AddTo(t time.Time) time.Time {
+	if iv.months != 0 || iv.days != 0 {
+		t = t.AddDate(0, int(iv.months), int(iv.days))
+	}
+	return t.Add(time.Duration(iv.nanos))
+}
+
+// Normalize returns the number of days and the remaining nanoseconds
+// assuming that a month is 30 days and a day is 24 hours.
+func (iv Interval) Normalize() (int64, int64) {
+	days := iv.months*daysPerMonth + iv.days + iv.nanos/nanosPerDay
+	nanos := iv.nanos % nanosPerDay
+	if nanos < 0 {
+		days--
+		nanos += nanosPerDay
+	}
+	return days, nanos
+}
+
+// Cmp compares the intervals assuming that a month is 30 days and a day is 24 hours.
+func (iv Interval) Cmp(iv2 *Interval) int {
+	d1, n1 := iv.Normalize()
+	d2, n2 := iv2.Normalize()
+
+	switch {
+	case d1 < d2:
+		return -1
+	case d2 < d1:
+		return 1
+	case n1 < n2:
+		return -1
+	case n2 < n1:
+		return 1
+	}
+	return 0
+}
+
+// IsValidBucketWidth returns whether the interval can be used as the width of
+// time buckets. The width must be positive, and months cannot be mixed with
+// days or nanoseconds.
+func (iv Interval) IsValidBucketWidth() bool {
+	if iv.months < 0 || iv.days < 0 || iv.nanos < 0 {
+		return false
+	}
+	if iv.months != 0 {
+		return iv.days == 0 && iv.nanos == 0
+	}
+	return iv.days != 0 || iv.nanos != 0
+}
+
+// Bucket returns the start of the bucket that contains the datetime.
+// Buckets are aligned to the origin, and the result is in the location of the datetime.
+// Months and days are treated as calendar units, so buckets of them are not
+// affected by daylight saving time.
+func (iv Interval) Bucket(t time.Time, origin time.Time) time.Time {
+	loc := t.Location()
+	o := origin.In(loc)
+
+	switch {
+	case iv.months != 0:
+		diff := int64(t.Year()-o.Year())*12 + int64(t.Month()-o.Month())
+		k := floorDiv(diff, iv.months)
+		b := o.AddDate(0, int(k*iv.months), 0)
+		if t.Before(b) {
+			b = o.AddDate(0, int((k-1)*iv.months), 0)
+		}
+		return b
+	case iv.nanos == 0:
+		d1 := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		d0 := time.Date(o.Year(), o.Month(), o.Day(), 0, 0, 0, 0, time.UTC)
+		k := floorDiv(int64(d1.Sub(d0)/(24*time.Hour)), iv.days)
+		b := o.AddDate(0, 0, int(k*iv.days))
+		if t.Before(b) {
+			b = o.AddDate(0, 0, int((k-1)*iv.days))
+		}
+		return b
+	default:
+		width := iv.days*nanosPerDay + iv.nanos
+		k := floorDiv(int64(t.Sub(o)), width)
+		return o.Add(time.Duration(k * width))
+	}
+}
+
+func floorDiv(a int64, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
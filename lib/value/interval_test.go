@@ -0,0 +1,214 @@
+package value
+
+import (
+	"testing"
+	"time"
+)
+
+var parseIntervalTests = []struct {
+	Input  string
+	Result string
+	OK     bool
+}{
+	{Input: "P1Y2M3DT4H5M6.5S", Result: "P1Y2M3DT4H5M6.5S", OK: true},
+	{Input: "p2w", Result: "P14D", OK: true},
+	{Input: "-P1DT12H", Result: "-P1DT12H", OK: true},
+	{Input: "PT-0.25S", Result: "-PT0.25S", OK: true},
+	{Input: "P1MT-1H", Result: "P1MT-1H", OK: true},
+	{Input: "1h30m", Result: "PT1H30M", OK: true},
+	{Input: "-90s", Result: "-PT1M30S", OK: true},
+	{Input: "0s", Result: "PT0S", OK: true},
+	{Input: "P", OK: false},
+	{Input: "PT", OK: false},
+	{Input: "1 day", OK: false},
+	{Input: "", OK: false},
+}
+
+func TestParseInterval(t *testing.T) {
+	for _, v := range parseIntervalTests {
+		iv, ok := ParseInterval(v.Input)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Input)
+			continue
+		}
+		if ok && iv.String() != v.Result {
+			t.Errorf("result = %s, want %s for %q", iv.String(), v.Result, v.Input)
+		}
+	}
+}
+
+var newIntervalFromUnitTests = []struct {
+	Amount float64
+	Unit   string
+	Result string
+	Error  string
+}{
+	{Amount: 2, Unit: "year", Result: "P2Y"},
+	{Amount: 14, Unit: "MONTH", Result: "P1Y2M"},
+	{Amount: 1, Unit: "WEEK", Result: "P7D"},
+	{Amount: -3, Unit: "DAY", Result: "-P3D"},
+	{Amount: 1.5, Unit: "HOUR", Result: "PT1H30M"},
+	{Amount: 90, Unit: "MINUTE", Result: "PT1H30M"},
+	{Amount: 1500, Unit: "MILLISECOND", Result: "PT1.5S"},
+	{Amount: 1.5, Unit: "DAY", Error: "the amount of interval for DAY must be an integer"},
+	{Amount: 1, Unit: "fortnight", Error: "fortnight is an unknown interval unit"},
+}
+
+func TestNewIntervalFromUnit(t *testing.T) {
+	for _, v := range newIntervalFromUnitTests {
+		iv, err := NewIntervalFromUnit(v.Amount, v.Unit)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %v %s", err, v.Amount, v.Unit)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %v %s", err, v.Error, v.Amount, v.Unit)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %v %s", v.Error, v.Amount, v.Unit)
+			continue
+		}
+		if iv.String() != v.Result {
+			t.Errorf("result = %s, want %s for %v %s", iv.String(), v.Result, v.Amount, v.Unit)
+		}
+	}
+}
+
+func TestInterval_AddTo(t *testing.T) {
+	loc := time.UTC
+	for _, v := range []struct {
+		Interval *Interval
+		Time     time.Time
+		Result   time.Time
+	}{
+		{
+			Interval: NewInterval(1, 0, 0),
+			Time:     time.Date(2024, 1, 31, 0, 0, 0, 0, loc),
+			Result:   time.Date(2024, 3, 2, 0, 0, 0, 0, loc),
+		},
+		{
+			Interval: NewInterval(0, 1, int64(90*time.Minute)),
+			Time:     time.Date(2024, 2, 28, 23, 0, 0, 0, loc),
+			Result:   time.Date(2024, 3, 1, 0, 30, 0, 0, loc),
+		},
+		{
+			Interval: NewInterval(-12, 0, 0),
+			Time:     time.Date(2024, 2, 29, 0, 0, 0, 0, loc),
+			Result:   time.Date(2023, 3, 1, 0, 0, 0, 0, loc),
+		},
+	} {
+		if r := v.Interval.AddTo(v.Time); !r.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for %s + %s", r, v.Result, v.Time, v.Interval)
+		}
+	}
+}
+
+func TestInterval_IsValidBucketWidth(t *testing.T) {
+	for _, v := range []struct {
+		Interval *Interval
+		Result   bool
+	}{
+		{Interval: NewInterval(3, 0, 0), Result: true},
+		{Interval: NewInterval(0, 1, int64(time.Hour)), Result: true},
+		{Interval: NewInterval(0, 0, 0), Result: false},
+		{Interval: NewInterval(0, 0, -int64(time.Hour)), Result: false},
+		{Interval: NewInterval(1, 1, 0), Result: false},
+	} {
+		if r := v.Interval.IsValidBucketWidth(); r != v.Result {
+			t.Errorf("result = %t, want %t for %s", r, v.Result, v.Interval)
+		}
+	}
+}
+
+func TestInterval_Bucket(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	origin := time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	for _, v := range []struct {
+		Interval *Interval
+		Time     time.Time
+		Origin   time.Time
+		Result   time.Time
+	}{
+		{
+			Interval: NewInterval(0, 0, int64(5*time.Minute)),
+			Time:     time.Date(2024, 1, 1, 10, 37, 12, 0, time.UTC),
+			Origin:   origin,
+			Result:   time.Date(2024, 1, 1, 10, 35, 0, 0, time.UTC),
+		},
+		{
+			Interval: NewInterval(0, 0, int64(time.Hour)),
+			Time:     time.Date(1999, 12, 31, 23, 10, 0, 0, time.UTC),
+			Origin:   origin,
+			Result:   time.Date(1999, 12, 31, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			Interval: NewInterval(0, 7, 0),
+			Time:     time.Date(2024, 5, 22, 10, 0, 0, 0, time.UTC),
+			Origin:   origin,
+			Result:   time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Interval: NewInterval(0, 1, 0),
+			Time:     time.Date(2024, 3, 10, 12, 0, 0, 0, ny),
+			Origin:   time.Date(2000, 1, 3, 0, 0, 0, 0, ny),
+			Result:   time.Date(2024, 3, 10, 0, 0, 0, 0, ny),
+		},
+		{
+			Interval: NewInterval(2, 0, 0),
+			Time:     time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+			Origin:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Result:   time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Interval: NewInterval(1, 0, 0),
+			Time:     time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+			Origin:   time.Date(2000, 1, 15, 0, 0, 0, 0, time.UTC),
+			Result:   time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Interval: NewInterval(12, 0, 0),
+			Time:     time.Date(1990, 5, 10, 0, 0, 0, 0, time.UTC),
+			Origin:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Result:   time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		if r := v.Interval.Bucket(v.Time, v.Origin); !r.Equal(v.Result) {
+			t.Errorf("result = %s, want %s for the bucket of %s in %s", r, v.Result, v.Time, v.Interval)
+		}
+	}
+}
+
+func TestInterval_Cmp(t *testing.T) {
+	for _, v := range []struct {
+		LHS    *Interval
+		RHS    *Interval
+		Result int
+	}{
+		{LHS: NewInterval(1, 0, 0), RHS: NewInterval(0, 30, 0), Result: 0},
+		{LHS: NewInterval(0, 1, 0), RHS: NewInterval(0, 0, int64(24*time.Hour)), Result: 0},
+		{LHS: NewInterval(0, 0, int64(90*time.Minute)), RHS: NewInterval(0, 0, int64(time.Hour)), Result: 1},
+		{LHS: NewInterval(0, 0, -1), RHS: NewInterval(0, 0, 0), Result: -1},
+		{LHS: NewInterval(0, 1, -1), RHS: NewInterval(0, 0, int64(25*time.Hour)), Result: -1},
+	} {
+		if r := v.LHS.Cmp(v.RHS); r != v.Result {
+			t.Errorf("result = %d, want %d for %s and %s", r, v.Result, v.LHS, v.RHS)
+		}
+	}
+}
+
+func TestInterval_Arithmetic(t *testing.T) {
+	iv1 := NewInterval(1, 2, int64(time.Hour))
+	iv2 := NewInterval(0, 3, int64(2*time.Hour))
+
+	if r := iv1.Add(iv2).String(); r != "P1M5DT3H" {
+		t.Errorf("add = %s, want %s", r, "P1M5DT3H")
+	}
+	if r := iv1.Sub(iv2).String(); r != "P1M-1DT-1H" {
+		t.Errorf("sub = %s, want %s", r, "P1M-1DT-1H")
+	}
+	if r := iv1.Mul(-2).String(); r != "-P2M4DT2H" {
+		t.Errorf("mul = %s, want %s", r, "-P2M4DT2H")
+	}
+}
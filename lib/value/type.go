@@ -2,6 +2,7 @@ package value
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -217,6 +218,49 @@ func (dt Datetime) Format(s string) string {
 	return dt.value.Format(s)
 }
 
+type Array struct {
+	values []Primary
+}
+
+func NewArray(values []Primary) *Array {
+	return &Array{
+		values: values,
+	}
+}
+
+func (a Array) String() string {
+	s := make([]string, len(a.values))
+	for i, v := range a.values {
+		s[i] = v.String()
+	}
+	return "ARRAY[" + strings.Join(s, ", ") + "]"
+}
+
+func (a Array) Raw() []Primary {
+	return a.values
+}
+
+func (a Array) Len() int {
+	return len(a.values)
+}
+
+// Index returns the element at the one-based position.
+// A negative position is counted from the end of the array.
+// If the position is out of range, then returns a null.
+func (a Array) Index(i int64) Primary {
+	if i < 0 {
+		i = int64(len(a.values)) + i + 1
+	}
+	if i < 1 || int64(len(a.values)) < i {
+		return NewNull()
+	}
+	return a.values[i-1]
+}
+
+func (a Array) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
 type Null struct{}
 
 func NewNull() *Null {
@@ -1,6 +1,7 @@
 package value
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -253,6 +254,43 @@ func TestDatetime_Format(t *testing.T) {
 	}
 }
 
+func TestArray_String(t *testing.T) {
+	p := NewArray([]Primary{NewInteger(1), NewString("a"), NewNull()})
+	expect := "ARRAY[1, 'a', NULL]"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+}
+
+func TestArray_Index(t *testing.T) {
+	p := NewArray([]Primary{NewInteger(1), NewInteger(2), NewInteger(3)})
+
+	for _, v := range []struct {
+		Index  int64
+		Result Primary
+	}{
+		{Index: 1, Result: NewInteger(1)},
+		{Index: 3, Result: NewInteger(3)},
+		{Index: -1, Result: NewInteger(3)},
+		{Index: -3, Result: NewInteger(1)},
+		{Index: 0, Result: NewNull()},
+		{Index: 4, Result: NewNull()},
+		{Index: -4, Result: NewNull()},
+	} {
+		r := p.Index(v.Index)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("result = %s, want %s for index %d", r, v.Result, v.Index)
+		}
+	}
+}
+
+func TestArray_Ternary(t *testing.T) {
+	p := NewArray([]Primary{NewTernary(ternary.TRUE)})
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %#v", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
 func TestNull_String(t *testing.T) {
 	p := NewNull()
 	if p.String() != "NULL" {
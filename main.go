@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/action"
 	"github.com/mithrandie/csvq/lib/cmd"
@@ -49,6 +50,10 @@ func main() {
 			Name:  "ansi-quotes, k",
 			Usage: "use double quotation mark as identifier enclosure",
 		},
+		cli.BoolFlag{
+			Name:  "exact-decimal",
+			Usage: "calculate numbers as exact decimals",
+		},
 		cli.Float64Flag{
 			Name:  "wait-timeout, w",
 			Value: 10,
@@ -129,6 +134,10 @@ func main() {
 			Name:  "enclose-all, Q",
 			Usage: "enclose all string values in CSV and TSV",
 		},
+		cli.StringFlag{
+			Name:  "array-separator",
+			Usage: "separator of array elements in CSV, TSV, FIXED and LTSV",
+		},
 		cli.StringFlag{
 			Name:  "json-escape, J",
 			Value: "BACKSLASH",
@@ -172,6 +181,10 @@ func main() {
 			Name:  "stats, x",
 			Usage: "show execution time and memory statistics",
 		},
+		cli.BoolFlag{
+			Name:  "streaming",
+			Usage: "process simple select queries on large files without loading whole files",
+		},
 	}
 
 	app.Commands = []cli.Command{
@@ -233,6 +246,68 @@ func main() {
 				return action.CheckUpdate(includePreRelease)
 			}),
 		},
+		{
+			Name:      "serve",
+			Usage:     "Run a server that accepts connections from PostgreSQL clients",
+			ArgsUsage: " ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "listen, l",
+					Value: "127.0.0.1:5432",
+					Usage: "`ADDRESS` to listen on",
+				},
+				cli.StringFlag{
+					Name:   "password",
+					Usage:  "`PASSWORD` required from clients that are not connecting from trusted hosts",
+					EnvVar: "CSVQ_SERVE_PASSWORD",
+				},
+				cli.StringFlag{
+					Name:  "trusted-hosts",
+					Value: "127.0.0.1,::1",
+					Usage: "comma-separated IP addresses or CIDR notations of `HOSTS` from which clients are accepted without passwords",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 0 < c.NArg() {
+					return query.NewIncorrectCommandUsageError("serve subcommand takes no argument")
+				}
+
+				return action.Serve(ctx, proc, c.String("listen"), c.String("password"), c.String("trusted-hosts"), initializeServerProcessor(c))
+			}),
+		},
+		{
+			Name:      "http",
+			Usage:     "Run a server that accepts queries over HTTP",
+			ArgsUsage: " ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "listen, l",
+					Value: "127.0.0.1:8080",
+					Usage: "`ADDRESS` to listen on",
+				},
+				cli.Float64Flag{
+					Name:  "timeout",
+					Value: 30,
+					Usage: "limit of the execution time in seconds for each request, 0 means no limit",
+				},
+				cli.StringFlag{
+					Name:   "token",
+					Usage:  "`TOKEN` required in the Authorization header of each request as a bearer token",
+					EnvVar: "CSVQ_HTTP_TOKEN",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 0 < c.NArg() {
+					return query.NewIncorrectCommandUsageError("http subcommand takes no argument")
+				}
+				if c.Float64("timeout") < 0 {
+					return query.NewIncorrectCommandUsageError("timeout must be a non-negative number")
+				}
+
+				timeout := time.Duration(c.Float64("timeout") * float64(time.Second))
+				return action.ServeHTTP(ctx, proc, c.String("listen"), timeout, c.String("token"), initializeServerProcessor(c))
+			}),
+		},
 	}
 
 	for i := range app.Commands {
@@ -329,6 +404,17 @@ func commandAction(fn func(ctx context.Context, c *cli.Context, proc *query.Proc
 	}
 }
 
+// initializeServerProcessor returns a function to initialize processors for the clients of servers
+// in the same way as the processor of the command.
+func initializeServerProcessor(c *cli.Context) func(context.Context, *query.Processor) error {
+	return func(ctx context.Context, proc *query.Processor) error {
+		if err := runPreloadCommands(ctx, proc); err != nil {
+			return err
+		}
+		return overwriteFlags(c, proc.Tx)
+	}
+}
+
 func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("repository") {
 		if err := tx.SetFlag(cmd.RepositoryFlag, c.GlobalString("repository")); err != nil {
@@ -346,6 +432,9 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("ansi-quotes") {
 		_ = tx.SetFlag(cmd.AnsiQuotesFlag, c.GlobalBool("ansi-quotes"))
 	}
+	if c.GlobalIsSet("exact-decimal") {
+		_ = tx.SetFlag(cmd.ExactDecimalFlag, c.GlobalBool("exact-decimal"))
+	}
 
 	if c.GlobalIsSet("wait-timeout") {
 		_ = tx.SetFlag(cmd.WaitTimeoutFlag, c.GlobalFloat64("wait-timeout"))
@@ -418,6 +507,9 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("enclose-all") {
 		_ = tx.SetFlag(cmd.EncloseAllFlag, c.GlobalBool("enclose-all"))
 	}
+	if c.GlobalIsSet("array-separator") {
+		_ = tx.SetFlag(cmd.ArraySeparatorFlag, c.GlobalString("array-separator"))
+	}
 	if c.GlobalIsSet("json-escape") {
 		if err := tx.SetFlag(cmd.JsonEscapeFlag, c.GlobalString("json-escape")); err != nil {
 			return query.NewIncorrectCommandUsageError(err.Error())
@@ -449,6 +541,9 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.GlobalIsSet("stats") {
 		_ = tx.SetFlag(cmd.StatsFlag, c.GlobalBool("stats"))
 	}
+	if c.GlobalIsSet("streaming") {
+		_ = tx.SetFlag(cmd.StreamingFlag, c.GlobalBool("streaming"))
+	}
 
 	return nil
 }